@@ -1,23 +1,97 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net/http"
+	"os"
+	"strconv"
 	"time"
+	// База часовых поясов встроена в бинарник: в runtime образе alpine ее нет
+	_ "time/tzdata"
 
 	"crud/config"
 	"crud/internal/application"
+	trash_usecases "crud/internal/application/trash/usecases"
+	users_usecases "crud/internal/application/users/usecases"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/internal/infrastructure/database/migrations"
 	v1 "crud/internal/presentation/api/v1"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/dig"
 )
 
 func main() {
 	// Инициализируем контейнер зависимостей
 	container := application.InitContainer()
 
+	// Получаем конфиг
+	cfg, err := application.ResolveFromContainer[*config.Config](container)
+	if err != nil {
+		log.Fatalf("Failed to get config: %v", err)
+	}
+
+	// Подкоманда migrate управляет схемой базы и завершает процесс, не запуская сервер
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if cfg.DBDriver == config.DBDriverMemory {
+			log.Fatalf("Migration failed: DB_DRIVER=memory has no database schema")
+		}
+		if err := runMigrate(container, os.Args[2:]); err != nil {
+			log.Fatalf("Migration failed: %v", err)
+		}
+		return
+	}
+
+	// Приводим схему базы к версии приложения и не запускаемся на схеме новее, чем известно приложению.
+	// In-memory хранилищу схема не нужна
+	if cfg.DBDriver != config.DBDriverMemory {
+		migrator, err := application.ResolveFromContainer[*migrations.Migrator](container)
+		if err != nil {
+			log.Fatalf("Failed to resolve migrator: %v", err)
+		}
+		if cfg.MigrateOnStart {
+			applied, err := migrator.Up(context.Background())
+			if err != nil {
+				log.Fatalf("Failed to run migrations: %v", err)
+			}
+			for _, migration := range applied {
+				log.Printf("Applied migration %d_%s", migration.Version, migration.Name)
+			}
+		}
+		if err := migrator.Check(context.Background()); err != nil {
+			log.Fatalf("Database schema check failed: %v", err)
+		}
+	}
+
+	// Загружаем рабочий процесс и иерархию задач: ошибка в их настройке должна останавливать запуск
+	if _, err := application.ResolveFromContainer[*tasks_domain.Workflow](container); err != nil {
+		log.Fatalf("Failed to load task workflow: %v", err)
+	}
+	if _, err := application.ResolveFromContainer[*tasks_domain.Hierarchy](container); err != nil {
+		log.Fatalf("Failed to configure task hierarchy: %v", err)
+	}
+
+	// Создаем администратора, если он задан в конфиге и еще не существует
+	ensureAdmin, err := application.ResolveFromContainer[*users_usecases.EnsureAdminUseCase](container)
+	if err != nil {
+		log.Fatalf("Failed to resolve admin bootstrap: %v", err)
+	}
+	if _, err := ensureAdmin.Execute(context.Background()); err != nil {
+		log.Fatalf("Failed to ensure admin user: %v", err)
+	}
+
+	// Запускаем очистку корзины от записей старше TRASH_RETENTION
+	purgeExpiredTrash, err := application.ResolveFromContainer[*trash_usecases.PurgeExpiredTrashUseCase](container)
+	if err != nil {
+		log.Fatalf("Failed to resolve trash retention: %v", err)
+	}
+	if cfg.TrashRetention > 0 && cfg.TrashPurgeInterval > 0 {
+		go runTrashRetention(context.Background(), purgeExpiredTrash, cfg.TrashPurgeInterval)
+	}
+
 	// Создаем chi роутер
 	r := chi.NewRouter()
 
@@ -41,15 +115,88 @@ func main() {
 		w.Write([]byte("OK"))
 	})
 
-	// Получаем конфиг для порта
-	cfg, err := application.ResolveFromContainer[*config.Config](container)
-	if err != nil {
-		log.Fatalf("Failed to get config: %v", err)
-	}
-
 	// Запускаем сервер
 	log.Printf("Server starting on port %d", cfg.APIPort)
 	if err := http.ListenAndServe(fmt.Sprintf(":%d", cfg.APIPort), r); err != nil {
 		log.Fatalf("Server failed to start: %v", err)
 	}
 }
+
+// runMigrate выполняет подкоманду migrate: up применяет все новые миграции,
+// down [N] откатывает N последних (по умолчанию одну), status печатает состояние миграций
+func runMigrate(container *dig.Container, args []string) error {
+	migrator, err := application.ResolveFromContainer[*migrations.Migrator](container)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if len(args) == 0 {
+		return fmt.Errorf("usage: migrate up|down [steps]|status")
+	}
+
+	switch args[0] {
+	case "up":
+		applied, err := migrator.Up(ctx)
+		for _, migration := range applied {
+			log.Printf("Applied migration %d_%s", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			log.Printf("Database schema is up to date")
+		}
+		return err
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps <= 0 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		reverted, err := migrator.Down(ctx, steps)
+		for _, migration := range reverted {
+			log.Printf("Reverted migration %d_%s", migration.Version, migration.Name)
+		}
+		return err
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, status := range statuses {
+			state := "pending"
+			if status.AppliedAt != nil {
+				state = "applied " + status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Printf("%04d_%s\t%s\n", status.Version, status.Name, state)
+		}
+		// Миграции новее приложения в списке не видны, о них сообщает проверка схемы
+		if err := migrator.Check(ctx); migrations.IsSchemaTooNew(err) {
+			return err
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown migrate command %q, expected up, down or status", args[0])
+	}
+}
+
+// runTrashRetention периодически окончательно удаляет устаревшие записи корзины.
+// Ошибка очистки не останавливает сервер: следующая попытка будет через interval
+func runTrashRetention(ctx context.Context, useCase *trash_usecases.PurgeExpiredTrashUseCase, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, err := useCase.Execute(ctx, time.Now())
+		if err != nil {
+			log.Printf("Trash retention failed: %v", err)
+		} else if result.Tasks > 0 || result.Users > 0 {
+			log.Printf("Trash retention purged %d tasks and %d users, skipped %d", result.Tasks, result.Users, result.Skipped)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
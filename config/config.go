@@ -4,35 +4,119 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Хранилища данных, которые выбирает DB_DRIVER
+const (
+	DBDriverPostgres = "postgres"
+	DBDriverSQLite   = "sqlite"
+	DBDriverMemory   = "memory"
+)
+
 type Config struct {
 	APIPort int
 
+	DBDriver   string
+	SQLitePath string
+
 	PostgresDB       string
 	PostgresUser     string
 	PostgresPassword string
 	PostgresHost     string
 	PostgresPort     int
+
+	MigrateOnStart bool
+
+	JWTSecret          string
+	JWTIssuer          string
+	JWTAccessTokenTTL  time.Duration
+	JWTRefreshTokenTTL time.Duration
+	BcryptCost         int
+
+	AdminEmail    string
+	AdminName     string
+	AdminPassword string
+
+	UserDeletionPolicy string
+
+	TaskWorkflowPath string
+	TaskMaxDepth     int
+
+	AttachmentsStoragePath string
+	AttachmentMaxSize      int64
+	AttachmentAllowedTypes []string
+
+	TrashRetention     time.Duration
+	TrashPurgeInterval time.Duration
+}
+
+// MinJWTSecretLength минимальная длина JWT_SECRET в байтах: ключ HS256 короче 256 бит можно подобрать
+const MinJWTSecretLength = 32
+
+// NewConfig загружает конфиг из окружения и проверяет его. Без JWT_SECRET приложение не запускается:
+// известный всем ключ по умолчанию позволил бы подделать токен с любой ролью
+func NewConfig() (*Config, error) {
+	cfg := Load()
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
 }
 
-func NewConfig() *Config {
+// Load загружает конфиг из окружения и файла .env без проверки обязательных значений
+func Load() *Config {
 	_ = godotenv.Load()
 
 	cfg := &Config{
-		APIPort:          getEnvAsInt("API_PORT", 8000),
-		PostgresDB:       getEnv("POSTGRES_DB", "tasks"),
-		PostgresUser:     getEnv("POSTGRES_USER", "postgres"),
-		PostgresPassword: getEnv("POSTGRES_PASSWORD", "postgres"),
-		PostgresHost:     getEnv("POSTGRES_HOST", "postgres"),
-		PostgresPort:     getEnvAsInt("POSTGRES_PORT", 5432),
+		APIPort:            getEnvAsInt("API_PORT", 8000),
+		DBDriver:           getEnv("DB_DRIVER", DBDriverPostgres),
+		SQLitePath:         getEnv("SQLITE_PATH", "data/tasks.db"),
+		PostgresDB:         getEnv("POSTGRES_DB", "tasks"),
+		PostgresUser:       getEnv("POSTGRES_USER", "postgres"),
+		PostgresPassword:   getEnv("POSTGRES_PASSWORD", "postgres"),
+		PostgresHost:       getEnv("POSTGRES_HOST", "postgres"),
+		PostgresPort:       getEnvAsInt("POSTGRES_PORT", 5432),
+		MigrateOnStart:     getEnvAsBool("MIGRATE_ON_START", true),
+		JWTSecret:          getEnv("JWT_SECRET", ""),
+		JWTIssuer:          getEnv("JWT_ISSUER", "go-task-manager"),
+		JWTAccessTokenTTL:  getEnvAsDuration("JWT_ACCESS_TOKEN_TTL", 15*time.Minute),
+		JWTRefreshTokenTTL: getEnvAsDuration("JWT_REFRESH_TOKEN_TTL", 30*24*time.Hour),
+		BcryptCost:         getEnvAsInt("BCRYPT_COST", 10),
+		AdminEmail:         getEnv("ADMIN_EMAIL", ""),
+		AdminName:          getEnv("ADMIN_NAME", "Administrator"),
+		AdminPassword:      getEnv("ADMIN_PASSWORD", ""),
+		UserDeletionPolicy: getEnv("USER_DELETION_POLICY", "restrict"),
+		TaskWorkflowPath:   getEnv("TASK_WORKFLOW_PATH", ""),
+		TaskMaxDepth:       getEnvAsInt("TASK_MAX_DEPTH", 5),
+
+		AttachmentsStoragePath: getEnv("ATTACHMENTS_STORAGE_PATH", "data/attachments"),
+		AttachmentMaxSize:      int64(getEnvAsInt("ATTACHMENT_MAX_SIZE", 10<<20)),
+		AttachmentAllowedTypes: getEnvAsList("ATTACHMENT_ALLOWED_TYPES", []string{
+			"image/*", "text/plain", "text/csv", "application/json", "application/pdf", "application/zip",
+		}),
+
+		TrashRetention:     getEnvAsDuration("TRASH_RETENTION", 30*24*time.Hour),
+		TrashPurgeInterval: getEnvAsDuration("TRASH_PURGE_INTERVAL", time.Hour),
 	}
 
 	return cfg
 }
 
+// Validate проверяет значения, без которых приложение нельзя запускать
+func (c *Config) Validate() error {
+	if c.JWTSecret == "" {
+		return fmt.Errorf("JWT_SECRET is not set")
+	}
+	if len(c.JWTSecret) < MinJWTSecretLength {
+		return fmt.Errorf("JWT_SECRET must be at least %d bytes long, got %d", MinJWTSecretLength, len(c.JWTSecret))
+	}
+	return nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
@@ -52,3 +136,43 @@ func getEnvAsInt(key string, defaultValue int) int {
 	}
 	return value
 }
+
+func getEnvAsBool(key string, defaultValue bool) bool {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := strconv.ParseBool(valueStr)
+	if err != nil {
+		fmt.Printf("Warning: invalid boolean value for %s: %s, using default: %t\n", key, valueStr, defaultValue)
+		return defaultValue
+	}
+	return value
+}
+
+func getEnvAsList(key string, defaultValue []string) []string {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	values := make([]string, 0)
+	for _, value := range strings.Split(valueStr, ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := os.Getenv(key)
+	if valueStr == "" {
+		return defaultValue
+	}
+	value, err := time.ParseDuration(valueStr)
+	if err != nil {
+		fmt.Printf("Warning: invalid duration value for %s: %s, using default: %s\n", key, valueStr, defaultValue)
+		return defaultValue
+	}
+	return value
+}
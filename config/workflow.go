@@ -0,0 +1,50 @@
+package config
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// defaultTaskWorkflow рабочий процесс задач, используемый без TASK_WORKFLOW_PATH
+//
+//go:embed task_workflow.json
+var defaultTaskWorkflow []byte
+
+// TaskWorkflowConfig описание рабочего процесса задач: статусы и разрешенные переходы между ними
+type TaskWorkflowConfig struct {
+	// Statuses все допустимые статусы задачи
+	Statuses []string `json:"statuses"`
+	// Initial статусы, в которых можно создать задачу; по умолчанию только первый статус
+	Initial []string `json:"initial,omitempty"`
+	// Closed статусы, в которых задача считается завершенной
+	Closed []string `json:"closed"`
+	// Transitions разрешенные переходы; переходы, которых нет в списке, запрещены
+	Transitions []TaskTransitionConfig `json:"transitions"`
+}
+
+// TaskTransitionConfig разрешенный переход между статусами с необязательными условиями
+type TaskTransitionConfig struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Guards []string `json:"guards,omitempty"`
+}
+
+// LoadTaskWorkflow читает рабочий процесс из файла TaskWorkflowPath или возвращает процесс по умолчанию
+func (c *Config) LoadTaskWorkflow() (*TaskWorkflowConfig, error) {
+	data := defaultTaskWorkflow
+	if c.TaskWorkflowPath != "" {
+		fileData, err := os.ReadFile(c.TaskWorkflowPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read task workflow: %w", err)
+		}
+		data = fileData
+	}
+
+	var workflow TaskWorkflowConfig
+	if err := json.Unmarshal(data, &workflow); err != nil {
+		return nil, fmt.Errorf("failed to parse task workflow: %w", err)
+	}
+	return &workflow, nil
+}
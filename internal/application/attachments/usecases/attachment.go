@@ -0,0 +1,39 @@
+package attachments
+
+import (
+	"context"
+
+	"crud/config"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// NewAttachmentLimits создает ограничения на вложения из конфигурации
+func NewAttachmentLimits(cfg *config.Config) (*attachments.Limits, error) {
+	return attachments.NewLimits(cfg.AttachmentMaxSize, cfg.AttachmentAllowedTypes)
+}
+
+// loadTaskAttachment возвращает задачу и ее вложение; вложение другой задачи считается ненайденным
+func loadTaskAttachment(
+	ctx context.Context,
+	tasksRepo tasks.BaseTasksRepository,
+	repo attachments.BaseAttachmentsRepository,
+	taskID, attachmentID uuid.UUID,
+) (*tasks.Task, *attachments.Attachment, error) {
+	task, err := tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := repo.GetByID(ctx, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if attachment.TaskID != task.ID {
+		return nil, nil, &attachments.AttachmentNotFoundError{AttachmentID: attachmentID}
+	}
+
+	return task, attachment, nil
+}
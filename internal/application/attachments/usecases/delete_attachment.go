@@ -0,0 +1,53 @@
+package attachments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// DeleteAttachmentUseCase use case для удаления вложения
+type DeleteAttachmentUseCase struct {
+	repo      attachments.BaseAttachmentsRepository
+	storage   attachments.BaseBlobStorage
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.TaskPolicy
+}
+
+// NewDeleteAttachmentUseCase создает новый use case
+func NewDeleteAttachmentUseCase(
+	repo attachments.BaseAttachmentsRepository,
+	storage attachments.BaseBlobStorage,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.TaskPolicy,
+) *DeleteAttachmentUseCase {
+	return &DeleteAttachmentUseCase{
+		repo:      repo,
+		storage:   storage,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute удаляет описание вложения, а затем его содержимое.
+// Содержимое без описания недостижимо, поэтому порядок обратный загрузке
+func (uc *DeleteAttachmentUseCase) Execute(ctx context.Context, taskID, attachmentID uuid.UUID) error {
+	task, attachment, err := loadTaskAttachment(ctx, uc.tasksRepo, uc.repo, taskID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return err
+	}
+
+	if err := uc.repo.Delete(ctx, attachment.ID); err != nil {
+		return err
+	}
+
+	return uc.storage.Delete(ctx, attachment.StorageKey)
+}
@@ -0,0 +1,57 @@
+package attachments
+
+import (
+	"context"
+	"io"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// DownloadAttachmentUseCase use case для скачивания содержимого вложения
+type DownloadAttachmentUseCase struct {
+	repo      attachments.BaseAttachmentsRepository
+	storage   attachments.BaseBlobStorage
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.TaskPolicy
+}
+
+// NewDownloadAttachmentUseCase создает новый use case
+func NewDownloadAttachmentUseCase(
+	repo attachments.BaseAttachmentsRepository,
+	storage attachments.BaseBlobStorage,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.TaskPolicy,
+) *DownloadAttachmentUseCase {
+	return &DownloadAttachmentUseCase{
+		repo:      repo,
+		storage:   storage,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute возвращает описание вложения и его содержимое; вызывающий закрывает reader
+func (uc *DownloadAttachmentUseCase) Execute(
+	ctx context.Context,
+	taskID, attachmentID uuid.UUID,
+) (*attachments.Attachment, io.ReadCloser, error) {
+	task, attachment, err := loadTaskAttachment(ctx, uc.tasksRepo, uc.repo, taskID, attachmentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, nil, err
+	}
+
+	content, err := uc.storage.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return attachment, content, nil
+}
@@ -0,0 +1,45 @@
+package attachments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// GetAttachmentUseCase use case для получения описания вложения
+type GetAttachmentUseCase struct {
+	repo      attachments.BaseAttachmentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.TaskPolicy
+}
+
+// NewGetAttachmentUseCase создает новый use case
+func NewGetAttachmentUseCase(
+	repo attachments.BaseAttachmentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.TaskPolicy,
+) *GetAttachmentUseCase {
+	return &GetAttachmentUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute возвращает описание вложения задачи
+func (uc *GetAttachmentUseCase) Execute(ctx context.Context, taskID, attachmentID uuid.UUID) (*attachments.Attachment, error) {
+	task, attachment, err := loadTaskAttachment(ctx, uc.tasksRepo, uc.repo, taskID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
@@ -0,0 +1,45 @@
+package attachments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListAttachmentsUseCase use case для получения вложений задачи
+type ListAttachmentsUseCase struct {
+	repo      attachments.BaseAttachmentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.TaskPolicy
+}
+
+// NewListAttachmentsUseCase создает новый use case
+func NewListAttachmentsUseCase(
+	repo attachments.BaseAttachmentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.TaskPolicy,
+) *ListAttachmentsUseCase {
+	return &ListAttachmentsUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute возвращает вложения задачи в порядке загрузки
+func (uc *ListAttachmentsUseCase) Execute(ctx context.Context, taskID uuid.UUID) ([]*attachments.Attachment, error) {
+	task, err := uc.tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListByTask(ctx, task.ID)
+}
@@ -0,0 +1,135 @@
+package attachments
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"io"
+	"net/http"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/attachments/value_objects"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// sniffLength сколько байт содержимого нужно для определения MIME типа
+const sniffLength = 512
+
+// UploadAttachmentInput данные загружаемого файла; Content читается потоком один раз.
+// ContentType - тип, заявленный клиентом, пустой, если клиент его не указал
+type UploadAttachmentInput struct {
+	TaskID      uuid.UUID
+	FileName    string
+	ContentType string
+	Content     io.Reader
+}
+
+// UploadAttachmentUseCase use case для загрузки файла к задаче
+type UploadAttachmentUseCase struct {
+	repo      attachments.BaseAttachmentsRepository
+	storage   attachments.BaseBlobStorage
+	tasksRepo tasks.BaseTasksRepository
+	limits    *attachments.Limits
+	policy    *policies.TaskPolicy
+}
+
+// NewUploadAttachmentUseCase создает новый use case
+func NewUploadAttachmentUseCase(
+	repo attachments.BaseAttachmentsRepository,
+	storage attachments.BaseBlobStorage,
+	tasksRepo tasks.BaseTasksRepository,
+	limits *attachments.Limits,
+	policy *policies.TaskPolicy,
+) *UploadAttachmentUseCase {
+	return &UploadAttachmentUseCase{
+		repo:      repo,
+		storage:   storage,
+		tasksRepo: tasksRepo,
+		limits:    limits,
+		policy:    policy,
+	}
+}
+
+// Execute сохраняет содержимое в хранилище, считая размер и SHA-256 на лету, и затем его описание.
+// Тип файла определяется по первым байтам содержимого: файл, тип которого не совпадает с заявленным
+// или не разрешен, отклоняется. Файл больше допустимого размера прерывает запись, не дочитывая
+// содержимое до конца
+func (uc *UploadAttachmentUseCase) Execute(ctx context.Context, input UploadAttachmentInput) (*attachments.Attachment, error) {
+	task, err := uc.tasksRepo.GetByID(ctx, input.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return nil, err
+	}
+	principal, err := auth.RequirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName, err := value_objects.NewFileNameValueObject(input.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Content == nil {
+		return nil, &attachments.InvalidAttachmentDataError{Field: "content", Message: "content is required"}
+	}
+
+	// Peek возвращает доступные байты и для файлов короче sniffLength, а ошибку чтения
+	// вернет запись содержимого в хранилище
+	buffered := bufio.NewReaderSize(input.Content, sniffLength)
+	head, _ := buffered.Peek(sniffLength)
+	contentType, err := uc.limits.ResolveContentType(input.ContentType, http.DetectContentType(head))
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := attachments.NewAttachment(task.ID, principal.UserID, fileName, contentType)
+	content := &measuredReader{r: buffered, hash: sha256.New(), maxSize: uc.limits.MaxSize()}
+	if err := uc.storage.Put(ctx, attachment.StorageKey, content); err != nil {
+		return nil, err
+	}
+
+	if content.size == 0 {
+		_ = uc.storage.Delete(ctx, attachment.StorageKey)
+		return nil, &attachments.InvalidAttachmentDataError{Field: "content", Message: "file is empty"}
+	}
+	attachment.Size = content.size
+	attachment.Checksum = hex.EncodeToString(content.hash.Sum(nil))
+
+	created, err := uc.repo.Create(ctx, attachment)
+	if err != nil {
+		// Без описания содержимое недостижимо, поэтому его не оставляем
+		_ = uc.storage.Delete(ctx, attachment.StorageKey)
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// measuredReader считает размер и контрольную сумму прочитанного и
+// возвращает AttachmentTooLargeError, как только размер превышает maxSize
+type measuredReader struct {
+	r       io.Reader
+	hash    hash.Hash
+	size    int64
+	maxSize int64
+}
+
+func (m *measuredReader) Read(p []byte) (int, error) {
+	n, err := m.r.Read(p)
+	m.size += int64(n)
+	if m.size > m.maxSize {
+		return 0, &attachments.AttachmentTooLargeError{MaxSize: m.maxSize}
+	}
+	m.hash.Write(p[:n])
+	return n, err
+}
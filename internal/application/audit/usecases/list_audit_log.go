@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+)
+
+// ListAuditLogUseCase use case для получения журнала аудита организации
+type ListAuditLogUseCase struct {
+	repo   audit.BaseAuditRepository
+	policy *policies.AuditPolicy
+}
+
+// NewListAuditLogUseCase создает новый use case
+func NewListAuditLogUseCase(repo audit.BaseAuditRepository, policy *policies.AuditPolicy) *ListAuditLogUseCase {
+	return &ListAuditLogUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute возвращает записи журнала по фильтру от новых к старым
+func (uc *ListAuditLogUseCase) Execute(
+	ctx context.Context,
+	filter audit.ListEntriesFilter,
+	page, pageSize int,
+) ([]*audit.Entry, int64, error) {
+	if err := uc.policy.CanReadLog(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	if err := filter.Validate(); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
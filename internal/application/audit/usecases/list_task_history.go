@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListTaskHistoryUseCase use case для получения истории изменений задачи
+type ListTaskHistoryUseCase struct {
+	repo      audit.BaseAuditRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.TaskPolicy
+}
+
+// NewListTaskHistoryUseCase создает новый use case
+func NewListTaskHistoryUseCase(
+	repo audit.BaseAuditRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.TaskPolicy,
+) *ListTaskHistoryUseCase {
+	return &ListTaskHistoryUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute возвращает записи журнала задачи от новых к старым
+func (uc *ListTaskHistoryUseCase) Execute(ctx context.Context, taskID uuid.UUID, page, pageSize int) ([]*audit.Entry, int64, error) {
+	task, err := uc.tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, 0, err
+	}
+
+	entityType := audit.EntityTask
+	return uc.repo.List(ctx, audit.ListEntriesFilter{EntityType: &entityType, EntityID: &task.ID}, page, pageSize)
+}
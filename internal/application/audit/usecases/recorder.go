@@ -0,0 +1,107 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// AuditRecorder записывает изменения сущностей в журнал от имени вызывающего.
+// Use cases вызывают его в той же транзакции, что и само изменение
+type AuditRecorder struct {
+	repo audit.BaseAuditRepository
+}
+
+// NewAuditRecorder создает новый регистратор изменений
+func NewAuditRecorder(repo audit.BaseAuditRepository) *AuditRecorder {
+	return &AuditRecorder{repo: repo}
+}
+
+// RecordCreate записывает создание сущности со всеми ее полями
+func (r *AuditRecorder) RecordCreate(ctx context.Context, entityType audit.EntityType, entityID uuid.UUID, after audit.Snapshot) error {
+	return r.record(ctx, audit.NewEntry(entityType, entityID, audit.ActionCreate, actorFromContext(ctx), nil, after))
+}
+
+// RecordUpdate записывает изменившиеся поля сущности; изменение без отличий не записывается
+func (r *AuditRecorder) RecordUpdate(ctx context.Context, entityType audit.EntityType, entityID uuid.UUID, before, after audit.Snapshot) error {
+	entry := audit.NewEntry(entityType, entityID, audit.ActionUpdate, actorFromContext(ctx), before, after)
+	if !entry.HasChanges() {
+		return nil
+	}
+	return r.record(ctx, entry)
+}
+
+// RecordDelete записывает удаление сущности с ее последними значениями полей
+func (r *AuditRecorder) RecordDelete(ctx context.Context, entityType audit.EntityType, entityID uuid.UUID, before audit.Snapshot) error {
+	return r.record(ctx, audit.NewEntry(entityType, entityID, audit.ActionDelete, actorFromContext(ctx), before, nil))
+}
+
+// RecordRestore записывает возврат сущности из корзины с ее значениями полей
+func (r *AuditRecorder) RecordRestore(ctx context.Context, entityType audit.EntityType, entityID uuid.UUID, after audit.Snapshot) error {
+	return r.record(ctx, audit.NewEntry(entityType, entityID, audit.ActionRestore, actorFromContext(ctx), nil, after))
+}
+
+// RecordPurge записывает окончательное удаление сущности с ее последними значениями полей
+func (r *AuditRecorder) RecordPurge(ctx context.Context, entityType audit.EntityType, entityID uuid.UUID, before audit.Snapshot) error {
+	return r.record(ctx, audit.NewEntry(entityType, entityID, audit.ActionPurge, actorFromContext(ctx), before, nil))
+}
+
+func (r *AuditRecorder) record(ctx context.Context, entry *audit.Entry) error {
+	return r.repo.Create(ctx, entry)
+}
+
+// actorFromContext возвращает ID вызывающего; nil для изменений без аутентификации
+// (регистрация, создание администратора при запуске, очистка корзины по сроку хранения)
+func actorFromContext(ctx context.Context) *uuid.UUID {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	actorID := principal.UserID
+	return &actorID
+}
+
+// TaskSnapshot возвращает отслеживаемые поля задачи
+func TaskSnapshot(task *tasks.Task) audit.Snapshot {
+	return audit.Snapshot{
+		"title":       task.Title.Value(),
+		"description": task.Description,
+		"status":      task.Status.Value(),
+		"priority":    task.Priority.Value(),
+		"user_id":     task.UserID.String(),
+		"project_id":  optionalID(task.ProjectID),
+		"parent_id":   optionalID(task.ParentID),
+		"start_date":  optionalTime(task.Schedule.StartDate()),
+		"due_date":    optionalTime(task.Schedule.DueDate()),
+	}
+}
+
+// UserSnapshot возвращает отслеживаемые поля пользователя; хеш пароля не попадает в журнал
+func UserSnapshot(user *users.User) audit.Snapshot {
+	return audit.Snapshot{
+		"email":    user.Email.Value(),
+		"name":     user.Name.Value(),
+		"role":     user.Role.Value(),
+		"password": audit.Secret(user.PasswordHash),
+	}
+}
+
+func optionalID(id *uuid.UUID) any {
+	if id == nil {
+		return nil
+	}
+	return id.String()
+}
+
+func optionalTime(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/users"
+)
+
+// GetCurrentUserUseCase use case для получения пользователя, выполняющего запрос
+type GetCurrentUserUseCase struct {
+	usersRepo users.BaseUsersRepository
+}
+
+// NewGetCurrentUserUseCase создает новый use case
+func NewGetCurrentUserUseCase(usersRepo users.BaseUsersRepository) *GetCurrentUserUseCase {
+	return &GetCurrentUserUseCase{
+		usersRepo: usersRepo,
+	}
+}
+
+// Execute возвращает пользователя из контекста запроса
+func (uc *GetCurrentUserUseCase) Execute(ctx context.Context) (*users.User, error) {
+	principal, err := auth.RequirePrincipal(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.usersRepo.GetByID(ctx, principal.UserID)
+}
@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// LoginUseCase use case для входа по email и паролю
+type LoginUseCase struct {
+	usersRepo     users.BaseUsersRepository
+	refreshTokens auth.BaseRefreshTokensRepository
+	hasher        auth.BasePasswordHasher
+	tokens        auth.BaseTokenManager
+}
+
+// NewLoginUseCase создает новый use case
+func NewLoginUseCase(
+	usersRepo users.BaseUsersRepository,
+	refreshTokens auth.BaseRefreshTokensRepository,
+	hasher auth.BasePasswordHasher,
+	tokens auth.BaseTokenManager,
+) *LoginUseCase {
+	return &LoginUseCase{
+		usersRepo:     usersRepo,
+		refreshTokens: refreshTokens,
+		hasher:        hasher,
+		tokens:        tokens,
+	}
+}
+
+// Execute проверяет учетные данные и выпускает пару токенов
+func (uc *LoginUseCase) Execute(ctx context.Context, email string, password string) (*auth.TokenPair, error) {
+	// Email уникален среди всех организаций, а организация запроса становится известна только после входа
+	user, err := uc.usersRepo.GetByEmail(organizations.ContextWithoutScope(ctx), strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		// Не раскрываем, существует ли пользователь с таким email
+		if users.IsUserNotFound(err) {
+			return nil, &auth.InvalidCredentialsError{}
+		}
+		return nil, err
+	}
+
+	if !user.HasPassword() {
+		return nil, &auth.InvalidCredentialsError{}
+	}
+
+	if err := uc.hasher.Compare(user.PasswordHash, password); err != nil {
+		return nil, err
+	}
+
+	// Каждый вход открывает новую цепочку ротаций refresh токенов
+	return issueTokenPair(ctx, uc.tokens, uc.refreshTokens, auth.Principal{UserID: user.ID, Role: user.Role.Value(), OrganizationID: user.OrganizationID}, uuid.New())
+}
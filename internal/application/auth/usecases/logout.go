@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+)
+
+// LogoutUseCase use case для завершения сессии
+type LogoutUseCase struct {
+	refreshTokens auth.BaseRefreshTokensRepository
+	tokens        auth.BaseTokenManager
+}
+
+// NewLogoutUseCase создает новый use case
+func NewLogoutUseCase(refreshTokens auth.BaseRefreshTokensRepository, tokens auth.BaseTokenManager) *LogoutUseCase {
+	return &LogoutUseCase{
+		refreshTokens: refreshTokens,
+		tokens:        tokens,
+	}
+}
+
+// Execute отзывает цепочку ротаций, к которой относится refresh токен
+func (uc *LogoutUseCase) Execute(ctx context.Context, refreshToken string) error {
+	stored, err := uc.refreshTokens.GetByTokenHash(ctx, uc.tokens.HashRefreshToken(refreshToken))
+	if err != nil {
+		return err
+	}
+
+	return uc.refreshTokens.RevokeFamily(ctx, stored.FamilyID)
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/users"
+)
+
+// RefreshTokensUseCase use case для ротации refresh токена
+type RefreshTokensUseCase struct {
+	usersRepo     users.BaseUsersRepository
+	refreshTokens auth.BaseRefreshTokensRepository
+	tokens        auth.BaseTokenManager
+}
+
+// NewRefreshTokensUseCase создает новый use case
+func NewRefreshTokensUseCase(
+	usersRepo users.BaseUsersRepository,
+	refreshTokens auth.BaseRefreshTokensRepository,
+	tokens auth.BaseTokenManager,
+) *RefreshTokensUseCase {
+	return &RefreshTokensUseCase{
+		usersRepo:     usersRepo,
+		refreshTokens: refreshTokens,
+		tokens:        tokens,
+	}
+}
+
+// Execute обменивает refresh токен на новую пару токенов, отзывая использованный
+func (uc *RefreshTokensUseCase) Execute(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
+	stored, err := uc.refreshTokens.GetByTokenHash(ctx, uc.tokens.HashRefreshToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+
+	if stored.IsRevoked() {
+		return nil, uc.revokeReusedFamily(ctx, stored)
+	}
+
+	if stored.IsExpired(time.Now()) {
+		return nil, &auth.InvalidTokenError{Reason: "refresh token has expired"}
+	}
+
+	if err := uc.refreshTokens.Revoke(ctx, stored.ID); err != nil {
+		// Токен успели использовать параллельно
+		if auth.IsInvalidToken(err) {
+			return nil, uc.revokeReusedFamily(ctx, stored)
+		}
+		return nil, err
+	}
+
+	// Refresh токен не содержит организацию, поэтому владелец ищется среди всех организаций
+	user, err := uc.usersRepo.GetByID(organizations.ContextWithoutScope(ctx), stored.UserID)
+	if err != nil {
+		if users.IsUserNotFound(err) {
+			return nil, &auth.InvalidTokenError{Reason: "user no longer exists"}
+		}
+		return nil, err
+	}
+
+	return issueTokenPair(ctx, uc.tokens, uc.refreshTokens, auth.Principal{UserID: user.ID, Role: user.Role.Value(), OrganizationID: user.OrganizationID}, stored.FamilyID)
+}
+
+// revokeReusedFamily отзывает всю цепочку ротаций: повторное использование
+// отозванного токена означает его утечку, и сессию нужно завершить у всех
+func (uc *RefreshTokensUseCase) revokeReusedFamily(ctx context.Context, stored *auth.RefreshToken) error {
+	if err := uc.refreshTokens.RevokeFamily(ctx, stored.FamilyID); err != nil {
+		return err
+	}
+	return &auth.InvalidTokenError{Reason: "refresh token reuse detected"}
+}
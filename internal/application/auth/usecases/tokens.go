@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+
+	"github.com/google/uuid"
+)
+
+// issueTokenPair выпускает пару токенов и сохраняет refresh токен в цепочке familyID
+func issueTokenPair(
+	ctx context.Context,
+	tokens auth.BaseTokenManager,
+	refreshTokens auth.BaseRefreshTokensRepository,
+	principal auth.Principal,
+	familyID uuid.UUID,
+) (*auth.TokenPair, error) {
+	accessToken, accessExpiresAt, err := tokens.IssueAccessToken(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, refreshHash, refreshExpiresAt, err := tokens.IssueRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	stored := auth.NewRefreshToken(principal.UserID, familyID, refreshHash, refreshExpiresAt)
+	if _, err := refreshTokens.Create(ctx, stored); err != nil {
+		return nil, err
+	}
+
+	return &auth.TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/domain/comments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// loadTaskComment возвращает задачу и ее комментарий; комментарий другой задачи считается ненайденным
+func loadTaskComment(
+	ctx context.Context,
+	tasksRepo tasks.BaseTasksRepository,
+	repo comments.BaseCommentsRepository,
+	taskID, commentID uuid.UUID,
+) (*tasks.Task, *comments.Comment, error) {
+	task, err := tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	comment, err := repo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if comment.TaskID != task.ID {
+		return nil, nil, &comments.CommentNotFoundError{CommentID: commentID}
+	}
+
+	return task, comment, nil
+}
@@ -0,0 +1,70 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/comments"
+	vo "crud/internal/domain/comments/value_objects"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// CreateCommentInput данные для создания комментария; ParentID задается для ответа на комментарий
+type CreateCommentInput struct {
+	TaskID   uuid.UUID
+	ParentID *uuid.UUID
+	Body     string
+}
+
+// CreateCommentUseCase use case для создания комментария к задаче
+type CreateCommentUseCase struct {
+	repo      comments.BaseCommentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.CommentPolicy
+}
+
+// NewCreateCommentUseCase создает новый use case
+func NewCreateCommentUseCase(
+	repo comments.BaseCommentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.CommentPolicy,
+) *CreateCommentUseCase {
+	return &CreateCommentUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет создание комментария от имени вызывающего
+func (uc *CreateCommentUseCase) Execute(ctx context.Context, input CreateCommentInput) (*comments.Comment, error) {
+	task, err := uc.tasksRepo.GetByID(ctx, input.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	principal, err := uc.policy.CanCreate(ctx, task)
+	if err != nil {
+		return nil, err
+	}
+
+	bodyVO, err := vo.NewCommentBodyValueObject(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ответить можно только на комментарий той же задачи
+	if input.ParentID != nil {
+		parent, err := uc.repo.GetByID(ctx, *input.ParentID)
+		if err != nil && !comments.IsCommentNotFound(err) {
+			return nil, err
+		}
+		if parent == nil || parent.TaskID != task.ID {
+			return nil, &comments.ParentCommentNotFoundError{ParentID: *input.ParentID, TaskID: task.ID}
+		}
+	}
+
+	return uc.repo.Create(ctx, comments.NewComment(task.ID, principal.UserID, input.ParentID, bodyVO))
+}
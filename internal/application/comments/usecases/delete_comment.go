@@ -0,0 +1,48 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// DeleteCommentUseCase use case для удаления комментария
+type DeleteCommentUseCase struct {
+	repo      comments.BaseCommentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.CommentPolicy
+}
+
+// NewDeleteCommentUseCase создает новый use case
+func NewDeleteCommentUseCase(
+	repo comments.BaseCommentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.CommentPolicy,
+) *DeleteCommentUseCase {
+	return &DeleteCommentUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет удаление комментария вместе с ответами на него
+func (uc *DeleteCommentUseCase) Execute(ctx context.Context, taskID, commentID uuid.UUID) error {
+	task, comment, err := loadTaskComment(ctx, uc.tasksRepo, uc.repo, taskID, commentID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanRead(ctx, task); err != nil {
+		return err
+	}
+	if err := uc.policy.CanDelete(ctx, comment); err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, comment.ID)
+}
@@ -0,0 +1,48 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListCommentRevisionsUseCase use case для получения истории изменений комментария
+type ListCommentRevisionsUseCase struct {
+	repo      comments.BaseCommentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.CommentPolicy
+}
+
+// NewListCommentRevisionsUseCase создает новый use case
+func NewListCommentRevisionsUseCase(
+	repo comments.BaseCommentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.CommentPolicy,
+) *ListCommentRevisionsUseCase {
+	return &ListCommentRevisionsUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет получение прежних версий текста комментария от старых к новым
+func (uc *ListCommentRevisionsUseCase) Execute(
+	ctx context.Context,
+	taskID, commentID uuid.UUID,
+) ([]*comments.CommentRevision, error) {
+	task, comment, err := loadTaskComment(ctx, uc.tasksRepo, uc.repo, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanRead(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListRevisions(ctx, comment.ID)
+}
@@ -0,0 +1,62 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListCommentsUseCase use case для получения комментариев задачи
+type ListCommentsUseCase struct {
+	repo      comments.BaseCommentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.CommentPolicy
+}
+
+// NewListCommentsUseCase создает новый use case
+func NewListCommentsUseCase(
+	repo comments.BaseCommentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.CommentPolicy,
+) *ListCommentsUseCase {
+	return &ListCommentsUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет получение комментариев задачи: без parentID - комментариев верхнего уровня,
+// с parentID - ответов на этот комментарий
+func (uc *ListCommentsUseCase) Execute(
+	ctx context.Context,
+	taskID uuid.UUID,
+	parentID *uuid.UUID,
+	page, pageSize int,
+) ([]*comments.Comment, int64, error) {
+	task, err := uc.tasksRepo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := uc.policy.CanRead(ctx, task); err != nil {
+		return nil, 0, err
+	}
+
+	// Ответы можно получить только на комментарий этой задачи
+	if parentID != nil {
+		parent, err := uc.repo.GetByID(ctx, *parentID)
+		if err != nil {
+			return nil, 0, err
+		}
+		if parent.TaskID != task.ID {
+			return nil, 0, &comments.CommentNotFoundError{CommentID: *parentID}
+		}
+	}
+
+	return uc.repo.List(ctx, comments.ListCommentsFilter{TaskID: task.ID, ParentID: parentID}, page, pageSize)
+}
@@ -0,0 +1,63 @@
+package comments
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/comments"
+	vo "crud/internal/domain/comments/value_objects"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// UpdateCommentUseCase use case для изменения текста комментария
+type UpdateCommentUseCase struct {
+	repo      comments.BaseCommentsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.CommentPolicy
+}
+
+// NewUpdateCommentUseCase создает новый use case
+func NewUpdateCommentUseCase(
+	repo comments.BaseCommentsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.CommentPolicy,
+) *UpdateCommentUseCase {
+	return &UpdateCommentUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет изменение текста комментария; прежний текст сохраняется в истории
+func (uc *UpdateCommentUseCase) Execute(
+	ctx context.Context,
+	taskID, commentID uuid.UUID,
+	body string,
+) (*comments.Comment, error) {
+	task, comment, err := loadTaskComment(ctx, uc.tasksRepo, uc.repo, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanRead(ctx, task); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.CanUpdate(ctx, comment); err != nil {
+		return nil, err
+	}
+
+	bodyVO, err := vo.NewCommentBodyValueObject(body)
+	if err != nil {
+		return nil, err
+	}
+
+	revision := comment.Edit(bodyVO)
+	if revision == nil {
+		return comment, nil
+	}
+
+	return uc.repo.Update(ctx, comment, revision)
+}
@@ -0,0 +1,10 @@
+package common
+
+import "context"
+
+// BaseTransactionManager определяет интерфейс для выполнения нескольких операций в одной транзакции
+type BaseTransactionManager interface {
+	// WithinTransaction выполняет fn в транзакции; если fn возвращает ошибку, изменения откатываются.
+	// Репозитории, вызванные с переданным в fn контекстом, работают внутри этой транзакции
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
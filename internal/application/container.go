@@ -1,15 +1,37 @@
 package application
 
 import (
+	"fmt"
 	"sync"
 
 	"crud/config"
+	attachments_usecases "crud/internal/application/attachments/usecases"
+	audit_usecases "crud/internal/application/audit/usecases"
+	auth_usecases "crud/internal/application/auth/usecases"
+	comments_usecases "crud/internal/application/comments/usecases"
+	"crud/internal/application/common"
+	labels_usecases "crud/internal/application/labels/usecases"
+	organizations_usecases "crud/internal/application/organizations/usecases"
+	"crud/internal/application/policies"
+	projects_usecases "crud/internal/application/projects/usecases"
 	tasks_usecases "crud/internal/application/tasks/usecases"
+	trash_usecases "crud/internal/application/trash/usecases"
 	users_usecases "crud/internal/application/users/usecases"
+	attachments_domain "crud/internal/domain/attachments"
+	audit_domain "crud/internal/domain/audit"
+	auth_domain "crud/internal/domain/auth"
+	comments_domain "crud/internal/domain/comments"
+	labels_domain "crud/internal/domain/labels"
+	organizations_domain "crud/internal/domain/organizations"
+	projects_domain "crud/internal/domain/projects"
 	tasks_domain "crud/internal/domain/tasks"
 	users_domain "crud/internal/domain/users"
+	auth_infrastructure "crud/internal/infrastructure/auth"
 	"crud/internal/infrastructure/database/gateways"
+	"crud/internal/infrastructure/database/migrations"
 	"crud/internal/infrastructure/database/repositories"
+	"crud/internal/infrastructure/database/repositories/dummy"
+	"crud/internal/infrastructure/storage"
 
 	"go.uber.org/dig"
 	"gorm.io/gorm"
@@ -31,35 +53,183 @@ func InitContainer() *dig.Container {
 
 // initContainer регистрирует все зависимости в контейнере
 func initContainer(c *dig.Container) {
-	// Регистрируем конфиг
-	c.Provide(config.NewConfig)
+	// Регистрируем конфиг: от него зависит, какое хранилище данных регистрируется ниже
+	cfg, err := config.NewConfig()
+	if err != nil {
+		// Ошибка конфигурации всплывает при получении конфига, с которого начинается запуск
+		c.Provide(func() (*config.Config, error) {
+			return nil, err
+		})
+		return
+	}
+	c.Provide(func() *config.Config {
+		return cfg
+	})
 
-	// Регистрируем gateway для подключения к БД
-	c.Provide(gateways.NewPostgresGateway)
+	// Регистрируем хранилище данных, выбранное DB_DRIVER
+	switch cfg.DBDriver {
+	case config.DBDriverMemory:
+		provideMemoryRepositories(c)
+	case config.DBDriverSQLite:
+		c.Provide(gateways.NewSQLiteGateway)
+		c.Provide(func(gw *gateways.SQLiteGateway) *gorm.DB {
+			return gw.DB()
+		})
+		provideGormRepositories(c)
+	case config.DBDriverPostgres:
+		c.Provide(gateways.NewPostgresGateway)
+		c.Provide(func(gw *gateways.PostgresGateway) *gorm.DB {
+			return gw.DB()
+		})
+		provideGormRepositories(c)
+	default:
+		// Ошибка всплывает при получении первого репозитория, как и другие ошибки конфигурации
+		c.Provide(func() (*gorm.DB, error) {
+			return nil, fmt.Errorf("unknown DB_DRIVER %q, expected postgres, sqlite or memory", cfg.DBDriver)
+		})
+		provideGormRepositories(c)
+	}
 
-	// Провайдер для *gorm.DB из gateway
-	c.Provide(func(gw *gateways.PostgresGateway) *gorm.DB {
-		return gw.DB()
-	})
+	// Регистрируем сервисы аутентификации
+	c.Provide(auth_infrastructure.NewBcryptPasswordHasher, dig.As(new(auth_domain.BasePasswordHasher)))
+	c.Provide(auth_infrastructure.NewJWTTokenManager, dig.As(new(auth_domain.BaseTokenManager)))
 
-	// Регистрируем репозитории
-	c.Provide(repositories.NewUsersRepository, dig.As(new(users_domain.BaseUsersRepository)))
-	c.Provide(repositories.NewTasksRepository, dig.As(new(tasks_domain.BaseTasksRepository)))
+	// Регистрируем политики доступа
+	c.Provide(policies.NewTaskPolicy)
+	c.Provide(policies.NewProjectPolicy)
+	c.Provide(policies.NewUserPolicy)
+	c.Provide(policies.NewLabelPolicy)
+	c.Provide(policies.NewCommentPolicy)
+	c.Provide(policies.NewOrganizationPolicy)
+	c.Provide(policies.NewAuditPolicy)
+	c.Provide(policies.NewTrashPolicy)
+
+	// Регистрируем журнал аудита, в который use cases записывают изменения
+	c.Provide(audit_usecases.NewAuditRecorder)
+
+	// Регистрируем use cases для аутентификации
+	c.Provide(auth_usecases.NewLoginUseCase)
+	c.Provide(auth_usecases.NewRefreshTokensUseCase)
+	c.Provide(auth_usecases.NewLogoutUseCase)
+	c.Provide(auth_usecases.NewGetCurrentUserUseCase)
 
 	// Регистрируем use cases для пользователей
 	c.Provide(users_usecases.NewCreateUserUseCase)
+	c.Provide(users_usecases.NewRegisterUserUseCase)
+	c.Provide(users_usecases.NewEnsureAdminUseCase)
 	c.Provide(users_usecases.NewGetUserByIDUseCase)
 	c.Provide(users_usecases.NewGetUserByEmailUseCase)
 	c.Provide(users_usecases.NewListUsersUseCase)
+	c.Provide(users_usecases.NewListUsersByCursorUseCase)
 	c.Provide(users_usecases.NewUpdateUserUseCase)
 	c.Provide(users_usecases.NewDeleteUserUseCase)
 
+	// Регистрируем рабочий процесс и иерархию задач
+	c.Provide(tasks_usecases.NewTaskTransitionGuards)
+	c.Provide(tasks_usecases.NewTaskWorkflow)
+	c.Provide(tasks_usecases.NewTaskHierarchy)
+
 	// Регистрируем use cases для задач
 	c.Provide(tasks_usecases.NewCreateTaskUseCase)
 	c.Provide(tasks_usecases.NewGetTaskByIDUseCase)
 	c.Provide(tasks_usecases.NewListTasksUseCase)
+	c.Provide(tasks_usecases.NewListTasksByCursorUseCase)
 	c.Provide(tasks_usecases.NewUpdateTaskUseCase)
+	c.Provide(tasks_usecases.NewTransitionTaskUseCase)
 	c.Provide(tasks_usecases.NewDeleteTaskUseCase)
+	c.Provide(tasks_usecases.NewListTaskLabelsUseCase)
+	c.Provide(tasks_usecases.NewAttachTaskLabelUseCase)
+	c.Provide(tasks_usecases.NewDetachTaskLabelUseCase)
+	c.Provide(tasks_usecases.NewListTaskChildrenUseCase)
+	c.Provide(tasks_usecases.NewListTaskSubtreeUseCase)
+	c.Provide(tasks_usecases.NewGetTaskProgressUseCase)
+	c.Provide(tasks_usecases.NewListTaskDependenciesUseCase)
+	c.Provide(tasks_usecases.NewAddTaskDependencyUseCase)
+	c.Provide(tasks_usecases.NewRemoveTaskDependencyUseCase)
+
+	// Регистрируем use cases для проектов
+	c.Provide(projects_usecases.NewCreateProjectUseCase)
+	c.Provide(projects_usecases.NewGetProjectByIDUseCase)
+	c.Provide(projects_usecases.NewListProjectsUseCase)
+	c.Provide(projects_usecases.NewUpdateProjectUseCase)
+	c.Provide(projects_usecases.NewDeleteProjectUseCase)
+
+	// Регистрируем use cases для меток
+	c.Provide(labels_usecases.NewCreateLabelUseCase)
+	c.Provide(labels_usecases.NewGetLabelByIDUseCase)
+	c.Provide(labels_usecases.NewListLabelsUseCase)
+	c.Provide(labels_usecases.NewUpdateLabelUseCase)
+	c.Provide(labels_usecases.NewDeleteLabelUseCase)
+
+	// Регистрируем use cases для комментариев
+	c.Provide(comments_usecases.NewCreateCommentUseCase)
+	c.Provide(comments_usecases.NewListCommentsUseCase)
+	c.Provide(comments_usecases.NewListCommentRevisionsUseCase)
+	c.Provide(comments_usecases.NewUpdateCommentUseCase)
+	c.Provide(comments_usecases.NewDeleteCommentUseCase)
+
+	// Регистрируем use cases для вложений
+	c.Provide(attachments_usecases.NewAttachmentLimits)
+	c.Provide(attachments_usecases.NewUploadAttachmentUseCase)
+	c.Provide(attachments_usecases.NewListAttachmentsUseCase)
+	c.Provide(attachments_usecases.NewGetAttachmentUseCase)
+	c.Provide(attachments_usecases.NewDownloadAttachmentUseCase)
+	c.Provide(attachments_usecases.NewDeleteAttachmentUseCase)
+
+	// Регистрируем use cases для журнала аудита
+	c.Provide(audit_usecases.NewListTaskHistoryUseCase)
+	c.Provide(audit_usecases.NewListAuditLogUseCase)
+
+	// Регистрируем use cases для корзины
+	c.Provide(trash_usecases.NewTrashPurger)
+	c.Provide(trash_usecases.NewListDeletedTasksUseCase)
+	c.Provide(trash_usecases.NewRestoreTaskUseCase)
+	c.Provide(trash_usecases.NewPurgeTaskUseCase)
+	c.Provide(trash_usecases.NewListDeletedUsersUseCase)
+	c.Provide(trash_usecases.NewRestoreUserUseCase)
+	c.Provide(trash_usecases.NewPurgeUserUseCase)
+	c.Provide(trash_usecases.NewPurgeExpiredTrashUseCase)
+
+	// Регистрируем use cases для организаций
+	c.Provide(organizations_usecases.NewCreateOrganizationUseCase)
+	c.Provide(organizations_usecases.NewListOrganizationsUseCase)
+	c.Provide(organizations_usecases.NewGetCurrentOrganizationUseCase)
+}
+
+// provideGormRepositories регистрирует GORM репозитории, миграции схемы и хранилище вложений на диске.
+// Репозитории не зависят от диалекта: *gorm.DB регистрирует gateway выбранной базы
+func provideGormRepositories(c *dig.Container) {
+	c.Provide(migrations.NewDialectMigrator)
+
+	c.Provide(repositories.NewOrganizationsRepository, dig.As(new(organizations_domain.BaseOrganizationsRepository)))
+	c.Provide(repositories.NewUsersRepository, dig.As(new(users_domain.BaseUsersRepository)))
+	c.Provide(repositories.NewTasksRepository, dig.As(new(tasks_domain.BaseTasksRepository)))
+	c.Provide(repositories.NewProjectsRepository, dig.As(new(projects_domain.BaseProjectsRepository)))
+	c.Provide(repositories.NewLabelsRepository, dig.As(new(labels_domain.BaseLabelsRepository)))
+	c.Provide(repositories.NewCommentsRepository, dig.As(new(comments_domain.BaseCommentsRepository)))
+	c.Provide(repositories.NewAttachmentsRepository, dig.As(new(attachments_domain.BaseAttachmentsRepository)))
+	c.Provide(repositories.NewAuditRepository, dig.As(new(audit_domain.BaseAuditRepository)))
+	c.Provide(repositories.NewRefreshTokensRepository, dig.As(new(auth_domain.BaseRefreshTokensRepository)))
+	c.Provide(repositories.NewTransactionManager, dig.As(new(common.BaseTransactionManager)))
+
+	c.Provide(storage.NewLocalBlobStorage, dig.As(new(attachments_domain.BaseBlobStorage)))
+}
+
+// provideMemoryRepositories регистрирует in-memory репозитории и хранилище вложений.
+// Данные живут до остановки процесса, миграций у такого хранилища нет
+func provideMemoryRepositories(c *dig.Container) {
+	c.Provide(dummy.NewOrganizationsRepository, dig.As(new(organizations_domain.BaseOrganizationsRepository)))
+	c.Provide(dummy.NewUsersRepository, dig.As(new(users_domain.BaseUsersRepository)))
+	c.Provide(dummy.NewTasksRepository, dig.As(new(tasks_domain.BaseTasksRepository)))
+	c.Provide(dummy.NewProjectsRepository, dig.As(new(projects_domain.BaseProjectsRepository)))
+	c.Provide(dummy.NewLabelsRepository, dig.As(new(labels_domain.BaseLabelsRepository)))
+	c.Provide(dummy.NewCommentsRepository, dig.As(new(comments_domain.BaseCommentsRepository)))
+	c.Provide(dummy.NewAttachmentsRepository, dig.As(new(attachments_domain.BaseAttachmentsRepository)))
+	c.Provide(dummy.NewAuditRepository, dig.As(new(audit_domain.BaseAuditRepository)))
+	c.Provide(dummy.NewRefreshTokensRepository, dig.As(new(auth_domain.BaseRefreshTokensRepository)))
+	c.Provide(dummy.NewTransactionManager, dig.As(new(common.BaseTransactionManager)))
+
+	c.Provide(dummy.NewBlobStorage, dig.As(new(attachments_domain.BaseBlobStorage)))
 }
 
 // ResolveFromContainer получает зависимость из переданного контейнера по типу
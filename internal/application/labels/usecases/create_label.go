@@ -0,0 +1,37 @@
+package labels
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+	vo "crud/internal/domain/labels/value_objects"
+)
+
+// CreateLabelUseCase use case для создания метки
+type CreateLabelUseCase struct {
+	repo   labels.BaseLabelsRepository
+	policy *policies.LabelPolicy
+}
+
+// NewCreateLabelUseCase создает новый use case
+func NewCreateLabelUseCase(repo labels.BaseLabelsRepository, policy *policies.LabelPolicy) *CreateLabelUseCase {
+	return &CreateLabelUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет создание метки
+func (uc *CreateLabelUseCase) Execute(ctx context.Context, name string) (*labels.Label, error) {
+	if err := uc.policy.CanCreate(ctx); err != nil {
+		return nil, err
+	}
+
+	nameVO, err := vo.NewLabelNameValueObject(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Create(ctx, labels.NewLabel(nameVO))
+}
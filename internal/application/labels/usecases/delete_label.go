@@ -0,0 +1,33 @@
+package labels
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+
+	"github.com/google/uuid"
+)
+
+// DeleteLabelUseCase use case для удаления метки
+type DeleteLabelUseCase struct {
+	repo   labels.BaseLabelsRepository
+	policy *policies.LabelPolicy
+}
+
+// NewDeleteLabelUseCase создает новый use case
+func NewDeleteLabelUseCase(repo labels.BaseLabelsRepository, policy *policies.LabelPolicy) *DeleteLabelUseCase {
+	return &DeleteLabelUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет удаление метки; метка снимается со всех задач
+func (uc *DeleteLabelUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	if err := uc.policy.CanManage(ctx); err != nil {
+		return err
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
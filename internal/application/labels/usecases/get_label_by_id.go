@@ -0,0 +1,33 @@
+package labels
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+
+	"github.com/google/uuid"
+)
+
+// GetLabelByIDUseCase use case для получения метки по ID
+type GetLabelByIDUseCase struct {
+	repo   labels.BaseLabelsRepository
+	policy *policies.LabelPolicy
+}
+
+// NewGetLabelByIDUseCase создает новый use case
+func NewGetLabelByIDUseCase(repo labels.BaseLabelsRepository, policy *policies.LabelPolicy) *GetLabelByIDUseCase {
+	return &GetLabelByIDUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение метки
+func (uc *GetLabelByIDUseCase) Execute(ctx context.Context, id uuid.UUID) (*labels.Label, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, id)
+}
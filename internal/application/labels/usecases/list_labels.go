@@ -0,0 +1,31 @@
+package labels
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+)
+
+// ListLabelsUseCase use case для получения списка меток
+type ListLabelsUseCase struct {
+	repo   labels.BaseLabelsRepository
+	policy *policies.LabelPolicy
+}
+
+// NewListLabelsUseCase создает новый use case
+func NewListLabelsUseCase(repo labels.BaseLabelsRepository, policy *policies.LabelPolicy) *ListLabelsUseCase {
+	return &ListLabelsUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение списка меток
+func (uc *ListLabelsUseCase) Execute(ctx context.Context, page, pageSize int) ([]*labels.Label, int64, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.List(ctx, page, pageSize)
+}
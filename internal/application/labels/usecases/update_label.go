@@ -0,0 +1,45 @@
+package labels
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+	vo "crud/internal/domain/labels/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// UpdateLabelUseCase use case для переименования метки
+type UpdateLabelUseCase struct {
+	repo   labels.BaseLabelsRepository
+	policy *policies.LabelPolicy
+}
+
+// NewUpdateLabelUseCase создает новый use case
+func NewUpdateLabelUseCase(repo labels.BaseLabelsRepository, policy *policies.LabelPolicy) *UpdateLabelUseCase {
+	return &UpdateLabelUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет переименование метки; задачи сохраняют метку под новым именем
+func (uc *UpdateLabelUseCase) Execute(ctx context.Context, id uuid.UUID, name string) (*labels.Label, error) {
+	if err := uc.policy.CanManage(ctx); err != nil {
+		return nil, err
+	}
+
+	label, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	nameVO, err := vo.NewLabelNameValueObject(name)
+	if err != nil {
+		return nil, err
+	}
+	label.Name = nameVO
+
+	return uc.repo.Update(ctx, label)
+}
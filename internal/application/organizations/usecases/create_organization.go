@@ -0,0 +1,86 @@
+package organizations
+
+import (
+	"context"
+
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	users_usecases "crud/internal/application/users/usecases"
+	"crud/internal/domain/organizations"
+	vo "crud/internal/domain/organizations/value_objects"
+	"crud/internal/domain/users"
+	users_vo "crud/internal/domain/users/value_objects"
+)
+
+// CreateOrganizationInput данные для создания организации вместе с ее первым администратором
+type CreateOrganizationInput struct {
+	Name          string
+	AdminEmail    string
+	AdminName     string
+	AdminPassword string
+}
+
+// CreateOrganizationUseCase use case для создания организации
+type CreateOrganizationUseCase struct {
+	repo       organizations.BaseOrganizationsRepository
+	createUser *users_usecases.CreateUserUseCase
+	txManager  common.BaseTransactionManager
+	policy     *policies.OrganizationPolicy
+}
+
+// NewCreateOrganizationUseCase создает новый use case
+func NewCreateOrganizationUseCase(
+	repo organizations.BaseOrganizationsRepository,
+	createUser *users_usecases.CreateUserUseCase,
+	txManager common.BaseTransactionManager,
+	policy *policies.OrganizationPolicy,
+) *CreateOrganizationUseCase {
+	return &CreateOrganizationUseCase{
+		repo:       repo,
+		createUser: createUser,
+		txManager:  txManager,
+		policy:     policy,
+	}
+}
+
+// Execute выполняет создание организации и ее администратора в одной транзакции.
+// Без администратора в новую организацию некому было бы войти
+func (uc *CreateOrganizationUseCase) Execute(
+	ctx context.Context,
+	input CreateOrganizationInput,
+) (*organizations.Organization, *users.User, error) {
+	if err := uc.policy.CanManage(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	nameVO, err := vo.NewOrganizationNameValueObject(input.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		organization *organizations.Organization
+		admin        *users.User
+	)
+	err = uc.txManager.WithinTransaction(ctx, func(ctx context.Context) error {
+		created, err := uc.repo.Create(ctx, organizations.NewOrganization(nameVO))
+		if err != nil {
+			return err
+		}
+
+		// Администратор создается уже в контексте новой организации
+		orgCtx := organizations.ContextWithOrganization(ctx, created.ID)
+		user, err := uc.createUser.Execute(orgCtx, input.AdminEmail, input.AdminName, input.AdminPassword, users_vo.RoleAdmin)
+		if err != nil {
+			return err
+		}
+
+		organization, admin = created, user
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return organization, admin, nil
+}
@@ -0,0 +1,35 @@
+package organizations
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/organizations"
+)
+
+// GetCurrentOrganizationUseCase use case для получения организации вызывающего пользователя
+type GetCurrentOrganizationUseCase struct {
+	repo   organizations.BaseOrganizationsRepository
+	policy *policies.OrganizationPolicy
+}
+
+// NewGetCurrentOrganizationUseCase создает новый use case
+func NewGetCurrentOrganizationUseCase(
+	repo organizations.BaseOrganizationsRepository,
+	policy *policies.OrganizationPolicy,
+) *GetCurrentOrganizationUseCase {
+	return &GetCurrentOrganizationUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение организации вызывающего пользователя
+func (uc *GetCurrentOrganizationUseCase) Execute(ctx context.Context) (*organizations.Organization, error) {
+	principal, err := uc.policy.CanReadCurrent(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, principal.OrganizationID)
+}
@@ -0,0 +1,34 @@
+package organizations
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/organizations"
+)
+
+// ListOrganizationsUseCase use case для получения списка организаций
+type ListOrganizationsUseCase struct {
+	repo   organizations.BaseOrganizationsRepository
+	policy *policies.OrganizationPolicy
+}
+
+// NewListOrganizationsUseCase создает новый use case
+func NewListOrganizationsUseCase(
+	repo organizations.BaseOrganizationsRepository,
+	policy *policies.OrganizationPolicy,
+) *ListOrganizationsUseCase {
+	return &ListOrganizationsUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение списка организаций
+func (uc *ListOrganizationsUseCase) Execute(ctx context.Context, page, pageSize int) ([]*organizations.Organization, int64, error) {
+	if err := uc.policy.CanManage(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.List(ctx, page, pageSize)
+}
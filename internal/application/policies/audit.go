@@ -0,0 +1,19 @@
+package policies
+
+import "context"
+
+// AuditPolicy проверяет права вызывающего пользователя на журнал аудита.
+// История отдельной задачи доступна всем, кто может ее просматривать (см. TaskPolicy),
+// а журнал всей организации - только с правом audit:read.
+type AuditPolicy struct{}
+
+// NewAuditPolicy создает новую политику доступа к журналу аудита
+func NewAuditPolicy() *AuditPolicy {
+	return &AuditPolicy{}
+}
+
+// CanReadLog проверяет, может ли вызывающий просматривать журнал организации
+func (p *AuditPolicy) CanReadLog(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionAuditRead)
+	return err
+}
@@ -0,0 +1,68 @@
+package policies
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/tasks"
+)
+
+// CommentPolicy проверяет права вызывающего пользователя на комментарии.
+// Читать и писать комментарии можно к задачам, которые пользователь может просматривать,
+// а изменять и удалять комментарий может только его автор
+type CommentPolicy struct {
+	tasks *TaskPolicy
+}
+
+// NewCommentPolicy создает новую политику доступа к комментариям
+func NewCommentPolicy(taskPolicy *TaskPolicy) *CommentPolicy {
+	return &CommentPolicy{tasks: taskPolicy}
+}
+
+// CanRead проверяет, может ли вызывающий читать комментарии к задаче
+func (p *CommentPolicy) CanRead(ctx context.Context, task *tasks.Task) error {
+	if _, err := RequirePermission(ctx, PermissionCommentsRead); err != nil {
+		return err
+	}
+	return p.tasks.CanView(ctx, task)
+}
+
+// CanCreate проверяет, может ли вызывающий комментировать задачу, и возвращает его
+func (p *CommentPolicy) CanCreate(ctx context.Context, task *tasks.Task) (auth.Principal, error) {
+	principal, err := RequirePermission(ctx, PermissionCommentsCreate)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	if err := p.tasks.CanView(ctx, task); err != nil {
+		return auth.Principal{}, err
+	}
+	return principal, nil
+}
+
+// CanUpdate проверяет, может ли вызывающий изменить комментарий
+func (p *CommentPolicy) CanUpdate(ctx context.Context, comment *comments.Comment) error {
+	return p.requireAuthor(ctx, "update", comment, PermissionCommentsUpdate)
+}
+
+// CanDelete проверяет, может ли вызывающий удалить комментарий
+func (p *CommentPolicy) CanDelete(ctx context.Context, comment *comments.Comment) error {
+	return p.requireAuthor(ctx, "delete", comment, PermissionCommentsDelete)
+}
+
+// requireAuthor проверяет право permission и то, что вызывающий - автор комментария
+func (p *CommentPolicy) requireAuthor(
+	ctx context.Context,
+	action string,
+	comment *comments.Comment,
+	permission Permission,
+) error {
+	principal, err := RequirePermission(ctx, permission)
+	if err != nil {
+		return err
+	}
+	if comment.AuthorID != principal.UserID {
+		return &auth.ForbiddenError{Action: action, Resource: "comment", Reason: "only the author can " + action + " a comment"}
+	}
+	return nil
+}
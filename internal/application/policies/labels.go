@@ -0,0 +1,31 @@
+package policies
+
+import "context"
+
+// LabelPolicy проверяет права вызывающего пользователя на метки.
+// Метки общие для всех пользователей организации: создавать их могут участники,
+// а переименовывать и удалять - только с правом labels:manage.
+type LabelPolicy struct{}
+
+// NewLabelPolicy создает новую политику доступа к меткам
+func NewLabelPolicy() *LabelPolicy {
+	return &LabelPolicy{}
+}
+
+// CanRead проверяет, может ли вызывающий просматривать метки
+func (p *LabelPolicy) CanRead(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionLabelsRead)
+	return err
+}
+
+// CanCreate проверяет, может ли вызывающий создавать метки
+func (p *LabelPolicy) CanCreate(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionLabelsCreate)
+	return err
+}
+
+// CanManage проверяет, может ли вызывающий переименовывать и удалять метки
+func (p *LabelPolicy) CanManage(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionLabelsManage)
+	return err
+}
@@ -0,0 +1,38 @@
+package policies
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+)
+
+// OrganizationPolicy проверяет права вызывающего пользователя на организации.
+// Свою организацию видит любой пользователь, а создавать организации и просматривать
+// их список могут только администраторы организации по умолчанию
+type OrganizationPolicy struct{}
+
+// NewOrganizationPolicy создает новую политику доступа к организациям
+func NewOrganizationPolicy() *OrganizationPolicy {
+	return &OrganizationPolicy{}
+}
+
+// CanReadCurrent проверяет, может ли вызывающий просматривать свою организацию, и возвращает его
+func (p *OrganizationPolicy) CanReadCurrent(ctx context.Context) (auth.Principal, error) {
+	return RequirePermission(ctx, PermissionOrganizationsRead)
+}
+
+// CanManage проверяет, может ли вызывающий создавать организации и просматривать их список
+func (p *OrganizationPolicy) CanManage(ctx context.Context) error {
+	principal, err := RequirePermission(ctx, PermissionOrganizationsManage)
+	if err != nil {
+		return err
+	}
+	if principal.OrganizationID != organizations.DefaultOrganizationID {
+		return &auth.ForbiddenError{
+			Permission: string(PermissionOrganizationsManage),
+			Reason:     "only administrators of the default organization can manage organizations",
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package policies
+
+import (
+	"context"
+	"slices"
+
+	"crud/internal/domain/auth"
+	vo "crud/internal/domain/users/value_objects"
+)
+
+// Permission право на выполнение действия
+type Permission string
+
+const (
+	PermissionUsersCreate Permission = "users:create"
+	PermissionUsersRead   Permission = "users:read"
+	PermissionUsersUpdate Permission = "users:update" // собственный профиль
+	PermissionUsersDelete Permission = "users:delete"
+	PermissionUsersManage Permission = "users:manage" // чужие профили и роли
+
+	PermissionTasksCreate    Permission = "tasks:create" // свои задачи
+	PermissionTasksRead      Permission = "tasks:read"
+	PermissionTasksUpdate    Permission = "tasks:update"
+	PermissionTasksDelete    Permission = "tasks:delete"
+	PermissionTasksReadAny   Permission = "tasks:read_any"   // чтение чужих задач
+	PermissionTasksManageAny Permission = "tasks:manage_any" // изменение и удаление чужих задач
+
+	PermissionProjectsCreate    Permission = "projects:create" // свои проекты
+	PermissionProjectsRead      Permission = "projects:read"
+	PermissionProjectsUpdate    Permission = "projects:update"
+	PermissionProjectsDelete    Permission = "projects:delete"
+	PermissionProjectsReadAny   Permission = "projects:read_any"   // чтение чужих проектов
+	PermissionProjectsManageAny Permission = "projects:manage_any" // изменение и удаление чужих проектов
+
+	PermissionLabelsRead   Permission = "labels:read"
+	PermissionLabelsCreate Permission = "labels:create"
+	PermissionLabelsManage Permission = "labels:manage" // переименование и удаление общих меток
+
+	PermissionCommentsRead   Permission = "comments:read"
+	PermissionCommentsCreate Permission = "comments:create"
+	PermissionCommentsUpdate Permission = "comments:update" // только свои комментарии
+	PermissionCommentsDelete Permission = "comments:delete" // только свои комментарии
+
+	PermissionOrganizationsRead   Permission = "organizations:read"   // своя организация
+	PermissionOrganizationsManage Permission = "organizations:manage" // создание и список организаций
+
+	PermissionAuditRead Permission = "audit:read" // журнал аудита всей организации
+
+	PermissionTrashPurge Permission = "trash:purge" // окончательное удаление из корзины
+)
+
+// rolePermissions матрица прав по ролям
+var rolePermissions = map[string][]Permission{
+	vo.RoleAdmin: {
+		PermissionUsersCreate,
+		PermissionUsersRead,
+		PermissionUsersUpdate,
+		PermissionUsersDelete,
+		PermissionUsersManage,
+		PermissionTasksCreate,
+		PermissionTasksRead,
+		PermissionTasksUpdate,
+		PermissionTasksDelete,
+		PermissionTasksReadAny,
+		PermissionTasksManageAny,
+		PermissionProjectsCreate,
+		PermissionProjectsRead,
+		PermissionProjectsUpdate,
+		PermissionProjectsDelete,
+		PermissionProjectsReadAny,
+		PermissionProjectsManageAny,
+		PermissionLabelsRead,
+		PermissionLabelsCreate,
+		PermissionLabelsManage,
+		PermissionCommentsRead,
+		PermissionCommentsCreate,
+		PermissionCommentsUpdate,
+		PermissionCommentsDelete,
+		PermissionOrganizationsRead,
+		PermissionOrganizationsManage,
+		PermissionAuditRead,
+		PermissionTrashPurge,
+	},
+	vo.RoleMember: {
+		PermissionUsersRead,
+		PermissionUsersUpdate,
+		PermissionTasksCreate,
+		PermissionTasksRead,
+		PermissionTasksUpdate,
+		PermissionTasksDelete,
+		PermissionProjectsCreate,
+		PermissionProjectsRead,
+		PermissionProjectsUpdate,
+		PermissionProjectsDelete,
+		PermissionLabelsRead,
+		PermissionLabelsCreate,
+		PermissionCommentsRead,
+		PermissionCommentsCreate,
+		PermissionCommentsUpdate,
+		PermissionCommentsDelete,
+		PermissionOrganizationsRead,
+	},
+	vo.RoleViewer: {
+		PermissionUsersRead,
+		PermissionUsersUpdate,
+		PermissionTasksRead,
+		PermissionTasksReadAny,
+		PermissionProjectsRead,
+		PermissionProjectsReadAny,
+		PermissionLabelsRead,
+		PermissionCommentsRead,
+		PermissionOrganizationsRead,
+	},
+}
+
+// UseCasePermissions право, которое требует каждый use case из application.initContainer.
+// Use cases аутентификации и системные use cases (создание администратора при запуске,
+// очистка корзины по сроку хранения) доступны без прав и в матрицу не входят.
+var UseCasePermissions = map[string]Permission{
+	"CreateUserUseCase":             PermissionUsersCreate,
+	"GetUserByIDUseCase":            PermissionUsersRead,
+	"GetUserByEmailUseCase":         PermissionUsersRead,
+	"ListUsersUseCase":              PermissionUsersRead,
+	"ListUsersByCursorUseCase":      PermissionUsersRead,
+	"UpdateUserUseCase":             PermissionUsersUpdate,
+	"DeleteUserUseCase":             PermissionUsersDelete,
+	"CreateTaskUseCase":             PermissionTasksCreate,
+	"GetTaskByIDUseCase":            PermissionTasksRead,
+	"ListTasksUseCase":              PermissionTasksRead,
+	"ListTasksByCursorUseCase":      PermissionTasksRead,
+	"UpdateTaskUseCase":             PermissionTasksUpdate,
+	"TransitionTaskUseCase":         PermissionTasksUpdate,
+	"DeleteTaskUseCase":             PermissionTasksDelete,
+	"ListTaskLabelsUseCase":         PermissionTasksRead,
+	"AttachTaskLabelUseCase":        PermissionTasksUpdate,
+	"DetachTaskLabelUseCase":        PermissionTasksUpdate,
+	"ListTaskChildrenUseCase":       PermissionTasksRead,
+	"ListTaskSubtreeUseCase":        PermissionTasksRead,
+	"GetTaskProgressUseCase":        PermissionTasksRead,
+	"ListTaskDependenciesUseCase":   PermissionTasksRead,
+	"AddTaskDependencyUseCase":      PermissionTasksUpdate,
+	"RemoveTaskDependencyUseCase":   PermissionTasksUpdate,
+	"CreateProjectUseCase":          PermissionProjectsCreate,
+	"GetProjectByIDUseCase":         PermissionProjectsRead,
+	"ListProjectsUseCase":           PermissionProjectsRead,
+	"UpdateProjectUseCase":          PermissionProjectsUpdate,
+	"DeleteProjectUseCase":          PermissionProjectsDelete,
+	"CreateLabelUseCase":            PermissionLabelsCreate,
+	"GetLabelByIDUseCase":           PermissionLabelsRead,
+	"ListLabelsUseCase":             PermissionLabelsRead,
+	"UpdateLabelUseCase":            PermissionLabelsManage,
+	"DeleteLabelUseCase":            PermissionLabelsManage,
+	"CreateCommentUseCase":          PermissionCommentsCreate,
+	"ListCommentsUseCase":           PermissionCommentsRead,
+	"ListCommentRevisionsUseCase":   PermissionCommentsRead,
+	"UpdateCommentUseCase":          PermissionCommentsUpdate,
+	"DeleteCommentUseCase":          PermissionCommentsDelete,
+	"UploadAttachmentUseCase":       PermissionTasksUpdate,
+	"ListAttachmentsUseCase":        PermissionTasksRead,
+	"GetAttachmentUseCase":          PermissionTasksRead,
+	"DownloadAttachmentUseCase":     PermissionTasksRead,
+	"DeleteAttachmentUseCase":       PermissionTasksUpdate,
+	"CreateOrganizationUseCase":     PermissionOrganizationsManage,
+	"ListOrganizationsUseCase":      PermissionOrganizationsManage,
+	"GetCurrentOrganizationUseCase": PermissionOrganizationsRead,
+	"ListTaskHistoryUseCase":        PermissionTasksRead,
+	"ListAuditLogUseCase":           PermissionAuditRead,
+	"ListDeletedTasksUseCase":       PermissionTasksRead,
+	"RestoreTaskUseCase":            PermissionTasksDelete,
+	"PurgeTaskUseCase":              PermissionTrashPurge,
+	"ListDeletedUsersUseCase":       PermissionUsersDelete,
+	"RestoreUserUseCase":            PermissionUsersDelete,
+	"PurgeUserUseCase":              PermissionTrashPurge,
+}
+
+// HasPermission проверяет, входит ли право в набор прав роли
+func HasPermission(role string, permission Permission) bool {
+	return slices.Contains(rolePermissions[role], permission)
+}
+
+// RequirePermission проверяет, что у вызывающего есть право, и возвращает его
+func RequirePermission(ctx context.Context, permission Permission) (auth.Principal, error) {
+	principal, err := auth.RequirePrincipal(ctx)
+	if err != nil {
+		return auth.Principal{}, err
+	}
+	if !HasPermission(principal.Role, permission) {
+		return auth.Principal{}, &auth.ForbiddenError{Permission: string(permission)}
+	}
+	return principal, nil
+}
@@ -0,0 +1,84 @@
+package policies
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/projects"
+
+	"github.com/google/uuid"
+)
+
+// ProjectPolicy проверяет права вызывающего пользователя на проекты.
+// Пользователь работает со своими проектами (Project.UserID), а с чужими —
+// только при наличии прав projects:read_any и projects:manage_any.
+type ProjectPolicy struct{}
+
+// NewProjectPolicy создает новую политику доступа к проектам
+func NewProjectPolicy() *ProjectPolicy {
+	return &ProjectPolicy{}
+}
+
+// CanCreateFor проверяет, может ли вызывающий создать проект для владельца ownerID
+func (p *ProjectPolicy) CanCreateFor(ctx context.Context, ownerID uuid.UUID) error {
+	principal, err := RequirePermission(ctx, PermissionProjectsCreate)
+	if err != nil {
+		return err
+	}
+	if principal.UserID != ownerID && !HasPermission(principal.Role, PermissionProjectsManageAny) {
+		return &auth.ForbiddenError{Action: "create", Resource: "project", Reason: "projects can only be created for yourself"}
+	}
+	return nil
+}
+
+// CanView проверяет, может ли вызывающий просматривать проект
+func (p *ProjectPolicy) CanView(ctx context.Context, project *projects.Project) error {
+	return p.requireAccess(ctx, "view", project, PermissionProjectsRead, PermissionProjectsReadAny)
+}
+
+// CanUpdate проверяет, может ли вызывающий изменять проект
+func (p *ProjectPolicy) CanUpdate(ctx context.Context, project *projects.Project) error {
+	return p.requireAccess(ctx, "update", project, PermissionProjectsUpdate, PermissionProjectsManageAny)
+}
+
+// CanDelete проверяет, может ли вызывающий удалить проект
+func (p *ProjectPolicy) CanDelete(ctx context.Context, project *projects.Project) error {
+	return p.requireAccess(ctx, "delete", project, PermissionProjectsDelete, PermissionProjectsManageAny)
+}
+
+// ScopeOwnerFilter возвращает фильтр по владельцу для списка проектов.
+// Без явного фильтра список ограничивается проектами вызывающего,
+// если у него нет права читать чужие проекты.
+func (p *ProjectPolicy) ScopeOwnerFilter(ctx context.Context, userID *uuid.UUID) (*uuid.UUID, error) {
+	principal, err := RequirePermission(ctx, PermissionProjectsRead)
+	if err != nil {
+		return nil, err
+	}
+	if HasPermission(principal.Role, PermissionProjectsReadAny) {
+		return userID, nil
+	}
+	if userID == nil {
+		return &principal.UserID, nil
+	}
+	if *userID != principal.UserID {
+		return nil, &auth.ForbiddenError{Action: "list", Resource: "projects", Reason: "cannot list projects of another user"}
+	}
+	return userID, nil
+}
+
+// requireAccess проверяет право на действие со своим проектом или право anyPermission на чужой
+func (p *ProjectPolicy) requireAccess(
+	ctx context.Context,
+	action string,
+	project *projects.Project,
+	ownPermission, anyPermission Permission,
+) error {
+	principal, err := RequirePermission(ctx, ownPermission)
+	if err != nil {
+		return err
+	}
+	if project.UserID != principal.UserID && !HasPermission(principal.Role, anyPermission) {
+		return &auth.ForbiddenError{Action: action, Resource: "project"}
+	}
+	return nil
+}
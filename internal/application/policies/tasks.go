@@ -0,0 +1,84 @@
+package policies
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// TaskPolicy проверяет права вызывающего пользователя на задачи.
+// Пользователь работает со своими задачами (Task.UserID), а с чужими —
+// только при наличии прав tasks:read_any и tasks:manage_any.
+type TaskPolicy struct{}
+
+// NewTaskPolicy создает новую политику доступа к задачам
+func NewTaskPolicy() *TaskPolicy {
+	return &TaskPolicy{}
+}
+
+// CanCreateFor проверяет, может ли вызывающий создать задачу для владельца ownerID
+func (p *TaskPolicy) CanCreateFor(ctx context.Context, ownerID uuid.UUID) error {
+	principal, err := RequirePermission(ctx, PermissionTasksCreate)
+	if err != nil {
+		return err
+	}
+	if principal.UserID != ownerID && !HasPermission(principal.Role, PermissionTasksManageAny) {
+		return &auth.ForbiddenError{Action: "create", Resource: "task", Reason: "tasks can only be created for yourself"}
+	}
+	return nil
+}
+
+// CanView проверяет, может ли вызывающий просматривать задачу
+func (p *TaskPolicy) CanView(ctx context.Context, task *tasks.Task) error {
+	return p.requireAccess(ctx, "view", task, PermissionTasksRead, PermissionTasksReadAny)
+}
+
+// CanUpdate проверяет, может ли вызывающий изменять задачу
+func (p *TaskPolicy) CanUpdate(ctx context.Context, task *tasks.Task) error {
+	return p.requireAccess(ctx, "update", task, PermissionTasksUpdate, PermissionTasksManageAny)
+}
+
+// CanDelete проверяет, может ли вызывающий удалить задачу
+func (p *TaskPolicy) CanDelete(ctx context.Context, task *tasks.Task) error {
+	return p.requireAccess(ctx, "delete", task, PermissionTasksDelete, PermissionTasksManageAny)
+}
+
+// ScopeOwnerFilter возвращает фильтр по владельцу для списка задач.
+// Без явного фильтра список ограничивается задачами вызывающего,
+// если у него нет права читать чужие задачи.
+func (p *TaskPolicy) ScopeOwnerFilter(ctx context.Context, userID *uuid.UUID) (*uuid.UUID, error) {
+	principal, err := RequirePermission(ctx, PermissionTasksRead)
+	if err != nil {
+		return nil, err
+	}
+	if HasPermission(principal.Role, PermissionTasksReadAny) {
+		return userID, nil
+	}
+	if userID == nil {
+		return &principal.UserID, nil
+	}
+	if *userID != principal.UserID {
+		return nil, &auth.ForbiddenError{Action: "list", Resource: "tasks", Reason: "cannot list tasks of another user"}
+	}
+	return userID, nil
+}
+
+// requireAccess проверяет право на действие со своей задачей или право anyPermission на чужую
+func (p *TaskPolicy) requireAccess(
+	ctx context.Context,
+	action string,
+	task *tasks.Task,
+	ownPermission, anyPermission Permission,
+) error {
+	principal, err := RequirePermission(ctx, ownPermission)
+	if err != nil {
+		return err
+	}
+	if task.UserID != principal.UserID && !HasPermission(principal.Role, anyPermission) {
+		return &auth.ForbiddenError{Action: action, Resource: "task"}
+	}
+	return nil
+}
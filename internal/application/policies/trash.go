@@ -0,0 +1,19 @@
+package policies
+
+import "context"
+
+// TrashPolicy проверяет права вызывающего пользователя на окончательное удаление из корзины.
+// Задачи в корзине просматриваются и восстанавливаются по правилам TaskPolicy,
+// пользователи - теми, кто может удалять пользователей (см. UserPolicy).
+type TrashPolicy struct{}
+
+// NewTrashPolicy создает новую политику доступа к корзине
+func NewTrashPolicy() *TrashPolicy {
+	return &TrashPolicy{}
+}
+
+// CanPurge проверяет, может ли вызывающий окончательно удалять данные из корзины
+func (p *TrashPolicy) CanPurge(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionTrashPurge)
+	return err
+}
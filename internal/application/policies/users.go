@@ -0,0 +1,53 @@
+package policies
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/users"
+)
+
+// UserPolicy проверяет права вызывающего пользователя на управление пользователями
+type UserPolicy struct{}
+
+// NewUserPolicy создает новую политику доступа к пользователям
+func NewUserPolicy() *UserPolicy {
+	return &UserPolicy{}
+}
+
+// CanCreate проверяет, может ли вызывающий создавать пользователей
+func (p *UserPolicy) CanCreate(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionUsersCreate)
+	return err
+}
+
+// CanRead проверяет, может ли вызывающий просматривать пользователей
+func (p *UserPolicy) CanRead(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionUsersRead)
+	return err
+}
+
+// CanUpdate проверяет, может ли вызывающий изменить пользователя.
+// Свой профиль можно менять с правом users:update, чужой и роль — только с users:manage.
+func (p *UserPolicy) CanUpdate(ctx context.Context, user *users.User, changesRole bool) error {
+	principal, err := RequirePermission(ctx, PermissionUsersUpdate)
+	if err != nil {
+		return err
+	}
+	if HasPermission(principal.Role, PermissionUsersManage) {
+		return nil
+	}
+	if changesRole {
+		return &auth.ForbiddenError{Action: "change role of", Resource: "user", Permission: string(PermissionUsersManage)}
+	}
+	if user.ID != principal.UserID {
+		return &auth.ForbiddenError{Action: "update", Resource: "user", Reason: "only your own profile can be updated"}
+	}
+	return nil
+}
+
+// CanDelete проверяет, может ли вызывающий удалять пользователей
+func (p *UserPolicy) CanDelete(ctx context.Context) error {
+	_, err := RequirePermission(ctx, PermissionUsersDelete)
+	return err
+}
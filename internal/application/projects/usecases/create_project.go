@@ -0,0 +1,71 @@
+package projects
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/projects"
+	vo "crud/internal/domain/projects/value_objects"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// CreateProjectInput данные для создания проекта; при UserID == uuid.Nil владельцем становится вызывающий
+type CreateProjectInput struct {
+	UserID      uuid.UUID
+	Name        string
+	Description string
+}
+
+// CreateProjectUseCase use case для создания проекта
+type CreateProjectUseCase struct {
+	repo      projects.BaseProjectsRepository
+	usersRepo users.BaseUsersRepository
+	policy    *policies.ProjectPolicy
+}
+
+// NewCreateProjectUseCase создает новый use case
+func NewCreateProjectUseCase(
+	repo projects.BaseProjectsRepository,
+	usersRepo users.BaseUsersRepository,
+	policy *policies.ProjectPolicy,
+) *CreateProjectUseCase {
+	return &CreateProjectUseCase{
+		repo:      repo,
+		usersRepo: usersRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет создание проекта
+func (uc *CreateProjectUseCase) Execute(ctx context.Context, input CreateProjectInput) (*projects.Project, error) {
+	userID := input.UserID
+	if userID == uuid.Nil {
+		principal, err := auth.RequirePrincipal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID = principal.UserID
+	}
+
+	if err := uc.policy.CanCreateFor(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Владелец проекта должен существовать
+	if _, err := uc.usersRepo.GetByID(ctx, userID); err != nil {
+		if users.IsUserNotFound(err) {
+			return nil, &projects.ProjectOwnerNotFoundError{UserID: userID}
+		}
+		return nil, err
+	}
+
+	nameVO, err := vo.NewProjectNameValueObject(input.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Create(ctx, projects.NewProject(userID, nameVO, input.Description))
+}
@@ -0,0 +1,54 @@
+package projects
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// DeleteProjectUseCase use case для удаления проекта
+type DeleteProjectUseCase struct {
+	repo      projects.BaseProjectsRepository
+	tasksRepo tasks.BaseTasksRepository
+	policy    *policies.ProjectPolicy
+}
+
+// NewDeleteProjectUseCase создает новый use case
+func NewDeleteProjectUseCase(
+	repo projects.BaseProjectsRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	policy *policies.ProjectPolicy,
+) *DeleteProjectUseCase {
+	return &DeleteProjectUseCase{
+		repo:      repo,
+		tasksRepo: tasksRepo,
+		policy:    policy,
+	}
+}
+
+// Execute выполняет удаление проекта; проект с задачами удалить нельзя
+func (uc *DeleteProjectUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	project, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanDelete(ctx, project); err != nil {
+		return err
+	}
+
+	projectID := project.ID
+	_, projectTasks, err := uc.tasksRepo.List(ctx, tasks.ListTasksFilter{ProjectID: &projectID}, 1, 1)
+	if err != nil {
+		return err
+	}
+	if projectTasks > 0 {
+		return &projects.ProjectHasTasksError{ProjectID: project.ID, Tasks: projectTasks}
+	}
+
+	return uc.repo.Delete(ctx, id)
+}
@@ -0,0 +1,38 @@
+package projects
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/projects"
+
+	"github.com/google/uuid"
+)
+
+// GetProjectByIDUseCase use case для получения проекта по ID
+type GetProjectByIDUseCase struct {
+	repo   projects.BaseProjectsRepository
+	policy *policies.ProjectPolicy
+}
+
+// NewGetProjectByIDUseCase создает новый use case
+func NewGetProjectByIDUseCase(repo projects.BaseProjectsRepository, policy *policies.ProjectPolicy) *GetProjectByIDUseCase {
+	return &GetProjectByIDUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение проекта по ID
+func (uc *GetProjectByIDUseCase) Execute(ctx context.Context, id uuid.UUID) (*projects.Project, error) {
+	project, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, project); err != nil {
+		return nil, err
+	}
+
+	return project, nil
+}
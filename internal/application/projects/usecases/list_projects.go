@@ -0,0 +1,37 @@
+package projects
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/projects"
+)
+
+// ListProjectsUseCase use case для получения списка проектов
+type ListProjectsUseCase struct {
+	repo   projects.BaseProjectsRepository
+	policy *policies.ProjectPolicy
+}
+
+// NewListProjectsUseCase создает новый use case
+func NewListProjectsUseCase(repo projects.BaseProjectsRepository, policy *policies.ProjectPolicy) *ListProjectsUseCase {
+	return &ListProjectsUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение списка проектов; без filter.UserID возвращаются проекты вызывающего
+func (uc *ListProjectsUseCase) Execute(
+	ctx context.Context,
+	filter projects.ListProjectsFilter,
+	page, pageSize int,
+) ([]*projects.Project, int64, error) {
+	ownerID, err := uc.policy.ScopeOwnerFilter(ctx, filter.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	filter.UserID = ownerID
+
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
@@ -0,0 +1,57 @@
+package projects
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/projects"
+	vo "crud/internal/domain/projects/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// UpdateProjectInput данные для обновления проекта; nil поля не меняются
+type UpdateProjectInput struct {
+	Name        *string
+	Description *string
+}
+
+// UpdateProjectUseCase use case для обновления проекта
+type UpdateProjectUseCase struct {
+	repo   projects.BaseProjectsRepository
+	policy *policies.ProjectPolicy
+}
+
+// NewUpdateProjectUseCase создает новый use case
+func NewUpdateProjectUseCase(repo projects.BaseProjectsRepository, policy *policies.ProjectPolicy) *UpdateProjectUseCase {
+	return &UpdateProjectUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет обновление проекта
+func (uc *UpdateProjectUseCase) Execute(ctx context.Context, id uuid.UUID, input UpdateProjectInput) (*projects.Project, error) {
+	project, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if input.Name != nil {
+		name, err := vo.NewProjectNameValueObject(*input.Name)
+		if err != nil {
+			return nil, err
+		}
+		project.Name = name
+	}
+
+	if input.Description != nil {
+		project.Description = *input.Description
+	}
+
+	return uc.repo.Update(ctx, project)
+}
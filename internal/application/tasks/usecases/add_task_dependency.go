@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// AddTaskDependencyUseCase use case для добавления блокирующей задачи
+type AddTaskDependencyUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewAddTaskDependencyUseCase создает новый use case
+func NewAddTaskDependencyUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *AddTaskDependencyUseCase {
+	return &AddTaskDependencyUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute делает blockerID блокирующей задачей для taskID и возвращает все зависимости задачи.
+// Зависимость, которая замыкает цепочку блокировок, отклоняется
+func (uc *AddTaskDependencyUseCase) Execute(ctx context.Context, taskID, blockerID uuid.UUID) (*tasks.TaskDependencies, error) {
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return nil, err
+	}
+
+	blocker, err := uc.repo.GetByID(ctx, blockerID)
+	if err != nil {
+		if tasks.IsTaskNotFound(err) {
+			return nil, &tasks.BlockerTaskNotFoundError{BlockerID: blockerID}
+		}
+		return nil, err
+	}
+
+	chain, err := uc.repo.ListBlockerChain(ctx, blocker.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tasks.ValidateDependency(task, blocker, chain); err != nil {
+		return nil, err
+	}
+
+	if err := uc.repo.AddDependency(ctx, task.ID, blocker.ID); err != nil {
+		return nil, err
+	}
+
+	return listDependencies(ctx, uc.repo, task.ID)
+}
@@ -0,0 +1,49 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// AttachTaskLabelUseCase use case для добавления метки к задаче
+type AttachTaskLabelUseCase struct {
+	repo   tasks.BaseTasksRepository
+	labels labels.BaseLabelsRepository
+	policy *policies.TaskPolicy
+}
+
+// NewAttachTaskLabelUseCase создает новый use case
+func NewAttachTaskLabelUseCase(
+	repo tasks.BaseTasksRepository,
+	labelsRepo labels.BaseLabelsRepository,
+	policy *policies.TaskPolicy,
+) *AttachTaskLabelUseCase {
+	return &AttachTaskLabelUseCase{
+		repo:   repo,
+		labels: labelsRepo,
+		policy: policy,
+	}
+}
+
+// Execute добавляет метку к задаче и возвращает все метки задачи
+func (uc *AttachTaskLabelUseCase) Execute(ctx context.Context, taskID, labelID uuid.UUID) ([]*labels.Label, error) {
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return nil, err
+	}
+
+	if err := uc.labels.AttachToTask(ctx, task.ID, labelID); err != nil {
+		return nil, err
+	}
+
+	return uc.labels.ListByTask(ctx, task.ID)
+}
@@ -2,43 +2,147 @@ package tasks
 
 import (
 	"context"
+	"time"
 
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/projects"
 	"crud/internal/domain/tasks"
 	vo "crud/internal/domain/tasks/value_objects"
+	"crud/internal/domain/users"
 
 	"github.com/google/uuid"
 )
 
+// CreateTaskInput данные для создания задачи; при UserID == uuid.Nil владельцем становится вызывающий.
+// ProjectID и ParentID помещают задачу в проект и делают ее подзадачей; оба должны принадлежать тому же владельцу
+type CreateTaskInput struct {
+	UserID      uuid.UUID
+	ProjectID   *uuid.UUID
+	ParentID    *uuid.UUID
+	Title       string
+	Description string
+	Status      string
+	Priority    string // пустое значение означает приоритет по умолчанию
+	StartDate   *time.Time
+	DueDate     *time.Time
+}
+
 // CreateTaskUseCase use case для создания задачи
 type CreateTaskUseCase struct {
-	repo tasks.BaseTasksRepository
+	repo         tasks.BaseTasksRepository
+	usersRepo    users.BaseUsersRepository
+	projectsRepo projects.BaseProjectsRepository
+	hierarchy    *tasks.Hierarchy
+	workflow     *tasks.Workflow
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.TaskPolicy
 }
 
 // NewCreateTaskUseCase создает новый use case
-func NewCreateTaskUseCase(repo tasks.BaseTasksRepository) *CreateTaskUseCase {
+func NewCreateTaskUseCase(
+	repo tasks.BaseTasksRepository,
+	usersRepo users.BaseUsersRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	hierarchy *tasks.Hierarchy,
+	workflow *tasks.Workflow,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.TaskPolicy,
+) *CreateTaskUseCase {
 	return &CreateTaskUseCase{
-		repo: repo,
+		repo:         repo,
+		usersRepo:    usersRepo,
+		projectsRepo: projectsRepo,
+		hierarchy:    hierarchy,
+		workflow:     workflow,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
 	}
 }
 
 // Execute выполняет создание задачи
-func (uc *CreateTaskUseCase) Execute(
-	ctx context.Context,
-	userID uuid.UUID,
-	title string,
-	description string,
-	status string,
-) (*tasks.Task, error) {
-	titleVO, err := vo.NewTaskTitleValueObject(title)
+func (uc *CreateTaskUseCase) Execute(ctx context.Context, input CreateTaskInput) (*tasks.Task, error) {
+	userID := input.UserID
+	if userID == uuid.Nil {
+		principal, err := auth.RequirePrincipal(ctx)
+		if err != nil {
+			return nil, err
+		}
+		userID = principal.UserID
+	}
+
+	if err := uc.policy.CanCreateFor(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// Владелец задачи должен существовать
+	if _, err := uc.usersRepo.GetByID(ctx, userID); err != nil {
+		if users.IsUserNotFound(err) {
+			return nil, &tasks.TaskOwnerNotFoundError{UserID: userID}
+		}
+		return nil, err
+	}
+
+	titleVO, err := vo.NewTaskTitleValueObject(input.Title)
+	if err != nil {
+		return nil, err
+	}
+
+	statusVO, err := vo.NewTaskStatusValueObject(input.Status)
 	if err != nil {
 		return nil, err
 	}
+	// Начальный статус задает рабочий процесс: задачу нельзя создать сразу, например, завершенной
+	if err := uc.workflow.CheckInitialStatus(statusVO); err != nil {
+		return nil, err
+	}
 
-	statusVO, err := vo.NewTaskStatusValueObject(status)
+	priorityVO := vo.NewDefaultTaskPriorityValueObject()
+	if input.Priority != "" {
+		priorityVO, err = vo.NewTaskPriorityValueObject(input.Priority)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	scheduleVO, err := vo.NewTaskScheduleValueObject(input.StartDate, input.DueDate)
 	if err != nil {
 		return nil, err
 	}
 
-	task := tasks.NewTask(userID, titleVO, description, statusVO)
-	return uc.repo.Create(ctx, task)
+	task := tasks.NewTask(userID, titleVO, input.Description, statusVO)
+	task.Priority = priorityVO
+	task.Schedule = scheduleVO
+
+	if input.ProjectID != nil {
+		if err := assignProject(ctx, uc.projectsRepo, task, *input.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ParentID != nil {
+		if err := assignParent(ctx, uc.repo, uc.hierarchy, task, *input.ParentID, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var created *tasks.Task
+	err = uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = uc.repo.Create(ctx, task)
+		if err != nil {
+			return err
+		}
+		return uc.recorder.RecordCreate(ctx, audit.EntityTask, created.ID, audit_usecases.TaskSnapshot(created))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
 }
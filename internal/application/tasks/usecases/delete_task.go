@@ -3,6 +3,10 @@ package tasks
 import (
 	"context"
 
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
 	"crud/internal/domain/tasks"
 
 	"github.com/google/uuid"
@@ -10,17 +14,51 @@ import (
 
 // DeleteTaskUseCase use case для удаления задачи
 type DeleteTaskUseCase struct {
-	repo tasks.BaseTasksRepository
+	repo         tasks.BaseTasksRepository
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.TaskPolicy
 }
 
 // NewDeleteTaskUseCase создает новый use case
-func NewDeleteTaskUseCase(repo tasks.BaseTasksRepository) *DeleteTaskUseCase {
+func NewDeleteTaskUseCase(
+	repo tasks.BaseTasksRepository,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.TaskPolicy,
+) *DeleteTaskUseCase {
 	return &DeleteTaskUseCase{
-		repo: repo,
+		repo:         repo,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
 	}
 }
 
-// Execute выполняет удаление задачи
+// Execute выполняет удаление задачи; задачу с подзадачами удалить нельзя
 func (uc *DeleteTaskUseCase) Execute(ctx context.Context, id uuid.UUID) error {
-	return uc.repo.Delete(ctx, id)
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanDelete(ctx, task); err != nil {
+		return err
+	}
+
+	parentID := task.ID
+	_, subtasks, err := uc.repo.List(ctx, tasks.ListTasksFilter{ParentID: &parentID}, 1, 1)
+	if err != nil {
+		return err
+	}
+	if subtasks > 0 {
+		return &tasks.TaskHasSubtasksError{TaskID: task.ID, Subtasks: subtasks}
+	}
+
+	return uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return uc.recorder.RecordDelete(ctx, audit.EntityTask, task.ID, audit_usecases.TaskSnapshot(task))
+	})
 }
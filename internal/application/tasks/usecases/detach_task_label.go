@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// DetachTaskLabelUseCase use case для снятия метки с задачи
+type DetachTaskLabelUseCase struct {
+	repo   tasks.BaseTasksRepository
+	labels labels.BaseLabelsRepository
+	policy *policies.TaskPolicy
+}
+
+// NewDetachTaskLabelUseCase создает новый use case
+func NewDetachTaskLabelUseCase(
+	repo tasks.BaseTasksRepository,
+	labelsRepo labels.BaseLabelsRepository,
+	policy *policies.TaskPolicy,
+) *DetachTaskLabelUseCase {
+	return &DetachTaskLabelUseCase{
+		repo:   repo,
+		labels: labelsRepo,
+		policy: policy,
+	}
+}
+
+// Execute снимает метку с задачи; снятие отсутствующей метки не считается ошибкой
+func (uc *DetachTaskLabelUseCase) Execute(ctx context.Context, taskID, labelID uuid.UUID) error {
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return err
+	}
+
+	return uc.labels.DetachFromTask(ctx, task.ID, labelID)
+}
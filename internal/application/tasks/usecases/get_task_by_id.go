@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 
+	"crud/internal/application/policies"
 	"crud/internal/domain/tasks"
 
 	"github.com/google/uuid"
@@ -10,17 +11,28 @@ import (
 
 // GetTaskByIDUseCase use case для получения задачи по ID
 type GetTaskByIDUseCase struct {
-	repo tasks.BaseTasksRepository
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
 }
 
 // NewGetTaskByIDUseCase создает новый use case
-func NewGetTaskByIDUseCase(repo tasks.BaseTasksRepository) *GetTaskByIDUseCase {
+func NewGetTaskByIDUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *GetTaskByIDUseCase {
 	return &GetTaskByIDUseCase{
-		repo: repo,
+		repo:   repo,
+		policy: policy,
 	}
 }
 
 // Execute выполняет получение задачи
 func (uc *GetTaskByIDUseCase) Execute(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
-	return uc.repo.GetByID(ctx, id)
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return task, nil
 }
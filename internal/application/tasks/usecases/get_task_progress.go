@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// GetTaskProgressUseCase use case для подсчета прогресса задачи по ее подзадачам
+type GetTaskProgressUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewGetTaskProgressUseCase создает новый use case
+func NewGetTaskProgressUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *GetTaskProgressUseCase {
+	return &GetTaskProgressUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute подсчитывает, сколько потомков задачи на всех уровнях находятся в завершенных статусах
+func (uc *GetTaskProgressUseCase) Execute(ctx context.Context, id uuid.UUID) (tasks.TaskProgress, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return tasks.TaskProgress{}, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return tasks.TaskProgress{}, err
+	}
+
+	descendants, err := uc.repo.ListSubtree(ctx, task.ID)
+	if err != nil {
+		return tasks.TaskProgress{}, err
+	}
+
+	return tasks.NewTaskProgress(descendants), nil
+}
@@ -0,0 +1,46 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/config"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// NewTaskHierarchy создает правила иерархии задач с максимальной глубиной из конфигурации
+func NewTaskHierarchy(cfg *config.Config) (*tasks.Hierarchy, error) {
+	return tasks.NewHierarchy(cfg.TaskMaxDepth)
+}
+
+// assignParent проверяет правила иерархии и делает задачу подзадачей parentID без сохранения.
+// descendants - потомки задачи, которые переносятся вместе с ней
+func assignParent(
+	ctx context.Context,
+	repo tasks.BaseTasksRepository,
+	hierarchy *tasks.Hierarchy,
+	task *tasks.Task,
+	parentID uuid.UUID,
+	descendants []*tasks.Task,
+) error {
+	parent, err := repo.GetByID(ctx, parentID)
+	if err != nil {
+		if tasks.IsTaskNotFound(err) {
+			return &tasks.ParentTaskNotFoundError{ParentID: parentID}
+		}
+		return err
+	}
+
+	ancestors, err := repo.ListAncestors(ctx, parent.ID)
+	if err != nil {
+		return err
+	}
+
+	if err := hierarchy.ValidateParent(task, parent, ancestors, descendants); err != nil {
+		return err
+	}
+
+	task.ParentID = &parent.ID
+	return nil
+}
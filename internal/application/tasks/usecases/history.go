@@ -0,0 +1,34 @@
+package tasks
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/tasks"
+)
+
+// updateWithHistory сохраняет задачу и запись журнала об отличиях от before в одной транзакции
+func updateWithHistory(
+	ctx context.Context,
+	transactions common.BaseTransactionManager,
+	repo tasks.BaseTasksRepository,
+	recorder *audit_usecases.AuditRecorder,
+	task *tasks.Task,
+	before audit.Snapshot,
+) (*tasks.Task, error) {
+	var updated *tasks.Task
+	err := transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updated, err = repo.Update(ctx, task)
+		if err != nil {
+			return err
+		}
+		return recorder.RecordUpdate(ctx, audit.EntityTask, updated.ID, before, audit_usecases.TaskSnapshot(updated))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
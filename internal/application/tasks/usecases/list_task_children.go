@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListTaskChildrenUseCase use case для получения непосредственных подзадач задачи
+type ListTaskChildrenUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewListTaskChildrenUseCase создает новый use case
+func NewListTaskChildrenUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *ListTaskChildrenUseCase {
+	return &ListTaskChildrenUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение подзадач с пагинацией
+func (uc *ListTaskChildrenUseCase) Execute(ctx context.Context, id uuid.UUID, page, pageSize int) ([]*tasks.Task, int64, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.List(ctx, tasks.ListTasksFilter{ParentID: &task.ID}, page, pageSize)
+}
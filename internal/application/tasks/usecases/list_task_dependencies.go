@@ -0,0 +1,53 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListTaskDependenciesUseCase use case для получения зависимостей задачи
+type ListTaskDependenciesUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewListTaskDependenciesUseCase создает новый use case
+func NewListTaskDependenciesUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *ListTaskDependenciesUseCase {
+	return &ListTaskDependenciesUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute возвращает задачи, которые блокируют задачу, и задачи, которые она блокирует
+func (uc *ListTaskDependenciesUseCase) Execute(ctx context.Context, id uuid.UUID) (*tasks.TaskDependencies, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return listDependencies(ctx, uc.repo, task.ID)
+}
+
+// listDependencies загружает блокирующие и зависимые задачи
+func listDependencies(ctx context.Context, repo tasks.BaseTasksRepository, taskID uuid.UUID) (*tasks.TaskDependencies, error) {
+	blockers, err := repo.ListBlockers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	dependents, err := repo.ListDependents(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tasks.TaskDependencies{Blockers: blockers, Dependents: dependents}, nil
+}
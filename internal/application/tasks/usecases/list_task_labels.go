@@ -0,0 +1,45 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListTaskLabelsUseCase use case для получения меток задачи
+type ListTaskLabelsUseCase struct {
+	repo   tasks.BaseTasksRepository
+	labels labels.BaseLabelsRepository
+	policy *policies.TaskPolicy
+}
+
+// NewListTaskLabelsUseCase создает новый use case
+func NewListTaskLabelsUseCase(
+	repo tasks.BaseTasksRepository,
+	labelsRepo labels.BaseLabelsRepository,
+	policy *policies.TaskPolicy,
+) *ListTaskLabelsUseCase {
+	return &ListTaskLabelsUseCase{
+		repo:   repo,
+		labels: labelsRepo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение меток задачи
+func (uc *ListTaskLabelsUseCase) Execute(ctx context.Context, taskID uuid.UUID) ([]*labels.Label, error) {
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return uc.labels.ListByTask(ctx, task.ID)
+}
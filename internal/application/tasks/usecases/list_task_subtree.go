@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListTaskSubtreeUseCase use case для получения всех подзадач задачи на любой глубине
+type ListTaskSubtreeUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewListTaskSubtreeUseCase создает новый use case
+func NewListTaskSubtreeUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *ListTaskSubtreeUseCase {
+	return &ListTaskSubtreeUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute выполняет получение потомков задачи, упорядоченных по времени создания
+func (uc *ListTaskSubtreeUseCase) Execute(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanView(ctx, task); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.ListSubtree(ctx, task.ID)
+}
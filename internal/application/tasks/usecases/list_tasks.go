@@ -2,30 +2,105 @@ package tasks
 
 import (
 	"context"
+	"time"
 
+	"crud/internal/application/policies"
+	labels_vo "crud/internal/domain/labels/value_objects"
+	"crud/internal/domain/projects"
 	"crud/internal/domain/tasks"
-
-	"github.com/google/uuid"
 )
 
 // ListTasksUseCase use case для получения списка задач
 type ListTasksUseCase struct {
-	repo tasks.BaseTasksRepository
+	repo          tasks.BaseTasksRepository
+	projectsRepo  projects.BaseProjectsRepository
+	policy        *policies.TaskPolicy
+	projectPolicy *policies.ProjectPolicy
 }
 
 // NewListTasksUseCase создает новый use case
-func NewListTasksUseCase(repo tasks.BaseTasksRepository) *ListTasksUseCase {
+func NewListTasksUseCase(
+	repo tasks.BaseTasksRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	policy *policies.TaskPolicy,
+	projectPolicy *policies.ProjectPolicy,
+) *ListTasksUseCase {
 	return &ListTasksUseCase{
-		repo: repo,
+		repo:          repo,
+		projectsRepo:  projectsRepo,
+		policy:        policy,
+		projectPolicy: projectPolicy,
 	}
 }
 
-// Execute выполняет получение списка задач
+// Execute выполняет получение списка задач; без filter.UserID возвращаются задачи вызывающего.
+// filter.ProjectID ограничивает список проектом, который вызывающий может просматривать.
+// Если filter.Now не задан, просроченность определяется на текущий момент.
+// Имена меток приводятся к виду, в котором они хранятся, повторы отбрасываются
 func (uc *ListTasksUseCase) Execute(
 	ctx context.Context,
-	userID *uuid.UUID,
-	status *string,
+	filter tasks.ListTasksFilter,
 	page, pageSize int,
 ) ([]*tasks.Task, int64, error) {
-	return uc.repo.List(ctx, userID, status, page, pageSize)
+	filter, err := uc.scopeFilter(ctx, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.List(ctx, filter, page, pageSize)
+}
+
+// scopeFilter ограничивает фильтр задачами, которые видит вызывающий, и приводит его к виду,
+// в котором его ожидает репозиторий
+func (uc *ListTasksUseCase) scopeFilter(ctx context.Context, filter tasks.ListTasksFilter) (tasks.ListTasksFilter, error) {
+	ownerID, err := uc.policy.ScopeOwnerFilter(ctx, filter.UserID)
+	if err != nil {
+		return filter, err
+	}
+	filter.UserID = ownerID
+
+	if filter.ProjectID != nil {
+		project, err := uc.projectsRepo.GetByID(ctx, *filter.ProjectID)
+		if err != nil {
+			return filter, err
+		}
+		if err := uc.projectPolicy.CanView(ctx, project); err != nil {
+			return filter, err
+		}
+	}
+
+	if filter.Now.IsZero() {
+		filter.Now = time.Now().UTC()
+	}
+
+	if filter.Labels, err = normalizeLabelNames(filter.Labels); err != nil {
+		return filter, err
+	}
+	if filter.LabelMatch == "" {
+		filter.LabelMatch = tasks.LabelMatchAny
+	}
+
+	return filter, nil
+}
+
+// normalizeLabelNames валидирует имена меток фильтра и убирает повторы
+func normalizeLabelNames(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		nameVO, err := labels_vo.NewLabelNameValueObject(name)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[nameVO.Value()]; ok {
+			continue
+		}
+		seen[nameVO.Value()] = struct{}{}
+		normalized = append(normalized, nameVO.Value())
+	}
+	return normalized, nil
 }
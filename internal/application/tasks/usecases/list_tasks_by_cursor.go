@@ -0,0 +1,81 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/domain/tasks"
+)
+
+// TasksCursorPage страница списка задач при выводе по курсору
+type TasksCursorPage struct {
+	Tasks []*tasks.Task
+
+	// Next и Prev - позиции соседних страниц; nil, если в эту сторону задач больше нет
+	Next *tasks.TaskCursor
+	Prev *tasks.TaskCursor
+}
+
+// ListTasksByCursorUseCase use case для получения списка задач по курсору: страница начинается
+// сразу за последней задачей предыдущей страницы, поэтому задачи не пропускаются и не повторяются,
+// когда между запросами в список добавляются или из него удаляются задачи
+type ListTasksByCursorUseCase struct {
+	repo tasks.BaseTasksRepository
+	list *ListTasksUseCase
+}
+
+// NewListTasksByCursorUseCase создает новый use case
+func NewListTasksByCursorUseCase(repo tasks.BaseTasksRepository, list *ListTasksUseCase) *ListTasksByCursorUseCase {
+	return &ListTasksByCursorUseCase{
+		repo: repo,
+		list: list,
+	}
+}
+
+// Execute возвращает не больше pageSize задач после позиции cursor или перед ней; без cursor - первую страницу.
+// Фильтр ограничивается так же, как в ListTasksUseCase; позиция должна быть выдана для того же порядка сортировки,
+// а сортировка по списку полей (filter.SortKeys) курсором не поддерживается
+func (uc *ListTasksByCursorUseCase) Execute(
+	ctx context.Context,
+	filter tasks.ListTasksFilter,
+	cursor *tasks.TaskCursor,
+	pageSize int,
+) (*TasksCursorPage, error) {
+	filter, err := uc.list.scopeFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(filter.SortKeys) > 0 {
+		return nil, &tasks.InvalidTaskDataError{Field: "cursor", Message: "cursor pagination supports only the default and priority sort orders"}
+	}
+	if cursor != nil && cursor.Sort != filter.Sort {
+		return nil, &tasks.InvalidTaskDataError{Field: "cursor", Message: "cursor was issued for a different sort order"}
+	}
+
+	// Лишняя задача показывает, есть ли задачи за страницей
+	list, err := uc.repo.ListByCursor(ctx, filter, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	backward := cursor != nil && cursor.Backward
+	more := len(list) > pageSize
+	if more && backward {
+		list = list[1:]
+	} else if more {
+		list = list[:pageSize]
+	}
+
+	page := &TasksCursorPage{Tasks: list}
+	if len(list) == 0 {
+		// На пустой странице не от чего отсчитывать соседние страницы
+		return page, nil
+	}
+
+	if more || backward {
+		page.Next = tasks.NewTaskCursor(list[len(list)-1], filter.Sort, false)
+	}
+	if (more && backward) || (cursor != nil && !backward) {
+		page.Prev = tasks.NewTaskCursor(list[0], filter.Sort, true)
+	}
+	return page, nil
+}
@@ -0,0 +1,25 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// assignProject переносит задачу в проект projectID без сохранения; проект должен принадлежать владельцу задачи
+func assignProject(ctx context.Context, repo projects.BaseProjectsRepository, task *tasks.Task, projectID uuid.UUID) error {
+	project, err := repo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if project.UserID != task.UserID {
+		return &tasks.InvalidTaskDataError{Field: "project_id", Message: "project must belong to the task owner"}
+	}
+
+	task.ProjectID = &project.ID
+	return nil
+}
@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// RemoveTaskDependencyUseCase use case для удаления блокирующей задачи
+type RemoveTaskDependencyUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewRemoveTaskDependencyUseCase создает новый use case
+func NewRemoveTaskDependencyUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *RemoveTaskDependencyUseCase {
+	return &RemoveTaskDependencyUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute удаляет зависимость задачи taskID от blockerID
+func (uc *RemoveTaskDependencyUseCase) Execute(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	task, err := uc.repo.GetByID(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return err
+	}
+
+	return uc.repo.RemoveDependency(ctx, task.ID, blockerID)
+}
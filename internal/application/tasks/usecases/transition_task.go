@@ -0,0 +1,73 @@
+package tasks
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// TransitionTaskUseCase use case для смены статуса задачи по правилам рабочего процесса
+type TransitionTaskUseCase struct {
+	repo         tasks.BaseTasksRepository
+	workflow     *tasks.Workflow
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.TaskPolicy
+}
+
+// NewTransitionTaskUseCase создает новый use case
+func NewTransitionTaskUseCase(
+	repo tasks.BaseTasksRepository,
+	workflow *tasks.Workflow,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.TaskPolicy,
+) *TransitionTaskUseCase {
+	return &TransitionTaskUseCase{
+		repo:         repo,
+		workflow:     workflow,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
+	}
+}
+
+// Execute переводит задачу в статус status
+func (uc *TransitionTaskUseCase) Execute(ctx context.Context, id uuid.UUID, status string) (*tasks.Task, error) {
+	task, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return nil, err
+	}
+
+	before := audit_usecases.TaskSnapshot(task)
+	if err := uc.apply(ctx, task, status); err != nil {
+		return nil, err
+	}
+
+	return updateWithHistory(ctx, uc.transactions, uc.repo, uc.recorder, task, before)
+}
+
+// apply проверяет переход и меняет статус задачи без сохранения
+func (uc *TransitionTaskUseCase) apply(ctx context.Context, task *tasks.Task, status string) error {
+	statusVO, err := vo.NewTaskStatusValueObject(status)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.workflow.CheckTransition(ctx, task, statusVO); err != nil {
+		return err
+	}
+
+	task.Status = statusVO
+	return nil
+}
@@ -2,59 +2,154 @@ package tasks
 
 import (
 	"context"
+	"time"
 
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/projects"
 	"crud/internal/domain/tasks"
 	vo "crud/internal/domain/tasks/value_objects"
 
 	"github.com/google/uuid"
 )
 
-// UpdateTaskUseCase use case для обновления задачи
+// UpdateTaskInput изменения задачи; nil поля остаются без изменений.
+// ClearStartDate и ClearDueDate снимают соответствующую дату, ClearProject убирает задачу из проекта,
+// ClearParent делает задачу корневой. Version - ожидаемая версия задачи: если задачу успели изменить,
+// обновление отклоняется с ConcurrentModificationError; nil отключает проверку
+type UpdateTaskInput struct {
+	Title          *string
+	Description    *string
+	Status         *string
+	Priority       *string
+	StartDate      *time.Time
+	DueDate        *time.Time
+	ProjectID      *uuid.UUID
+	ParentID       *uuid.UUID
+	ClearStartDate bool
+	ClearDueDate   bool
+	ClearProject   bool
+	ClearParent    bool
+	Version        *int64
+}
+
+// UpdateTaskUseCase use case для обновления задачи; смена статуса проходит через рабочий процесс
 type UpdateTaskUseCase struct {
-	repo tasks.BaseTasksRepository
+	repo         tasks.BaseTasksRepository
+	projectsRepo projects.BaseProjectsRepository
+	transitions  *TransitionTaskUseCase
+	hierarchy    *tasks.Hierarchy
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.TaskPolicy
 }
 
 // NewUpdateTaskUseCase создает новый use case
-func NewUpdateTaskUseCase(repo tasks.BaseTasksRepository) *UpdateTaskUseCase {
+func NewUpdateTaskUseCase(
+	repo tasks.BaseTasksRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	transitions *TransitionTaskUseCase,
+	hierarchy *tasks.Hierarchy,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.TaskPolicy,
+) *UpdateTaskUseCase {
 	return &UpdateTaskUseCase{
-		repo: repo,
+		repo:         repo,
+		projectsRepo: projectsRepo,
+		transitions:  transitions,
+		hierarchy:    hierarchy,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
 	}
 }
 
 // Execute выполняет обновление задачи
-func (uc *UpdateTaskUseCase) Execute(
-	ctx context.Context,
-	id uuid.UUID,
-	titleStr *string,
-	description *string,
-	statusStr *string,
-) (*tasks.Task, error) {
+func (uc *UpdateTaskUseCase) Execute(ctx context.Context, id uuid.UUID, input UpdateTaskInput) (*tasks.Task, error) {
 	// Получаем существующую задачу
 	task, err := uc.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := uc.policy.CanUpdate(ctx, task); err != nil {
+		return nil, err
+	}
+	if input.Version != nil && *input.Version != task.Version {
+		return nil, &tasks.ConcurrentModificationError{TaskID: task.ID, Version: *input.Version}
+	}
+	before := audit_usecases.TaskSnapshot(task)
+
 	// Обновляем поля, если они переданы
-	if titleStr != nil {
-		title, err := vo.NewTaskTitleValueObject(*titleStr)
+	if input.Title != nil {
+		title, err := vo.NewTaskTitleValueObject(*input.Title)
 		if err != nil {
 			return nil, err
 		}
 		task.Title = title
 	}
 
-	if description != nil {
-		task.Description = *description
+	if input.Description != nil {
+		task.Description = *input.Description
 	}
 
-	if statusStr != nil {
-		status, err := vo.NewTaskStatusValueObject(*statusStr)
+	if input.Priority != nil {
+		priority, err := vo.NewTaskPriorityValueObject(*input.Priority)
 		if err != nil {
 			return nil, err
 		}
-		task.Status = status
+		task.Priority = priority
+	}
+
+	startDate := task.Schedule.StartDate()
+	if input.ClearStartDate {
+		startDate = nil
+	} else if input.StartDate != nil {
+		startDate = input.StartDate
+	}
+
+	dueDate := task.Schedule.DueDate()
+	if input.ClearDueDate {
+		dueDate = nil
+	} else if input.DueDate != nil {
+		dueDate = input.DueDate
+	}
+
+	schedule, err := vo.NewTaskScheduleValueObject(startDate, dueDate)
+	if err != nil {
+		return nil, err
+	}
+	task.Schedule = schedule
+
+	if input.ClearProject {
+		task.ProjectID = nil
+	} else if input.ProjectID != nil {
+		if err := assignProject(ctx, uc.projectsRepo, task, *input.ProjectID); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.ClearParent {
+		task.ParentID = nil
+	} else if input.ParentID != nil {
+		// Задача переносится вместе со своим поддеревом
+		descendants, err := uc.repo.ListSubtree(ctx, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		if err := assignParent(ctx, uc.repo, uc.hierarchy, task, *input.ParentID, descendants); err != nil {
+			return nil, err
+		}
+	}
+
+	// Статус меняется последним, чтобы условия перехода видели остальные изменения
+	if input.Status != nil {
+		if err := uc.transitions.apply(ctx, task, *input.Status); err != nil {
+			return nil, err
+		}
 	}
 
-	return uc.repo.Update(ctx, task)
+	return updateWithHistory(ctx, uc.transactions, uc.repo, uc.recorder, task, before)
 }
@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"crud/config"
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+)
+
+// NewTaskWorkflow создает рабочий процесс задач из конфигурации и делает его статусы
+// допустимыми для TaskStatusValueObject
+func NewTaskWorkflow(cfg *config.Config, guards tasks.TransitionGuards) (*tasks.Workflow, error) {
+	workflowConfig, err := cfg.LoadTaskWorkflow()
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]tasks.Transition, len(workflowConfig.Transitions))
+	for i, transition := range workflowConfig.Transitions {
+		transitions[i] = tasks.Transition{
+			From:   transition.From,
+			To:     transition.To,
+			Guards: transition.Guards,
+		}
+	}
+
+	workflow, err := tasks.NewWorkflow(workflowConfig.Statuses, workflowConfig.Initial, workflowConfig.Closed, transitions, guards)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := vo.SetAllowedStatuses(workflow.Statuses(), workflow.ClosedStatuses()); err != nil {
+		return nil, err
+	}
+	return workflow, nil
+}
+
+// NewTaskTransitionGuards возвращает условия переходов для рабочего процесса: встроенные
+// и GuardBlockersClosed, которому для проверки нужен репозиторий задач
+func NewTaskTransitionGuards(repo tasks.BaseTasksRepository) tasks.TransitionGuards {
+	guards := tasks.NewBuiltinTransitionGuards()
+	guards[tasks.GuardBlockersClosed] = tasks.NewBlockersClosedGuard(repo)
+	return guards
+}
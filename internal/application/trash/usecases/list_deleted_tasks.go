@@ -0,0 +1,35 @@
+package trash
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// ListDeletedTasksUseCase use case для получения списка задач в корзине
+type ListDeletedTasksUseCase struct {
+	repo   tasks.BaseTasksRepository
+	policy *policies.TaskPolicy
+}
+
+// NewListDeletedTasksUseCase создает новый use case
+func NewListDeletedTasksUseCase(repo tasks.BaseTasksRepository, policy *policies.TaskPolicy) *ListDeletedTasksUseCase {
+	return &ListDeletedTasksUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute возвращает задачи из корзины, начиная с удаленных последними.
+// Без права читать чужие задачи список ограничивается задачами вызывающего
+func (uc *ListDeletedTasksUseCase) Execute(ctx context.Context, userID *uuid.UUID, page, pageSize int) ([]*tasks.Task, int64, error) {
+	ownerID, err := uc.policy.ScopeOwnerFilter(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.ListDeleted(ctx, tasks.ListDeletedTasksFilter{UserID: ownerID}, page, pageSize)
+}
@@ -0,0 +1,31 @@
+package trash
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/users"
+)
+
+// ListDeletedUsersUseCase use case для получения списка пользователей в корзине
+type ListDeletedUsersUseCase struct {
+	repo   users.BaseUsersRepository
+	policy *policies.UserPolicy
+}
+
+// NewListDeletedUsersUseCase создает новый use case
+func NewListDeletedUsersUseCase(repo users.BaseUsersRepository, policy *policies.UserPolicy) *ListDeletedUsersUseCase {
+	return &ListDeletedUsersUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute возвращает пользователей из корзины, начиная с удаленных последними
+func (uc *ListDeletedUsersUseCase) Execute(ctx context.Context, page, pageSize int) ([]*users.User, int64, error) {
+	if err := uc.policy.CanDelete(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	return uc.repo.ListDeleted(ctx, users.ListDeletedUsersFilter{}, page, pageSize)
+}
@@ -0,0 +1,148 @@
+package trash
+
+import (
+	"context"
+	"time"
+
+	"crud/config"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
+)
+
+// purgeBatchSize количество записей корзины, загружаемых за один запрос при очистке
+const purgeBatchSize = 100
+
+// PurgeExpiredTrashResult итог очистки корзины по сроку хранения
+type PurgeExpiredTrashResult struct {
+	Tasks   int64 // окончательно удаленные задачи, включая подзадачи
+	Users   int64 // окончательно удаленные пользователи
+	Skipped int64 // записи, которые пока нельзя удалить; они будут проверены при следующем запуске
+}
+
+// PurgeExpiredTrashUseCase use case для очистки корзины всех организаций от записей старше срока хранения.
+// Это системная задача: она запускается по расписанию без аутентифицированного пользователя
+type PurgeExpiredTrashUseCase struct {
+	tasksRepo tasks.BaseTasksRepository
+	usersRepo users.BaseUsersRepository
+	purger    *TrashPurger
+	retention time.Duration
+}
+
+// NewPurgeExpiredTrashUseCase создает новый use case; срок хранения берется из конфигурации
+func NewPurgeExpiredTrashUseCase(
+	tasksRepo tasks.BaseTasksRepository,
+	usersRepo users.BaseUsersRepository,
+	purger *TrashPurger,
+	cfg *config.Config,
+) *PurgeExpiredTrashUseCase {
+	return &PurgeExpiredTrashUseCase{
+		tasksRepo: tasksRepo,
+		usersRepo: usersRepo,
+		purger:    purger,
+		retention: cfg.TrashRetention,
+	}
+}
+
+// Execute окончательно удаляет задачи и пользователей, которые на момент now лежат в корзине дольше
+// срока хранения. Задачи удаляются первыми, потому что ссылаются на пользователей. Нулевой срок
+// хранения отключает очистку
+func (uc *PurgeExpiredTrashUseCase) Execute(ctx context.Context, now time.Time) (PurgeExpiredTrashResult, error) {
+	var result PurgeExpiredTrashResult
+	if uc.retention <= 0 {
+		return result, nil
+	}
+
+	ctx = organizations.ContextWithoutScope(ctx)
+	deletedBefore := now.Add(-uc.retention)
+
+	if err := uc.purgeTasks(ctx, deletedBefore, &result); err != nil {
+		return result, err
+	}
+	if err := uc.purgeUsers(ctx, deletedBefore, &result); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// purgeTasks удаляет задачи, перемещенные в корзину до deletedBefore. Пропущенные задачи остаются
+// в начале списка корзины, поэтому каждый следующий запрос загружает их и новую порцию задач
+func (uc *PurgeExpiredTrashUseCase) purgeTasks(ctx context.Context, deletedBefore time.Time, result *PurgeExpiredTrashResult) error {
+	filter := tasks.ListDeletedTasksFilter{DeletedBefore: &deletedBefore}
+	skipped := 0
+	for {
+		expired, _, err := uc.tasksRepo.ListDeleted(ctx, filter, 1, skipped+purgeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(expired) <= skipped {
+			return nil
+		}
+
+		for _, task := range expired[skipped:] {
+			// Записи журнала и проверки ссылок должны относиться к организации задачи
+			taskCtx := organizations.ContextWithOrganization(ctx, task.OrganizationID)
+
+			purged, err := uc.purgeTask(taskCtx, task, deletedBefore)
+			switch {
+			case err == nil && purged > 0:
+				result.Tasks += purged
+			case err == nil, tasks.IsTaskHasSubtasks(err):
+				skipped++
+				result.Skipped++
+			case tasks.IsTaskNotFound(err):
+				// Задача уже удалена вместе с поддеревом родительской задачи
+			default:
+				return err
+			}
+		}
+	}
+}
+
+// purgeTask удаляет задачу, если все ее подзадачи из корзины тоже старше срока хранения;
+// иначе ничего не удаляет и возвращает 0
+func (uc *PurgeExpiredTrashUseCase) purgeTask(ctx context.Context, task *tasks.Task, deletedBefore time.Time) (int64, error) {
+	descendants, err := uc.tasksRepo.ListDeletedSubtree(ctx, task.ID)
+	if err != nil {
+		return 0, err
+	}
+	for _, descendant := range descendants {
+		if !descendant.DeletedAt.Before(deletedBefore) {
+			return 0, nil
+		}
+	}
+
+	return uc.purger.PurgeTask(ctx, task)
+}
+
+// purgeUsers удаляет пользователей, перемещенных в корзину до deletedBefore
+func (uc *PurgeExpiredTrashUseCase) purgeUsers(ctx context.Context, deletedBefore time.Time, result *PurgeExpiredTrashResult) error {
+	filter := users.ListDeletedUsersFilter{DeletedBefore: &deletedBefore}
+	skipped := 0
+	for {
+		expired, _, err := uc.usersRepo.ListDeleted(ctx, filter, 1, skipped+purgeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(expired) <= skipped {
+			return nil
+		}
+
+		for _, user := range expired[skipped:] {
+			userCtx := organizations.ContextWithOrganization(ctx, user.OrganizationID)
+
+			err := uc.purger.PurgeUser(userCtx, user)
+			switch {
+			case err == nil:
+				result.Users++
+			case users.IsUserInUse(err):
+				skipped++
+				result.Skipped++
+			case users.IsUserNotFound(err):
+				// Пользователь уже удален параллельным запросом
+			default:
+				return err
+			}
+		}
+	}
+}
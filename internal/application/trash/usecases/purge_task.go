@@ -0,0 +1,41 @@
+package trash
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+)
+
+// PurgeTaskUseCase use case для окончательного удаления задачи из корзины
+type PurgeTaskUseCase struct {
+	repo   tasks.BaseTasksRepository
+	purger *TrashPurger
+	policy *policies.TrashPolicy
+}
+
+// NewPurgeTaskUseCase создает новый use case
+func NewPurgeTaskUseCase(repo tasks.BaseTasksRepository, purger *TrashPurger, policy *policies.TrashPolicy) *PurgeTaskUseCase {
+	return &PurgeTaskUseCase{
+		repo:   repo,
+		purger: purger,
+		policy: policy,
+	}
+}
+
+// Execute окончательно удаляет задачу из корзины вместе с ее подзадачами из корзины,
+// комментариями и вложениями; возвращает количество удаленных задач
+func (uc *PurgeTaskUseCase) Execute(ctx context.Context, id uuid.UUID) (int64, error) {
+	if err := uc.policy.CanPurge(ctx); err != nil {
+		return 0, err
+	}
+
+	task, err := uc.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	return uc.purger.PurgeTask(ctx, task)
+}
@@ -0,0 +1,41 @@
+package trash
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// PurgeUserUseCase use case для окончательного удаления пользователя из корзины
+type PurgeUserUseCase struct {
+	repo   users.BaseUsersRepository
+	purger *TrashPurger
+	policy *policies.TrashPolicy
+}
+
+// NewPurgeUserUseCase создает новый use case
+func NewPurgeUserUseCase(repo users.BaseUsersRepository, purger *TrashPurger, policy *policies.TrashPolicy) *PurgeUserUseCase {
+	return &PurgeUserUseCase{
+		repo:   repo,
+		purger: purger,
+		policy: policy,
+	}
+}
+
+// Execute окончательно удаляет пользователя из корзины; сначала нужно удалить
+// или передать другим пользователям все его задачи и проекты
+func (uc *PurgeUserUseCase) Execute(ctx context.Context, id uuid.UUID) error {
+	if err := uc.policy.CanPurge(ctx); err != nil {
+		return err
+	}
+
+	user, err := uc.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	return uc.purger.PurgeUser(ctx, user)
+}
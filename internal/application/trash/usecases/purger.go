@@ -0,0 +1,171 @@
+package trash
+
+import (
+	"context"
+	"sort"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// TrashPurger окончательно удаляет задачи и пользователей из корзины вместе с зависящими от них данными.
+// Его используют use cases окончательного удаления и очистки корзины по сроку хранения
+type TrashPurger struct {
+	tasksRepo       tasks.BaseTasksRepository
+	usersRepo       users.BaseUsersRepository
+	projectsRepo    projects.BaseProjectsRepository
+	attachmentsRepo attachments.BaseAttachmentsRepository
+	storage         attachments.BaseBlobStorage
+	transactions    common.BaseTransactionManager
+	recorder        *audit_usecases.AuditRecorder
+}
+
+// NewTrashPurger создает новый механизм окончательного удаления
+func NewTrashPurger(
+	tasksRepo tasks.BaseTasksRepository,
+	usersRepo users.BaseUsersRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	attachmentsRepo attachments.BaseAttachmentsRepository,
+	storage attachments.BaseBlobStorage,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+) *TrashPurger {
+	return &TrashPurger{
+		tasksRepo:       tasksRepo,
+		usersRepo:       usersRepo,
+		projectsRepo:    projectsRepo,
+		attachmentsRepo: attachmentsRepo,
+		storage:         storage,
+		transactions:    transactions,
+		recorder:        recorder,
+	}
+}
+
+// PurgeTask окончательно удаляет задачу из корзины вместе с ее подзадачами из корзины
+// и возвращает количество удаленных задач. Задачу, у которой в поддереве остались
+// действующие подзадачи, удалить нельзя
+func (p *TrashPurger) PurgeTask(ctx context.Context, task *tasks.Task) (int64, error) {
+	descendants, err := p.tasksRepo.ListDeletedSubtree(ctx, task.ID)
+	if err != nil {
+		return 0, err
+	}
+	purged := append(purgeOrder(task.ID, descendants), task)
+
+	for _, t := range purged {
+		parentID := t.ID
+		_, subtasks, err := p.tasksRepo.List(ctx, tasks.ListTasksFilter{ParentID: &parentID}, 1, 1)
+		if err != nil {
+			return 0, err
+		}
+		if subtasks > 0 {
+			return 0, &tasks.TaskHasSubtasksError{TaskID: t.ID, Subtasks: subtasks}
+		}
+	}
+
+	var storageKeys []string
+	err = p.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		for _, t := range purged {
+			keys, err := p.deleteAttachments(ctx, t.ID)
+			if err != nil {
+				return err
+			}
+			storageKeys = append(storageKeys, keys...)
+
+			if err := p.tasksRepo.Purge(ctx, t.ID); err != nil {
+				return err
+			}
+			if err := p.recorder.RecordPurge(ctx, audit.EntityTask, t.ID, audit_usecases.TaskSnapshot(t)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// Содержимое вложений удаляется после фиксации транзакции: без описаний оно недостижимо,
+	// а удаление файла нельзя откатить вместе с транзакцией
+	for _, key := range storageKeys {
+		if err := p.storage.Delete(ctx, key); err != nil {
+			return int64(len(purged)), err
+		}
+	}
+
+	return int64(len(purged)), nil
+}
+
+// PurgeUser окончательно удаляет пользователя из корзины. Пользователя, которому принадлежат
+// задачи (в том числе из корзины) или проекты, удалить нельзя
+func (p *TrashPurger) PurgeUser(ctx context.Context, user *users.User) error {
+	userID := user.ID
+	_, activeTasks, err := p.tasksRepo.List(ctx, tasks.ListTasksFilter{UserID: &userID}, 1, 1)
+	if err != nil {
+		return err
+	}
+	_, deletedTasks, err := p.tasksRepo.ListDeleted(ctx, tasks.ListDeletedTasksFilter{UserID: &userID}, 1, 1)
+	if err != nil {
+		return err
+	}
+	_, ownedProjects, err := p.projectsRepo.List(ctx, projects.ListProjectsFilter{UserID: &userID}, 1, 1)
+	if err != nil {
+		return err
+	}
+	if activeTasks+deletedTasks+ownedProjects > 0 {
+		return &users.UserInUseError{UserID: user.ID}
+	}
+
+	return p.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := p.usersRepo.Purge(ctx, user.ID); err != nil {
+			return err
+		}
+		return p.recorder.RecordPurge(ctx, audit.EntityUser, user.ID, audit_usecases.UserSnapshot(user))
+	})
+}
+
+// deleteAttachments удаляет описания вложений задачи и возвращает ключи их содержимого
+func (p *TrashPurger) deleteAttachments(ctx context.Context, taskID uuid.UUID) ([]string, error) {
+	list, err := p.attachmentsRepo.ListByTask(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(list))
+	for _, attachment := range list {
+		if err := p.attachmentsRepo.Delete(ctx, attachment.ID); err != nil {
+			return nil, err
+		}
+		keys = append(keys, attachment.StorageKey)
+	}
+	return keys, nil
+}
+
+// purgeOrder упорядочивает потомков задачи rootID от самых глубоких к непосредственным подзадачам:
+// внешний ключ parent_id не дает удалить задачу раньше ее подзадач
+func purgeOrder(rootID uuid.UUID, descendants []*tasks.Task) []*tasks.Task {
+	parents := make(map[uuid.UUID]*uuid.UUID, len(descendants))
+	for _, task := range descendants {
+		parents[task.ID] = task.ParentID
+	}
+
+	depth := make(map[uuid.UUID]int, len(descendants))
+	for _, task := range descendants {
+		for parentID := task.ParentID; parentID != nil && *parentID != rootID; parentID = parents[*parentID] {
+			depth[task.ID]++
+		}
+	}
+
+	ordered := make([]*tasks.Task, len(descendants))
+	copy(ordered, descendants)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return depth[ordered[i].ID] > depth[ordered[j].ID]
+	})
+	return ordered
+}
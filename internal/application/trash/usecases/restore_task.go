@@ -0,0 +1,100 @@
+package trash
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// RestoreTaskUseCase use case для возврата задачи из корзины
+type RestoreTaskUseCase struct {
+	repo         tasks.BaseTasksRepository
+	usersRepo    users.BaseUsersRepository
+	projectsRepo projects.BaseProjectsRepository
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.TaskPolicy
+}
+
+// NewRestoreTaskUseCase создает новый use case
+func NewRestoreTaskUseCase(
+	repo tasks.BaseTasksRepository,
+	usersRepo users.BaseUsersRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.TaskPolicy,
+) *RestoreTaskUseCase {
+	return &RestoreTaskUseCase{
+		repo:         repo,
+		usersRepo:    usersRepo,
+		projectsRepo: projectsRepo,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
+	}
+}
+
+// Execute возвращает задачу из корзины. Владелец, проект и родительская задача
+// восстанавливаемой задачи не должны находиться в корзине
+func (uc *RestoreTaskUseCase) Execute(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
+	task, err := uc.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanDelete(ctx, task); err != nil {
+		return nil, err
+	}
+
+	if err := uc.checkReferences(ctx, task); err != nil {
+		return nil, err
+	}
+
+	err = uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Restore(ctx, task.ID); err != nil {
+			return err
+		}
+		return uc.recorder.RecordRestore(ctx, audit.EntityTask, task.ID, audit_usecases.TaskSnapshot(task))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, task.ID)
+}
+
+// checkReferences проверяет, что владелец, проект и родитель задачи не находятся в корзине
+func (uc *RestoreTaskUseCase) checkReferences(ctx context.Context, task *tasks.Task) error {
+	if _, err := uc.usersRepo.GetByID(ctx, task.UserID); err != nil {
+		if users.IsUserNotFound(err) {
+			return &tasks.TaskOwnerNotFoundError{UserID: task.UserID}
+		}
+		return err
+	}
+
+	if task.ProjectID != nil {
+		if _, err := uc.projectsRepo.GetByID(ctx, *task.ProjectID); err != nil {
+			return err
+		}
+	}
+
+	if task.ParentID != nil {
+		if _, err := uc.repo.GetByID(ctx, *task.ParentID); err != nil {
+			if tasks.IsTaskNotFound(err) {
+				return &tasks.ParentTaskNotFoundError{ParentID: *task.ParentID}
+			}
+			return err
+		}
+	}
+
+	return nil
+}
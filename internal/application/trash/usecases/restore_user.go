@@ -0,0 +1,61 @@
+package trash
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// RestoreUserUseCase use case для возврата пользователя из корзины
+type RestoreUserUseCase struct {
+	repo         users.BaseUsersRepository
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.UserPolicy
+}
+
+// NewRestoreUserUseCase создает новый use case
+func NewRestoreUserUseCase(
+	repo users.BaseUsersRepository,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.UserPolicy,
+) *RestoreUserUseCase {
+	return &RestoreUserUseCase{
+		repo:         repo,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
+	}
+}
+
+// Execute возвращает пользователя из корзины. Задачи и проекты, удаленные вместе с ним
+// политикой cascade, остаются в корзине и восстанавливаются отдельно
+func (uc *RestoreUserUseCase) Execute(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	if err := uc.policy.CanDelete(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := uc.repo.GetDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := uc.repo.Restore(ctx, user.ID); err != nil {
+			return err
+		}
+		return uc.recorder.RecordRestore(ctx, audit.EntityUser, user.ID, audit_usecases.UserSnapshot(user))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.GetByID(ctx, user.ID)
+}
@@ -3,27 +3,67 @@ package users
 import (
 	"context"
 
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/auth"
 	"crud/internal/domain/users"
 	vo "crud/internal/domain/users/value_objects"
 )
 
 // CreateUserUseCase use case для создания пользователя
 type CreateUserUseCase struct {
-	repo users.BaseUsersRepository
+	repo         users.BaseUsersRepository
+	hasher       auth.BasePasswordHasher
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	policy       *policies.UserPolicy
 }
 
 // NewCreateUserUseCase создает новый use case
-func NewCreateUserUseCase(repo users.BaseUsersRepository) *CreateUserUseCase {
+func NewCreateUserUseCase(
+	repo users.BaseUsersRepository,
+	hasher auth.BasePasswordHasher,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.UserPolicy,
+) *CreateUserUseCase {
 	return &CreateUserUseCase{
-		repo: repo,
+		repo:         repo,
+		hasher:       hasher,
+		transactions: transactions,
+		recorder:     recorder,
+		policy:       policy,
 	}
 }
 
-// Execute выполняет создание пользователя
+// Execute выполняет создание пользователя; пустая роль означает роль по умолчанию
 func (uc *CreateUserUseCase) Execute(
 	ctx context.Context,
 	email string,
 	name string,
+	password string,
+	role string,
+) (*users.User, error) {
+	if err := uc.policy.CanCreate(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := newUserWithPassword(uc.hasher, email, name, password, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return createWithHistory(ctx, uc.transactions, uc.repo, uc.recorder, user)
+}
+
+// newUserWithPassword валидирует данные и собирает пользователя с хешем пароля
+func newUserWithPassword(
+	hasher auth.BasePasswordHasher,
+	email string,
+	name string,
+	password string,
+	role string,
 ) (*users.User, error) {
 	emailVO, err := vo.NewEmailValueObject(email)
 	if err != nil {
@@ -35,6 +75,26 @@ func (uc *CreateUserUseCase) Execute(
 		return nil, err
 	}
 
+	passwordVO, err := vo.NewPasswordValueObject(password)
+	if err != nil {
+		return nil, err
+	}
+
 	user := users.NewUser(emailVO, nameVO)
-	return uc.repo.Create(ctx, user)
+
+	if role != "" {
+		roleVO, err := vo.NewUserRoleValueObject(role)
+		if err != nil {
+			return nil, err
+		}
+		user.Role = roleVO
+	}
+
+	passwordHash, err := hasher.Hash(passwordVO.Value())
+	if err != nil {
+		return nil, err
+	}
+	user.PasswordHash = passwordHash
+
+	return user, nil
 }
@@ -3,24 +3,161 @@ package users
 import (
 	"context"
 
+	"crud/config"
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
 	"crud/internal/domain/users"
 
 	"github.com/google/uuid"
 )
 
-// DeleteUserUseCase use case для удаления пользователя
+// DeleteUserUseCase use case для удаления пользователя с учетом политики обработки его задач и проектов
 type DeleteUserUseCase struct {
-	repo users.BaseUsersRepository
+	repo           users.BaseUsersRepository
+	tasksRepo      tasks.BaseTasksRepository
+	projectsRepo   projects.BaseProjectsRepository
+	refreshTokens  auth.BaseRefreshTokensRepository
+	transactions   common.BaseTransactionManager
+	recorder       *audit_usecases.AuditRecorder
+	policy         *policies.UserPolicy
+	deletionPolicy users.DeletionPolicy
 }
 
-// NewDeleteUserUseCase создает новый use case
-func NewDeleteUserUseCase(repo users.BaseUsersRepository) *DeleteUserUseCase {
+// NewDeleteUserUseCase создает новый use case; политика удаления берется из конфигурации
+func NewDeleteUserUseCase(
+	repo users.BaseUsersRepository,
+	tasksRepo tasks.BaseTasksRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	refreshTokens auth.BaseRefreshTokensRepository,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.UserPolicy,
+	cfg *config.Config,
+) (*DeleteUserUseCase, error) {
+	deletionPolicy, err := users.ParseDeletionPolicy(cfg.UserDeletionPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	return &DeleteUserUseCase{
-		repo: repo,
+		repo:           repo,
+		tasksRepo:      tasksRepo,
+		projectsRepo:   projectsRepo,
+		refreshTokens:  refreshTokens,
+		transactions:   transactions,
+		recorder:       recorder,
+		policy:         policy,
+		deletionPolicy: deletionPolicy,
+	}, nil
+}
+
+// Execute выполняет удаление пользователя.
+// reassignTo обязателен для политики reassign и недопустим для остальных политик
+func (uc *DeleteUserUseCase) Execute(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	if err := uc.policy.CanDelete(ctx); err != nil {
+		return err
+	}
+
+	user, err := uc.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.validateReassignTarget(ctx, id, reassignTo); err != nil {
+		return err
 	}
+
+	return uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		switch uc.deletionPolicy {
+		case users.DeletionPolicyCascade:
+			// Задачи удаляются первыми, потому что ссылаются на проекты
+			deleted, err := uc.tasksRepo.DeleteByUser(ctx, id)
+			if err != nil {
+				return err
+			}
+			for _, task := range deleted {
+				if err := uc.recorder.RecordDelete(ctx, audit.EntityTask, task.ID, audit_usecases.TaskSnapshot(task)); err != nil {
+					return err
+				}
+			}
+			if _, err := uc.projectsRepo.DeleteByUser(ctx, id); err != nil {
+				return err
+			}
+		case users.DeletionPolicyReassign:
+			reassigned, err := uc.tasksRepo.ReassignOwner(ctx, id, *reassignTo)
+			if err != nil {
+				return err
+			}
+			for _, task := range reassigned {
+				before := audit_usecases.TaskSnapshot(task)
+				after := audit_usecases.TaskSnapshot(task)
+				after["user_id"] = reassignTo.String()
+				if err := uc.recorder.RecordUpdate(ctx, audit.EntityTask, task.ID, before, after); err != nil {
+					return err
+				}
+			}
+			if _, err := uc.projectsRepo.ReassignOwner(ctx, id, *reassignTo); err != nil {
+				return err
+			}
+		case users.DeletionPolicyRestrict:
+			openTasks, err := uc.tasksRepo.CountOpenByUser(ctx, id)
+			if err != nil {
+				return err
+			}
+			if openTasks > 0 {
+				return &users.UserHasOpenTasksError{UserID: id, OpenTasks: openTasks}
+			}
+		}
+
+		if err := uc.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+		// Сессии удаленного пользователя не должны ожить после его восстановления из корзины
+		if err := uc.refreshTokens.RevokeAllForUser(ctx, id); err != nil {
+			return err
+		}
+		return uc.recorder.RecordDelete(ctx, audit.EntityUser, user.ID, audit_usecases.UserSnapshot(user))
+	})
 }
 
-// Execute выполняет удаление пользователя
-func (uc *DeleteUserUseCase) Execute(ctx context.Context, id uuid.UUID) error {
-	return uc.repo.Delete(ctx, id)
+// validateReassignTarget проверяет пользователя, которому будут переданы задачи
+func (uc *DeleteUserUseCase) validateReassignTarget(ctx context.Context, id uuid.UUID, reassignTo *uuid.UUID) error {
+	if uc.deletionPolicy != users.DeletionPolicyReassign {
+		if reassignTo != nil {
+			return &users.InvalidUserDataError{
+				Field:   "reassign_to",
+				Message: "only allowed with the reassign deletion policy",
+			}
+		}
+		return nil
+	}
+
+	if reassignTo == nil {
+		return &users.InvalidUserDataError{
+			Field:   "reassign_to",
+			Message: "required by the reassign deletion policy",
+		}
+	}
+	if *reassignTo == id {
+		return &users.InvalidUserDataError{
+			Field:   "reassign_to",
+			Message: "cannot reassign tasks to the user being deleted",
+		}
+	}
+	if _, err := uc.repo.GetByID(ctx, *reassignTo); err != nil {
+		if users.IsUserNotFound(err) {
+			return &users.InvalidUserDataError{
+				Field:   "reassign_to",
+				Message: "user " + reassignTo.String() + " not found",
+			}
+		}
+		return err
+	}
+
+	return nil
 }
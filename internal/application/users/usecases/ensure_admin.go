@@ -0,0 +1,65 @@
+package users
+
+import (
+	"context"
+	"strings"
+
+	"crud/config"
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/users"
+	vo "crud/internal/domain/users/value_objects"
+)
+
+// EnsureAdminUseCase use case для создания первого администратора при старте
+type EnsureAdminUseCase struct {
+	repo         users.BaseUsersRepository
+	hasher       auth.BasePasswordHasher
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+	cfg          *config.Config
+}
+
+// NewEnsureAdminUseCase создает новый use case
+func NewEnsureAdminUseCase(
+	repo users.BaseUsersRepository,
+	hasher auth.BasePasswordHasher,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	cfg *config.Config,
+) *EnsureAdminUseCase {
+	return &EnsureAdminUseCase{
+		repo:         repo,
+		hasher:       hasher,
+		transactions: transactions,
+		recorder:     recorder,
+		cfg:          cfg,
+	}
+}
+
+// Execute создает администратора из ADMIN_EMAIL/ADMIN_PASSWORD в организации по умолчанию,
+// если его еще нет. Без настроенного email ничего не делает.
+func (uc *EnsureAdminUseCase) Execute(ctx context.Context) (*users.User, error) {
+	if uc.cfg.AdminEmail == "" {
+		return nil, nil
+	}
+
+	ctx = organizations.ContextWithOrganization(ctx, organizations.DefaultOrganizationID)
+
+	existing, err := uc.repo.GetByEmail(ctx, strings.ToLower(strings.TrimSpace(uc.cfg.AdminEmail)))
+	if err == nil {
+		return existing, nil
+	}
+	if !users.IsUserNotFound(err) {
+		return nil, err
+	}
+
+	user, err := newUserWithPassword(uc.hasher, uc.cfg.AdminEmail, uc.cfg.AdminName, uc.cfg.AdminPassword, vo.RoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return createWithHistory(ctx, uc.transactions, uc.repo, uc.recorder, user)
+}
@@ -3,22 +3,29 @@ package users
 import (
 	"context"
 
+	"crud/internal/application/policies"
 	"crud/internal/domain/users"
 )
 
 // GetUserByEmailUseCase use case для получения пользователя по email
 type GetUserByEmailUseCase struct {
-	repo users.BaseUsersRepository
+	repo   users.BaseUsersRepository
+	policy *policies.UserPolicy
 }
 
 // NewGetUserByEmailUseCase создает новый use case
-func NewGetUserByEmailUseCase(repo users.BaseUsersRepository) *GetUserByEmailUseCase {
+func NewGetUserByEmailUseCase(repo users.BaseUsersRepository, policy *policies.UserPolicy) *GetUserByEmailUseCase {
 	return &GetUserByEmailUseCase{
-		repo: repo,
+		repo:   repo,
+		policy: policy,
 	}
 }
 
 // Execute выполняет получение пользователя по email
 func (uc *GetUserByEmailUseCase) Execute(ctx context.Context, email string) (*users.User, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, err
+	}
+
 	return uc.repo.GetByEmail(ctx, email)
 }
@@ -3,6 +3,7 @@ package users
 import (
 	"context"
 
+	"crud/internal/application/policies"
 	"crud/internal/domain/users"
 
 	"github.com/google/uuid"
@@ -10,17 +11,23 @@ import (
 
 // GetUserByIDUseCase use case для получения пользователя по ID
 type GetUserByIDUseCase struct {
-	repo users.BaseUsersRepository
+	repo   users.BaseUsersRepository
+	policy *policies.UserPolicy
 }
 
 // NewGetUserByIDUseCase создает новый use case
-func NewGetUserByIDUseCase(repo users.BaseUsersRepository) *GetUserByIDUseCase {
+func NewGetUserByIDUseCase(repo users.BaseUsersRepository, policy *policies.UserPolicy) *GetUserByIDUseCase {
 	return &GetUserByIDUseCase{
-		repo: repo,
+		repo:   repo,
+		policy: policy,
 	}
 }
 
 // Execute выполняет получение пользователя
 func (uc *GetUserByIDUseCase) Execute(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, err
+	}
+
 	return uc.repo.GetByID(ctx, id)
 }
@@ -0,0 +1,33 @@
+package users
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/users"
+)
+
+// createWithHistory сохраняет пользователя и запись журнала о его создании в одной транзакции
+func createWithHistory(
+	ctx context.Context,
+	transactions common.BaseTransactionManager,
+	repo users.BaseUsersRepository,
+	recorder *audit_usecases.AuditRecorder,
+	user *users.User,
+) (*users.User, error) {
+	var created *users.User
+	err := transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = repo.Create(ctx, user)
+		if err != nil {
+			return err
+		}
+		return recorder.RecordCreate(ctx, audit.EntityUser, created.ID, audit_usecases.UserSnapshot(created))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
@@ -3,22 +3,29 @@ package users
 import (
 	"context"
 
+	"crud/internal/application/policies"
 	"crud/internal/domain/users"
 )
 
 // ListUsersUseCase use case для получения списка пользователей
 type ListUsersUseCase struct {
-	repo users.BaseUsersRepository
+	repo   users.BaseUsersRepository
+	policy *policies.UserPolicy
 }
 
 // NewListUsersUseCase создает новый use case
-func NewListUsersUseCase(repo users.BaseUsersRepository) *ListUsersUseCase {
+func NewListUsersUseCase(repo users.BaseUsersRepository, policy *policies.UserPolicy) *ListUsersUseCase {
 	return &ListUsersUseCase{
-		repo: repo,
+		repo:   repo,
+		policy: policy,
 	}
 }
 
 // Execute выполняет получение списка пользователей
 func (uc *ListUsersUseCase) Execute(ctx context.Context, page, pageSize int) ([]*users.User, int64, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, 0, err
+	}
+
 	return uc.repo.List(ctx, page, pageSize)
 }
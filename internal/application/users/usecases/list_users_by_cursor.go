@@ -0,0 +1,66 @@
+package users
+
+import (
+	"context"
+
+	"crud/internal/application/policies"
+	"crud/internal/domain/users"
+)
+
+// UsersCursorPage страница списка пользователей при выводе по курсору
+type UsersCursorPage struct {
+	Users []*users.User
+
+	// Next и Prev - позиции соседних страниц; nil, если в эту сторону пользователей больше нет
+	Next *users.UserCursor
+	Prev *users.UserCursor
+}
+
+// ListUsersByCursorUseCase use case для получения списка пользователей по курсору
+type ListUsersByCursorUseCase struct {
+	repo   users.BaseUsersRepository
+	policy *policies.UserPolicy
+}
+
+// NewListUsersByCursorUseCase создает новый use case
+func NewListUsersByCursorUseCase(repo users.BaseUsersRepository, policy *policies.UserPolicy) *ListUsersByCursorUseCase {
+	return &ListUsersByCursorUseCase{
+		repo:   repo,
+		policy: policy,
+	}
+}
+
+// Execute возвращает не больше pageSize пользователей после позиции cursor или перед ней; без cursor - первую страницу
+func (uc *ListUsersByCursorUseCase) Execute(ctx context.Context, cursor *users.UserCursor, pageSize int) (*UsersCursorPage, error) {
+	if err := uc.policy.CanRead(ctx); err != nil {
+		return nil, err
+	}
+
+	// Лишний пользователь показывает, есть ли пользователи за страницей
+	list, err := uc.repo.ListByCursor(ctx, cursor, pageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	backward := cursor != nil && cursor.Backward
+	more := len(list) > pageSize
+	if more && backward {
+		list = list[1:]
+	} else if more {
+		list = list[:pageSize]
+	}
+
+	page := &UsersCursorPage{Users: list}
+	if len(list) == 0 {
+		// На пустой странице не от чего отсчитывать соседние страницы
+		return page, nil
+	}
+
+	if more || backward {
+		page.Next = users.NewUserCursor(list[len(list)-1], false)
+	}
+	if (more && backward) || (cursor != nil && !backward) {
+		page.Prev = users.NewUserCursor(list[0], true)
+	}
+	return page, nil
+}
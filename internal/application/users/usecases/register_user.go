@@ -0,0 +1,48 @@
+package users
+
+import (
+	"context"
+
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/users"
+)
+
+// RegisterUserUseCase use case для самостоятельной регистрации пользователя
+type RegisterUserUseCase struct {
+	repo         users.BaseUsersRepository
+	hasher       auth.BasePasswordHasher
+	transactions common.BaseTransactionManager
+	recorder     *audit_usecases.AuditRecorder
+}
+
+// NewRegisterUserUseCase создает новый use case
+func NewRegisterUserUseCase(
+	repo users.BaseUsersRepository,
+	hasher auth.BasePasswordHasher,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+) *RegisterUserUseCase {
+	return &RegisterUserUseCase{
+		repo:         repo,
+		hasher:       hasher,
+		transactions: transactions,
+		recorder:     recorder,
+	}
+}
+
+// Execute регистрирует пользователя с ролью по умолчанию
+func (uc *RegisterUserUseCase) Execute(
+	ctx context.Context,
+	email string,
+	name string,
+	password string,
+) (*users.User, error) {
+	user, err := newUserWithPassword(uc.hasher, email, name, password, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return createWithHistory(ctx, uc.transactions, uc.repo, uc.recorder, user)
+}
@@ -3,6 +3,11 @@ package users
 import (
 	"context"
 
+	audit_usecases "crud/internal/application/audit/usecases"
+	"crud/internal/application/common"
+	"crud/internal/application/policies"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
 	"crud/internal/domain/users"
 	vo "crud/internal/domain/users/value_objects"
 
@@ -11,22 +16,43 @@ import (
 
 // UpdateUserUseCase use case для обновления пользователя
 type UpdateUserUseCase struct {
-	repo users.BaseUsersRepository
+	repo          users.BaseUsersRepository
+	hasher        auth.BasePasswordHasher
+	refreshTokens auth.BaseRefreshTokensRepository
+	transactions  common.BaseTransactionManager
+	recorder      *audit_usecases.AuditRecorder
+	policy        *policies.UserPolicy
 }
 
 // NewUpdateUserUseCase создает новый use case
-func NewUpdateUserUseCase(repo users.BaseUsersRepository) *UpdateUserUseCase {
+func NewUpdateUserUseCase(
+	repo users.BaseUsersRepository,
+	hasher auth.BasePasswordHasher,
+	refreshTokens auth.BaseRefreshTokensRepository,
+	transactions common.BaseTransactionManager,
+	recorder *audit_usecases.AuditRecorder,
+	policy *policies.UserPolicy,
+) *UpdateUserUseCase {
 	return &UpdateUserUseCase{
-		repo: repo,
+		repo:          repo,
+		hasher:        hasher,
+		refreshTokens: refreshTokens,
+		transactions:  transactions,
+		recorder:      recorder,
+		policy:        policy,
 	}
 }
 
-// Execute выполняет обновление пользователя
+// Execute выполняет обновление пользователя. Если expectedVersion задана и пользователя
+// успели изменить, обновление отклоняется с ConcurrentModificationError
 func (uc *UpdateUserUseCase) Execute(
 	ctx context.Context,
 	id uuid.UUID,
 	emailStr *string,
 	nameStr *string,
+	passwordStr *string,
+	roleStr *string,
+	expectedVersion *int64,
 ) (*users.User, error) {
 	// Получаем существующего пользователя
 	user, err := uc.repo.GetByID(ctx, id)
@@ -34,6 +60,14 @@ func (uc *UpdateUserUseCase) Execute(
 		return nil, err
 	}
 
+	if err := uc.policy.CanUpdate(ctx, user, roleStr != nil); err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != user.Version {
+		return nil, &users.ConcurrentModificationError{UserID: user.ID, Version: *expectedVersion}
+	}
+	before := audit_usecases.UserSnapshot(user)
+
 	// Обновляем поля, если они переданы
 	if emailStr != nil {
 		email, err := vo.NewEmailValueObject(*emailStr)
@@ -51,5 +85,45 @@ func (uc *UpdateUserUseCase) Execute(
 		user.Name = name
 	}
 
-	return uc.repo.Update(ctx, user)
+	if roleStr != nil {
+		role, err := vo.NewUserRoleValueObject(*roleStr)
+		if err != nil {
+			return nil, err
+		}
+		user.Role = role
+	}
+
+	if passwordStr != nil {
+		password, err := vo.NewPasswordValueObject(*passwordStr)
+		if err != nil {
+			return nil, err
+		}
+		passwordHash, err := uc.hasher.Hash(password.Value())
+		if err != nil {
+			return nil, err
+		}
+		user.PasswordHash = passwordHash
+	}
+
+	var updatedUser *users.User
+	err = uc.transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		updatedUser, err = uc.repo.Update(ctx, user)
+		if err != nil {
+			return err
+		}
+		return uc.recorder.RecordUpdate(ctx, audit.EntityUser, updatedUser.ID, before, audit_usecases.UserSnapshot(updatedUser))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// При смене пароля или роли завершаем все активные сессии пользователя
+	if passwordStr != nil || roleStr != nil {
+		if err := uc.refreshTokens.RevokeAllForUser(ctx, updatedUser.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return updatedUser, nil
 }
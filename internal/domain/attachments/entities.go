@@ -0,0 +1,56 @@
+package attachments
+
+import (
+	"time"
+
+	"crud/internal/domain/attachments/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Attachment представляет сущность файла, приложенного к задаче. Содержимое файла хранится
+// в хранилище BaseBlobStorage под ключом StorageKey, в сущности - только его описание
+type Attachment struct {
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	TaskID         uuid.UUID
+	UploaderID     uuid.UUID
+	FileName       value_objects.FileNameValueObject
+	ContentType    string
+	Size           int64  // размер в байтах
+	Checksum       string // SHA-256 содержимого в hex
+	StorageKey     string
+	CreatedAt      time.Time
+}
+
+// NewAttachment создает описание вложения задачи; размер и контрольная сумма
+// заполняются после сохранения содержимого
+func NewAttachment(
+	taskID, uploaderID uuid.UUID,
+	fileName value_objects.FileNameValueObject,
+	contentType string,
+) *Attachment {
+	id := uuid.New()
+	return &Attachment{
+		ID:          id,
+		TaskID:      taskID,
+		UploaderID:  uploaderID,
+		FileName:    fileName,
+		ContentType: contentType,
+		StorageKey:  StorageKey(taskID, id),
+		CreatedAt:   time.Now(),
+	}
+}
+
+// StorageKey возвращает ключ содержимого вложения в хранилище
+func StorageKey(taskID, attachmentID uuid.UUID) string {
+	return "tasks/" + taskID.String() + "/" + attachmentID.String()
+}
+
+// Equals проверяет равенство двух вложений по ID
+func (a *Attachment) Equals(other *Attachment) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	return a.ID == other.ID
+}
@@ -0,0 +1,116 @@
+package attachments
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentNotFoundError представляет ошибку, когда вложение не найдено
+type AttachmentNotFoundError struct {
+	AttachmentID uuid.UUID
+}
+
+func (e *AttachmentNotFoundError) Error() string {
+	return fmt.Sprintf("attachment with ID %s not found", e.AttachmentID)
+}
+
+// AttachmentTooLargeError представляет ошибку, когда файл больше допустимого размера
+type AttachmentTooLargeError struct {
+	MaxSize int64
+}
+
+func (e *AttachmentTooLargeError) Error() string {
+	return fmt.Sprintf("attachment exceeds the maximum size of %d bytes", e.MaxSize)
+}
+
+// UnsupportedContentTypeError представляет ошибку, когда MIME тип файла не разрешен
+type UnsupportedContentTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedContentTypeError) Error() string {
+	return fmt.Sprintf("content type '%s' is not allowed", e.ContentType)
+}
+
+// ContentTypeMismatchError представляет ошибку, когда заявленный клиентом тип не совпадает с содержимым
+type ContentTypeMismatchError struct {
+	Declared string
+	Detected string
+}
+
+func (e *ContentTypeMismatchError) Error() string {
+	return fmt.Sprintf("declared content type '%s' does not match the detected '%s'", e.Declared, e.Detected)
+}
+
+// BlobNotFoundError представляет ошибку, когда содержимого нет в хранилище
+type BlobNotFoundError struct {
+	Key string
+}
+
+func (e *BlobNotFoundError) Error() string {
+	return fmt.Sprintf("blob '%s' not found", e.Key)
+}
+
+// InvalidAttachmentDataError представляет ошибку валидации данных вложения
+type InvalidAttachmentDataError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidAttachmentDataError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid attachment data: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid attachment data: %s", e.Message)
+}
+
+// AttachmentOperationFailedError представляет ошибку при выполнении операции с вложением или хранилищем
+type AttachmentOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *AttachmentOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("attachment operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("attachment operation '%s' failed", e.Operation)
+}
+
+// IsAttachmentNotFound проверяет, является ли ошибка ошибкой "вложение не найдено"
+func IsAttachmentNotFound(err error) bool {
+	var attachmentNotFoundErr *AttachmentNotFoundError
+	return errors.As(err, &attachmentNotFoundErr)
+}
+
+// IsAttachmentTooLarge проверяет, является ли ошибка ошибкой превышения размера файла
+func IsAttachmentTooLarge(err error) bool {
+	var tooLargeErr *AttachmentTooLargeError
+	return errors.As(err, &tooLargeErr)
+}
+
+// IsUnsupportedContentType проверяет, является ли ошибка ошибкой неразрешенного MIME типа
+func IsUnsupportedContentType(err error) bool {
+	var unsupportedErr *UnsupportedContentTypeError
+	return errors.As(err, &unsupportedErr)
+}
+
+// IsContentTypeMismatch проверяет, является ли ошибка ошибкой несовпадения типа с содержимым
+func IsContentTypeMismatch(err error) bool {
+	var mismatchErr *ContentTypeMismatchError
+	return errors.As(err, &mismatchErr)
+}
+
+// IsBlobNotFound проверяет, является ли ошибка ошибкой "содержимое не найдено"
+func IsBlobNotFound(err error) bool {
+	var blobNotFoundErr *BlobNotFoundError
+	return errors.As(err, &blobNotFoundErr)
+}
+
+// IsInvalidAttachmentData проверяет, является ли ошибка ошибкой валидации данных вложения
+func IsInvalidAttachmentData(err error) bool {
+	var invalidDataErr *InvalidAttachmentDataError
+	return errors.As(err, &invalidDataErr)
+}
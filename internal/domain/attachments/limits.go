@@ -0,0 +1,106 @@
+package attachments
+
+import (
+	"fmt"
+	"mime"
+	"slices"
+	"strings"
+)
+
+// contentTypeRefinements типы, которые по содержимому не отличить от более общего определенного типа.
+// Заявленный клиентом тип из этого списка принимается вместо определенного
+var contentTypeRefinements = map[string][]string{
+	"text/plain": {"text/csv", "text/markdown", "application/json"},
+	"application/zip": {
+		"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+		"application/vnd.openxmlformats-officedocument.presentationml.presentation",
+		"application/vnd.oasis.opendocument.text",
+		"application/vnd.oasis.opendocument.spreadsheet",
+	},
+}
+
+// Limits ограничения на загружаемые файлы: максимальный размер и допустимые MIME типы.
+// Тип вида "image/*" разрешает все подтипы
+type Limits struct {
+	maxSize      int64
+	allowedTypes []string
+}
+
+// NewLimits создает ограничения на вложения; allowedTypes не может быть пустым
+func NewLimits(maxSize int64, allowedTypes []string) (*Limits, error) {
+	if maxSize < 1 {
+		return nil, &InvalidAttachmentDataError{Field: "max_size", Message: fmt.Sprintf("must be positive, got %d", maxSize)}
+	}
+	if len(allowedTypes) == 0 {
+		return nil, &InvalidAttachmentDataError{Field: "allowed_types", Message: "at least one MIME type must be allowed"}
+	}
+
+	normalized := make([]string, len(allowedTypes))
+	for i, allowed := range allowedTypes {
+		normalized[i] = strings.ToLower(strings.TrimSpace(allowed))
+	}
+	return &Limits{maxSize: maxSize, allowedTypes: normalized}, nil
+}
+
+// MaxSize возвращает максимальный размер файла в байтах
+func (l *Limits) MaxSize() int64 {
+	return l.maxSize
+}
+
+// NormalizeContentType проверяет, что MIME тип разрешен, и возвращает его без параметров,
+// кроме charset текстовых типов
+func (l *Limits) NormalizeContentType(contentType string) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", &UnsupportedContentTypeError{ContentType: contentType}
+	}
+
+	if !l.allows(mediaType) {
+		return "", &UnsupportedContentTypeError{ContentType: mediaType}
+	}
+
+	if charset, ok := params["charset"]; ok && strings.HasPrefix(mediaType, "text/") {
+		return mime.FormatMediaType(mediaType, map[string]string{"charset": charset}), nil
+	}
+	return mediaType, nil
+}
+
+// ResolveContentType выбирает тип файла по типу detected, определенному по содержимому, и проверяет его
+// по списку разрешенных. Заявленный клиентом тип declared принимается, только если он совпадает с
+// определенным или уточняет его; пустой declared и application/octet-stream означают "тип не указан"
+func (l *Limits) ResolveContentType(declared, detected string) (string, error) {
+	detectedType, _, err := mime.ParseMediaType(detected)
+	if err != nil {
+		return "", &UnsupportedContentTypeError{ContentType: detected}
+	}
+	if declared == "" {
+		return l.NormalizeContentType(detected)
+	}
+
+	declaredType, _, err := mime.ParseMediaType(declared)
+	if err != nil {
+		return "", &UnsupportedContentTypeError{ContentType: declared}
+	}
+	switch {
+	case declaredType == "application/octet-stream":
+		return l.NormalizeContentType(detected)
+	case declaredType == detectedType, slices.Contains(contentTypeRefinements[detectedType], declaredType):
+		return l.NormalizeContentType(declared)
+	default:
+		return "", &ContentTypeMismatchError{Declared: declaredType, Detected: detectedType}
+	}
+}
+
+// allows проверяет тип без параметров по списку разрешенных
+func (l *Limits) allows(mediaType string) bool {
+	for _, allowed := range l.allowedTypes {
+		if allowed == mediaType || allowed == "*/*" {
+			return true
+		}
+		if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(mediaType, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
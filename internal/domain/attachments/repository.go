@@ -0,0 +1,22 @@
+package attachments
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BaseAttachmentsRepository определяет интерфейс для работы с описаниями вложений
+type BaseAttachmentsRepository interface {
+	// Create сохраняет описание вложения
+	Create(ctx context.Context, attachment *Attachment) (*Attachment, error)
+
+	// GetByID возвращает вложение по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Attachment, error)
+
+	// ListByTask возвращает вложения задачи в порядке загрузки
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]*Attachment, error)
+
+	// Delete удаляет описание вложения по ID
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,21 @@
+package attachments
+
+import (
+	"context"
+	"io"
+)
+
+// BaseBlobStorage определяет интерфейс хранилища содержимого файлов.
+// Ключи формируются приложением (см. StorageKey) и используют "/" как разделитель,
+// поэтому подходят и для файловой системы, и для S3-совместимых хранилищ
+type BaseBlobStorage interface {
+	// Put сохраняет содержимое r под ключом key, читая его потоком; существующее содержимое заменяется.
+	// При ошибке чтения r частично записанное содержимое не остается в хранилище
+	Put(ctx context.Context, key string, r io.Reader) error
+
+	// Get открывает содержимое по ключу для чтения; вызывающий закрывает reader
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete удаляет содержимое по ключу; отсутствие содержимого не считается ошибкой
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,25 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidFileNameError представляет ошибку валидации имени файла
+type InvalidFileNameError struct {
+	Value   string
+	Message string
+}
+
+func (e *InvalidFileNameError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("invalid file name: %s", e.Value)
+}
+
+// IsInvalidFileName проверяет, является ли ошибка ошибкой валидации имени файла
+func IsInvalidFileName(err error) bool {
+	var invalidNameErr *InvalidFileNameError
+	return errors.As(err, &invalidNameErr)
+}
@@ -0,0 +1,42 @@
+package value_objects
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// FileNameValueObject представляет имя файла вложения. Путь, который передают некоторые
+// браузеры, отбрасывается: сохраняется только последний элемент
+type FileNameValueObject struct {
+	value string
+}
+
+// NewFileNameValueObject создает новый FileNameValueObject с валидацией
+func NewFileNameValueObject(name string) (FileNameValueObject, error) {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(name)
+
+	if name == "" || name == "." || name == ".." {
+		return FileNameValueObject{}, &InvalidFileNameError{Message: "file name cannot be empty"}
+	}
+	if utf8.RuneCountInString(name) > 255 {
+		return FileNameValueObject{}, &InvalidFileNameError{Value: name, Message: "file name must be at most 255 characters long"}
+	}
+	if strings.IndexFunc(name, unicode.IsControl) >= 0 {
+		return FileNameValueObject{}, &InvalidFileNameError{Value: name, Message: "file name cannot contain control characters"}
+	}
+	return FileNameValueObject{value: name}, nil
+}
+
+// Value возвращает строковое значение имени
+func (n FileNameValueObject) Value() string {
+	return n.value
+}
+
+// Equals проверяет равенство двух имен
+func (n FileNameValueObject) Equals(other FileNameValueObject) bool {
+	return n.value == other.value
+}
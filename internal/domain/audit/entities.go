@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EntityType тип сущности, изменения которой попадают в журнал
+type EntityType string
+
+const (
+	EntityTask EntityType = "task"
+	EntityUser EntityType = "user"
+)
+
+// IsValid проверяет, что тип сущности поддерживается журналом
+func (t EntityType) IsValid() bool {
+	return t == EntityTask || t == EntityUser
+}
+
+// Action действие над сущностью
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"  // перемещение в корзину
+	ActionRestore Action = "restore" // возврат из корзины
+	ActionPurge   Action = "purge"   // окончательное удаление из корзины
+)
+
+// Snapshot значения отслеживаемых полей сущности по именам полей API.
+// Значения должны сравниваться через reflect.DeepEqual и сериализоваться в JSON
+type Snapshot map[string]any
+
+// Secret значение поля, которое нельзя сохранять в журнале (например, хеш пароля).
+// Журнал фиксирует только факт его изменения
+type Secret string
+
+// FieldChange изменение одного поля: прежнее и новое значение; nil - значения не было.
+// У секретных полей значения не сохраняются, а Redacted равен true
+type FieldChange struct {
+	Field    string
+	Old      any
+	New      any
+	Redacted bool
+}
+
+// Entry представляет неизменяемую запись журнала аудита
+type Entry struct {
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	EntityType     EntityType
+	EntityID       uuid.UUID
+	Action         Action
+	ActorID        *uuid.UUID // nil, если изменение выполнено без аутентифицированного пользователя
+	Changes        []FieldChange
+	CreatedAt      time.Time
+}
+
+// NewEntry создает запись журнала с изменениями между состояниями before и after.
+// При создании и восстановлении before равен nil, при удалении - after
+func NewEntry(
+	entityType EntityType,
+	entityID uuid.UUID,
+	action Action,
+	actorID *uuid.UUID,
+	before, after Snapshot,
+) *Entry {
+	return &Entry{
+		ID:         uuid.New(),
+		EntityType: entityType,
+		EntityID:   entityID,
+		Action:     action,
+		ActorID:    actorID,
+		Changes:    Diff(before, after),
+		CreatedAt:  time.Now(),
+	}
+}
+
+// Diff возвращает изменения полей между двумя снимками, упорядоченные по имени поля
+func Diff(before, after Snapshot) []FieldChange {
+	fields := make(map[string]struct{}, len(before)+len(after))
+	for field := range before {
+		fields[field] = struct{}{}
+	}
+	for field := range after {
+		fields[field] = struct{}{}
+	}
+
+	changes := make([]FieldChange, 0)
+	for field := range fields {
+		oldValue, newValue := before[field], after[field]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+
+		_, oldSecret := oldValue.(Secret)
+		_, newSecret := newValue.(Secret)
+		if oldSecret || newSecret {
+			changes = append(changes, FieldChange{Field: field, Redacted: true})
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field, Old: oldValue, New: newValue})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+// HasChanges проверяет, изменилось ли хотя бы одно поле
+func (e *Entry) HasChanges() bool {
+	return len(e.Changes) > 0
+}
+
+// Equals проверяет равенство двух записей по ID
+func (e *Entry) Equals(other *Entry) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	return e.ID == other.ID
+}
@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidAuditFilterError представляет ошибку в параметрах выборки журнала
+type InvalidAuditFilterError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidAuditFilterError) Error() string {
+	return fmt.Sprintf("invalid audit filter: field '%s' - %s", e.Field, e.Message)
+}
+
+// AuditOperationFailedError представляет ошибку при выполнении операции с журналом
+type AuditOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *AuditOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("audit operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("audit operation '%s' failed", e.Operation)
+}
+
+// IsInvalidAuditFilter проверяет, является ли ошибка ошибкой параметров выборки журнала
+func IsInvalidAuditFilter(err error) bool {
+	var invalidFilterErr *InvalidAuditFilterError
+	return errors.As(err, &invalidFilterErr)
+}
+
+// IsAuditOperationFailed проверяет, является ли ошибка ошибкой операции с журналом
+func IsAuditOperationFailed(err error) bool {
+	var operationErr *AuditOperationFailedError
+	return errors.As(err, &operationErr)
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ListEntriesFilter параметры выборки журнала; nil поля не ограничивают выборку.
+// From включает границу, To - нет
+type ListEntriesFilter struct {
+	EntityType *EntityType
+	EntityID   *uuid.UUID
+	ActorID    *uuid.UUID
+	From       *time.Time
+	To         *time.Time
+}
+
+// Validate проверяет согласованность параметров выборки
+func (f ListEntriesFilter) Validate() error {
+	if f.EntityType != nil && !f.EntityType.IsValid() {
+		return &InvalidAuditFilterError{Field: "entity_type", Message: "unknown entity type '" + string(*f.EntityType) + "'"}
+	}
+	if f.From != nil && f.To != nil && !f.From.Before(*f.To) {
+		return &InvalidAuditFilterError{Field: "to", Message: "must be after from"}
+	}
+	return nil
+}
+
+// BaseAuditRepository определяет интерфейс журнала аудита. Записи журнала неизменяемы,
+// поэтому методов изменения и удаления нет
+type BaseAuditRepository interface {
+	// Create добавляет запись в журнал
+	Create(ctx context.Context, entry *Entry) error
+
+	// List возвращает записи журнала от новых к старым с пагинацией
+	List(ctx context.Context, filter ListEntriesFilter, page, pageSize int) ([]*Entry, int64, error)
+}
@@ -0,0 +1,25 @@
+package auth
+
+import "context"
+
+type principalContextKey struct{}
+
+// ContextWithPrincipal возвращает контекст с аутентифицированным пользователем
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext возвращает аутентифицированного пользователя из контекста
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
+
+// RequirePrincipal возвращает пользователя из контекста или UnauthenticatedError
+func RequirePrincipal(ctx context.Context) (Principal, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return Principal{}, &UnauthenticatedError{}
+	}
+	return principal, nil
+}
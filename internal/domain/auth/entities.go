@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Principal представляет аутентифицированного пользователя, выполняющего запрос
+type Principal struct {
+	UserID         uuid.UUID
+	Role           string
+	OrganizationID uuid.UUID // организация пользователя; ею ограничиваются все данные запроса
+}
+
+// RefreshToken представляет выданный refresh токен (хранится только его хеш)
+type RefreshToken struct {
+	ID        uuid.UUID // Object ID для сравнения
+	UserID    uuid.UUID
+	FamilyID  uuid.UUID // Цепочка ротаций одной сессии
+	TokenHash string
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// NewRefreshToken создает новый refresh токен
+func NewRefreshToken(userID, familyID uuid.UUID, tokenHash string, expiresAt time.Time) *RefreshToken {
+	return &RefreshToken{
+		ID:        uuid.New(),
+		UserID:    userID,
+		FamilyID:  familyID,
+		TokenHash: tokenHash,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsRevoked проверяет, отозван ли токен
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// IsExpired проверяет, истек ли срок действия токена на момент now
+func (t *RefreshToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// Equals проверяет равенство двух токенов по ID
+func (t *RefreshToken) Equals(other *RefreshToken) bool {
+	if t == nil || other == nil {
+		return t == other
+	}
+	return t.ID == other.ID
+}
+
+// TokenPair пара токенов, выдаваемая при входе и ротации
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
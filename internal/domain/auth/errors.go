@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidCredentialsError представляет ошибку неверного email или пароля
+type InvalidCredentialsError struct{}
+
+func (e *InvalidCredentialsError) Error() string {
+	return "invalid email or password"
+}
+
+// InvalidTokenError представляет ошибку невалидного, просроченного или отозванного токена
+type InvalidTokenError struct {
+	Reason string
+}
+
+func (e *InvalidTokenError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("invalid token: %s", e.Reason)
+	}
+	return "invalid token"
+}
+
+// UnauthenticatedError представляет ошибку, когда вызывающий пользователь не определен
+type UnauthenticatedError struct{}
+
+func (e *UnauthenticatedError) Error() string {
+	return "authentication required"
+}
+
+// ForbiddenError представляет ошибку, когда у пользователя нет прав на действие
+type ForbiddenError struct {
+	Action     string
+	Resource   string
+	Permission string
+	Reason     string
+}
+
+func (e *ForbiddenError) Error() string {
+	message := fmt.Sprintf("forbidden: cannot %s %s", e.Action, e.Resource)
+	if e.Permission != "" {
+		message = fmt.Sprintf("forbidden: permission '%s' required", e.Permission)
+	}
+	if e.Reason != "" {
+		return fmt.Sprintf("%s: %s", message, e.Reason)
+	}
+	return message
+}
+
+// AuthOperationFailedError представляет ошибку при выполнении операции аутентификации
+type AuthOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *AuthOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("auth operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("auth operation '%s' failed", e.Operation)
+}
+
+// IsInvalidCredentials проверяет, является ли ошибка ошибкой неверных учетных данных
+func IsInvalidCredentials(err error) bool {
+	var invalidCredentialsErr *InvalidCredentialsError
+	return errors.As(err, &invalidCredentialsErr)
+}
+
+// IsInvalidToken проверяет, является ли ошибка ошибкой невалидного токена
+func IsInvalidToken(err error) bool {
+	var invalidTokenErr *InvalidTokenError
+	return errors.As(err, &invalidTokenErr)
+}
+
+// IsUnauthenticated проверяет, является ли ошибка ошибкой отсутствия аутентификации
+func IsUnauthenticated(err error) bool {
+	var unauthenticatedErr *UnauthenticatedError
+	return errors.As(err, &unauthenticatedErr)
+}
+
+// IsForbidden проверяет, является ли ошибка ошибкой отсутствия прав
+func IsForbidden(err error) bool {
+	var forbiddenErr *ForbiddenError
+	return errors.As(err, &forbiddenErr)
+}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BaseRefreshTokensRepository определяет интерфейс для работы с refresh токенами
+type BaseRefreshTokensRepository interface {
+	// Create сохраняет новый refresh токен
+	Create(ctx context.Context, token *RefreshToken) (*RefreshToken, error)
+
+	// GetByTokenHash возвращает refresh токен по хешу
+	GetByTokenHash(ctx context.Context, tokenHash string) (*RefreshToken, error)
+
+	// Revoke отзывает refresh токен по ID; возвращает InvalidTokenError, если токен уже отозван
+	Revoke(ctx context.Context, id uuid.UUID) error
+
+	// RevokeFamily отзывает все токены цепочки ротаций
+	RevokeFamily(ctx context.Context, familyID uuid.UUID) error
+
+	// RevokeAllForUser отзывает все токены пользователя
+	RevokeAllForUser(ctx context.Context, userID uuid.UUID) error
+}
@@ -0,0 +1,27 @@
+package auth
+
+import "time"
+
+// BasePasswordHasher определяет интерфейс для хеширования и проверки паролей
+type BasePasswordHasher interface {
+	// Hash возвращает хеш пароля
+	Hash(password string) (string, error)
+
+	// Compare проверяет соответствие пароля хешу
+	Compare(hash, password string) error
+}
+
+// BaseTokenManager определяет интерфейс для выпуска и проверки токенов
+type BaseTokenManager interface {
+	// IssueAccessToken выпускает подписанный access токен для пользователя
+	IssueAccessToken(principal Principal) (string, time.Time, error)
+
+	// ParseAccessToken проверяет access токен и возвращает пользователя
+	ParseAccessToken(token string) (*Principal, error)
+
+	// IssueRefreshToken выпускает случайный refresh токен и возвращает его вместе с хешем
+	IssueRefreshToken() (token string, tokenHash string, expiresAt time.Time, err error)
+
+	// HashRefreshToken возвращает хеш refresh токена для поиска в хранилище
+	HashRefreshToken(token string) string
+}
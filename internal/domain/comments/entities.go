@@ -0,0 +1,80 @@
+package comments
+
+import (
+	"time"
+
+	"crud/internal/domain/comments/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Comment представляет сущность комментария к задаче. Комментарий с ParentID является ответом
+// на другой комментарий той же задачи
+type Comment struct {
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	TaskID         uuid.UUID
+	AuthorID       uuid.UUID
+	ParentID       *uuid.UUID
+	Body           value_objects.CommentBodyValueObject
+	EditedAt       *time.Time // время последнего изменения текста; nil, если текст не менялся
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// CommentRevision предыдущая версия текста комментария, сохраняемая при каждом изменении
+type CommentRevision struct {
+	ID        uuid.UUID
+	CommentID uuid.UUID
+	Body      string    // текст до изменения
+	CreatedAt time.Time // время изменения, заменившего этот текст
+}
+
+// NewComment создает новый комментарий; parentID задается для ответа на комментарий
+func NewComment(taskID, authorID uuid.UUID, parentID *uuid.UUID, body value_objects.CommentBodyValueObject) *Comment {
+	now := time.Now()
+	return &Comment{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		AuthorID:  authorID,
+		ParentID:  parentID,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsReply проверяет, является ли комментарий ответом на другой комментарий
+func (c *Comment) IsReply() bool {
+	return c.ParentID != nil
+}
+
+// Edit заменяет текст комментария и возвращает версию с прежним текстом.
+// Если текст не изменился, возвращает nil и комментарий не меняется
+func (c *Comment) Edit(body value_objects.CommentBodyValueObject) *CommentRevision {
+	if c.Body.Equals(body) {
+		return nil
+	}
+
+	now := time.Now()
+	revision := &CommentRevision{
+		ID:        uuid.New(),
+		CommentID: c.ID,
+		Body:      c.Body.Value(),
+		CreatedAt: now,
+	}
+
+	c.Body = body
+	c.EditedAt = &now
+	c.UpdatedAt = now
+
+	return revision
+}
+
+// Equals проверяет равенство двух комментариев по ID
+func (c *Comment) Equals(other *Comment) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return c.ID == other.ID
+}
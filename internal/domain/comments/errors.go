@@ -0,0 +1,72 @@
+package comments
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// CommentNotFoundError представляет ошибку, когда комментарий не найден
+type CommentNotFoundError struct {
+	CommentID uuid.UUID
+}
+
+func (e *CommentNotFoundError) Error() string {
+	return fmt.Sprintf("comment with ID %s not found", e.CommentID)
+}
+
+// ParentCommentNotFoundError представляет ошибку, когда комментарий, на который отвечают,
+// не существует или относится к другой задаче
+type ParentCommentNotFoundError struct {
+	ParentID uuid.UUID
+	TaskID   uuid.UUID
+}
+
+func (e *ParentCommentNotFoundError) Error() string {
+	return fmt.Sprintf("parent comment with ID %s not found in task %s", e.ParentID, e.TaskID)
+}
+
+// InvalidCommentDataError представляет ошибку валидации данных комментария
+type InvalidCommentDataError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidCommentDataError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid comment data: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid comment data: %s", e.Message)
+}
+
+// CommentOperationFailedError представляет ошибку при выполнении операции с комментарием
+type CommentOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *CommentOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("comment operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("comment operation '%s' failed", e.Operation)
+}
+
+// IsCommentNotFound проверяет, является ли ошибка ошибкой "комментарий не найден"
+func IsCommentNotFound(err error) bool {
+	var commentNotFoundErr *CommentNotFoundError
+	return errors.As(err, &commentNotFoundErr)
+}
+
+// IsParentCommentNotFound проверяет, является ли ошибка ошибкой "родительский комментарий не найден"
+func IsParentCommentNotFound(err error) bool {
+	var parentNotFoundErr *ParentCommentNotFoundError
+	return errors.As(err, &parentNotFoundErr)
+}
+
+// IsInvalidCommentData проверяет, является ли ошибка ошибкой валидации данных комментария
+func IsInvalidCommentData(err error) bool {
+	var invalidDataErr *InvalidCommentDataError
+	return errors.As(err, &invalidDataErr)
+}
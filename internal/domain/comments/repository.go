@@ -0,0 +1,38 @@
+package comments
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListCommentsFilter параметры выборки комментариев задачи.
+// Без ParentID возвращаются комментарии верхнего уровня, с ParentID - ответы на этот комментарий
+type ListCommentsFilter struct {
+	TaskID   uuid.UUID
+	ParentID *uuid.UUID
+}
+
+// BaseCommentsRepository определяет интерфейс для работы с комментариями
+type BaseCommentsRepository interface {
+	// Create создает новый комментарий
+	Create(ctx context.Context, comment *Comment) (*Comment, error)
+
+	// GetByID возвращает комментарий по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Comment, error)
+
+	// List возвращает комментарии задачи в порядке создания с пагинацией
+	List(ctx context.Context, filter ListCommentsFilter, page, pageSize int) ([]*Comment, int64, error)
+
+	// Update сохраняет новый текст комментария вместе с версией прежнего текста
+	Update(ctx context.Context, comment *Comment, revision *CommentRevision) (*Comment, error)
+
+	// ListRevisions возвращает историю изменений комментария от старых версий к новым
+	ListRevisions(ctx context.Context, commentID uuid.UUID) ([]*CommentRevision, error)
+
+	// Delete удаляет комментарий вместе со всеми ответами на него
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// DeleteByTask удаляет все комментарии задачи и возвращает их количество
+	DeleteByTask(ctx context.Context, taskID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,36 @@
+package value_objects
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxCommentBodyLength максимальная длина текста комментария в символах
+const MaxCommentBodyLength = 5000
+
+// CommentBodyValueObject представляет текст комментария с валидацией
+type CommentBodyValueObject struct {
+	value string
+}
+
+// NewCommentBodyValueObject создает новый CommentBodyValueObject с валидацией
+func NewCommentBodyValueObject(body string) (CommentBodyValueObject, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return CommentBodyValueObject{}, &InvalidCommentBodyError{Message: "comment body cannot be empty"}
+	}
+	if utf8.RuneCountInString(body) > MaxCommentBodyLength {
+		return CommentBodyValueObject{}, &InvalidCommentBodyError{Message: "comment body must be at most 5000 characters long"}
+	}
+	return CommentBodyValueObject{value: body}, nil
+}
+
+// Value возвращает строковое значение текста
+func (b CommentBodyValueObject) Value() string {
+	return b.value
+}
+
+// Equals проверяет равенство двух текстов
+func (b CommentBodyValueObject) Equals(other CommentBodyValueObject) bool {
+	return b.value == other.value
+}
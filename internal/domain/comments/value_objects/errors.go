@@ -0,0 +1,25 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidCommentBodyError представляет ошибку валидации текста комментария
+type InvalidCommentBodyError struct {
+	Value   string
+	Message string
+}
+
+func (e *InvalidCommentBodyError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("invalid comment body: %s", e.Value)
+}
+
+// IsInvalidCommentBody проверяет, является ли ошибка ошибкой валидации текста комментария
+func IsInvalidCommentBody(err error) bool {
+	var invalidBodyErr *InvalidCommentBodyError
+	return errors.As(err, &invalidBodyErr)
+}
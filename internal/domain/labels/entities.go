@@ -0,0 +1,37 @@
+package labels
+
+import (
+	"time"
+
+	"crud/internal/domain/labels/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Label представляет сущность метки, которой можно пометить задачи
+type Label struct {
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	Name           value_objects.LabelNameValueObject
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewLabel создает новую метку
+func NewLabel(name value_objects.LabelNameValueObject) *Label {
+	now := time.Now()
+	return &Label{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// Equals проверяет равенство двух меток по ID
+func (l *Label) Equals(other *Label) bool {
+	if l == nil || other == nil {
+		return l == other
+	}
+	return l.ID == other.ID
+}
@@ -0,0 +1,70 @@
+package labels
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// LabelNotFoundError представляет ошибку, когда метка не найдена
+type LabelNotFoundError struct {
+	LabelID uuid.UUID
+}
+
+func (e *LabelNotFoundError) Error() string {
+	return fmt.Sprintf("label with ID %s not found", e.LabelID)
+}
+
+// LabelAlreadyExistsError представляет ошибку, когда метка с таким именем уже существует
+type LabelAlreadyExistsError struct {
+	Name string
+}
+
+func (e *LabelAlreadyExistsError) Error() string {
+	return fmt.Sprintf("label with name %s already exists", e.Name)
+}
+
+// InvalidLabelDataError представляет ошибку валидации данных метки
+type InvalidLabelDataError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidLabelDataError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid label data: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid label data: %s", e.Message)
+}
+
+// LabelOperationFailedError представляет ошибку при выполнении операции с меткой
+type LabelOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *LabelOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("label operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("label operation '%s' failed", e.Operation)
+}
+
+// IsLabelNotFound проверяет, является ли ошибка ошибкой "метка не найдена"
+func IsLabelNotFound(err error) bool {
+	var labelNotFoundErr *LabelNotFoundError
+	return errors.As(err, &labelNotFoundErr)
+}
+
+// IsLabelAlreadyExists проверяет, является ли ошибка ошибкой "метка уже существует"
+func IsLabelAlreadyExists(err error) bool {
+	var labelExistsErr *LabelAlreadyExistsError
+	return errors.As(err, &labelExistsErr)
+}
+
+// IsInvalidLabelData проверяет, является ли ошибка ошибкой валидации данных метки
+func IsInvalidLabelData(err error) bool {
+	var invalidDataErr *InvalidLabelDataError
+	return errors.As(err, &invalidDataErr)
+}
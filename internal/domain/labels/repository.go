@@ -0,0 +1,34 @@
+package labels
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BaseLabelsRepository определяет интерфейс для работы с метками и их связями с задачами
+type BaseLabelsRepository interface {
+	// Create создает новую метку
+	Create(ctx context.Context, label *Label) (*Label, error)
+
+	// GetByID возвращает метку по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Label, error)
+
+	// List возвращает список меток, упорядоченный по имени, с пагинацией
+	List(ctx context.Context, page, pageSize int) ([]*Label, int64, error)
+
+	// Update обновляет данные метки
+	Update(ctx context.Context, label *Label) (*Label, error)
+
+	// Delete удаляет метку по ID вместе с ее связями с задачами
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListByTask возвращает метки задачи, упорядоченные по имени
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]*Label, error)
+
+	// AttachToTask добавляет метку к задаче; повторное добавление ничего не меняет
+	AttachToTask(ctx context.Context, taskID, labelID uuid.UUID) error
+
+	// DetachFromTask снимает метку с задачи; снятие отсутствующей метки ничего не меняет
+	DetachFromTask(ctx context.Context, taskID, labelID uuid.UUID) error
+}
@@ -0,0 +1,25 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidLabelNameError представляет ошибку валидации имени метки
+type InvalidLabelNameError struct {
+	Value   string
+	Message string
+}
+
+func (e *InvalidLabelNameError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("invalid label name: %s", e.Value)
+}
+
+// IsInvalidLabelName проверяет, является ли ошибка ошибкой валидации имени метки
+func IsInvalidLabelName(err error) bool {
+	var invalidNameErr *InvalidLabelNameError
+	return errors.As(err, &invalidNameErr)
+}
@@ -0,0 +1,38 @@
+package value_objects
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// LabelNameValueObject представляет имя метки с валидацией.
+// Имя хранится в нижнем регистре, поэтому "Bug" и "bug" - одна и та же метка
+type LabelNameValueObject struct {
+	value string
+}
+
+// NewLabelNameValueObject создает новый LabelNameValueObject с валидацией
+func NewLabelNameValueObject(name string) (LabelNameValueObject, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return LabelNameValueObject{}, &InvalidLabelNameError{Message: "label name cannot be empty"}
+	}
+	if utf8.RuneCountInString(name) > 50 {
+		return LabelNameValueObject{}, &InvalidLabelNameError{Value: name, Message: "label name must be at most 50 characters long"}
+	}
+	// Запятая разделяет метки в фильтре списка задач
+	if strings.Contains(name, ",") {
+		return LabelNameValueObject{}, &InvalidLabelNameError{Value: name, Message: "label name cannot contain commas"}
+	}
+	return LabelNameValueObject{value: name}, nil
+}
+
+// Value возвращает строковое значение имени
+func (n LabelNameValueObject) Value() string {
+	return n.value
+}
+
+// Equals проверяет равенство двух имен
+func (n LabelNameValueObject) Equals(other LabelNameValueObject) bool {
+	return n.value == other.value
+}
@@ -0,0 +1,41 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type organizationContextKey struct{}
+
+type unscopedContextKey struct{}
+
+// ContextWithOrganization возвращает контекст, все обращения к данным в котором ограничены организацией
+func ContextWithOrganization(ctx context.Context, organizationID uuid.UUID) context.Context {
+	return context.WithValue(ctx, organizationContextKey{}, organizationID)
+}
+
+// OrganizationFromContext возвращает организацию запроса из контекста
+func OrganizationFromContext(ctx context.Context) (uuid.UUID, bool) {
+	organizationID, ok := ctx.Value(organizationContextKey{}).(uuid.UUID)
+	return organizationID, ok
+}
+
+// ContextWithoutScope возвращает контекст для системных операций, которые работают с данными
+// всех организаций, например поиск пользователя по email при входе
+func ContextWithoutScope(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unscopedContextKey{}, true)
+}
+
+// ScopeFromContext возвращает организацию, которой нужно ограничить обращение к данным.
+// scoped == false означает системную операцию без ограничения; если контекст не содержит
+// ни организации, ни явной отметки о системной операции, возвращается OrganizationRequiredError
+func ScopeFromContext(ctx context.Context) (organizationID uuid.UUID, scoped bool, err error) {
+	if organizationID, ok := OrganizationFromContext(ctx); ok {
+		return organizationID, true, nil
+	}
+	if unscoped, _ := ctx.Value(unscopedContextKey{}).(bool); unscoped {
+		return uuid.Nil, false, nil
+	}
+	return uuid.Nil, false, &OrganizationRequiredError{}
+}
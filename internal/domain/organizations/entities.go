@@ -0,0 +1,48 @@
+package organizations
+
+import (
+	"time"
+
+	"crud/internal/domain/organizations/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// DefaultOrganizationID ID организации по умолчанию. В нее попадают данные, созданные до появления
+// организаций, самостоятельно зарегистрированные пользователи и администратор из конфига
+var DefaultOrganizationID = uuid.MustParse("00000000-0000-0000-0000-000000000001")
+
+// DefaultOrganizationName имя организации по умолчанию
+const DefaultOrganizationName = "Default"
+
+// Organization представляет сущность организации (арендатора), данные которой изолированы от других
+type Organization struct {
+	ID        uuid.UUID // Object ID для сравнения
+	Name      value_objects.OrganizationNameValueObject
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NewOrganization создает новую организацию
+func NewOrganization(name value_objects.OrganizationNameValueObject) *Organization {
+	now := time.Now()
+	return &Organization{
+		ID:        uuid.New(),
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsDefault проверяет, является ли организация организацией по умолчанию
+func (o *Organization) IsDefault() bool {
+	return o.ID == DefaultOrganizationID
+}
+
+// Equals проверяет равенство двух организаций по ID
+func (o *Organization) Equals(other *Organization) bool {
+	if o == nil || other == nil {
+		return o == other
+	}
+	return o.ID == other.ID
+}
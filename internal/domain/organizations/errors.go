@@ -0,0 +1,69 @@
+package organizations
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationNotFoundError представляет ошибку, когда организация не найдена
+type OrganizationNotFoundError struct {
+	OrganizationID uuid.UUID
+}
+
+func (e *OrganizationNotFoundError) Error() string {
+	return fmt.Sprintf("organization with ID %s not found", e.OrganizationID)
+}
+
+// OrganizationRequiredError представляет ошибку, когда организация запроса не определена,
+// а операция не помечена как выполняемая вне организаций
+type OrganizationRequiredError struct{}
+
+func (e *OrganizationRequiredError) Error() string {
+	return "organization is not resolved for the request"
+}
+
+// InvalidOrganizationDataError представляет ошибку валидации данных организации
+type InvalidOrganizationDataError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidOrganizationDataError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid organization data: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid organization data: %s", e.Message)
+}
+
+// OrganizationOperationFailedError представляет ошибку при выполнении операции с организацией
+type OrganizationOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *OrganizationOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("organization operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("organization operation '%s' failed", e.Operation)
+}
+
+// IsOrganizationNotFound проверяет, является ли ошибка ошибкой "организация не найдена"
+func IsOrganizationNotFound(err error) bool {
+	var notFoundErr *OrganizationNotFoundError
+	return errors.As(err, &notFoundErr)
+}
+
+// IsOrganizationRequired проверяет, является ли ошибка ошибкой "организация запроса не определена"
+func IsOrganizationRequired(err error) bool {
+	var requiredErr *OrganizationRequiredError
+	return errors.As(err, &requiredErr)
+}
+
+// IsInvalidOrganizationData проверяет, является ли ошибка ошибкой валидации данных организации
+func IsInvalidOrganizationData(err error) bool {
+	var invalidDataErr *InvalidOrganizationDataError
+	return errors.As(err, &invalidDataErr)
+}
@@ -0,0 +1,20 @@
+package organizations
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// BaseOrganizationsRepository определяет интерфейс для работы с организациями.
+// Сами организации не ограничиваются организацией запроса
+type BaseOrganizationsRepository interface {
+	// Create создает новую организацию
+	Create(ctx context.Context, organization *Organization) (*Organization, error)
+
+	// GetByID возвращает организацию по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Organization, error)
+
+	// List возвращает список организаций, упорядоченный по имени, с пагинацией
+	List(ctx context.Context, page, pageSize int) ([]*Organization, int64, error)
+}
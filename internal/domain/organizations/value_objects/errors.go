@@ -0,0 +1,25 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidOrganizationNameError представляет ошибку валидации имени организации
+type InvalidOrganizationNameError struct {
+	Value   string
+	Message string
+}
+
+func (e *InvalidOrganizationNameError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("invalid organization name: %s", e.Value)
+}
+
+// IsInvalidOrganizationName проверяет, является ли ошибка ошибкой валидации имени организации
+func IsInvalidOrganizationName(err error) bool {
+	var invalidNameErr *InvalidOrganizationNameError
+	return errors.As(err, &invalidNameErr)
+}
@@ -0,0 +1,33 @@
+package value_objects
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// OrganizationNameValueObject представляет имя организации с валидацией
+type OrganizationNameValueObject struct {
+	value string
+}
+
+// NewOrganizationNameValueObject создает новый OrganizationNameValueObject с валидацией
+func NewOrganizationNameValueObject(name string) (OrganizationNameValueObject, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return OrganizationNameValueObject{}, &InvalidOrganizationNameError{Message: "organization name cannot be empty"}
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return OrganizationNameValueObject{}, &InvalidOrganizationNameError{Value: name, Message: "organization name must be at most 100 characters long"}
+	}
+	return OrganizationNameValueObject{value: name}, nil
+}
+
+// Value возвращает строковое значение имени
+func (n OrganizationNameValueObject) Value() string {
+	return n.value
+}
+
+// Equals проверяет равенство двух имен
+func (n OrganizationNameValueObject) Equals(other OrganizationNameValueObject) bool {
+	return n.value == other.value
+}
@@ -0,0 +1,41 @@
+package projects
+
+import (
+	"time"
+
+	"crud/internal/domain/projects/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// Project представляет сущность проекта, который объединяет задачи одного владельца
+type Project struct {
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	UserID         uuid.UUID
+	Name           value_objects.ProjectNameValueObject
+	Description    string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// NewProject создает новый проект
+func NewProject(userID uuid.UUID, name value_objects.ProjectNameValueObject, description string) *Project {
+	now := time.Now()
+	return &Project{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Name:        name,
+		Description: description,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+// Equals проверяет равенство двух проектов по ID
+func (p *Project) Equals(other *Project) bool {
+	if p == nil || other == nil {
+		return p == other
+	}
+	return p.ID == other.ID
+}
@@ -0,0 +1,101 @@
+package projects
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// ProjectNotFoundError представляет ошибку, когда проект не найден
+type ProjectNotFoundError struct {
+	ProjectID uuid.UUID
+}
+
+func (e *ProjectNotFoundError) Error() string {
+	return fmt.Sprintf("project with ID %s not found", e.ProjectID)
+}
+
+// ProjectAlreadyExistsError представляет ошибку, когда проект уже существует
+type ProjectAlreadyExistsError struct {
+	ProjectID uuid.UUID
+}
+
+func (e *ProjectAlreadyExistsError) Error() string {
+	return fmt.Sprintf("project with ID %s already exists", e.ProjectID)
+}
+
+// ProjectOwnerNotFoundError представляет ошибку, когда владелец проекта не существует
+type ProjectOwnerNotFoundError struct {
+	UserID uuid.UUID
+}
+
+func (e *ProjectOwnerNotFoundError) Error() string {
+	return fmt.Sprintf("project owner with ID %s not found", e.UserID)
+}
+
+// ProjectHasTasksError представляет ошибку, когда проект нельзя удалить из-за его задач
+type ProjectHasTasksError struct {
+	ProjectID uuid.UUID
+	Tasks     int64
+}
+
+func (e *ProjectHasTasksError) Error() string {
+	return fmt.Sprintf("project with ID %s has %d tasks", e.ProjectID, e.Tasks)
+}
+
+// InvalidProjectDataError представляет ошибку валидации данных проекта
+type InvalidProjectDataError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidProjectDataError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid project data: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid project data: %s", e.Message)
+}
+
+// ProjectOperationFailedError представляет ошибку при выполнении операции с проектом
+type ProjectOperationFailedError struct {
+	Operation string
+	Reason    string
+}
+
+func (e *ProjectOperationFailedError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("project operation '%s' failed: %s", e.Operation, e.Reason)
+	}
+	return fmt.Sprintf("project operation '%s' failed", e.Operation)
+}
+
+// IsProjectNotFound проверяет, является ли ошибка ошибкой "проект не найден"
+func IsProjectNotFound(err error) bool {
+	var projectNotFoundErr *ProjectNotFoundError
+	return errors.As(err, &projectNotFoundErr)
+}
+
+// IsProjectAlreadyExists проверяет, является ли ошибка ошибкой "проект уже существует"
+func IsProjectAlreadyExists(err error) bool {
+	var projectExistsErr *ProjectAlreadyExistsError
+	return errors.As(err, &projectExistsErr)
+}
+
+// IsProjectOwnerNotFound проверяет, является ли ошибка ошибкой "владелец проекта не найден"
+func IsProjectOwnerNotFound(err error) bool {
+	var ownerNotFoundErr *ProjectOwnerNotFoundError
+	return errors.As(err, &ownerNotFoundErr)
+}
+
+// IsProjectHasTasks проверяет, является ли ошибка ошибкой "в проекте есть задачи"
+func IsProjectHasTasks(err error) bool {
+	var hasTasksErr *ProjectHasTasksError
+	return errors.As(err, &hasTasksErr)
+}
+
+// IsInvalidProjectData проверяет, является ли ошибка ошибкой валидации данных проекта
+func IsInvalidProjectData(err error) bool {
+	var invalidDataErr *InvalidProjectDataError
+	return errors.As(err, &invalidDataErr)
+}
@@ -0,0 +1,36 @@
+package projects
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// ListProjectsFilter параметры фильтрации списка проектов; nil поля не ограничивают выборку
+type ListProjectsFilter struct {
+	UserID *uuid.UUID
+}
+
+// BaseProjectsRepository определяет интерфейс для работы с проектами
+type BaseProjectsRepository interface {
+	// Create создает новый проект
+	Create(ctx context.Context, project *Project) (*Project, error)
+
+	// GetByID возвращает проект по ID
+	GetByID(ctx context.Context, id uuid.UUID) (*Project, error)
+
+	// List возвращает список проектов, упорядоченный по имени, с фильтрацией и пагинацией
+	List(ctx context.Context, filter ListProjectsFilter, page, pageSize int) ([]*Project, int64, error)
+
+	// Update обновляет данные проекта
+	Update(ctx context.Context, project *Project) (*Project, error)
+
+	// Delete удаляет проект по ID
+	Delete(ctx context.Context, id uuid.UUID) error
+
+	// DeleteByUser удаляет все проекты пользователя и возвращает их количество
+	DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// ReassignOwner передает все проекты пользователя fromUserID пользователю toUserID
+	ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error)
+}
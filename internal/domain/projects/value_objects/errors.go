@@ -0,0 +1,25 @@
+package value_objects
+
+import (
+	"errors"
+	"fmt"
+)
+
+// InvalidProjectNameError представляет ошибку валидации имени проекта
+type InvalidProjectNameError struct {
+	Value   string
+	Message string
+}
+
+func (e *InvalidProjectNameError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return fmt.Sprintf("invalid project name: %s", e.Value)
+}
+
+// IsInvalidProjectName проверяет, является ли ошибка ошибкой валидации имени проекта
+func IsInvalidProjectName(err error) bool {
+	var invalidNameErr *InvalidProjectNameError
+	return errors.As(err, &invalidNameErr)
+}
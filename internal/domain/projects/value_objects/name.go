@@ -0,0 +1,33 @@
+package value_objects
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ProjectNameValueObject представляет имя проекта с валидацией
+type ProjectNameValueObject struct {
+	value string
+}
+
+// NewProjectNameValueObject создает новый ProjectNameValueObject с валидацией
+func NewProjectNameValueObject(name string) (ProjectNameValueObject, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return ProjectNameValueObject{}, &InvalidProjectNameError{Message: "project name cannot be empty"}
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return ProjectNameValueObject{}, &InvalidProjectNameError{Value: name, Message: "project name must be at most 100 characters long"}
+	}
+	return ProjectNameValueObject{value: name}, nil
+}
+
+// Value возвращает строковое значение имени
+func (n ProjectNameValueObject) Value() string {
+	return n.value
+}
+
+// Equals проверяет равенство двух имен
+func (n ProjectNameValueObject) Equals(other ProjectNameValueObject) bool {
+	return n.value == other.value
+}
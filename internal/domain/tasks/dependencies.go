@@ -0,0 +1,38 @@
+package tasks
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// TaskDependencies зависимости задачи: задачи, которые ее блокируют, и задачи, которые ждут ее
+type TaskDependencies struct {
+	Blockers   []*Task
+	Dependents []*Task
+}
+
+// ValidateDependency проверяет, что task можно заблокировать задачей blocker.
+// blockerChain - ID всех задач, от которых blocker зависит напрямую или через другие задачи
+func ValidateDependency(task, blocker *Task, blockerChain []uuid.UUID) error {
+	if blocker.ID == task.ID || slices.Contains(blockerChain, task.ID) {
+		return &TaskDependencyCycleError{TaskID: task.ID, BlockerID: blocker.ID}
+	}
+
+	if blocker.UserID != task.UserID {
+		return &InvalidTaskDataError{Field: "blocker_id", Message: "blocker task must belong to the same user"}
+	}
+
+	return nil
+}
+
+// OpenBlockers возвращает незавершенные задачи из blockers
+func OpenBlockers(blockers []*Task) []*Task {
+	open := make([]*Task, 0, len(blockers))
+	for _, blocker := range blockers {
+		if blocker.Status.IsOpen() {
+			open = append(open, blocker)
+		}
+	}
+	return open
+}
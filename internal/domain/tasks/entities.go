@@ -10,16 +10,23 @@ import (
 
 // Task представляет сущность задачи
 type Task struct {
-	ID          uuid.UUID // Object ID для сравнения
-	UserID      uuid.UUID
-	Title       value_objects.TaskTitleValueObject
-	Description string
-	Status      value_objects.TaskStatusValueObject
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	UserID         uuid.UUID
+	ProjectID      *uuid.UUID // проект задачи; nil у задач вне проектов
+	ParentID       *uuid.UUID // родительская задача; nil у корневых задач
+	Title          value_objects.TaskTitleValueObject
+	Description    string
+	Status         value_objects.TaskStatusValueObject
+	Priority       value_objects.TaskPriorityValueObject
+	Schedule       value_objects.TaskScheduleValueObject
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time // время перемещения в корзину; nil у действующих задач
+	Version        int64      // версия для оптимистичной блокировки; растет при каждом сохранении
 }
 
-// NewTask создает новую задачу
+// NewTask создает новую задачу с приоритетом по умолчанию
 func NewTask(
 	userID uuid.UUID,
 	title value_objects.TaskTitleValueObject,
@@ -33,11 +40,23 @@ func NewTask(
 		Title:       title,
 		Description: description,
 		Status:      status,
+		Priority:    value_objects.NewDefaultTaskPriorityValueObject(),
 		CreatedAt:   now,
 		UpdatedAt:   now,
+		Version:     1,
 	}
 }
 
+// IsOverdue проверяет, что задача не завершена, а ее срок выполнения наступил раньше now
+func (t *Task) IsOverdue(now time.Time) bool {
+	return t.Status.IsOpen() && t.Schedule.IsPastDue(now)
+}
+
+// IsSubtask проверяет, есть ли у задачи родительская задача
+func (t *Task) IsSubtask() bool {
+	return t.ParentID != nil
+}
+
 // Equals проверяет равенство двух задач по ID
 func (t *Task) Equals(other *Task) bool {
 	if t == nil || other == nil {
@@ -25,6 +25,117 @@ func (e *TaskAlreadyExistsError) Error() string {
 	return fmt.Sprintf("task with ID %s already exists", e.TaskID)
 }
 
+// TaskOwnerNotFoundError представляет ошибку, когда владелец задачи не существует
+type TaskOwnerNotFoundError struct {
+	UserID uuid.UUID
+}
+
+func (e *TaskOwnerNotFoundError) Error() string {
+	return fmt.Sprintf("task owner with ID %s not found", e.UserID)
+}
+
+// ParentTaskNotFoundError представляет ошибку, когда родительская задача не существует
+type ParentTaskNotFoundError struct {
+	ParentID uuid.UUID
+}
+
+func (e *ParentTaskNotFoundError) Error() string {
+	return fmt.Sprintf("parent task with ID %s not found", e.ParentID)
+}
+
+// TaskHierarchyCycleError представляет ошибку, когда задача становится собственным предком
+type TaskHierarchyCycleError struct {
+	TaskID   uuid.UUID
+	ParentID uuid.UUID
+}
+
+func (e *TaskHierarchyCycleError) Error() string {
+	return fmt.Sprintf("task %s cannot be a subtask of %s: it would create a cycle", e.TaskID, e.ParentID)
+}
+
+// TaskDepthExceededError представляет ошибку, когда дерево задач становится глубже допустимого
+type TaskDepthExceededError struct {
+	TaskID   uuid.UUID
+	Depth    int
+	MaxDepth int
+}
+
+func (e *TaskDepthExceededError) Error() string {
+	return fmt.Sprintf("task %s would be nested %d levels deep, maximum is %d", e.TaskID, e.Depth, e.MaxDepth)
+}
+
+// TaskHasSubtasksError представляет ошибку, когда задачу нельзя удалить из-за подзадач
+type TaskHasSubtasksError struct {
+	TaskID   uuid.UUID
+	Subtasks int64
+}
+
+func (e *TaskHasSubtasksError) Error() string {
+	return fmt.Sprintf("task with ID %s has %d subtasks", e.TaskID, e.Subtasks)
+}
+
+// ConcurrentModificationError представляет ошибку, когда задачу изменили после того,
+// как была прочитана ее версия Version
+type ConcurrentModificationError struct {
+	TaskID  uuid.UUID
+	Version int64
+}
+
+func (e *ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("task with ID %s was modified concurrently: version %d is outdated", e.TaskID, e.Version)
+}
+
+// BlockerTaskNotFoundError представляет ошибку, когда блокирующая задача не существует
+type BlockerTaskNotFoundError struct {
+	BlockerID uuid.UUID
+}
+
+func (e *BlockerTaskNotFoundError) Error() string {
+	return fmt.Sprintf("blocker task with ID %s not found", e.BlockerID)
+}
+
+// TaskDependencyCycleError представляет ошибку, когда зависимость замыкает цепочку блокировок
+type TaskDependencyCycleError struct {
+	TaskID    uuid.UUID
+	BlockerID uuid.UUID
+}
+
+func (e *TaskDependencyCycleError) Error() string {
+	return fmt.Sprintf("task %s cannot be blocked by %s: it would create a cycle", e.TaskID, e.BlockerID)
+}
+
+// IllegalTransitionError представляет ошибку перехода, не разрешенного рабочим процессом
+type IllegalTransitionError struct {
+	From    string
+	To      string
+	Allowed []string
+}
+
+func (e *IllegalTransitionError) Error() string {
+	return fmt.Sprintf("cannot move task from '%s' to '%s'. Allowed transitions: %v", e.From, e.To, e.Allowed)
+}
+
+// TransitionGuardError представляет ошибку, когда условие разрешенного перехода не выполнено
+type TransitionGuardError struct {
+	From   string
+	To     string
+	Guard  string
+	Reason string
+}
+
+func (e *TransitionGuardError) Error() string {
+	return fmt.Sprintf("cannot move task from '%s' to '%s': guard '%s' failed: %s", e.From, e.To, e.Guard, e.Reason)
+}
+
+// InvalidWorkflowError представляет ошибку в описании рабочего процесса задач
+type InvalidWorkflowError struct {
+	Message string
+}
+
+func (e *InvalidWorkflowError) Error() string {
+	return fmt.Sprintf("invalid task workflow: %s", e.Message)
+}
+
 // InvalidTaskDataError представляет ошибку валидации данных задачи
 type InvalidTaskDataError struct {
 	Field   string
@@ -63,6 +174,72 @@ func IsTaskAlreadyExists(err error) bool {
 	return errors.As(err, &taskExistsErr)
 }
 
+// IsTaskOwnerNotFound проверяет, является ли ошибка ошибкой "владелец задачи не найден"
+func IsTaskOwnerNotFound(err error) bool {
+	var ownerNotFoundErr *TaskOwnerNotFoundError
+	return errors.As(err, &ownerNotFoundErr)
+}
+
+// IsParentTaskNotFound проверяет, является ли ошибка ошибкой "родительская задача не найдена"
+func IsParentTaskNotFound(err error) bool {
+	var parentNotFoundErr *ParentTaskNotFoundError
+	return errors.As(err, &parentNotFoundErr)
+}
+
+// IsTaskHierarchyCycle проверяет, является ли ошибка ошибкой цикла в иерархии задач
+func IsTaskHierarchyCycle(err error) bool {
+	var cycleErr *TaskHierarchyCycleError
+	return errors.As(err, &cycleErr)
+}
+
+// IsTaskDepthExceeded проверяет, является ли ошибка ошибкой превышения глубины иерархии
+func IsTaskDepthExceeded(err error) bool {
+	var depthErr *TaskDepthExceededError
+	return errors.As(err, &depthErr)
+}
+
+// IsTaskHasSubtasks проверяет, является ли ошибка ошибкой "у задачи есть подзадачи"
+func IsTaskHasSubtasks(err error) bool {
+	var subtasksErr *TaskHasSubtasksError
+	return errors.As(err, &subtasksErr)
+}
+
+// IsConcurrentModification проверяет, является ли ошибка ошибкой одновременного изменения задачи
+func IsConcurrentModification(err error) bool {
+	var concurrentErr *ConcurrentModificationError
+	return errors.As(err, &concurrentErr)
+}
+
+// IsBlockerTaskNotFound проверяет, является ли ошибка ошибкой "блокирующая задача не найдена"
+func IsBlockerTaskNotFound(err error) bool {
+	var blockerNotFoundErr *BlockerTaskNotFoundError
+	return errors.As(err, &blockerNotFoundErr)
+}
+
+// IsTaskDependencyCycle проверяет, является ли ошибка ошибкой цикла в зависимостях задач
+func IsTaskDependencyCycle(err error) bool {
+	var cycleErr *TaskDependencyCycleError
+	return errors.As(err, &cycleErr)
+}
+
+// IsIllegalTransition проверяет, является ли ошибка ошибкой недопустимого перехода
+func IsIllegalTransition(err error) bool {
+	var illegalTransitionErr *IllegalTransitionError
+	return errors.As(err, &illegalTransitionErr)
+}
+
+// IsTransitionGuardFailed проверяет, является ли ошибка ошибкой невыполненного условия перехода
+func IsTransitionGuardFailed(err error) bool {
+	var guardErr *TransitionGuardError
+	return errors.As(err, &guardErr)
+}
+
+// IsInvalidWorkflow проверяет, является ли ошибка ошибкой описания рабочего процесса
+func IsInvalidWorkflow(err error) bool {
+	var invalidWorkflowErr *InvalidWorkflowError
+	return errors.As(err, &invalidWorkflowErr)
+}
+
 // IsInvalidTaskData проверяет, является ли ошибка ошибкой валидации данных задачи
 func IsInvalidTaskData(err error) bool {
 	var invalidDataErr *InvalidTaskDataError
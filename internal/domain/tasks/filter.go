@@ -0,0 +1,455 @@
+package tasks
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"crud/internal/domain/tasks/value_objects"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// maxFilterLength ограничивает длину выражения фильтра, а вместе с ней и размер SQL запроса
+	maxFilterLength = 1000
+	// maxFilterDepth ограничивает вложенность скобок в выражении фильтра
+	maxFilterDepth = 10
+
+	filterDateLayout = "2006-01-02"
+)
+
+// filterFieldKind определяет, какие значения принимает поле и какие операторы к нему применимы
+type filterFieldKind int
+
+const (
+	filterKindText filterFieldKind = iota
+	filterKindStatus
+	filterKindPriority
+	filterKindID
+	filterKindTime
+)
+
+var filterFieldKinds = map[TaskField]filterFieldKind{
+	TaskFieldTitle:       filterKindText,
+	TaskFieldDescription: filterKindText,
+	TaskFieldStatus:      filterKindStatus,
+	TaskFieldPriority:    filterKindPriority,
+	TaskFieldUserID:      filterKindID,
+	TaskFieldProjectID:   filterKindID,
+	TaskFieldStartDate:   filterKindTime,
+	TaskFieldDueDate:     filterKindTime,
+	TaskFieldCreatedAt:   filterKindTime,
+	TaskFieldUpdatedAt:   filterKindTime,
+}
+
+var filterKindOperators = map[filterFieldKind][]FilterOperator{
+	filterKindText:   {FilterEqual, FilterNotEqual, FilterIn, FilterContains},
+	filterKindStatus: {FilterEqual, FilterNotEqual, FilterIn},
+	filterKindPriority: {
+		FilterEqual, FilterNotEqual, FilterIn,
+		FilterLess, FilterLessOrEqual, FilterGreater, FilterGreaterOrEqual,
+	},
+	filterKindID: {FilterEqual, FilterNotEqual, FilterIn},
+	filterKindTime: {
+		FilterEqual, FilterNotEqual,
+		FilterLess, FilterLessOrEqual, FilterGreater, FilterGreaterOrEqual,
+	},
+}
+
+// sortableTaskFields поля, по которым можно упорядочить список задач
+var sortableTaskFields = []TaskField{
+	TaskFieldTitle, TaskFieldStatus, TaskFieldPriority,
+	TaskFieldStartDate, TaskFieldDueDate, TaskFieldCreatedAt, TaskFieldUpdatedAt,
+}
+
+// ParseTaskFilter разбирает выражение языка фильтров списка задач. Выражение состоит из сравнений
+// "поле оператор значение", объединенных and и or (and связывает сильнее) и сгруппированных скобками:
+//
+//	status in (todo, in_progress) and (due_date < 2025-07-01 or title contains "отчет")
+//
+// Значения с пробелами и спецсимволами записываются в двойных кавычках (\" и \\ внутри кавычек).
+// Даты принимаются в RFC 3339 или как YYYY-MM-DD в часовом поясе loc; дата без времени обозначает
+// весь день: due_date = 2025-07-01 выбирает задачи со сроком в течение этого дня.
+// Пустое выражение не ограничивает выборку и возвращает nil
+func ParseTaskFilter(expression string, loc *time.Location) (TaskSpecification, error) {
+	if strings.TrimSpace(expression) == "" {
+		return nil, nil
+	}
+	if len(expression) > maxFilterLength {
+		return nil, &InvalidTaskDataError{Field: "filter", Message: fmt.Sprintf("filter is longer than %d bytes", maxFilterLength)}
+	}
+
+	tokens, err := tokenizeFilter(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &filterParser{tokens: tokens, loc: loc}
+	spec, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := parser.peek(); token.kind != filterTokenEnd {
+		return nil, unexpectedFilterToken(token, "expected and, or or end of filter")
+	}
+	return spec, nil
+}
+
+// ParseTaskSort разбирает параметр sort: имя готового порядка (priority) или список полей через запятую,
+// например -updated_at,title. Поле упорядочивает задачи по возрастанию, минус перед ним - по убыванию;
+// priority сравнивается по важности, а задачи без start_date или due_date идут последними при любом
+// направлении. Для списка полей возвращается TaskSortDefault вместе с ключами сортировки
+func ParseTaskSort(value string) (TaskSortOrder, []TaskSortKey, error) {
+	if order, err := ParseTaskSortOrder(value); err == nil {
+		return order, nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	keys := make([]TaskSortKey, 0, len(parts))
+	for _, part := range parts {
+		key := TaskSortKey{Field: TaskField(strings.TrimSpace(part))}
+		if strings.HasPrefix(string(key.Field), "-") {
+			key.Field = key.Field[1:]
+			key.Descending = true
+		}
+
+		if !slices.Contains(sortableTaskFields, key.Field) {
+			fields := make([]string, len(sortableTaskFields))
+			for i, field := range sortableTaskFields {
+				fields[i] = string(field)
+			}
+			return "", nil, &InvalidTaskDataError{
+				Field:   "sort",
+				Message: fmt.Sprintf("unknown sort field '%s', expected priority or a list of %s", part, strings.Join(fields, ", ")),
+			}
+		}
+		if slices.ContainsFunc(keys, func(other TaskSortKey) bool { return other.Field == key.Field }) {
+			return "", nil, &InvalidTaskDataError{Field: "sort", Message: fmt.Sprintf("sort field '%s' is repeated", key.Field)}
+		}
+		keys = append(keys, key)
+	}
+	return TaskSortDefault, keys, nil
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenEnd filterTokenKind = iota
+	filterTokenWord
+	filterTokenString
+	filterTokenOperator
+	filterTokenOpen
+	filterTokenClose
+	filterTokenComma
+)
+
+// filterToken лексема выражения фильтра; pos - смещение в байтах от начала выражения
+type filterToken struct {
+	kind filterTokenKind
+	text string
+	pos  int
+}
+
+// tokenizeFilter разбивает выражение фильтра на лексемы; последней всегда идет filterTokenEnd
+func tokenizeFilter(expression string) ([]filterToken, error) {
+	var tokens []filterToken
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, filterToken{kind: filterTokenOpen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, filterToken{kind: filterTokenClose, text: ")", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, filterToken{kind: filterTokenComma, text: ",", pos: i})
+			i++
+		case c == '=':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, text: "=", pos: i})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			length := 1
+			if i+1 < len(expression) && expression[i+1] == '=' {
+				length = 2
+			} else if c == '!' {
+				return nil, filterError(i, "unexpected '!', expected '!='")
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, text: expression[i : i+length], pos: i})
+			i += length
+		case c == '"':
+			value, next, err := readFilterString(expression, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenString, text: value, pos: i})
+			i = next
+		default:
+			start := i
+			for i < len(expression) && !strings.ContainsRune(" \t\n\r(),=!<>\"", rune(expression[i])) {
+				i++
+			}
+			tokens = append(tokens, filterToken{kind: filterTokenWord, text: expression[start:i], pos: start})
+		}
+	}
+	return append(tokens, filterToken{kind: filterTokenEnd, pos: len(expression)}), nil
+}
+
+// readFilterString читает строку в двойных кавычках, начинающуюся с позиции start,
+// и возвращает ее значение и позицию после закрывающей кавычки
+func readFilterString(expression string, start int) (string, int, error) {
+	var value strings.Builder
+	for i := start + 1; i < len(expression); i++ {
+		switch c := expression[i]; c {
+		case '"':
+			return value.String(), i + 1, nil
+		case '\\':
+			if i+1 < len(expression) && (expression[i+1] == '"' || expression[i+1] == '\\') {
+				i++
+				value.WriteByte(expression[i])
+				continue
+			}
+			return "", 0, filterError(i, "unknown escape sequence, expected \\\" or \\\\")
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return "", 0, filterError(start, "unterminated string")
+}
+
+// filterParser разбирает лексемы выражения фильтра рекурсивным спуском:
+//
+//	or         = and { "or" and }
+//	and        = term { "and" term }
+//	term       = "(" or ")" | comparison
+//	comparison = field operator value | field "in" "(" value { "," value } ")"
+type filterParser struct {
+	tokens []filterToken
+	next   int
+	depth  int
+	loc    *time.Location
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.next]
+}
+
+func (p *filterParser) take() filterToken {
+	token := p.tokens[p.next]
+	if token.kind != filterTokenEnd {
+		p.next++
+	}
+	return token
+}
+
+// keyword пропускает следующее слово, если оно совпадает с name без учета регистра
+func (p *filterParser) keyword(name string) bool {
+	if token := p.peek(); token.kind == filterTokenWord && strings.EqualFold(token.text, name) {
+		p.next++
+		return true
+	}
+	return false
+}
+
+func (p *filterParser) parseOr() (TaskSpecification, error) {
+	spec, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	operands := []TaskSpecification{spec}
+	for p.keyword("or") {
+		if spec, err = p.parseAnd(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, spec)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return AnyOfSpecification{Operands: operands}, nil
+}
+
+func (p *filterParser) parseAnd() (TaskSpecification, error) {
+	spec, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	operands := []TaskSpecification{spec}
+	for p.keyword("and") {
+		if spec, err = p.parseTerm(); err != nil {
+			return nil, err
+		}
+		operands = append(operands, spec)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return AllOfSpecification{Operands: operands}, nil
+}
+
+func (p *filterParser) parseTerm() (TaskSpecification, error) {
+	if p.peek().kind != filterTokenOpen {
+		return p.parseComparison()
+	}
+
+	open := p.take()
+	if p.depth++; p.depth > maxFilterDepth {
+		return nil, filterError(open.pos, "parentheses are nested deeper than %d levels", maxFilterDepth)
+	}
+	spec, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.take(); token.kind != filterTokenClose {
+		return nil, unexpectedFilterToken(token, "expected ')'")
+	}
+	p.depth--
+	return spec, nil
+}
+
+func (p *filterParser) parseComparison() (TaskSpecification, error) {
+	fieldToken := p.take()
+	if fieldToken.kind != filterTokenWord {
+		return nil, unexpectedFilterToken(fieldToken, "expected field name")
+	}
+	field := TaskField(fieldToken.text)
+	kind, ok := filterFieldKinds[field]
+	if !ok {
+		return nil, filterError(fieldToken.pos, "unknown field '%s'", fieldToken.text)
+	}
+
+	operatorToken := p.peek()
+	var operator FilterOperator
+	switch {
+	case operatorToken.kind == filterTokenOperator:
+		operator = FilterOperator(p.take().text)
+	case p.keyword("in"):
+		operator = FilterIn
+	case p.keyword("contains"):
+		operator = FilterContains
+	default:
+		return nil, unexpectedFilterToken(operatorToken, "expected comparison operator")
+	}
+	if !slices.Contains(filterKindOperators[kind], operator) {
+		return nil, filterError(operatorToken.pos, "operator '%s' cannot be applied to field '%s'", operator, field)
+	}
+
+	var valueTokens []filterToken
+	if operator == FilterIn {
+		if token := p.take(); token.kind != filterTokenOpen {
+			return nil, unexpectedFilterToken(token, "expected '(' after in")
+		}
+		for {
+			token, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			valueTokens = append(valueTokens, token)
+			if p.peek().kind != filterTokenComma {
+				break
+			}
+			p.take()
+		}
+		if token := p.take(); token.kind != filterTokenClose {
+			return nil, unexpectedFilterToken(token, "expected ',' or ')'")
+		}
+	} else {
+		token, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		valueTokens = append(valueTokens, token)
+	}
+
+	return p.comparison(field, kind, operator, valueTokens)
+}
+
+func (p *filterParser) parseValue() (filterToken, error) {
+	token := p.take()
+	if token.kind != filterTokenWord && token.kind != filterTokenString {
+		return token, unexpectedFilterToken(token, "expected value")
+	}
+	return token, nil
+}
+
+// comparison приводит значения сравнения к типу поля. Сравнение с датой без времени
+// заменяется сравнением с границами дня
+func (p *filterParser) comparison(
+	field TaskField,
+	kind filterFieldKind,
+	operator FilterOperator,
+	tokens []filterToken,
+) (TaskSpecification, error) {
+	values := make([]interface{}, 0, len(tokens))
+	for _, token := range tokens {
+		switch kind {
+		case filterKindStatus:
+			status, err := value_objects.NewTaskStatusValueObject(token.text)
+			if err != nil {
+				return nil, filterError(token.pos, "%s", err.Error())
+			}
+			values = append(values, status.Value())
+		case filterKindPriority:
+			priority, err := value_objects.NewTaskPriorityValueObject(token.text)
+			if err != nil {
+				return nil, filterError(token.pos, "%s", err.Error())
+			}
+			values = append(values, priority.Value())
+		case filterKindID:
+			id, err := uuid.Parse(token.text)
+			if err != nil {
+				return nil, filterError(token.pos, "invalid UUID '%s'", token.text)
+			}
+			values = append(values, id)
+		case filterKindTime:
+			day, err := time.ParseInLocation(filterDateLayout, token.text, p.loc)
+			if err == nil {
+				return dayComparison(field, operator, day), nil
+			}
+			moment, err := time.Parse(time.RFC3339, token.text)
+			if err != nil {
+				return nil, filterError(token.pos, "invalid date '%s': expected RFC 3339 or YYYY-MM-DD", token.text)
+			}
+			values = append(values, moment.UTC())
+		default:
+			values = append(values, token.text)
+		}
+	}
+	return ComparisonSpecification{Field: field, Operator: operator, Values: values}, nil
+}
+
+// dayComparison сравнивает дату поля с днем, начинающимся в момент day
+func dayComparison(field TaskField, operator FilterOperator, day time.Time) TaskSpecification {
+	start, end := day.UTC(), day.AddDate(0, 0, 1).UTC()
+	compare := func(operator FilterOperator, moment time.Time) TaskSpecification {
+		return ComparisonSpecification{Field: field, Operator: operator, Values: []interface{}{moment}}
+	}
+
+	switch operator {
+	case FilterEqual:
+		return AllOfSpecification{Operands: []TaskSpecification{compare(FilterGreaterOrEqual, start), compare(FilterLess, end)}}
+	case FilterNotEqual:
+		return AnyOfSpecification{Operands: []TaskSpecification{compare(FilterLess, start), compare(FilterGreaterOrEqual, end)}}
+	case FilterLessOrEqual:
+		return compare(FilterLess, end)
+	case FilterGreater:
+		return compare(FilterGreaterOrEqual, end)
+	default:
+		return compare(operator, start)
+	}
+}
+
+func filterError(pos int, format string, args ...interface{}) error {
+	return &InvalidTaskDataError{Field: "filter", Message: fmt.Sprintf(format, args...) + fmt.Sprintf(" at position %d", pos+1)}
+}
+
+func unexpectedFilterToken(token filterToken, expected string) error {
+	if token.kind == filterTokenEnd {
+		return filterError(token.pos, "%s, got end of filter", expected)
+	}
+	return filterError(token.pos, "%s, got '%s'", expected, token.text)
+}
@@ -0,0 +1,56 @@
+package tasks
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// GuardStartDateReached разрешает переход, только если дата начала не задана или уже наступила
+	GuardStartDateReached = "start_date_reached"
+	// GuardHasDescription разрешает переход, только если у задачи есть описание
+	GuardHasDescription = "has_description"
+	// GuardBlockersClosed разрешает переход, только если все блокирующие задачи завершены
+	GuardBlockersClosed = "blockers_closed"
+)
+
+// NewBuiltinTransitionGuards возвращает встроенные условия переходов
+func NewBuiltinTransitionGuards() TransitionGuards {
+	return TransitionGuards{
+		GuardStartDateReached: TransitionGuardFunc(func(ctx context.Context, task *Task, to string) error {
+			if startDate := task.Schedule.StartDate(); startDate != nil && startDate.After(time.Now()) {
+				return errors.New("start date " + startDate.Format(time.RFC3339) + " has not been reached")
+			}
+			return nil
+		}),
+		GuardHasDescription: TransitionGuardFunc(func(ctx context.Context, task *Task, to string) error {
+			if strings.TrimSpace(task.Description) == "" {
+				return errors.New("task has no description")
+			}
+			return nil
+		}),
+	}
+}
+
+// NewBlockersClosedGuard возвращает условие GuardBlockersClosed, которое читает блокирующие задачи из repo
+func NewBlockersClosedGuard(repo BaseTasksRepository) TransitionGuard {
+	return TransitionGuardFunc(func(ctx context.Context, task *Task, to string) error {
+		blockers, err := repo.ListBlockers(ctx, task.ID)
+		if err != nil {
+			return err
+		}
+
+		open := OpenBlockers(blockers)
+		if len(open) == 0 {
+			return nil
+		}
+
+		ids := make([]string, len(open))
+		for i, blocker := range open {
+			ids[i] = blocker.ID.String()
+		}
+		return errors.New("task is blocked by open tasks: " + strings.Join(ids, ", "))
+	})
+}
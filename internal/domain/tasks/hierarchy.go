@@ -0,0 +1,104 @@
+package tasks
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Hierarchy правила дерева задач: подзадача принадлежит владельцу родителя,
+// не может стать собственным предком, а глубина дерева не превышает maxDepth уровней
+type Hierarchy struct {
+	maxDepth int
+}
+
+// NewHierarchy создает правила иерархии; maxDepth - число уровней, включая корневую задачу
+func NewHierarchy(maxDepth int) (*Hierarchy, error) {
+	if maxDepth < 1 {
+		return nil, &InvalidTaskDataError{Field: "max_depth", Message: fmt.Sprintf("must be at least 1, got %d", maxDepth)}
+	}
+	return &Hierarchy{maxDepth: maxDepth}, nil
+}
+
+// MaxDepth возвращает максимальное число уровней дерева задач
+func (h *Hierarchy) MaxDepth() int {
+	return h.maxDepth
+}
+
+// ValidateParent проверяет, что task можно сделать подзадачей parent.
+// parentAncestors - предки parent от ближайшего к корню, descendants - все потомки task
+func (h *Hierarchy) ValidateParent(task, parent *Task, parentAncestors, descendants []*Task) error {
+	if parent.ID == task.ID {
+		return &TaskHierarchyCycleError{TaskID: task.ID, ParentID: parent.ID}
+	}
+	for _, ancestor := range parentAncestors {
+		if ancestor.ID == task.ID {
+			return &TaskHierarchyCycleError{TaskID: task.ID, ParentID: parent.ID}
+		}
+	}
+
+	if parent.UserID != task.UserID {
+		return &InvalidTaskDataError{Field: "parent_id", Message: "parent task must belong to the same user"}
+	}
+
+	// Уровень родителя плюс высота поддерева, которое переносится вместе с задачей
+	depth := len(parentAncestors) + 1 + SubtreeHeight(task.ID, descendants)
+	if depth > h.maxDepth {
+		return &TaskDepthExceededError{TaskID: task.ID, Depth: depth, MaxDepth: h.maxDepth}
+	}
+
+	return nil
+}
+
+// SubtreeHeight возвращает число уровней поддерева с корнем rootID, включая сам корень
+func SubtreeHeight(rootID uuid.UUID, descendants []*Task) int {
+	children := make(map[uuid.UUID][]uuid.UUID, len(descendants))
+	for _, task := range descendants {
+		if task.ParentID != nil {
+			children[*task.ParentID] = append(children[*task.ParentID], task.ID)
+		}
+	}
+
+	height := 0
+	visited := map[uuid.UUID]bool{rootID: true}
+	level := []uuid.UUID{rootID}
+	for len(level) > 0 {
+		height++
+		var next []uuid.UUID
+		for _, id := range level {
+			for _, childID := range children[id] {
+				if !visited[childID] {
+					visited[childID] = true
+					next = append(next, childID)
+				}
+			}
+		}
+		level = next
+	}
+	return height
+}
+
+// TaskProgress прогресс задачи по ее подзадачам всех уровней
+type TaskProgress struct {
+	Total int // количество потомков
+	Done  int // количество потомков в завершенных статусах
+}
+
+// NewTaskProgress подсчитывает прогресс по списку потомков задачи
+func NewTaskProgress(descendants []*Task) TaskProgress {
+	progress := TaskProgress{Total: len(descendants)}
+	for _, task := range descendants {
+		if !task.Status.IsOpen() {
+			progress.Done++
+		}
+	}
+	return progress
+}
+
+// Percent возвращает долю завершенных потомков в процентах; без потомков прогресс равен 0
+func (p TaskProgress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
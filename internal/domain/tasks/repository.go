@@ -2,10 +2,132 @@ package tasks
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// TaskSortOrder порядок сортировки списка задач
+type TaskSortOrder string
+
+const (
+	// TaskSortDefault сортирует задачи по времени создания
+	TaskSortDefault TaskSortOrder = ""
+	// TaskSortPriority сортирует задачи от самого важного приоритета к наименее важному,
+	// внутри приоритета - по ближайшему сроку выполнения; задачи без срока идут последними
+	TaskSortPriority TaskSortOrder = "priority"
+)
+
+// ParseTaskSortOrder возвращает порядок сортировки по его строковому значению
+func ParseTaskSortOrder(value string) (TaskSortOrder, error) {
+	switch order := TaskSortOrder(value); order {
+	case TaskSortDefault, TaskSortPriority:
+		return order, nil
+	default:
+		return "", &InvalidTaskDataError{Field: "sort", Message: "unknown sort order '" + value + "', expected priority"}
+	}
+}
+
+// LabelMatch способ сопоставления задачи со списком меток фильтра
+type LabelMatch string
+
+const (
+	// LabelMatchAny выбирает задачи, у которых есть хотя бы одна из меток
+	LabelMatchAny LabelMatch = "any"
+	// LabelMatchAll выбирает задачи, у которых есть все метки
+	LabelMatchAll LabelMatch = "all"
+)
+
+// ParseLabelMatch возвращает способ сопоставления меток по его строковому значению;
+// пустое значение означает LabelMatchAny
+func ParseLabelMatch(value string) (LabelMatch, error) {
+	switch match := LabelMatch(value); match {
+	case "":
+		return LabelMatchAny, nil
+	case LabelMatchAny, LabelMatchAll:
+		return match, nil
+	default:
+		return "", &InvalidTaskDataError{Field: "labels_match", Message: "unknown label match '" + value + "', expected any or all"}
+	}
+}
+
+// ListTasksFilter параметры фильтрации и сортировки списка задач; nil поля не ограничивают выборку
+type ListTasksFilter struct {
+	UserID    *uuid.UUID
+	ProjectID *uuid.UUID
+	Status    *string
+
+	// ParentID выбирает непосредственные подзадачи задачи
+	ParentID *uuid.UUID
+
+	// DueBefore и DueAfter ограничивают срок выполнения включительно;
+	// задачи без срока выполнения в такую выборку не попадают
+	DueBefore *time.Time
+	DueAfter  *time.Time
+
+	// Overdue выбирает просроченные (true) или непросроченные (false) на момент Now задачи
+	Overdue *bool
+	Now     time.Time
+
+	// Labels имена меток в нижнем регистре; LabelMatch определяет, нужна ли задаче
+	// хотя бы одна из них или все сразу
+	Labels     []string
+	LabelMatch LabelMatch
+
+	// Blocked выбирает задачи, у которых есть незавершенные блокирующие задачи (true),
+	// или задачи без них (false)
+	Blocked *bool
+
+	// Specification условие из языка фильтров (см. ParseTaskFilter); сужает выборку вместе с остальными полями
+	Specification TaskSpecification
+
+	// SortKeys, если задан, заменяет Sort списком полей; равные по всем полям задачи
+	// упорядочены по времени создания. Вывод по курсору поддерживает только Sort
+	Sort     TaskSortOrder
+	SortKeys []TaskSortKey
+}
+
+// TaskCursor позиция в списке задач для постраничного вывода по ключам сортировки: значения ключей
+// задачи, рядом с которой начинается страница. В отличие от номера страницы позиция не сдвигается,
+// когда перед ней добавляются или удаляются задачи
+type TaskCursor struct {
+	// Sort порядок списка, для которого выдана позиция
+	Sort TaskSortOrder
+
+	// PriorityRank и DueDate - ключи порядка TaskSortPriority
+	PriorityRank int
+	DueDate      *time.Time
+
+	CreatedAt time.Time
+	ID        uuid.UUID
+
+	// Backward выбирает задачи перед позицией, а не после нее: так клиент возвращается на предыдущую страницу
+	Backward bool
+}
+
+// NewTaskCursor возвращает позицию задачи в списке с порядком order
+func NewTaskCursor(task *Task, order TaskSortOrder, backward bool) *TaskCursor {
+	cursor := &TaskCursor{
+		Sort:      order,
+		CreatedAt: task.CreatedAt,
+		ID:        task.ID,
+		Backward:  backward,
+	}
+	if order == TaskSortPriority {
+		cursor.PriorityRank = task.Priority.Rank()
+		cursor.DueDate = task.Schedule.DueDate()
+	}
+	return cursor
+}
+
+// ListDeletedTasksFilter параметры фильтрации задач в корзине; nil поля не ограничивают выборку
+type ListDeletedTasksFilter struct {
+	UserID *uuid.UUID
+
+	// DeletedBefore выбирает задачи, перемещенные в корзину раньше указанного момента
+	DeletedBefore *time.Time
+}
+
 // BaseTasksRepository определяет интерфейс для работы с задачами
 type BaseTasksRepository interface {
 	// Create создает новую задачу
@@ -14,12 +136,66 @@ type BaseTasksRepository interface {
 	// GetByID возвращает задачу по ID
 	GetByID(ctx context.Context, id uuid.UUID) (*Task, error)
 
-	// List возвращает список задач с фильтрацией и пагинацией
-	List(ctx context.Context, userID *uuid.UUID, status *string, page, pageSize int) ([]*Task, int64, error)
+	// List возвращает список задач с фильтрацией, сортировкой и пагинацией
+	List(ctx context.Context, filter ListTasksFilter, page, pageSize int) ([]*Task, int64, error)
 
-	// Update обновляет данные задачи
+	// ListByCursor возвращает не больше limit задач, следующих в порядке filter.Sort сразу за позицией cursor,
+	// а с cursor.Backward - непосредственно перед ней; без cursor список начинается с первой задачи.
+	// Задачи упорядочены так же, как в List, в любом направлении
+	ListByCursor(ctx context.Context, filter ListTasksFilter, cursor *TaskCursor, limit int) ([]*Task, error)
+
+	// Update сохраняет задачу, только если ее версия в хранилище совпадает с task.Version, и увеличивает версию;
+	// иначе возвращает ConcurrentModificationError
 	Update(ctx context.Context, task *Task) (*Task, error)
 
-	// Delete удаляет задачу по ID
+	// Delete перемещает задачу в корзину; остальные методы, кроме методов корзины, ее больше не видят
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDeleted возвращает задачи из корзины, начиная с удаленных последними
+	ListDeleted(ctx context.Context, filter ListDeletedTasksFilter, page, pageSize int) ([]*Task, int64, error)
+
+	// GetDeletedByID возвращает задачу из корзины по ID
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (*Task, error)
+
+	// ListDeletedSubtree возвращает потомков задачи из корзины, связанных с ней только через задачи
+	// из корзины, упорядоченных по времени создания
+	ListDeletedSubtree(ctx context.Context, id uuid.UUID) ([]*Task, error)
+
+	// Restore возвращает задачу из корзины
+	Restore(ctx context.Context, id uuid.UUID) error
+
+	// Purge окончательно удаляет задачу из корзины вместе с ее комментариями, метками и зависимостями
+	Purge(ctx context.Context, id uuid.UUID) error
+
+	// ListAncestors возвращает предков задачи от родителя до корневой задачи
+	ListAncestors(ctx context.Context, id uuid.UUID) ([]*Task, error)
+
+	// ListSubtree возвращает всех потомков задачи, упорядоченных по времени создания
+	ListSubtree(ctx context.Context, id uuid.UUID) ([]*Task, error)
+
+	// AddDependency делает blockerID блокирующей задачей для taskID; повторное добавление ничего не меняет
+	AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+
+	// RemoveDependency удаляет зависимость taskID от blockerID; удаление отсутствующей зависимости ничего не меняет
+	RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+
+	// ListBlockers возвращает задачи, которые блокируют задачу, упорядоченные по времени создания
+	ListBlockers(ctx context.Context, taskID uuid.UUID) ([]*Task, error)
+
+	// ListDependents возвращает задачи, которые блокирует задача, упорядоченные по времени создания
+	ListDependents(ctx context.Context, taskID uuid.UUID) ([]*Task, error)
+
+	// ListBlockerChain возвращает ID всех задач, от которых задача зависит напрямую или через другие задачи
+	ListBlockerChain(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error)
+
+	// CountOpenByUser возвращает количество незавершенных задач пользователя
+	CountOpenByUser(ctx context.Context, userID uuid.UUID) (int64, error)
+
+	// DeleteByUser перемещает в корзину все задачи пользователя и возвращает их в состоянии до удаления,
+	// упорядоченными по времени создания
+	DeleteByUser(ctx context.Context, userID uuid.UUID) ([]*Task, error)
+
+	// ReassignOwner передает все задачи пользователя fromUserID пользователю toUserID и возвращает
+	// переданные задачи в состоянии до передачи, упорядоченными по времени создания
+	ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) ([]*Task, error)
 }
@@ -0,0 +1,71 @@
+package tasks
+
+// TaskField поле задачи, по которому можно фильтровать и сортировать список задач
+type TaskField string
+
+const (
+	TaskFieldTitle       TaskField = "title"
+	TaskFieldDescription TaskField = "description"
+	TaskFieldStatus      TaskField = "status"
+	TaskFieldPriority    TaskField = "priority"
+	TaskFieldUserID      TaskField = "user_id"
+	TaskFieldProjectID   TaskField = "project_id"
+	TaskFieldStartDate   TaskField = "start_date"
+	TaskFieldDueDate     TaskField = "due_date"
+	TaskFieldCreatedAt   TaskField = "created_at"
+	TaskFieldUpdatedAt   TaskField = "updated_at"
+)
+
+// FilterOperator оператор сравнения поля задачи со значениями
+type FilterOperator string
+
+const (
+	FilterEqual          FilterOperator = "="
+	FilterNotEqual       FilterOperator = "!="
+	FilterLess           FilterOperator = "<"
+	FilterLessOrEqual    FilterOperator = "<="
+	FilterGreater        FilterOperator = ">"
+	FilterGreaterOrEqual FilterOperator = ">="
+	// FilterIn выполняется, когда поле равно одному из значений
+	FilterIn FilterOperator = "in"
+	// FilterContains выполняется, когда текст поля содержит значение без учета регистра
+	FilterContains FilterOperator = "contains"
+)
+
+// TaskSpecification условие на задачу, собранное из языка фильтров. Репозитории разбирают условие
+// по его типу: GORM репозиторий переводит его в SQL с параметрами, in-memory репозиторий проверяет
+// по нему задачи. Условия создает ParseTaskFilter, поэтому поля, операторы и типы значений в них
+// уже согласованы друг с другом
+type TaskSpecification interface {
+	taskSpecification()
+}
+
+// AllOfSpecification выполняется, когда выполнены все вложенные условия
+type AllOfSpecification struct {
+	Operands []TaskSpecification
+}
+
+// AnyOfSpecification выполняется, когда выполнено хотя бы одно из вложенных условий
+type AnyOfSpecification struct {
+	Operands []TaskSpecification
+}
+
+// ComparisonSpecification сравнивает поле задачи со значениями. Тип значений зависит от поля:
+// uuid.UUID для user_id и project_id, time.Time в UTC для дат, string для остальных полей.
+// У priority операторы порядка сравнивают важность приоритетов. Задача без значения поля
+// (без проекта или срока) не удовлетворяет ни одному сравнению, в том числе !=
+type ComparisonSpecification struct {
+	Field    TaskField
+	Operator FilterOperator
+	Values   []interface{}
+}
+
+func (AllOfSpecification) taskSpecification()      {}
+func (AnyOfSpecification) taskSpecification()      {}
+func (ComparisonSpecification) taskSpecification() {}
+
+// TaskSortKey поле сортировки списка задач и его направление
+type TaskSortKey struct {
+	Field      TaskField
+	Descending bool
+}
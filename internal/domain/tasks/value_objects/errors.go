@@ -35,6 +35,36 @@ func (e *InvalidStatusError) Error() string {
 	return fmt.Sprintf("invalid task status: %s", e.Value)
 }
 
+// InvalidPriorityError представляет ошибку валидации приоритета задачи
+type InvalidPriorityError struct {
+	Value       string
+	ValidValues []string
+	Message     string
+}
+
+func (e *InvalidPriorityError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if len(e.ValidValues) > 0 {
+		return fmt.Sprintf("invalid task priority '%s'. Valid priorities: %v", e.Value, e.ValidValues)
+	}
+	return fmt.Sprintf("invalid task priority: %s", e.Value)
+}
+
+// InvalidScheduleError представляет ошибку валидации дат задачи
+type InvalidScheduleError struct {
+	Field   string
+	Message string
+}
+
+func (e *InvalidScheduleError) Error() string {
+	if e.Field != "" {
+		return fmt.Sprintf("invalid task schedule: field '%s' - %s", e.Field, e.Message)
+	}
+	return fmt.Sprintf("invalid task schedule: %s", e.Message)
+}
+
 // IsInvalidTitle проверяет, является ли ошибка ошибкой валидации заголовка
 func IsInvalidTitle(err error) bool {
 	var invalidTitleErr *InvalidTitleError
@@ -46,3 +76,15 @@ func IsInvalidStatus(err error) bool {
 	var invalidStatusErr *InvalidStatusError
 	return errors.As(err, &invalidStatusErr)
 }
+
+// IsInvalidPriority проверяет, является ли ошибка ошибкой валидации приоритета
+func IsInvalidPriority(err error) bool {
+	var invalidPriorityErr *InvalidPriorityError
+	return errors.As(err, &invalidPriorityErr)
+}
+
+// IsInvalidSchedule проверяет, является ли ошибка ошибкой валидации дат задачи
+func IsInvalidSchedule(err error) bool {
+	var invalidScheduleErr *InvalidScheduleError
+	return errors.As(err, &invalidScheduleErr)
+}
@@ -0,0 +1,72 @@
+package value_objects
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+	PriorityUrgent = "urgent"
+)
+
+// validPriorities перечислены по возрастанию важности
+var validPriorities = []string{
+	PriorityLow,
+	PriorityMedium,
+	PriorityHigh,
+	PriorityUrgent,
+}
+
+// TaskPriorityValueObject представляет приоритет задачи с валидацией
+type TaskPriorityValueObject struct {
+	value string
+}
+
+// NewTaskPriorityValueObject создает новый TaskPriorityValueObject с валидацией
+func NewTaskPriorityValueObject(priority string) (TaskPriorityValueObject, error) {
+	priority = strings.TrimSpace(priority)
+	if priority == "" {
+		return TaskPriorityValueObject{}, &InvalidPriorityError{Message: "priority cannot be empty"}
+	}
+	if !slices.Contains(validPriorities, priority) {
+		return TaskPriorityValueObject{}, &InvalidPriorityError{Value: priority, ValidValues: validPriorities}
+	}
+	return TaskPriorityValueObject{value: priority}, nil
+}
+
+// NewDefaultTaskPriorityValueObject возвращает приоритет, назначаемый новым задачам
+func NewDefaultTaskPriorityValueObject() TaskPriorityValueObject {
+	return TaskPriorityValueObject{value: PriorityMedium}
+}
+
+// Value возвращает строковое значение приоритета
+func (p TaskPriorityValueObject) Value() string {
+	return p.value
+}
+
+// Rank возвращает вес приоритета: чем важнее задача, тем больше значение
+func (p TaskPriorityValueObject) Rank() int {
+	return slices.Index(validPriorities, p.value) + 1
+}
+
+// Equals проверяет равенство двух приоритетов
+func (p TaskPriorityValueObject) Equals(other TaskPriorityValueObject) bool {
+	return p.value == other.value
+}
+
+// IsValid проверяет валидность приоритета
+func (p TaskPriorityValueObject) IsValid() bool {
+	return slices.Contains(validPriorities, p.value)
+}
+
+// PriorityRanks возвращает веса всех приоритетов для сортировки на стороне хранилища
+func PriorityRanks() map[string]int {
+	ranks := make(map[string]int, len(validPriorities))
+	for i, priority := range validPriorities {
+		ranks[priority] = i + 1
+	}
+	return ranks
+}
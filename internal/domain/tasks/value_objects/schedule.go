@@ -0,0 +1,79 @@
+package value_objects
+
+import "time"
+
+// TaskScheduleValueObject представляет необязательные даты начала и завершения задачи.
+// Даты хранятся в UTC, поэтому сравнение не зависит от часового пояса клиента
+type TaskScheduleValueObject struct {
+	startDate *time.Time
+	dueDate   *time.Time
+}
+
+// NewTaskScheduleValueObject создает новый TaskScheduleValueObject с валидацией
+func NewTaskScheduleValueObject(startDate, dueDate *time.Time) (TaskScheduleValueObject, error) {
+	start, err := normalizeDate("start_date", startDate)
+	if err != nil {
+		return TaskScheduleValueObject{}, err
+	}
+
+	due, err := normalizeDate("due_date", dueDate)
+	if err != nil {
+		return TaskScheduleValueObject{}, err
+	}
+
+	if start != nil && due != nil && due.Before(*start) {
+		return TaskScheduleValueObject{}, &InvalidScheduleError{
+			Field:   "due_date",
+			Message: "due date cannot be earlier than start date",
+		}
+	}
+
+	return TaskScheduleValueObject{startDate: start, dueDate: due}, nil
+}
+
+// StartDate возвращает дату начала или nil, если она не задана
+func (s TaskScheduleValueObject) StartDate() *time.Time {
+	return copyDate(s.startDate)
+}
+
+// DueDate возвращает срок выполнения или nil, если он не задан
+func (s TaskScheduleValueObject) DueDate() *time.Time {
+	return copyDate(s.dueDate)
+}
+
+// IsPastDue проверяет, что срок выполнения задан и наступил раньше момента now
+func (s TaskScheduleValueObject) IsPastDue(now time.Time) bool {
+	return s.dueDate != nil && s.dueDate.Before(now)
+}
+
+// Equals проверяет равенство двух расписаний
+func (s TaskScheduleValueObject) Equals(other TaskScheduleValueObject) bool {
+	return datesEqual(s.startDate, other.startDate) && datesEqual(s.dueDate, other.dueDate)
+}
+
+// normalizeDate приводит дату к UTC и отклоняет нулевое значение
+func normalizeDate(field string, date *time.Time) (*time.Time, error) {
+	if date == nil {
+		return nil, nil
+	}
+	if date.IsZero() {
+		return nil, &InvalidScheduleError{Field: field, Message: "date cannot be zero"}
+	}
+	utc := date.UTC()
+	return &utc, nil
+}
+
+func copyDate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+	value := *date
+	return &value
+}
+
+func datesEqual(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -1,14 +1,64 @@
 package value_objects
 
 import (
+	"fmt"
 	"slices"
 	"strings"
+	"sync"
+	"unicode/utf8"
 )
 
-var validStatuses = []string{
-	"todo",
-	"in_progress",
-	"done",
+const (
+	StatusTodo       = "todo"
+	StatusInProgress = "in_progress"
+	StatusDone       = "done"
+)
+
+// MaxStatusLength наибольшая длина статуса; совпадает с размером колонки tasks.status
+const MaxStatusLength = 20
+
+// statusRegistry допустимые и завершающие статусы; задаются рабочим процессом задач
+var statusRegistry = struct {
+	mu     sync.RWMutex
+	valid  []string
+	closed []string
+}{
+	valid:  []string{StatusTodo, StatusInProgress, StatusDone},
+	closed: []string{StatusDone},
+}
+
+// SetAllowedStatuses заменяет набор допустимых статусов и статусов, в которых задача считается завершенной.
+// Статус длиннее MaxStatusLength не поместится в БД, поэтому такой набор отклоняется
+func SetAllowedStatuses(valid, closed []string) error {
+	for _, status := range valid {
+		if utf8.RuneCountInString(status) > MaxStatusLength {
+			return &InvalidStatusError{
+				Value:   status,
+				Message: fmt.Sprintf("status '%s' is longer than %d characters", status, MaxStatusLength),
+			}
+		}
+	}
+
+	statusRegistry.mu.Lock()
+	defer statusRegistry.mu.Unlock()
+
+	statusRegistry.valid = slices.Clone(valid)
+	statusRegistry.closed = slices.Clone(closed)
+	return nil
+}
+
+// AllowedStatuses возвращает допустимые статусы
+func AllowedStatuses() []string {
+	statusRegistry.mu.RLock()
+	defer statusRegistry.mu.RUnlock()
+	return slices.Clone(statusRegistry.valid)
+}
+
+// ClosedStatuses возвращает статусы, в которых задача считается завершенной
+func ClosedStatuses() []string {
+	statusRegistry.mu.RLock()
+	defer statusRegistry.mu.RUnlock()
+	return slices.Clone(statusRegistry.closed)
 }
 
 // TaskStatusValueObject представляет статус задачи с валидацией
@@ -22,6 +72,7 @@ func NewTaskStatusValueObject(status string) (TaskStatusValueObject, error) {
 	if status == "" {
 		return TaskStatusValueObject{}, &InvalidStatusError{Message: "status cannot be empty"}
 	}
+	validStatuses := AllowedStatuses()
 	if !slices.Contains(validStatuses, status) {
 		return TaskStatusValueObject{}, &InvalidStatusError{Value: status, ValidValues: validStatuses}
 	}
@@ -38,7 +89,12 @@ func (s TaskStatusValueObject) Equals(other TaskStatusValueObject) bool {
 	return s.value == other.value
 }
 
+// IsOpen проверяет, что задача в этом статусе еще не завершена
+func (s TaskStatusValueObject) IsOpen() bool {
+	return !slices.Contains(ClosedStatuses(), s.value)
+}
+
 // IsValid проверяет валидность статуса
 func (s TaskStatusValueObject) IsValid() bool {
-	return slices.Contains(validStatuses, s.value)
+	return slices.Contains(AllowedStatuses(), s.value)
 }
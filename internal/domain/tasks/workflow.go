@@ -0,0 +1,187 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"crud/internal/domain/tasks/value_objects"
+)
+
+// TransitionGuard проверяет дополнительное условие перехода задачи в статус to;
+// ошибка содержит причину, по которой переход невозможен
+type TransitionGuard interface {
+	Check(ctx context.Context, task *Task, to string) error
+}
+
+// TransitionGuardFunc позволяет использовать функцию как TransitionGuard
+type TransitionGuardFunc func(ctx context.Context, task *Task, to string) error
+
+// Check вызывает f
+func (f TransitionGuardFunc) Check(ctx context.Context, task *Task, to string) error {
+	return f(ctx, task, to)
+}
+
+// TransitionGuards условия переходов по именам, на которые ссылается описание рабочего процесса
+type TransitionGuards map[string]TransitionGuard
+
+// Transition разрешенный переход между статусами
+type Transition struct {
+	From   string
+	To     string
+	Guards []string
+}
+
+// Workflow рабочий процесс задач: допустимые статусы и разрешенные переходы между ними
+type Workflow struct {
+	statuses    []string
+	initial     []string
+	closed      []string
+	transitions []Transition
+	guards      TransitionGuards
+}
+
+// NewWorkflow создает рабочий процесс и проверяет его согласованность: статусы уникальны,
+// начальные и завершающие статусы и переходы ссылаются на известные статусы, условия переходов
+// есть в guards. Без начальных статусов задача создается только в первом статусе процесса
+func NewWorkflow(statuses, initial, closed []string, transitions []Transition, guards TransitionGuards) (*Workflow, error) {
+	if len(statuses) == 0 {
+		return nil, &InvalidWorkflowError{Message: "at least one status is required"}
+	}
+	for i, status := range statuses {
+		if status == "" {
+			return nil, &InvalidWorkflowError{Message: "status cannot be empty"}
+		}
+		if slices.Contains(statuses[:i], status) {
+			return nil, &InvalidWorkflowError{Message: fmt.Sprintf("duplicate status '%s'", status)}
+		}
+	}
+
+	if len(initial) == 0 {
+		initial = statuses[:1]
+	}
+	for _, status := range initial {
+		if !slices.Contains(statuses, status) {
+			return nil, &InvalidWorkflowError{Message: fmt.Sprintf("initial status '%s' is not a workflow status", status)}
+		}
+	}
+
+	if len(closed) == 0 {
+		return nil, &InvalidWorkflowError{Message: "at least one closed status is required"}
+	}
+	for _, status := range closed {
+		if !slices.Contains(statuses, status) {
+			return nil, &InvalidWorkflowError{Message: fmt.Sprintf("closed status '%s' is not a workflow status", status)}
+		}
+	}
+
+	for i, transition := range transitions {
+		if !slices.Contains(statuses, transition.From) || !slices.Contains(statuses, transition.To) {
+			return nil, &InvalidWorkflowError{
+				Message: fmt.Sprintf("transition %s -> %s refers to an unknown status", transition.From, transition.To),
+			}
+		}
+		if transition.From == transition.To {
+			return nil, &InvalidWorkflowError{Message: fmt.Sprintf("transition %s -> %s is a no-op", transition.From, transition.To)}
+		}
+		for _, previous := range transitions[:i] {
+			if previous.From == transition.From && previous.To == transition.To {
+				return nil, &InvalidWorkflowError{
+					Message: fmt.Sprintf("duplicate transition %s -> %s", transition.From, transition.To),
+				}
+			}
+		}
+		for _, guard := range transition.Guards {
+			if _, ok := guards[guard]; !ok {
+				return nil, &InvalidWorkflowError{
+					Message: fmt.Sprintf("transition %s -> %s uses unknown guard '%s'", transition.From, transition.To, guard),
+				}
+			}
+		}
+	}
+
+	workflow := &Workflow{
+		statuses:    slices.Clone(statuses),
+		initial:     slices.Clone(initial),
+		closed:      slices.Clone(closed),
+		transitions: make([]Transition, len(transitions)),
+		guards:      guards,
+	}
+	for i, transition := range transitions {
+		transition.Guards = slices.Clone(transition.Guards)
+		workflow.transitions[i] = transition
+	}
+
+	return workflow, nil
+}
+
+// Statuses возвращает допустимые статусы
+func (w *Workflow) Statuses() []string {
+	return slices.Clone(w.statuses)
+}
+
+// InitialStatuses возвращает статусы, в которых можно создать задачу
+func (w *Workflow) InitialStatuses() []string {
+	return slices.Clone(w.initial)
+}
+
+// CheckInitialStatus проверяет, что задачу можно создать в статусе status
+func (w *Workflow) CheckInitialStatus(status value_objects.TaskStatusValueObject) error {
+	if !slices.Contains(w.initial, status.Value()) {
+		return &value_objects.InvalidStatusError{
+			Value:   status.Value(),
+			Message: fmt.Sprintf("task cannot be created in status '%s'. Initial statuses: %v", status.Value(), w.initial),
+		}
+	}
+	return nil
+}
+
+// ClosedStatuses возвращает статусы, в которых задача считается завершенной
+func (w *Workflow) ClosedStatuses() []string {
+	return slices.Clone(w.closed)
+}
+
+// Transitions возвращает разрешенные переходы
+func (w *Workflow) Transitions() []Transition {
+	transitions := make([]Transition, len(w.transitions))
+	for i, transition := range w.transitions {
+		transition.Guards = slices.Clone(transition.Guards)
+		transitions[i] = transition
+	}
+	return transitions
+}
+
+// AllowedTransitions возвращает статусы, в которые можно перейти из статуса from
+func (w *Workflow) AllowedTransitions(from string) []string {
+	allowed := make([]string, 0)
+	for _, transition := range w.transitions {
+		if transition.From == from {
+			allowed = append(allowed, transition.To)
+		}
+	}
+	return allowed
+}
+
+// CheckTransition проверяет, что задачу можно перевести в статус to: переход разрешен
+// и все его условия выполнены. Переход в текущий статус всегда допустим
+func (w *Workflow) CheckTransition(ctx context.Context, task *Task, to value_objects.TaskStatusValueObject) error {
+	from := task.Status.Value()
+	if from == to.Value() {
+		return nil
+	}
+
+	index := slices.IndexFunc(w.transitions, func(transition Transition) bool {
+		return transition.From == from && transition.To == to.Value()
+	})
+	if index < 0 {
+		return &IllegalTransitionError{From: from, To: to.Value(), Allowed: w.AllowedTransitions(from)}
+	}
+
+	for _, name := range w.transitions[index].Guards {
+		if err := w.guards[name].Check(ctx, task, to.Value()); err != nil {
+			return &TransitionGuardError{From: from, To: to.Value(), Guard: name, Reason: err.Error()}
+		}
+	}
+
+	return nil
+}
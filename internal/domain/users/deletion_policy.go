@@ -0,0 +1,28 @@
+package users
+
+import "fmt"
+
+// DeletionPolicy определяет, что происходит с задачами и проектами пользователя при его удалении
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyCascade удаляет задачи и проекты вместе с пользователем
+	DeletionPolicyCascade DeletionPolicy = "cascade"
+	// DeletionPolicyReassign передает задачи и проекты другому пользователю
+	DeletionPolicyReassign DeletionPolicy = "reassign"
+	// DeletionPolicyRestrict запрещает удаление, пока у пользователя есть незавершенные задачи
+	DeletionPolicyRestrict DeletionPolicy = "restrict"
+)
+
+// ParseDeletionPolicy возвращает политику удаления по ее строковому значению
+func ParseDeletionPolicy(value string) (DeletionPolicy, error) {
+	switch policy := DeletionPolicy(value); policy {
+	case DeletionPolicyCascade, DeletionPolicyReassign, DeletionPolicyRestrict:
+		return policy, nil
+	default:
+		return "", &InvalidUserDataError{
+			Field:   "deletion_policy",
+			Message: fmt.Sprintf("unknown deletion policy '%s', expected cascade, reassign or restrict", value),
+		}
+	}
+}
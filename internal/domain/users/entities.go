@@ -10,25 +10,37 @@ import (
 
 // User представляет сущность пользователя
 type User struct {
-	ID        uuid.UUID // Object ID для сравнения
-	Email     value_objects.EmailValueObject
-	Name      value_objects.UserNameValueObject
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             uuid.UUID // Object ID для сравнения
+	OrganizationID uuid.UUID // организация; репозиторий заполняет ее из контекста запроса
+	Email          value_objects.EmailValueObject
+	Name           value_objects.UserNameValueObject
+	Role           value_objects.UserRoleValueObject
+	PasswordHash   string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time // время перемещения в корзину; nil у действующих пользователей
+	Version        int64      // версия для оптимистичной блокировки; растет при каждом сохранении
 }
 
-// NewUser создает нового пользователя
+// NewUser создает нового пользователя с ролью по умолчанию
 func NewUser(email value_objects.EmailValueObject, name value_objects.UserNameValueObject) *User {
 	now := time.Now()
 	return &User{
 		ID:        uuid.New(),
 		Email:     email,
 		Name:      name,
+		Role:      value_objects.NewDefaultUserRoleValueObject(),
 		CreatedAt: now,
 		UpdatedAt: now,
+		Version:   1,
 	}
 }
 
+// HasPassword проверяет, задан ли пользователю пароль
+func (u *User) HasPassword() bool {
+	return u.PasswordHash != ""
+}
+
 // Equals проверяет равенство двух пользователей по ID
 func (u *User) Equals(other *User) bool {
 	if u == nil || other == nil {
@@ -29,6 +29,37 @@ func (e *UserAlreadyExistsError) Error() string {
 	return fmt.Sprintf("user with email %s already exists", e.Email)
 }
 
+// UserHasOpenTasksError представляет ошибку, когда пользователя нельзя удалить из-за незавершенных задач
+type UserHasOpenTasksError struct {
+	UserID    uuid.UUID
+	OpenTasks int64
+}
+
+func (e *UserHasOpenTasksError) Error() string {
+	return fmt.Sprintf("user with ID %s has %d open tasks", e.UserID, e.OpenTasks)
+}
+
+// UserInUseError представляет ошибку, когда пользователя нельзя окончательно удалить,
+// потому что на него ссылаются задачи, проекты, комментарии или вложения
+type UserInUseError struct {
+	UserID uuid.UUID
+}
+
+func (e *UserInUseError) Error() string {
+	return fmt.Sprintf("user with ID %s is still referenced by other data", e.UserID)
+}
+
+// ConcurrentModificationError представляет ошибку, когда пользователя изменили после того,
+// как была прочитана его версия Version
+type ConcurrentModificationError struct {
+	UserID  uuid.UUID
+	Version int64
+}
+
+func (e *ConcurrentModificationError) Error() string {
+	return fmt.Sprintf("user with ID %s was modified concurrently: version %d is outdated", e.UserID, e.Version)
+}
+
 // InvalidUserDataError представляет ошибку валидации данных пользователя
 type InvalidUserDataError struct {
 	Field   string
@@ -67,6 +98,24 @@ func IsUserAlreadyExists(err error) bool {
 	return errors.As(err, &userExistsErr)
 }
 
+// IsUserHasOpenTasks проверяет, является ли ошибка ошибкой "у пользователя есть незавершенные задачи"
+func IsUserHasOpenTasks(err error) bool {
+	var openTasksErr *UserHasOpenTasksError
+	return errors.As(err, &openTasksErr)
+}
+
+// IsUserInUse проверяет, является ли ошибка ошибкой "на пользователя ссылаются другие данные"
+func IsUserInUse(err error) bool {
+	var inUseErr *UserInUseError
+	return errors.As(err, &inUseErr)
+}
+
+// IsConcurrentModification проверяет, является ли ошибка ошибкой одновременного изменения пользователя
+func IsConcurrentModification(err error) bool {
+	var concurrentErr *ConcurrentModificationError
+	return errors.As(err, &concurrentErr)
+}
+
 // IsInvalidUserData проверяет, является ли ошибка ошибкой валидации данных пользователя
 func IsInvalidUserData(err error) bool {
 	var invalidDataErr *InvalidUserDataError
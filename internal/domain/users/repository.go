@@ -2,10 +2,32 @@ package users
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// ListDeletedUsersFilter параметры фильтрации пользователей в корзине; nil поля не ограничивают выборку
+type ListDeletedUsersFilter struct {
+	// DeletedBefore выбирает пользователей, перемещенных в корзину раньше указанного момента
+	DeletedBefore *time.Time
+}
+
+// UserCursor позиция в списке пользователей для постраничного вывода по ключам сортировки:
+// время создания и ID пользователя, рядом с которым начинается страница
+type UserCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+
+	// Backward выбирает пользователей перед позицией, а не после нее
+	Backward bool
+}
+
+// NewUserCursor возвращает позицию пользователя в списке
+func NewUserCursor(user *User, backward bool) *UserCursor {
+	return &UserCursor{CreatedAt: user.CreatedAt, ID: user.ID, Backward: backward}
+}
+
 // BaseUsersRepository определяет интерфейс для работы с пользователями
 type BaseUsersRepository interface {
 	// Create создает нового пользователя
@@ -17,12 +39,32 @@ type BaseUsersRepository interface {
 	// GetByEmail возвращает пользователя по email
 	GetByEmail(ctx context.Context, email string) (*User, error)
 
-	// List возвращает список пользователей с пагинацией
+	// List возвращает список пользователей с пагинацией, упорядоченный по времени создания
 	List(ctx context.Context, page, pageSize int) ([]*User, int64, error)
 
-	// Update обновляет данные пользователя
+	// ListByCursor возвращает не больше limit пользователей, следующих сразу за позицией cursor,
+	// а с cursor.Backward - непосредственно перед ней; без cursor список начинается с первого пользователя.
+	// Пользователи упорядочены так же, как в List, в любом направлении
+	ListByCursor(ctx context.Context, cursor *UserCursor, limit int) ([]*User, error)
+
+	// Update сохраняет пользователя, только если его версия в хранилище совпадает с user.Version, и увеличивает версию;
+	// иначе возвращает ConcurrentModificationError
 	Update(ctx context.Context, user *User) (*User, error)
 
-	// Delete удаляет пользователя по ID
+	// Delete перемещает пользователя в корзину; остальные методы, кроме методов корзины, его больше не видят.
+	// Email пользователя в корзине остается занятым
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// ListDeleted возвращает пользователей из корзины, начиная с удаленных последними
+	ListDeleted(ctx context.Context, filter ListDeletedUsersFilter, page, pageSize int) ([]*User, int64, error)
+
+	// GetDeletedByID возвращает пользователя из корзины по ID
+	GetDeletedByID(ctx context.Context, id uuid.UUID) (*User, error)
+
+	// Restore возвращает пользователя из корзины
+	Restore(ctx context.Context, id uuid.UUID) error
+
+	// Purge окончательно удаляет пользователя из корзины; пользователя, на которого ссылаются
+	// задачи, проекты, комментарии или вложения, удалить нельзя
+	Purge(ctx context.Context, id uuid.UUID) error
 }
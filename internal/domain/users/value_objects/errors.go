@@ -31,6 +31,35 @@ func (e *InvalidEmailError) Error() string {
 	return fmt.Sprintf("invalid email: %s", e.Value)
 }
 
+// InvalidPasswordError представляет ошибку валидации пароля пользователя
+type InvalidPasswordError struct {
+	Message string
+}
+
+func (e *InvalidPasswordError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return "invalid password"
+}
+
+// InvalidRoleError представляет ошибку валидации роли пользователя
+type InvalidRoleError struct {
+	Value       string
+	ValidValues []string
+	Message     string
+}
+
+func (e *InvalidRoleError) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	if len(e.ValidValues) > 0 {
+		return fmt.Sprintf("invalid user role '%s'. Valid roles: %v", e.Value, e.ValidValues)
+	}
+	return fmt.Sprintf("invalid user role: %s", e.Value)
+}
+
 // IsInvalidName проверяет, является ли ошибка ошибкой валидации имени
 func IsInvalidName(err error) bool {
 	var invalidNameErr *InvalidNameError
@@ -42,3 +71,15 @@ func IsInvalidEmail(err error) bool {
 	var invalidEmailErr *InvalidEmailError
 	return errors.As(err, &invalidEmailErr)
 }
+
+// IsInvalidPassword проверяет, является ли ошибка ошибкой валидации пароля
+func IsInvalidPassword(err error) bool {
+	var invalidPasswordErr *InvalidPasswordError
+	return errors.As(err, &invalidPasswordErr)
+}
+
+// IsInvalidRole проверяет, является ли ошибка ошибкой валидации роли
+func IsInvalidRole(err error) bool {
+	var invalidRoleErr *InvalidRoleError
+	return errors.As(err, &invalidRoleErr)
+}
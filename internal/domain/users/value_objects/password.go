@@ -0,0 +1,26 @@
+package value_objects
+
+// PasswordValueObject представляет пароль пользователя в открытом виде с валидацией
+type PasswordValueObject struct {
+	value string
+}
+
+// NewPasswordValueObject создает новый PasswordValueObject с валидацией
+func NewPasswordValueObject(password string) (PasswordValueObject, error) {
+	if password == "" {
+		return PasswordValueObject{}, &InvalidPasswordError{Message: "password cannot be empty"}
+	}
+	if len(password) < 8 {
+		return PasswordValueObject{}, &InvalidPasswordError{Message: "password must be at least 8 characters long"}
+	}
+	// bcrypt учитывает только первые 72 байта пароля
+	if len(password) > 72 {
+		return PasswordValueObject{}, &InvalidPasswordError{Message: "password must be at most 72 bytes long"}
+	}
+	return PasswordValueObject{value: password}, nil
+}
+
+// Value возвращает строковое значение пароля
+func (p PasswordValueObject) Value() string {
+	return p.value
+}
@@ -0,0 +1,58 @@
+package value_objects
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// RoleAdmin управляет пользователями и всеми задачами
+	RoleAdmin = "admin"
+	// RoleMember работает со своими задачами
+	RoleMember = "member"
+	// RoleViewer только читает задачи
+	RoleViewer = "viewer"
+)
+
+var validRoles = []string{
+	RoleAdmin,
+	RoleMember,
+	RoleViewer,
+}
+
+// UserRoleValueObject представляет роль пользователя с валидацией
+type UserRoleValueObject struct {
+	value string
+}
+
+// NewUserRoleValueObject создает новый UserRoleValueObject с валидацией
+func NewUserRoleValueObject(role string) (UserRoleValueObject, error) {
+	role = strings.TrimSpace(role)
+	if role == "" {
+		return UserRoleValueObject{}, &InvalidRoleError{Message: "role cannot be empty"}
+	}
+	if !slices.Contains(validRoles, role) {
+		return UserRoleValueObject{}, &InvalidRoleError{Value: role, ValidValues: validRoles}
+	}
+	return UserRoleValueObject{value: role}, nil
+}
+
+// NewDefaultUserRoleValueObject возвращает роль, назначаемую новым пользователям
+func NewDefaultUserRoleValueObject() UserRoleValueObject {
+	return UserRoleValueObject{value: RoleMember}
+}
+
+// Value возвращает строковое значение роли
+func (r UserRoleValueObject) Value() string {
+	return r.value
+}
+
+// Equals проверяет равенство двух ролей
+func (r UserRoleValueObject) Equals(other UserRoleValueObject) bool {
+	return r.value == other.value
+}
+
+// IsAdmin проверяет, является ли роль администраторской
+func (r UserRoleValueObject) IsAdmin() bool {
+	return r.value == RoleAdmin
+}
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"errors"
+
+	"crud/config"
+	auth_domain "crud/internal/domain/auth"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// BcryptPasswordHasher хеширует пароли с помощью bcrypt
+type BcryptPasswordHasher struct {
+	cost int
+}
+
+// NewBcryptPasswordHasher создает новый bcrypt хешер
+func NewBcryptPasswordHasher(cfg *config.Config) *BcryptPasswordHasher {
+	cost := cfg.BcryptCost
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptPasswordHasher{cost: cost}
+}
+
+// Hash возвращает хеш пароля
+func (h *BcryptPasswordHasher) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
+	if err != nil {
+		return "", &auth_domain.AuthOperationFailedError{Operation: "hash_password", Reason: err.Error()}
+	}
+	return string(hash), nil
+}
+
+// Compare проверяет соответствие пароля хешу
+func (h *BcryptPasswordHasher) Compare(hash, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return &auth_domain.InvalidCredentialsError{}
+		}
+		return &auth_domain.AuthOperationFailedError{Operation: "compare_password", Reason: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"crud/config"
+	auth_domain "crud/internal/domain/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// refreshTokenBytes размер случайной части refresh токена
+const refreshTokenBytes = 32
+
+// accessTokenClaims claims access токена
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	Role         string `json:"role"`
+	Organization string `json:"org"`
+}
+
+// JWTTokenManager выпускает access токены в формате JWT (HS256) и непрозрачные refresh токены
+type JWTTokenManager struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// NewJWTTokenManager создает новый менеджер токенов
+func NewJWTTokenManager(cfg *config.Config) *JWTTokenManager {
+	return &JWTTokenManager{
+		secret:     []byte(cfg.JWTSecret),
+		issuer:     cfg.JWTIssuer,
+		accessTTL:  cfg.JWTAccessTokenTTL,
+		refreshTTL: cfg.JWTRefreshTokenTTL,
+	}
+}
+
+// IssueAccessToken выпускает подписанный access токен для пользователя
+func (m *JWTTokenManager) IssueAccessToken(principal auth_domain.Principal) (string, time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(m.accessTTL)
+
+	claims := accessTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    m.issuer,
+			Subject:   principal.UserID.String(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			ID:        uuid.NewString(),
+		},
+		Role:         principal.Role,
+		Organization: principal.OrganizationID.String(),
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, &auth_domain.AuthOperationFailedError{Operation: "sign_access_token", Reason: err.Error()}
+	}
+
+	return token, expiresAt, nil
+}
+
+// ParseAccessToken проверяет access токен и возвращает пользователя
+func (m *JWTTokenManager) ParseAccessToken(token string) (*auth_domain.Principal, error) {
+	var claims accessTokenClaims
+	_, err := jwt.ParseWithClaims(
+		token,
+		&claims,
+		func(*jwt.Token) (interface{}, error) { return m.secret, nil },
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.issuer),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, &auth_domain.InvalidTokenError{Reason: "token has expired"}
+		}
+		return nil, &auth_domain.InvalidTokenError{Reason: "malformed or unsigned token"}
+	}
+
+	userID, err := uuid.Parse(claims.Subject)
+	if err != nil {
+		return nil, &auth_domain.InvalidTokenError{Reason: "invalid subject"}
+	}
+
+	if claims.Role == "" {
+		return nil, &auth_domain.InvalidTokenError{Reason: "missing role"}
+	}
+
+	// Токены без организации выпущены до ее появления и не могут ограничить запрос
+	organizationID, err := uuid.Parse(claims.Organization)
+	if err != nil {
+		return nil, &auth_domain.InvalidTokenError{Reason: "missing organization"}
+	}
+
+	return &auth_domain.Principal{UserID: userID, Role: claims.Role, OrganizationID: organizationID}, nil
+}
+
+// IssueRefreshToken выпускает случайный refresh токен и возвращает его вместе с хешем
+func (m *JWTTokenManager) IssueRefreshToken() (string, string, time.Time, error) {
+	buf := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", time.Time{}, &auth_domain.AuthOperationFailedError{Operation: "issue_refresh_token", Reason: err.Error()}
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(buf)
+	return token, m.HashRefreshToken(token), time.Now().Add(m.refreshTTL), nil
+}
+
+// HashRefreshToken возвращает хеш refresh токена для поиска в хранилище
+func (m *JWTTokenManager) HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
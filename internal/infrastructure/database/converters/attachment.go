@@ -0,0 +1,52 @@
+package converters
+
+import (
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/attachments/value_objects"
+	"crud/internal/infrastructure/database/models"
+)
+
+// AttachmentModelToEntity конвертирует GORM модель в domain entity
+func AttachmentModelToEntity(model *models.Attachment) (*attachments.Attachment, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	fileName, err := value_objects.NewFileNameValueObject(model.FileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &attachments.Attachment{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		TaskID:         model.TaskID,
+		UploaderID:     model.UploaderID,
+		FileName:       fileName,
+		ContentType:    model.ContentType,
+		Size:           model.Size,
+		Checksum:       model.Checksum,
+		StorageKey:     model.StorageKey,
+		CreatedAt:      model.CreatedAt,
+	}, nil
+}
+
+// AttachmentEntityToModel конвертирует domain entity в GORM модель
+func AttachmentEntityToModel(attachment *attachments.Attachment) *models.Attachment {
+	if attachment == nil {
+		return nil
+	}
+
+	return &models.Attachment{
+		ID:             attachment.ID,
+		OrganizationID: attachment.OrganizationID,
+		TaskID:         attachment.TaskID,
+		UploaderID:     attachment.UploaderID,
+		FileName:       attachment.FileName.Value(),
+		ContentType:    attachment.ContentType,
+		Size:           attachment.Size,
+		Checksum:       attachment.Checksum,
+		StorageKey:     attachment.StorageKey,
+		CreatedAt:      attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,62 @@
+package converters
+
+import (
+	"crud/internal/domain/audit"
+	"crud/internal/infrastructure/database/models"
+)
+
+// AuditEntryModelToEntity конвертирует GORM модель в domain entity
+func AuditEntryModelToEntity(model *models.AuditEntry) *audit.Entry {
+	if model == nil {
+		return nil
+	}
+
+	changes := make([]audit.FieldChange, len(model.Changes))
+	for i, change := range model.Changes {
+		changes[i] = audit.FieldChange{
+			Field:    change.Field,
+			Old:      change.Old,
+			New:      change.New,
+			Redacted: change.Redacted,
+		}
+	}
+
+	return &audit.Entry{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		EntityType:     audit.EntityType(model.EntityType),
+		EntityID:       model.EntityID,
+		Action:         audit.Action(model.Action),
+		ActorID:        model.ActorID,
+		Changes:        changes,
+		CreatedAt:      model.CreatedAt,
+	}
+}
+
+// AuditEntryEntityToModel конвертирует domain entity в GORM модель
+func AuditEntryEntityToModel(entry *audit.Entry) *models.AuditEntry {
+	if entry == nil {
+		return nil
+	}
+
+	changes := make([]models.AuditFieldChange, len(entry.Changes))
+	for i, change := range entry.Changes {
+		changes[i] = models.AuditFieldChange{
+			Field:    change.Field,
+			Old:      change.Old,
+			New:      change.New,
+			Redacted: change.Redacted,
+		}
+	}
+
+	return &models.AuditEntry{
+		ID:             entry.ID,
+		OrganizationID: entry.OrganizationID,
+		EntityType:     string(entry.EntityType),
+		EntityID:       entry.EntityID,
+		Action:         string(entry.Action),
+		ActorID:        entry.ActorID,
+		Changes:        changes,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
@@ -0,0 +1,78 @@
+package converters
+
+import (
+	"crud/internal/domain/comments"
+	"crud/internal/domain/comments/value_objects"
+	"crud/internal/infrastructure/database/models"
+)
+
+// CommentModelToEntity конвертирует GORM модель в domain entity
+func CommentModelToEntity(model *models.Comment) (*comments.Comment, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	body, err := value_objects.NewCommentBodyValueObject(model.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &comments.Comment{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		TaskID:         model.TaskID,
+		AuthorID:       model.AuthorID,
+		ParentID:       model.ParentID,
+		Body:           body,
+		EditedAt:       model.EditedAt,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}, nil
+}
+
+// CommentEntityToModel конвертирует domain entity в GORM модель
+func CommentEntityToModel(comment *comments.Comment) *models.Comment {
+	if comment == nil {
+		return nil
+	}
+
+	return &models.Comment{
+		ID:             comment.ID,
+		OrganizationID: comment.OrganizationID,
+		TaskID:         comment.TaskID,
+		AuthorID:       comment.AuthorID,
+		ParentID:       comment.ParentID,
+		Body:           comment.Body.Value(),
+		EditedAt:       comment.EditedAt,
+		CreatedAt:      comment.CreatedAt,
+		UpdatedAt:      comment.UpdatedAt,
+	}
+}
+
+// CommentRevisionModelToEntity конвертирует GORM модель версии комментария в domain entity
+func CommentRevisionModelToEntity(model *models.CommentRevision) *comments.CommentRevision {
+	if model == nil {
+		return nil
+	}
+
+	return &comments.CommentRevision{
+		ID:        model.ID,
+		CommentID: model.CommentID,
+		Body:      model.Body,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+// CommentRevisionEntityToModel конвертирует domain entity версии комментария в GORM модель
+func CommentRevisionEntityToModel(revision *comments.CommentRevision) *models.CommentRevision {
+	if revision == nil {
+		return nil
+	}
+
+	return &models.CommentRevision{
+		ID:        revision.ID,
+		CommentID: revision.CommentID,
+		Body:      revision.Body,
+		CreatedAt: revision.CreatedAt,
+	}
+}
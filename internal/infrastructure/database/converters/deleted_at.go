@@ -0,0 +1,24 @@
+package converters
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// deletedAtToTime конвертирует отметку мягкого удаления GORM во время удаления domain entity
+func deletedAtToTime(deletedAt gorm.DeletedAt) *time.Time {
+	if !deletedAt.Valid {
+		return nil
+	}
+	t := deletedAt.Time
+	return &t
+}
+
+// timeToDeletedAt конвертирует время удаления domain entity в отметку мягкого удаления GORM
+func timeToDeletedAt(t *time.Time) gorm.DeletedAt {
+	if t == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *t, Valid: true}
+}
@@ -0,0 +1,42 @@
+package converters
+
+import (
+	"crud/internal/domain/labels"
+	"crud/internal/domain/labels/value_objects"
+	"crud/internal/infrastructure/database/models"
+)
+
+// LabelModelToEntity конвертирует GORM модель в domain entity
+func LabelModelToEntity(model *models.Label) (*labels.Label, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	name, err := value_objects.NewLabelNameValueObject(model.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &labels.Label{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		Name:           name,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}, nil
+}
+
+// LabelEntityToModel конвертирует domain entity в GORM модель
+func LabelEntityToModel(label *labels.Label) *models.Label {
+	if label == nil {
+		return nil
+	}
+
+	return &models.Label{
+		ID:             label.ID,
+		OrganizationID: label.OrganizationID,
+		Name:           label.Name.Value(),
+		CreatedAt:      label.CreatedAt,
+		UpdatedAt:      label.UpdatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package converters
+
+import (
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/organizations/value_objects"
+	"crud/internal/infrastructure/database/models"
+)
+
+// OrganizationModelToEntity конвертирует GORM модель в domain entity
+func OrganizationModelToEntity(model *models.Organization) (*organizations.Organization, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	name, err := value_objects.NewOrganizationNameValueObject(model.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &organizations.Organization{
+		ID:        model.ID,
+		Name:      name,
+		CreatedAt: model.CreatedAt,
+		UpdatedAt: model.UpdatedAt,
+	}, nil
+}
+
+// OrganizationEntityToModel конвертирует domain entity в GORM модель
+func OrganizationEntityToModel(organization *organizations.Organization) *models.Organization {
+	if organization == nil {
+		return nil
+	}
+
+	return &models.Organization{
+		ID:        organization.ID,
+		Name:      organization.Name.Value(),
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}
+}
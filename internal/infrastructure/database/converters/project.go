@@ -0,0 +1,46 @@
+package converters
+
+import (
+	"crud/internal/domain/projects"
+	"crud/internal/domain/projects/value_objects"
+	"crud/internal/infrastructure/database/models"
+)
+
+// ProjectModelToEntity конвертирует GORM модель в domain entity
+func ProjectModelToEntity(model *models.Project) (*projects.Project, error) {
+	if model == nil {
+		return nil, nil
+	}
+
+	name, err := value_objects.NewProjectNameValueObject(model.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &projects.Project{
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		UserID:         model.UserID,
+		Name:           name,
+		Description:    model.Description,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+	}, nil
+}
+
+// ProjectEntityToModel конвертирует domain entity в GORM модель
+func ProjectEntityToModel(project *projects.Project) *models.Project {
+	if project == nil {
+		return nil
+	}
+
+	return &models.Project{
+		ID:             project.ID,
+		OrganizationID: project.OrganizationID,
+		UserID:         project.UserID,
+		Name:           project.Name.Value(),
+		Description:    project.Description,
+		CreatedAt:      project.CreatedAt,
+		UpdatedAt:      project.UpdatedAt,
+	}
+}
@@ -0,0 +1,40 @@
+package converters
+
+import (
+	"crud/internal/domain/auth"
+	"crud/internal/infrastructure/database/models"
+)
+
+// RefreshTokenModelToEntity конвертирует GORM модель в domain entity
+func RefreshTokenModelToEntity(model *models.RefreshToken) *auth.RefreshToken {
+	if model == nil {
+		return nil
+	}
+
+	return &auth.RefreshToken{
+		ID:        model.ID,
+		UserID:    model.UserID,
+		FamilyID:  model.FamilyID,
+		TokenHash: model.TokenHash,
+		ExpiresAt: model.ExpiresAt,
+		RevokedAt: model.RevokedAt,
+		CreatedAt: model.CreatedAt,
+	}
+}
+
+// RefreshTokenEntityToModel конвертирует domain entity в GORM модель
+func RefreshTokenEntityToModel(token *auth.RefreshToken) *models.RefreshToken {
+	if token == nil {
+		return nil
+	}
+
+	return &models.RefreshToken{
+		ID:        token.ID,
+		UserID:    token.UserID,
+		FamilyID:  token.FamilyID,
+		TokenHash: token.TokenHash,
+		ExpiresAt: token.ExpiresAt,
+		RevokedAt: token.RevokedAt,
+		CreatedAt: token.CreatedAt,
+	}
+}
@@ -22,14 +22,31 @@ func TaskModelToEntity(model *models.Task) (*tasks.Task, error) {
 		return nil, err
 	}
 
+	priority, err := value_objects.NewTaskPriorityValueObject(model.Priority)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule, err := value_objects.NewTaskScheduleValueObject(model.StartDate, model.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
 	return &tasks.Task{
-		ID:          model.ID,
-		UserID:      model.UserID,
-		Title:       title,
-		Description: model.Description,
-		Status:      status,
-		CreatedAt:   model.CreatedAt,
-		UpdatedAt:   model.UpdatedAt,
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		UserID:         model.UserID,
+		ProjectID:      model.ProjectID,
+		ParentID:       model.ParentID,
+		Title:          title,
+		Description:    model.Description,
+		Status:         status,
+		Priority:       priority,
+		Schedule:       schedule,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		DeletedAt:      deletedAtToTime(model.DeletedAt),
+		Version:        model.Version,
 	}, nil
 }
 
@@ -40,12 +57,20 @@ func TaskEntityToModel(task *tasks.Task) *models.Task {
 	}
 
 	return &models.Task{
-		ID:          task.ID,
-		UserID:      task.UserID,
-		Title:       task.Title.Value(),
-		Description: task.Description,
-		Status:      task.Status.Value(),
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
+		ID:             task.ID,
+		OrganizationID: task.OrganizationID,
+		UserID:         task.UserID,
+		ProjectID:      task.ProjectID,
+		ParentID:       task.ParentID,
+		Title:          task.Title.Value(),
+		Description:    task.Description,
+		Status:         task.Status.Value(),
+		Priority:       task.Priority.Value(),
+		StartDate:      task.Schedule.StartDate(),
+		DueDate:        task.Schedule.DueDate(),
+		CreatedAt:      task.CreatedAt,
+		UpdatedAt:      task.UpdatedAt,
+		DeletedAt:      timeToDeletedAt(task.DeletedAt),
+		Version:        task.Version,
 	}
 }
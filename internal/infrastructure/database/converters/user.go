@@ -22,12 +22,22 @@ func UserModelToEntity(model *models.User) (*users.User, error) {
 		return nil, err
 	}
 
+	role, err := value_objects.NewUserRoleValueObject(model.Role)
+	if err != nil {
+		return nil, err
+	}
+
 	return &users.User{
-		ID:        model.ID,
-		Email:     email,
-		Name:      name,
-		CreatedAt: model.CreatedAt,
-		UpdatedAt: model.UpdatedAt,
+		ID:             model.ID,
+		OrganizationID: model.OrganizationID,
+		Email:          email,
+		Name:           name,
+		Role:           role,
+		PasswordHash:   model.PasswordHash,
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		DeletedAt:      deletedAtToTime(model.DeletedAt),
+		Version:        model.Version,
 	}, nil
 }
 
@@ -38,10 +48,15 @@ func UserEntityToModel(user *users.User) *models.User {
 	}
 
 	return &models.User{
-		ID:        user.ID,
-		Email:     user.Email.Value(),
-		Name:      user.Name.Value(),
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:             user.ID,
+		OrganizationID: user.OrganizationID,
+		Email:          user.Email.Value(),
+		Name:           user.Name.Value(),
+		Role:           user.Role.Value(),
+		PasswordHash:   user.PasswordHash,
+		CreatedAt:      user.CreatedAt,
+		UpdatedAt:      user.UpdatedAt,
+		DeletedAt:      timeToDeletedAt(user.DeletedAt),
+		Version:        user.Version,
 	}
 }
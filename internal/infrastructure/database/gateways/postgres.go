@@ -4,7 +4,7 @@ import (
 	"fmt"
 
 	"crud/config"
-	"crud/internal/infrastructure/database/models"
+	"crud/internal/infrastructure/database/tenancy"
 
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -15,7 +15,7 @@ type PostgresGateway struct {
 	db *gorm.DB
 }
 
-// NewPostgresGateway создает новое подключение к PostgreSQL и выполняет миграции
+// NewPostgresGateway создает новое подключение к PostgreSQL. Схему базы создают миграции из пакета migrations
 func NewPostgresGateway(cfg *config.Config) (*PostgresGateway, error) {
 	dsn := fmt.Sprintf(
 		"host=%s user=%s password=%s dbname=%s port=%d sslmode=disable TimeZone=UTC",
@@ -26,17 +26,15 @@ func NewPostgresGateway(cfg *config.Config) (*PostgresGateway, error) {
 		cfg.PostgresPort,
 	)
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	// TranslateError приводит ошибки драйвера к gorm.ErrDuplicatedKey и gorm.ErrForeignKeyViolated
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
 
-	// Выполняем миграции
-	if err := db.AutoMigrate(
-		&models.User{},
-		&models.Task{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to run migrations: %w", err)
+	// Все обращения к данным организаций ограничиваются организацией из контекста запроса
+	if err := db.Use(tenancy.OrganizationScope{}); err != nil {
+		return nil, fmt.Errorf("failed to register organization scope: %w", err)
 	}
 
 	return &PostgresGateway{db: db}, nil
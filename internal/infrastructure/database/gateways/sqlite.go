@@ -0,0 +1,123 @@
+package gateways
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"crud/config"
+	"crud/internal/infrastructure/database/tenancy"
+
+	gosqlite "github.com/glebarez/go-sqlite"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// registerFunctions регистрирует функции SQLite один раз на процесс: драйвер хранит их глобально
+// и подключает к каждому новому соединению
+var registerFunctions = sync.OnceValue(func() error {
+	// Встроенная LOWER в SQLite приводит к нижнему регистру только ASCII, поэтому contains
+	// в фильтрах задач различал бы регистр кириллицы, в отличие от PostgreSQL
+	return gosqlite.RegisterDeterministicScalarFunction("lower", 1, unicodeLower)
+})
+
+// unicodeLower заменяет встроенную LOWER: приводит текст к нижнему регистру по правилам Unicode
+func unicodeLower(_ *gosqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+	switch value := args[0].(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.ToLower(value), nil
+	case []byte:
+		return strings.ToLower(string(value)), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
+
+// SQLiteGateway управляет подключением к файлу SQLite через GORM. Драйвер написан на чистом Go,
+// поэтому сервис запускается без Docker и без cgo
+type SQLiteGateway struct {
+	db *gorm.DB
+}
+
+// NewSQLiteGateway открывает базу SQLite по пути из конфига, создавая файл при необходимости.
+// Схему базы создают миграции из пакета migrations
+func NewSQLiteGateway(cfg *config.Config) (*SQLiteGateway, error) {
+	if err := registerFunctions(); err != nil {
+		return nil, fmt.Errorf("failed to register database functions: %w", err)
+	}
+
+	if cfg.SQLitePath != ":memory:" {
+		if err := os.MkdirAll(filepath.Dir(cfg.SQLitePath), 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create database directory: %w", err)
+		}
+	}
+
+	// Внешние ключи в SQLite выключены по умолчанию; busy_timeout заставляет конкурирующие
+	// записи ждать блокировку файла, а не сразу получать SQLITE_BUSY
+	dsn := cfg.SQLitePath + "?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)"
+
+	// SQLite хранит время строками и сравнивает их как строки, поэтому отметки GORM пишутся в UTC,
+	// как и границы фильтров в репозиториях
+	db, err := gorm.Open(&sqliteDialector{Dialector: sqlite.Open(dsn).(*sqlite.Dialector)}, &gorm.Config{
+		TranslateError: true,
+		NowFunc:        func() time.Time { return time.Now().UTC() },
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	// База в памяти живет, пока открыто соединение, и у каждого соединения своя
+	if cfg.SQLitePath == ":memory:" {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return nil, err
+		}
+		sqlDB.SetMaxOpenConns(1)
+	}
+
+	// Все обращения к данным организаций ограничиваются организацией из контекста запроса
+	if err := db.Use(tenancy.OrganizationScope{}); err != nil {
+		return nil, fmt.Errorf("failed to register organization scope: %w", err)
+	}
+
+	return &SQLiteGateway{db: db}, nil
+}
+
+// DB возвращает экземпляр *gorm.DB
+func (g *SQLiteGateway) DB() *gorm.DB {
+	return g.db
+}
+
+// Close закрывает подключение к базе данных
+func (g *SQLiteGateway) Close() error {
+	sqlDB, err := g.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// sqliteDialector дополняет перевод ошибок драйвера. Нарушение ON DELETE RESTRICT SQLite
+// сообщает кодом SQLITE_CONSTRAINT_TRIGGER, а не SQLITE_CONSTRAINT_FOREIGNKEY, и без перевода
+// репозитории не узнали бы в нем gorm.ErrForeignKeyViolated
+type sqliteDialector struct {
+	*sqlite.Dialector
+}
+
+// Translate переводит ошибки SQLite в ошибки GORM
+func (d *sqliteDialector) Translate(err error) error {
+	var sqliteErr *gosqlite.Error
+	if errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_TRIGGER &&
+		strings.Contains(sqliteErr.Error(), "FOREIGN KEY constraint failed") {
+		return gorm.ErrForeignKeyViolated
+	}
+	return d.Dialector.Translate(err)
+}
@@ -0,0 +1,63 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SchemaTooNewError представляет ошибку, когда схема базы новее, чем известно приложению:
+// базу уже обновила более новая версия приложения
+type SchemaTooNewError struct {
+	Version int64
+	Latest  int64
+}
+
+func (e *SchemaTooNewError) Error() string {
+	return fmt.Sprintf("database schema version %d is newer than the latest known version %d", e.Version, e.Latest)
+}
+
+// UnknownMigrationError представляет ошибку, когда в базе применена миграция, которой нет в приложении
+type UnknownMigrationError struct {
+	Version int64
+}
+
+func (e *UnknownMigrationError) Error() string {
+	return fmt.Sprintf("database has unknown migration %d applied", e.Version)
+}
+
+// PendingMigrationsError представляет ошибку, когда в базе применены не все миграции
+type PendingMigrationsError struct {
+	Versions []int64
+}
+
+func (e *PendingMigrationsError) Error() string {
+	return fmt.Sprintf("database schema is out of date, pending migrations: %v", e.Versions)
+}
+
+// MigrationFailedError представляет ошибку выполнения скрипта миграции
+type MigrationFailedError struct {
+	Version   int64
+	Name      string
+	Direction string
+	Err       error
+}
+
+func (e *MigrationFailedError) Error() string {
+	return fmt.Sprintf("migration %d_%s %s failed: %v", e.Version, e.Name, e.Direction, e.Err)
+}
+
+func (e *MigrationFailedError) Unwrap() error {
+	return e.Err
+}
+
+// IsSchemaTooNew проверяет, является ли ошибка SchemaTooNewError
+func IsSchemaTooNew(err error) bool {
+	var schemaTooNewErr *SchemaTooNewError
+	return errors.As(err, &schemaTooNewErr)
+}
+
+// IsPendingMigrations проверяет, является ли ошибка PendingMigrationsError
+func IsPendingMigrations(err error) bool {
+	var pendingMigrationsErr *PendingMigrationsError
+	return errors.As(err, &pendingMigrationsErr)
+}
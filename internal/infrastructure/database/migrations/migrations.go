@@ -0,0 +1,184 @@
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+//go:embed postgres/*.sql
+var postgresScripts embed.FS
+
+//go:embed sqlite/*.sql
+var sqliteScripts embed.FS
+
+// Migration версия схемы базы данных: Up переводит схему на эту версию, Down возвращает на предыдущую
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// fileName имя файла миграции: <версия>_<имя>.<up|down>.sql, например 0001_initial.up.sql
+var fileName = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Postgres возвращает встроенные в бинарник миграции PostgreSQL
+func Postgres() ([]Migration, error) {
+	scripts, err := fs.Sub(postgresScripts, "postgres")
+	if err != nil {
+		return nil, err
+	}
+	return Load(scripts)
+}
+
+// SQLite возвращает встроенные в бинарник миграции SQLite. Версии и имена совпадают с миграциями PostgreSQL
+func SQLite() ([]Migration, error) {
+	scripts, err := fs.Sub(sqliteScripts, "sqlite")
+	if err != nil {
+		return nil, err
+	}
+	return Load(scripts)
+}
+
+// ForDialect возвращает встроенные миграции для диалекта GORM
+func ForDialect(dialect string) ([]Migration, error) {
+	switch dialect {
+	case "postgres":
+		return Postgres()
+	case "sqlite":
+		return SQLite()
+	default:
+		return nil, fmt.Errorf("no migrations for database dialect %q", dialect)
+	}
+}
+
+// Load читает миграции из корня fsys и возвращает их по возрастанию версий.
+// У каждой версии должны быть оба скрипта, up и down
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := fileName.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name %q, expected <version>_<name>.<up|down>.sql", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version in %q", entry.Name())
+		}
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has different names: %q and %q", version, migration.Name, match[2])
+		}
+
+		script, err := fs.ReadFile(fsys, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %q: %w", entry.Name(), err)
+		}
+		if match[3] == "up" {
+			migration.Up = string(script)
+		} else {
+			migration.Down = string(script)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both up and down scripts", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Latest возвращает версию последней миграции или 0, если миграций нет
+func Latest(migrations []Migration) int64 {
+	if len(migrations) == 0 {
+		return 0
+	}
+	return migrations[len(migrations)-1].Version
+}
+
+// Pending возвращает миграции, которые еще не применены, по возрастанию версий.
+// Если в базе применена неизвестная версия, возвращает SchemaTooNewError или UnknownMigrationError
+func Pending(migrations []Migration, applied []int64) ([]Migration, error) {
+	if err := checkApplied(migrations, applied); err != nil {
+		return nil, err
+	}
+
+	done := map[int64]bool{}
+	for _, version := range applied {
+		done[version] = true
+	}
+
+	var pending []Migration
+	for _, migration := range migrations {
+		if !done[migration.Version] {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+// Rollback возвращает steps последних примененных миграций в порядке отката, от новых к старым
+func Rollback(migrations []Migration, applied []int64, steps int) ([]Migration, error) {
+	if err := checkApplied(migrations, applied); err != nil {
+		return nil, err
+	}
+
+	known := map[int64]Migration{}
+	for _, migration := range migrations {
+		known[migration.Version] = migration
+	}
+
+	versions := append([]int64(nil), applied...)
+	sort.Slice(versions, func(i, j int) bool { return versions[i] > versions[j] })
+	if steps < len(versions) {
+		versions = versions[:steps]
+	}
+
+	rollback := make([]Migration, 0, len(versions))
+	for _, version := range versions {
+		rollback = append(rollback, known[version])
+	}
+	return rollback, nil
+}
+
+// checkApplied проверяет, что все примененные версии известны этой версии приложения
+func checkApplied(migrations []Migration, applied []int64) error {
+	known := map[int64]bool{}
+	for _, migration := range migrations {
+		known[migration.Version] = true
+	}
+
+	latest := Latest(migrations)
+	for _, version := range applied {
+		if known[version] {
+			continue
+		}
+		if version > latest {
+			return &SchemaTooNewError{Version: version, Latest: latest}
+		}
+		return &UnknownMigrationError{Version: version}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"crud/internal/domain/organizations"
+
+	"gorm.io/gorm"
+)
+
+// lockKey ключ advisory lock, под которым выполняются миграции: реплики, запущенные одновременно,
+// применяют миграции по очереди, и каждая следующая видит результат предыдущей
+const lockKey int64 = 0x63727564 // "crud"
+
+// createTable создает таблицу примененных миграций; %s тип столбца со временем в диалекте базы
+const createTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version bigint PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    applied_at %s NOT NULL
+)`
+
+// Status состояние миграции в базе
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// appliedMigration строка таблицы schema_migrations
+type appliedMigration struct {
+	Version   int64
+	AppliedAt time.Time
+}
+
+// Migrator применяет и откатывает миграции и хранит примененные версии в schema_migrations.
+// Каждая миграция выполняется в своей транзакции вместе с записью о ней
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator создает Migrator для набора миграций
+func NewMigrator(db *gorm.DB, migrations []Migration) *Migrator {
+	return &Migrator{db: db, migrations: migrations}
+}
+
+// NewDialectMigrator создает Migrator со встроенными миграциями для диалекта db
+func NewDialectMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := ForDialect(db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+	return NewMigrator(db, migrations), nil
+}
+
+// Migrations возвращает известные миграции по возрастанию версий
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Up применяет все непримененные миграции и возвращает примененные
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		pending, err := Pending(m.migrations, versions(applied))
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range pending {
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				return tx.Exec(
+					"INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)",
+					migration.Version, migration.Name, time.Now().UTC(),
+				).Error
+			})
+			if err != nil {
+				return &MigrationFailedError{Version: migration.Version, Name: migration.Name, Direction: "up", Err: err}
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Down откатывает steps последних примененных миграций и возвращает откаченные
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var done []Migration
+	err := m.locked(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		rollback, err := Rollback(m.migrations, versions(applied), steps)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range rollback {
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Exec("DELETE FROM schema_migrations WHERE version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return &MigrationFailedError{Version: migration.Version, Name: migration.Name, Direction: "down", Err: err}
+			}
+			done = append(done, migration)
+		}
+		return nil
+	})
+	return done, err
+}
+
+// Status возвращает состояние известных миграций
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(m.conn(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	appliedAt := map[int64]time.Time{}
+	for _, migration := range applied {
+		appliedAt[migration.Version] = migration.AppliedAt
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		status := Status{Migration: migration}
+		if at, ok := appliedAt[migration.Version]; ok {
+			status.AppliedAt = &at
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Check проверяет, что схема базы соответствует приложению: SchemaTooNewError, если базу обновила
+// более новая версия приложения, PendingMigrationsError, если применены не все миграции
+func (m *Migrator) Check(ctx context.Context) error {
+	applied, err := m.applied(m.conn(ctx))
+	if err != nil {
+		return err
+	}
+	pending, err := Pending(m.migrations, versions(applied))
+	if err != nil {
+		return err
+	}
+	if len(pending) > 0 {
+		pendingVersions := make([]int64, 0, len(pending))
+		for _, migration := range pending {
+			pendingVersions = append(pendingVersions, migration.Version)
+		}
+		return &PendingMigrationsError{Versions: pendingVersions}
+	}
+	return nil
+}
+
+// conn возвращает подключение для миграций: они работают с данными всех организаций
+func (m *Migrator) conn(ctx context.Context) *gorm.DB {
+	return m.db.WithContext(organizations.ContextWithoutScope(ctx))
+}
+
+// locked выполняет fn на одном соединении под advisory lock. Блокировка сессионная,
+// поэтому и она, и миграции должны идти через одно и то же соединение пула.
+// В SQLite advisory lock нет: файл базы рассчитан на один экземпляр сервиса
+func (m *Migrator) locked(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.conn(ctx).Connection(func(conn *gorm.DB) error {
+		timeType := "datetime"
+		if conn.Dialector.Name() == "postgres" {
+			timeType = "timestamptz"
+			if err := conn.Exec("SELECT pg_advisory_lock(?)", lockKey).Error; err != nil {
+				return fmt.Errorf("failed to acquire migration lock: %w", err)
+			}
+			// Блокировка снимается и при отмене ctx запроса, иначе она осталась бы за соединением в пуле
+			defer conn.WithContext(context.Background()).Exec("SELECT pg_advisory_unlock(?)", lockKey)
+		}
+
+		if err := conn.Exec(fmt.Sprintf(createTable, timeType)).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations: %w", err)
+		}
+		return fn(conn)
+	})
+}
+
+// applied читает примененные миграции; до первой миграции таблицы schema_migrations нет
+func (m *Migrator) applied(conn *gorm.DB) ([]appliedMigration, error) {
+	if !conn.Migrator().HasTable("schema_migrations") {
+		return nil, nil
+	}
+
+	var applied []appliedMigration
+	if err := conn.Raw("SELECT version, applied_at FROM schema_migrations ORDER BY version").Scan(&applied).Error; err != nil {
+		return nil, fmt.Errorf("failed to read schema_migrations: %w", err)
+	}
+	return applied, nil
+}
+
+// versions возвращает версии примененных миграций
+func versions(applied []appliedMigration) []int64 {
+	result := make([]int64, 0, len(applied))
+	for _, migration := range applied {
+		result = append(result, migration.Version)
+	}
+	return result
+}
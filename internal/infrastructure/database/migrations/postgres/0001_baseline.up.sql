@@ -0,0 +1,30 @@
+-- Схема первых версий приложения, которую создавал AutoMigrate: только users и tasks.
+-- Базу, созданную ими, миграция не меняет, а пустой базе создает те же таблицы, поэтому
+-- дальнейшие миграции одинаково применяются к обеим
+
+CREATE TABLE IF NOT EXISTS users (
+    id uuid DEFAULT gen_random_uuid(),
+    email varchar(255) NOT NULL,
+    name varchar(100) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS tasks (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    title varchar(200) NOT NULL,
+    description text,
+    status varchar(20) NOT NULL DEFAULT 'todo',
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id ON tasks (user_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks (status);
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at);
@@ -0,0 +1,26 @@
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS audit_entries;
+DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS comment_revisions;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS task_dependencies;
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT fk_tasks_user,
+    DROP COLUMN organization_id,
+    DROP COLUMN project_id,
+    DROP COLUMN parent_id,
+    DROP COLUMN priority,
+    DROP COLUMN start_date,
+    DROP COLUMN due_date,
+    DROP COLUMN version;
+DROP TABLE IF EXISTS projects;
+
+ALTER TABLE users
+    DROP COLUMN organization_id,
+    DROP COLUMN role,
+    DROP COLUMN password_hash,
+    DROP COLUMN version;
+DROP TABLE IF EXISTS organizations;
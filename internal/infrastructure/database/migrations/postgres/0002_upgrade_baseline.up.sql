@@ -0,0 +1,176 @@
+-- Переводит схему первых версий приложения на текущую. Новые столбцы users и tasks добавляются
+-- со значениями по умолчанию, которые получают и существующие строки, затем добавляются
+-- внешние ключи и остальные таблицы. Задачи, владельца которых нет в users, нужно удалить
+-- или переназначить до миграции: иначе fk_tasks_user не создается и миграция откатывается
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id uuid DEFAULT gen_random_uuid(),
+    name varchar(100) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id)
+);
+
+-- Организация по умолчанию: на нее указывают значения по умолчанию столбцов organization_id
+INSERT INTO organizations (id, name, created_at, updated_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Default', now(), now())
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE users
+    ADD COLUMN organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    ADD COLUMN role varchar(20) NOT NULL DEFAULT 'member',
+    ADD COLUMN password_hash varchar(255) NOT NULL DEFAULT '',
+    ADD COLUMN version bigint NOT NULL DEFAULT 1,
+    ADD CONSTRAINT fk_users_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_users_organization_id ON users (organization_id);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+
+CREATE TABLE IF NOT EXISTS projects (
+    id uuid DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    user_id uuid NOT NULL,
+    name varchar(100) NOT NULL,
+    description text,
+    created_at timestamptz,
+    updated_at timestamptz,
+    deleted_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_projects_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_projects_user FOREIGN KEY (user_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_projects_organization_id ON projects (organization_id);
+CREATE INDEX IF NOT EXISTS idx_projects_user_id ON projects (user_id);
+CREATE INDEX IF NOT EXISTS idx_projects_deleted_at ON projects (deleted_at);
+
+ALTER TABLE tasks
+    ADD COLUMN organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    ADD COLUMN project_id uuid,
+    ADD COLUMN parent_id uuid,
+    ADD COLUMN priority varchar(10) NOT NULL DEFAULT 'medium',
+    ADD COLUMN start_date timestamptz,
+    ADD COLUMN due_date timestamptz,
+    ADD COLUMN version bigint NOT NULL DEFAULT 1,
+    ADD CONSTRAINT fk_tasks_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_tasks_user FOREIGN KEY (user_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_tasks_project FOREIGN KEY (project_id) REFERENCES projects(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    ADD CONSTRAINT fk_tasks_parent FOREIGN KEY (parent_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE RESTRICT;
+CREATE INDEX IF NOT EXISTS idx_tasks_organization_id ON tasks (organization_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks (priority);
+CREATE INDEX IF NOT EXISTS idx_tasks_due_date ON tasks (due_date);
+
+-- Имя метки уникально в пределах организации
+CREATE TABLE IF NOT EXISTS labels (
+    id uuid DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    name varchar(50) NOT NULL,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_labels_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_labels_organization_name ON labels (organization_id, name);
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id uuid,
+    label_id uuid,
+    created_at timestamptz,
+    PRIMARY KEY (task_id, label_id),
+    CONSTRAINT fk_task_labels_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_task_labels_label FOREIGN KEY (label_id) REFERENCES labels(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels (label_id);
+
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id uuid,
+    blocker_id uuid,
+    created_at timestamptz,
+    PRIMARY KEY (task_id, blocker_id),
+    CONSTRAINT fk_task_dependencies_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_task_dependencies_blocker FOREIGN KEY (blocker_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocker_id ON task_dependencies (blocker_id);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id uuid DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    task_id uuid NOT NULL,
+    parent_id uuid,
+    author_id uuid NOT NULL,
+    body text NOT NULL,
+    edited_at timestamptz,
+    created_at timestamptz,
+    updated_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_comments_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_comments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_comments_organization_id ON comments (organization_id);
+CREATE INDEX IF NOT EXISTS idx_comments_task_parent ON comments (task_id, parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_author_id ON comments (author_id);
+
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    id uuid DEFAULT gen_random_uuid(),
+    comment_id uuid NOT NULL,
+    body text NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_comment_revisions_comment FOREIGN KEY (comment_id) REFERENCES comments(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_id ON comment_revisions (comment_id);
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id uuid DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    task_id uuid NOT NULL,
+    uploader_id uuid NOT NULL,
+    file_name varchar(255) NOT NULL,
+    content_type varchar(255) NOT NULL,
+    size bigint NOT NULL,
+    checksum char(64) NOT NULL,
+    storage_key varchar(512) NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_attachments_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_attachments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_attachments_uploader FOREIGN KEY (uploader_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_attachments_organization_id ON attachments (organization_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_task_id ON attachments (task_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_uploader_id ON attachments (uploader_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachments_storage_key ON attachments (storage_key);
+
+-- Ссылки на сущность и автора изменения не являются внешними ключами: история переживает их удаление
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id uuid DEFAULT gen_random_uuid(),
+    organization_id uuid NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    entity_type varchar(32) NOT NULL,
+    entity_id uuid NOT NULL,
+    action varchar(16) NOT NULL,
+    actor_id uuid,
+    changes jsonb NOT NULL,
+    created_at timestamptz,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_audit_entries_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_organization_id ON audit_entries (organization_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_actor_id ON audit_entries (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id uuid DEFAULT gen_random_uuid(),
+    user_id uuid NOT NULL,
+    family_id uuid NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    expires_at timestamptz NOT NULL,
+    revoked_at timestamptz,
+    created_at timestamptz,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
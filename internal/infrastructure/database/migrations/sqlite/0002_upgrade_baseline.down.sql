@@ -0,0 +1,47 @@
+-- Возвращает users и tasks к схеме первых версий, пересоздавая их без новых столбцов
+
+DROP TABLE IF EXISTS refresh_tokens;
+DROP TABLE IF EXISTS audit_entries;
+DROP TABLE IF EXISTS attachments;
+DROP TABLE IF EXISTS comment_revisions;
+DROP TABLE IF EXISTS comments;
+DROP TABLE IF EXISTS task_dependencies;
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
+
+CREATE TABLE tasks_old (
+    id text,
+    user_id text NOT NULL,
+    title varchar(200) NOT NULL,
+    description text,
+    status varchar(20) NOT NULL DEFAULT 'todo',
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    PRIMARY KEY (id)
+);
+INSERT INTO tasks_old (id, user_id, title, description, status, created_at, updated_at, deleted_at)
+SELECT id, user_id, title, description, status, created_at, updated_at, deleted_at FROM tasks;
+DROP TABLE tasks;
+ALTER TABLE tasks_old RENAME TO tasks;
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id ON tasks (user_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks (status);
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at);
+DROP TABLE IF EXISTS projects;
+
+CREATE TABLE users_old (
+    id text,
+    email varchar(255) NOT NULL,
+    name varchar(100) NOT NULL,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    PRIMARY KEY (id)
+);
+INSERT INTO users_old (id, email, name, created_at, updated_at, deleted_at)
+SELECT id, email, name, created_at, updated_at, deleted_at FROM users;
+DROP TABLE users;
+ALTER TABLE users_old RENAME TO users;
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+DROP TABLE IF EXISTS organizations;
@@ -0,0 +1,207 @@
+-- Переводит схему первых версий приложения на текущую. SQLite не добавляет внешние ключи
+-- к существующей таблице, поэтому users и tasks пересоздаются с переносом строк; новые столбцы
+-- существующих строк получают значения по умолчанию. Задачи, владельца которых нет в users,
+-- нужно удалить или переназначить до миграции: иначе проверка внешних ключей ее откатывает
+
+CREATE TABLE IF NOT EXISTS organizations (
+    id text,
+    name varchar(100) NOT NULL,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (id)
+);
+
+-- Организация по умолчанию: на нее указывают значения по умолчанию столбцов organization_id
+INSERT INTO organizations (id, name, created_at, updated_at)
+VALUES ('00000000-0000-0000-0000-000000000001', 'Default', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)
+ON CONFLICT DO NOTHING;
+
+CREATE TABLE users_new (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    email varchar(255) NOT NULL,
+    name varchar(100) NOT NULL,
+    role varchar(20) NOT NULL DEFAULT 'member',
+    password_hash varchar(255) NOT NULL DEFAULT '',
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    version bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_users_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+INSERT INTO users_new (id, email, name, created_at, updated_at, deleted_at)
+SELECT id, email, name, created_at, updated_at, deleted_at FROM users;
+DROP TABLE users;
+ALTER TABLE users_new RENAME TO users;
+CREATE INDEX IF NOT EXISTS idx_users_organization_id ON users (organization_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_users_email ON users (email);
+CREATE INDEX IF NOT EXISTS idx_users_role ON users (role);
+CREATE INDEX IF NOT EXISTS idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE IF NOT EXISTS projects (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    user_id text NOT NULL,
+    name varchar(100) NOT NULL,
+    description text,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_projects_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_projects_user FOREIGN KEY (user_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_projects_organization_id ON projects (organization_id);
+CREATE INDEX IF NOT EXISTS idx_projects_user_id ON projects (user_id);
+CREATE INDEX IF NOT EXISTS idx_projects_deleted_at ON projects (deleted_at);
+
+CREATE TABLE tasks_new (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    user_id text NOT NULL,
+    project_id text,
+    parent_id text,
+    title varchar(200) NOT NULL,
+    description text,
+    status varchar(20) NOT NULL DEFAULT 'todo',
+    priority varchar(10) NOT NULL DEFAULT 'medium',
+    start_date datetime,
+    due_date datetime,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    version bigint NOT NULL DEFAULT 1,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_tasks_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_tasks_user FOREIGN KEY (user_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_tasks_project FOREIGN KEY (project_id) REFERENCES projects(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_tasks_parent FOREIGN KEY (parent_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+INSERT INTO tasks_new (id, user_id, title, description, status, created_at, updated_at, deleted_at)
+SELECT id, user_id, title, description, status, created_at, updated_at, deleted_at FROM tasks;
+DROP TABLE tasks;
+ALTER TABLE tasks_new RENAME TO tasks;
+CREATE INDEX IF NOT EXISTS idx_tasks_organization_id ON tasks (organization_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_user_id ON tasks (user_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id ON tasks (project_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id);
+CREATE INDEX IF NOT EXISTS idx_tasks_status ON tasks (status);
+CREATE INDEX IF NOT EXISTS idx_tasks_priority ON tasks (priority);
+CREATE INDEX IF NOT EXISTS idx_tasks_due_date ON tasks (due_date);
+CREATE INDEX IF NOT EXISTS idx_tasks_deleted_at ON tasks (deleted_at);
+
+-- Имя метки уникально в пределах организации
+CREATE TABLE IF NOT EXISTS labels (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    name varchar(50) NOT NULL,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_labels_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_labels_organization_name ON labels (organization_id, name);
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id text,
+    label_id text,
+    created_at datetime,
+    PRIMARY KEY (task_id, label_id),
+    CONSTRAINT fk_task_labels_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_task_labels_label FOREIGN KEY (label_id) REFERENCES labels(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels (label_id);
+
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    task_id text,
+    blocker_id text,
+    created_at datetime,
+    PRIMARY KEY (task_id, blocker_id),
+    CONSTRAINT fk_task_dependencies_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_task_dependencies_blocker FOREIGN KEY (blocker_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocker_id ON task_dependencies (blocker_id);
+
+CREATE TABLE IF NOT EXISTS comments (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    task_id text NOT NULL,
+    parent_id text,
+    author_id text NOT NULL,
+    body text NOT NULL,
+    edited_at datetime,
+    created_at datetime,
+    updated_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_comments_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_comments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_comments_parent FOREIGN KEY (parent_id) REFERENCES comments(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_comments_author FOREIGN KEY (author_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_comments_organization_id ON comments (organization_id);
+CREATE INDEX IF NOT EXISTS idx_comments_task_parent ON comments (task_id, parent_id);
+CREATE INDEX IF NOT EXISTS idx_comments_author_id ON comments (author_id);
+
+CREATE TABLE IF NOT EXISTS comment_revisions (
+    id text,
+    comment_id text NOT NULL,
+    body text NOT NULL,
+    created_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_comment_revisions_comment FOREIGN KEY (comment_id) REFERENCES comments(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_comment_revisions_comment_id ON comment_revisions (comment_id);
+
+CREATE TABLE IF NOT EXISTS attachments (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    task_id text NOT NULL,
+    uploader_id text NOT NULL,
+    file_name varchar(255) NOT NULL,
+    content_type varchar(255) NOT NULL,
+    size bigint NOT NULL,
+    checksum char(64) NOT NULL,
+    storage_key varchar(512) NOT NULL,
+    created_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_attachments_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT,
+    CONSTRAINT fk_attachments_task FOREIGN KEY (task_id) REFERENCES tasks(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    CONSTRAINT fk_attachments_uploader FOREIGN KEY (uploader_id) REFERENCES users(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_attachments_organization_id ON attachments (organization_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_task_id ON attachments (task_id);
+CREATE INDEX IF NOT EXISTS idx_attachments_uploader_id ON attachments (uploader_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_attachments_storage_key ON attachments (storage_key);
+
+-- Ссылки на сущность и автора изменения не являются внешними ключами: история переживает их удаление
+CREATE TABLE IF NOT EXISTS audit_entries (
+    id text,
+    organization_id text NOT NULL DEFAULT '00000000-0000-0000-0000-000000000001',
+    entity_type varchar(32) NOT NULL,
+    entity_id text NOT NULL,
+    action varchar(16) NOT NULL,
+    actor_id text,
+    changes text NOT NULL,
+    created_at datetime,
+    PRIMARY KEY (id),
+    CONSTRAINT fk_audit_entries_organization FOREIGN KEY (organization_id) REFERENCES organizations(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_organization_id ON audit_entries (organization_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_entity ON audit_entries (entity_type, entity_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_actor_id ON audit_entries (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_entries_created_at ON audit_entries (created_at);
+
+CREATE TABLE IF NOT EXISTS refresh_tokens (
+    id text,
+    user_id text NOT NULL,
+    family_id text NOT NULL,
+    token_hash varchar(64) NOT NULL,
+    expires_at datetime NOT NULL,
+    revoked_at datetime,
+    created_at datetime,
+    PRIMARY KEY (id)
+);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_user_id ON refresh_tokens (user_id);
+CREATE INDEX IF NOT EXISTS idx_refresh_tokens_family_id ON refresh_tokens (family_id);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_refresh_tokens_token_hash ON refresh_tokens (token_hash);
@@ -0,0 +1,33 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment модель описания вложения для базы данных; содержимое хранится в BaseBlobStorage
+type Attachment struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	TaskID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	UploaderID     uuid.UUID `gorm:"type:uuid;not null;index"`
+	FileName       string    `gorm:"type:varchar(255);not null"`
+	ContentType    string    `gorm:"type:varchar(255);not null"`
+	Size           int64     `gorm:"not null"`
+	Checksum       string    `gorm:"type:char(64);not null"`
+	StorageKey     string    `gorm:"type:varchar(512);not null;uniqueIndex"`
+	CreatedAt      time.Time
+
+	// Organization связь с организацией; задает внешний ключ attachments.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// Task связь с задачей; задает внешний ключ attachments.task_id -> tasks.id
+	Task *Task `gorm:"foreignKey:TaskID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Uploader связь с загрузившим пользователем; задает внешний ключ attachments.uploader_id -> users.id
+	Uploader *User `gorm:"foreignKey:UploaderID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (Attachment) TableName() string {
+	return "attachments"
+}
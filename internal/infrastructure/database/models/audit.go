@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry модель записи журнала аудита. Записи только добавляются; ссылки на сущность
+// и автора изменения не являются внешними ключами, чтобы история переживала их удаление
+type AuditEntry struct {
+	ID             uuid.UUID          `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID          `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	EntityType     string             `gorm:"type:varchar(32);not null;index:idx_audit_entries_entity,priority:1"`
+	EntityID       uuid.UUID          `gorm:"type:uuid;not null;index:idx_audit_entries_entity,priority:2"`
+	Action         string             `gorm:"type:varchar(16);not null"`
+	ActorID        *uuid.UUID         `gorm:"type:uuid;index"`
+	Changes        []AuditFieldChange `gorm:"type:jsonb;not null;serializer:json"`
+	CreatedAt      time.Time          `gorm:"index"`
+
+	// Organization связь с организацией; задает внешний ключ audit_entries.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (AuditEntry) TableName() string {
+	return "audit_entries"
+}
+
+// AuditFieldChange изменение поля в JSON колонке changes
+type AuditFieldChange struct {
+	Field    string `json:"field"`
+	Old      any    `json:"old"`
+	New      any    `json:"new"`
+	Redacted bool   `json:"redacted,omitempty"`
+}
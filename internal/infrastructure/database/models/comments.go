@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Comment модель для базы данных. Комментарии удаляются физически: вместе с задачей
+// при ее окончательном удалении и вместе с родительским комментарием
+type Comment struct {
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID  `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	TaskID         uuid.UUID  `gorm:"type:uuid;not null;index:idx_comments_task_parent,priority:1"`
+	ParentID       *uuid.UUID `gorm:"type:uuid;index:idx_comments_task_parent,priority:2"`
+	AuthorID       uuid.UUID  `gorm:"type:uuid;not null;index"`
+	Body           string     `gorm:"type:text;not null"`
+	EditedAt       *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Organization связь с организацией; задает внешний ключ comments.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// Task связь с задачей; задает внешний ключ comments.task_id -> tasks.id
+	Task *Task `gorm:"foreignKey:TaskID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Parent связь с комментарием, на который дан ответ; задает внешний ключ comments.parent_id -> comments.id
+	Parent *Comment `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	// Author связь с автором; задает внешний ключ comments.author_id -> users.id
+	Author *User `gorm:"foreignKey:AuthorID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (Comment) TableName() string {
+	return "comments"
+}
+
+// CommentRevision модель предыдущей версии текста комментария
+type CommentRevision struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	CommentID uuid.UUID `gorm:"type:uuid;not null;index"`
+	Body      string    `gorm:"type:text;not null"`
+	CreatedAt time.Time
+
+	// Comment связь с комментарием; версии удаляются вместе с ним
+	Comment *Comment `gorm:"foreignKey:CommentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (CommentRevision) TableName() string {
+	return "comment_revisions"
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Label модель для базы данных
+type Label struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;uniqueIndex:idx_labels_organization_name,priority:1;default:'00000000-0000-0000-0000-000000000001'"`
+	Name           string    `gorm:"type:varchar(50);not null;uniqueIndex:idx_labels_organization_name,priority:2"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+
+	// Organization связь с организацией; задает внешний ключ labels.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (Label) TableName() string {
+	return "labels"
+}
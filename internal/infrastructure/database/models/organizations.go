@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Organization модель для базы данных.
+// Столбцы organization_id остальных таблиц по умолчанию указывают на организацию по умолчанию,
+// чтобы существующие строки попали в нее при миграции; новые строки получают организацию
+// из контекста запроса через плагин tenancy.OrganizationScope
+type Organization struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	Name      string    `gorm:"type:varchar(100);not null"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TableName указывает имя таблицы для GORM
+func (Organization) TableName() string {
+	return "organizations"
+}
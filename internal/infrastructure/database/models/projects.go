@@ -0,0 +1,30 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Project модель для базы данных
+type Project struct {
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;index"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Description    string    `gorm:"type:text"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+
+	// Organization связь с организацией; задает внешний ключ projects.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// User связь с владельцем; задает внешний ключ projects.user_id -> users.id
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (Project) TableName() string {
+	return "projects"
+}
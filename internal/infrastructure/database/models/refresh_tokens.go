@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RefreshToken модель для базы данных
+type RefreshToken struct {
+	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	UserID    uuid.UUID `gorm:"type:uuid;not null;index"`
+	FamilyID  uuid.UUID `gorm:"type:uuid;not null;index"`
+	TokenHash string    `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt time.Time `gorm:"not null"`
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// TableName указывает имя таблицы для GORM
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
@@ -9,17 +9,65 @@ import (
 
 // Task модель для базы данных
 type Task struct {
-	ID          uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	UserID      uuid.UUID `gorm:"type:uuid;not null;index"`
-	Title       string    `gorm:"type:varchar(200);not null"`
-	Description string    `gorm:"type:text"`
-	Status      string    `gorm:"type:varchar(20);not null;default:'todo';index"`
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
-	DeletedAt   gorm.DeletedAt `gorm:"index"`
+	ID             uuid.UUID  `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID  `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	UserID         uuid.UUID  `gorm:"type:uuid;not null;index"`
+	ProjectID      *uuid.UUID `gorm:"type:uuid;index"`
+	ParentID       *uuid.UUID `gorm:"type:uuid;index"`
+	Title          string     `gorm:"type:varchar(200);not null"`
+	Description    string     `gorm:"type:text"`
+	Status         string     `gorm:"type:varchar(20);not null;default:'todo';index"`
+	Priority       string     `gorm:"type:varchar(10);not null;default:'medium';index"`
+	StartDate      *time.Time
+	DueDate        *time.Time `gorm:"index"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	Version        int64          `gorm:"not null;default:1"`
+
+	// Organization связь с организацией; задает внешний ключ tasks.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// User связь с владельцем; задает внешний ключ tasks.user_id -> users.id
+	User *User `gorm:"foreignKey:UserID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// Project связь с проектом; задает внешний ключ tasks.project_id -> projects.id
+	Project *Project `gorm:"foreignKey:ProjectID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
+	// Parent связь с родительской задачей; задает внешний ключ tasks.parent_id -> tasks.id
+	Parent *Task `gorm:"foreignKey:ParentID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
 
 // TableName указывает имя таблицы для GORM
 func (Task) TableName() string {
 	return "tasks"
 }
+
+// TaskLabel модель связи многие-ко-многим между задачами и метками
+type TaskLabel struct {
+	TaskID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	LabelID   uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt time.Time
+
+	// Task и Label задают внешние ключи; связи удаляются вместе с меткой
+	Task  *Task  `gorm:"foreignKey:TaskID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Label *Label `gorm:"foreignKey:LabelID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (TaskLabel) TableName() string {
+	return "task_labels"
+}
+
+// TaskDependency модель зависимости: задача TaskID не может начаться, пока не завершена BlockerID
+type TaskDependency struct {
+	TaskID    uuid.UUID `gorm:"type:uuid;primaryKey"`
+	BlockerID uuid.UUID `gorm:"type:uuid;primaryKey;index"`
+	CreatedAt time.Time
+
+	// Task и Blocker задают внешние ключи на задачи
+	Task    *Task `gorm:"foreignKey:TaskID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Blocker *Task `gorm:"foreignKey:BlockerID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// TableName указывает имя таблицы для GORM
+func (TaskDependency) TableName() string {
+	return "task_dependencies"
+}
@@ -9,12 +9,19 @@ import (
 
 // User модель для базы данных
 type User struct {
-	ID        uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
-	Email     string    `gorm:"type:varchar(255);uniqueIndex;not null"`
-	Name      string    `gorm:"type:varchar(100);not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt gorm.DeletedAt `gorm:"index"`
+	ID             uuid.UUID `gorm:"type:uuid;primary_key;default:gen_random_uuid()"`
+	OrganizationID uuid.UUID `gorm:"type:uuid;not null;index;default:'00000000-0000-0000-0000-000000000001'"`
+	Email          string    `gorm:"type:varchar(255);uniqueIndex;not null"`
+	Name           string    `gorm:"type:varchar(100);not null"`
+	Role           string    `gorm:"type:varchar(20);not null;default:'member';index"`
+	PasswordHash   string    `gorm:"type:varchar(255);not null;default:''"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	Version        int64          `gorm:"not null;default:1"`
+
+	// Organization связь с организацией; задает внешний ключ users.organization_id -> organizations.id
+	Organization *Organization `gorm:"foreignKey:OrganizationID;constraint:OnUpdate:CASCADE,OnDelete:RESTRICT"`
 }
 
 // TableName указывает имя таблицы для GORM
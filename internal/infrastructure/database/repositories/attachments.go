@@ -0,0 +1,88 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"crud/internal/domain/attachments"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// AttachmentsRepository GORM реализация репозитория вложений
+type AttachmentsRepository struct {
+	db *gorm.DB
+}
+
+// NewAttachmentsRepository создает новый GORM репозиторий вложений
+func NewAttachmentsRepository(db *gorm.DB) *AttachmentsRepository {
+	return &AttachmentsRepository{db: db}
+}
+
+// Create сохраняет описание вложения
+func (r *AttachmentsRepository) Create(ctx context.Context, attachment *attachments.Attachment) (*attachments.Attachment, error) {
+	if attachment == nil {
+		return nil, &attachments.InvalidAttachmentDataError{Field: "attachment", Message: "attachment cannot be nil"}
+	}
+
+	model := converters.AttachmentEntityToModel(attachment)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return nil, &attachments.InvalidAttachmentDataError{Message: "task or uploader does not exist"}
+		}
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	return converters.AttachmentModelToEntity(model)
+}
+
+// GetByID возвращает вложение по ID
+func (r *AttachmentsRepository) GetByID(ctx context.Context, id uuid.UUID) (*attachments.Attachment, error) {
+	var model models.Attachment
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &attachments.AttachmentNotFoundError{AttachmentID: id}
+		}
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "get_by_id", Reason: err.Error()}
+	}
+
+	return converters.AttachmentModelToEntity(&model)
+}
+
+// ListByTask возвращает вложения задачи в порядке загрузки
+func (r *AttachmentsRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*attachments.Attachment, error) {
+	var attachmentModels []*models.Attachment
+	if err := dbFromContext(ctx, r.db).
+		Where("task_id = ?", taskID).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&attachmentModels).Error; err != nil {
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "list_by_task", Reason: err.Error()}
+	}
+
+	domainAttachments := make([]*attachments.Attachment, 0, len(attachmentModels))
+	for _, model := range attachmentModels {
+		attachment, err := converters.AttachmentModelToEntity(model)
+		if err != nil {
+			return nil, &attachments.AttachmentOperationFailedError{Operation: "list_convert", Reason: err.Error()}
+		}
+		domainAttachments = append(domainAttachments, attachment)
+	}
+
+	return domainAttachments, nil
+}
+
+// Delete удаляет описание вложения по ID
+func (r *AttachmentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Delete(&models.Attachment{}, "id = ?", id)
+	if result.Error != nil {
+		return &attachments.AttachmentOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &attachments.AttachmentNotFoundError{AttachmentID: id}
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package repositories
+
+import (
+	"context"
+
+	"crud/internal/domain/audit"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"gorm.io/gorm"
+)
+
+// AuditRepository GORM реализация журнала аудита
+type AuditRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditRepository создает новый GORM репозиторий журнала аудита
+func NewAuditRepository(db *gorm.DB) *AuditRepository {
+	return &AuditRepository{db: db}
+}
+
+// Create добавляет запись в журнал; внутри транзакции запись сохраняется вместе с изменением
+func (r *AuditRepository) Create(ctx context.Context, entry *audit.Entry) error {
+	model := converters.AuditEntryEntityToModel(entry)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		return &audit.AuditOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	entry.OrganizationID = model.OrganizationID
+	return nil
+}
+
+// List возвращает записи журнала от новых к старым с пагинацией
+func (r *AuditRepository) List(
+	ctx context.Context,
+	filter audit.ListEntriesFilter,
+	page, pageSize int,
+) ([]*audit.Entry, int64, error) {
+	var entryModels []*models.AuditEntry
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&models.AuditEntry{})
+	if filter.EntityType != nil {
+		query = query.Where("entity_type = ?", string(*filter.EntityType))
+	}
+	if filter.EntityID != nil {
+		query = query.Where("entity_id = ?", *filter.EntityID)
+	}
+	if filter.ActorID != nil {
+		query = query.Where("actor_id = ?", *filter.ActorID)
+	}
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+	if filter.To != nil {
+		query = query.Where("created_at < ?", *filter.To)
+	}
+
+	// Подсчет общего количества с учетом фильтров
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, &audit.AuditOperationFailedError{Operation: "list_count", Reason: err.Error()}
+	}
+
+	// Получение данных с пагинацией
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Order("created_at DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&entryModels).Error; err != nil {
+		return nil, 0, &audit.AuditOperationFailedError{Operation: "list", Reason: err.Error()}
+	}
+
+	entries := make([]*audit.Entry, 0, len(entryModels))
+	for _, model := range entryModels {
+		entries = append(entries, converters.AuditEntryModelToEntity(model))
+	}
+
+	return entries, total, nil
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"crud/internal/domain/comments"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// CommentsRepository GORM реализация репозитория комментариев
+type CommentsRepository struct {
+	db *gorm.DB
+}
+
+// NewCommentsRepository создает новый GORM репозиторий комментариев
+func NewCommentsRepository(db *gorm.DB) *CommentsRepository {
+	return &CommentsRepository{db: db}
+}
+
+// Create создает новый комментарий
+func (r *CommentsRepository) Create(ctx context.Context, comment *comments.Comment) (*comments.Comment, error) {
+	if comment == nil {
+		return nil, &comments.InvalidCommentDataError{Field: "comment", Message: "comment cannot be nil"}
+	}
+
+	model := converters.CommentEntityToModel(comment)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return nil, &comments.InvalidCommentDataError{Message: "task, author or parent comment does not exist"}
+		}
+		return nil, &comments.CommentOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	return converters.CommentModelToEntity(model)
+}
+
+// GetByID возвращает комментарий по ID
+func (r *CommentsRepository) GetByID(ctx context.Context, id uuid.UUID) (*comments.Comment, error) {
+	var model models.Comment
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &comments.CommentNotFoundError{CommentID: id}
+		}
+		return nil, &comments.CommentOperationFailedError{Operation: "get_by_id", Reason: err.Error()}
+	}
+
+	return converters.CommentModelToEntity(&model)
+}
+
+// List возвращает комментарии задачи в порядке создания с пагинацией
+func (r *CommentsRepository) List(
+	ctx context.Context,
+	filter comments.ListCommentsFilter,
+	page, pageSize int,
+) ([]*comments.Comment, int64, error) {
+	var commentModels []*models.Comment
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&models.Comment{}).Where("task_id = ?", filter.TaskID)
+	if filter.ParentID != nil {
+		query = query.Where("parent_id = ?", *filter.ParentID)
+	} else {
+		query = query.Where("parent_id IS NULL")
+	}
+
+	// Подсчет общего количества с учетом фильтров
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, &comments.CommentOperationFailedError{Operation: "list_count", Reason: err.Error()}
+	}
+
+	// Получение данных с пагинацией
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Order("created_at ASC").
+		Order("id ASC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&commentModels).Error; err != nil {
+		return nil, 0, &comments.CommentOperationFailedError{Operation: "list", Reason: err.Error()}
+	}
+
+	domainComments := make([]*comments.Comment, 0, len(commentModels))
+	for _, model := range commentModels {
+		comment, err := converters.CommentModelToEntity(model)
+		if err != nil {
+			return nil, 0, &comments.CommentOperationFailedError{Operation: "list_convert", Reason: err.Error()}
+		}
+		domainComments = append(domainComments, comment)
+	}
+
+	return domainComments, total, nil
+}
+
+// Update сохраняет новый текст комментария вместе с версией прежнего текста
+func (r *CommentsRepository) Update(
+	ctx context.Context,
+	comment *comments.Comment,
+	revision *comments.CommentRevision,
+) (*comments.Comment, error) {
+	if comment == nil {
+		return nil, &comments.InvalidCommentDataError{Field: "comment", Message: "comment cannot be nil"}
+	}
+
+	model := converters.CommentEntityToModel(comment)
+	err := dbFromContext(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		// Меняются только текст и время изменения: задача, автор и родитель комментария неизменны
+		result := tx.Model(&models.Comment{}).
+			Where("id = ?", comment.ID).
+			Select("body", "edited_at", "updated_at").
+			Updates(model)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return &comments.CommentNotFoundError{CommentID: comment.ID}
+		}
+
+		if revision == nil {
+			return nil
+		}
+		return tx.Create(converters.CommentRevisionEntityToModel(revision)).Error
+	})
+	if err != nil {
+		if comments.IsCommentNotFound(err) {
+			return nil, err
+		}
+		return nil, &comments.CommentOperationFailedError{Operation: "update", Reason: err.Error()}
+	}
+
+	return r.GetByID(ctx, comment.ID)
+}
+
+// ListRevisions возвращает историю изменений комментария от старых версий к новым
+func (r *CommentsRepository) ListRevisions(ctx context.Context, commentID uuid.UUID) ([]*comments.CommentRevision, error) {
+	// Версии не хранят организацию, поэтому сначала проверяется доступ к самому комментарию
+	if _, err := r.GetByID(ctx, commentID); err != nil {
+		return nil, err
+	}
+
+	var revisionModels []*models.CommentRevision
+	if err := dbFromContext(ctx, r.db).
+		Where("comment_id = ?", commentID).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&revisionModels).Error; err != nil {
+		return nil, &comments.CommentOperationFailedError{Operation: "list_revisions", Reason: err.Error()}
+	}
+
+	revisions := make([]*comments.CommentRevision, len(revisionModels))
+	for i, model := range revisionModels {
+		revisions[i] = converters.CommentRevisionModelToEntity(model)
+	}
+
+	return revisions, nil
+}
+
+// Delete удаляет комментарий; ответы и версии удаляются внешними ключами ON DELETE CASCADE
+func (r *CommentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Delete(&models.Comment{}, "id = ?", id)
+	if result.Error != nil {
+		return &comments.CommentOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &comments.CommentNotFoundError{CommentID: id}
+	}
+	return nil
+}
+
+// DeleteByTask удаляет все комментарии задачи и возвращает их количество
+func (r *CommentsRepository) DeleteByTask(ctx context.Context, taskID uuid.UUID) (int64, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Comment{}, "task_id = ?", taskID)
+	if result.Error != nil {
+		return 0, &comments.CommentOperationFailedError{Operation: "delete_by_task", Reason: result.Error.Error()}
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,121 @@
+package dummy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"crud/internal/domain/attachments"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentsRepository in-memory реализация репозитория вложений
+type AttachmentsRepository struct {
+	mu          sync.RWMutex
+	attachments []*attachments.Attachment
+}
+
+// NewAttachmentsRepository создает новый in-memory репозиторий вложений
+func NewAttachmentsRepository() *AttachmentsRepository {
+	return &AttachmentsRepository{
+		attachments: make([]*attachments.Attachment, 0),
+	}
+}
+
+// Create сохраняет описание вложения
+func (r *AttachmentsRepository) Create(ctx context.Context, attachment *attachments.Attachment) (*attachments.Attachment, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if attachment == nil {
+		return nil, &attachments.InvalidAttachmentDataError{Field: "attachment", Message: "attachment cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&attachment.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	r.attachments = append(r.attachments, detach(attachment))
+	return attachment, nil
+}
+
+// GetByID возвращает вложение по ID
+func (r *AttachmentsRepository) GetByID(ctx context.Context, id uuid.UUID) (*attachments.Attachment, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, attachment := range r.attachments {
+		if attachment.ID == id && scope.contains(attachment.OrganizationID) {
+			return detach(attachment), nil
+		}
+	}
+
+	return nil, &attachments.AttachmentNotFoundError{AttachmentID: id}
+}
+
+// ListByTask возвращает вложения задачи в порядке загрузки
+func (r *AttachmentsRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*attachments.Attachment, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*attachments.Attachment, 0)
+	for _, attachment := range r.attachments {
+		if attachment.TaskID == taskID && scope.contains(attachment.OrganizationID) {
+			result = append(result, attachment)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CreatedAt.Before(result[j].CreatedAt)
+	})
+
+	return detachAll(result), nil
+}
+
+// Delete удаляет описание вложения по ID
+func (r *AttachmentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, attachment := range r.attachments {
+		if attachment.ID == id && scope.contains(attachment.OrganizationID) {
+			r.attachments = append(r.attachments[:i], r.attachments[i+1:]...)
+			return nil
+		}
+	}
+
+	return &attachments.AttachmentNotFoundError{AttachmentID: id}
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *AttachmentsRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует вложения и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *AttachmentsRepository) snapshot() func() {
+	saved := detachAll(r.attachments)
+	return func() {
+		r.attachments = saved
+	}
+}
@@ -0,0 +1,120 @@
+package dummy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"crud/internal/domain/audit"
+)
+
+// AuditRepository in-memory реализация журнала аудита
+type AuditRepository struct {
+	mu      sync.RWMutex
+	entries []*audit.Entry
+}
+
+// NewAuditRepository создает новый in-memory журнал аудита
+func NewAuditRepository() *AuditRepository {
+	return &AuditRepository{
+		entries: make([]*audit.Entry, 0),
+	}
+}
+
+// Create добавляет запись в журнал
+func (r *AuditRepository) Create(ctx context.Context, entry *audit.Entry) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := scope.assign(&entry.OrganizationID); err != nil {
+		return err
+	}
+
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+// List возвращает записи журнала от новых к старым с пагинацией
+func (r *AuditRepository) List(
+	ctx context.Context,
+	filter audit.ListEntriesFilter,
+	page, pageSize int,
+) ([]*audit.Entry, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := make([]*audit.Entry, 0)
+	for _, entry := range r.entries {
+		if scope.contains(entry.OrganizationID) && matchesAuditFilter(entry, filter) {
+			filtered = append(filtered, entry)
+		}
+	}
+	// Записи добавляются по порядку, поэтому обратный порядок - от новых к старым
+	for i, j := 0, len(filtered)-1; i < j; i, j = i+1, j-1 {
+		filtered[i], filtered[j] = filtered[j], filtered[i]
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.After(filtered[j].CreatedAt)
+	})
+
+	total := int64(len(filtered))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	if start >= len(filtered) {
+		return []*audit.Entry{}, total, nil
+	}
+
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *AuditRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует записи журнала и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *AuditRepository) snapshot() func() {
+	saved := append([]*audit.Entry(nil), r.entries...)
+	return func() {
+		r.entries = saved
+	}
+}
+
+// matchesAuditFilter проверяет запись по параметрам выборки
+func matchesAuditFilter(entry *audit.Entry, filter audit.ListEntriesFilter) bool {
+	if filter.EntityType != nil && entry.EntityType != *filter.EntityType {
+		return false
+	}
+	if filter.EntityID != nil && entry.EntityID != *filter.EntityID {
+		return false
+	}
+	if filter.ActorID != nil && (entry.ActorID == nil || *entry.ActorID != *filter.ActorID) {
+		return false
+	}
+	if filter.From != nil && entry.CreatedAt.Before(*filter.From) {
+		return false
+	}
+	if filter.To != nil && !entry.CreatedAt.Before(*filter.To) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,64 @@
+package dummy
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	"crud/internal/domain/attachments"
+)
+
+// BlobStorage in-memory реализация хранилища содержимого файлов
+type BlobStorage struct {
+	mu    sync.RWMutex
+	blobs map[string][]byte
+}
+
+// NewBlobStorage создает новое in-memory хранилище
+func NewBlobStorage() *BlobStorage {
+	return &BlobStorage{blobs: make(map[string][]byte)}
+}
+
+// Put сохраняет содержимое; при ошибке чтения хранилище не меняется
+func (s *BlobStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blobs[key] = content
+	return nil
+}
+
+// Get возвращает reader по копии содержимого
+func (s *BlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	content, ok := s.blobs[key]
+	if !ok {
+		return nil, &attachments.BlobNotFoundError{Key: key}
+	}
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+// Delete удаляет содержимое по ключу
+func (s *BlobStorage) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blobs, key)
+	return nil
+}
+
+// Len возвращает количество сохраненных файлов
+func (s *BlobStorage) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.blobs)
+}
@@ -0,0 +1,278 @@
+package dummy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"crud/internal/domain/comments"
+
+	"github.com/google/uuid"
+)
+
+// CommentsRepository in-memory реализация репозитория комментариев.
+// Существование задачи и автора проверяет use case: репозиторий задач сам зависит
+// от этого репозитория, чтобы удалять комментарии вместе с задачей
+type CommentsRepository struct {
+	mu        sync.RWMutex
+	comments  []*comments.Comment
+	revisions []*comments.CommentRevision
+}
+
+// NewCommentsRepository создает новый in-memory репозиторий комментариев
+func NewCommentsRepository() *CommentsRepository {
+	return &CommentsRepository{
+		comments:  make([]*comments.Comment, 0),
+		revisions: make([]*comments.CommentRevision, 0),
+	}
+}
+
+// Create создает новый комментарий
+func (r *CommentsRepository) Create(ctx context.Context, comment *comments.Comment) (*comments.Comment, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if comment == nil {
+		return nil, &comments.InvalidCommentDataError{Field: "comment", Message: "comment cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&comment.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	// Родительский комментарий должен существовать, как при внешнем ключе comments.parent_id
+	if comment.ParentID != nil && r.findByID(scope, *comment.ParentID) == nil {
+		return nil, &comments.InvalidCommentDataError{Message: "task, author or parent comment does not exist"}
+	}
+
+	r.comments = append(r.comments, detach(comment))
+	return comment, nil
+}
+
+// GetByID возвращает комментарий по ID
+func (r *CommentsRepository) GetByID(ctx context.Context, id uuid.UUID) (*comments.Comment, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := r.findByID(scope, id)
+	if comment == nil {
+		return nil, &comments.CommentNotFoundError{CommentID: id}
+	}
+	return detach(comment), nil
+}
+
+// List возвращает комментарии задачи в порядке создания с пагинацией
+func (r *CommentsRepository) List(
+	ctx context.Context,
+	filter comments.ListCommentsFilter,
+	page, pageSize int,
+) ([]*comments.Comment, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := make([]*comments.Comment, 0)
+	for _, comment := range r.comments {
+		if !scope.contains(comment.OrganizationID) || comment.TaskID != filter.TaskID {
+			continue
+		}
+		if filter.ParentID == nil && comment.ParentID == nil ||
+			filter.ParentID != nil && comment.ParentID != nil && *comment.ParentID == *filter.ParentID {
+			filtered = append(filtered, comment)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].CreatedAt.Before(filtered[j].CreatedAt)
+	})
+
+	total := int64(len(filtered))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	if start >= len(filtered) {
+		return []*comments.Comment{}, total, nil
+	}
+
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// Update сохраняет новый текст комментария вместе с версией прежнего текста
+func (r *CommentsRepository) Update(
+	ctx context.Context,
+	comment *comments.Comment,
+	revision *comments.CommentRevision,
+) (*comments.Comment, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if comment == nil {
+		return nil, &comments.InvalidCommentDataError{Field: "comment", Message: "comment cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, existing := range r.comments {
+		if existing.ID != comment.ID || !scope.contains(existing.OrganizationID) {
+			continue
+		}
+
+		// Меняются только текст и время изменения
+		updated := *existing
+		updated.Body = comment.Body
+		updated.EditedAt = comment.EditedAt
+		updated.UpdatedAt = comment.UpdatedAt
+		r.comments[i] = &updated
+
+		if revision != nil {
+			r.revisions = append(r.revisions, detach(revision))
+		}
+		return detach(&updated), nil
+	}
+
+	return nil, &comments.CommentNotFoundError{CommentID: comment.ID}
+}
+
+// ListRevisions возвращает историю изменений комментария от старых версий к новым
+func (r *CommentsRepository) ListRevisions(ctx context.Context, commentID uuid.UUID) ([]*comments.CommentRevision, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.findByID(scope, commentID) == nil {
+		return nil, &comments.CommentNotFoundError{CommentID: commentID}
+	}
+
+	revisions := make([]*comments.CommentRevision, 0)
+	for _, revision := range r.revisions {
+		if revision.CommentID == commentID {
+			revisions = append(revisions, revision)
+		}
+	}
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].CreatedAt.Before(revisions[j].CreatedAt)
+	})
+
+	return detachAll(revisions), nil
+}
+
+// Delete удаляет комментарий вместе со всеми ответами на него
+func (r *CommentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if r.findByID(scope, id) == nil {
+		return &comments.CommentNotFoundError{CommentID: id}
+	}
+
+	r.removeThreads(map[uuid.UUID]bool{id: true})
+	return nil
+}
+
+// DeleteByTask удаляет все комментарии задачи и возвращает их количество
+func (r *CommentsRepository) DeleteByTask(ctx context.Context, taskID uuid.UUID) (int64, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	roots := make(map[uuid.UUID]bool)
+	for _, comment := range r.comments {
+		if comment.TaskID == taskID && scope.contains(comment.OrganizationID) {
+			roots[comment.ID] = true
+		}
+	}
+
+	return r.removeThreads(roots), nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *CommentsRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует комментарии и их историю и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *CommentsRepository) snapshot() func() {
+	saved := detachAll(r.comments)
+	revisions := detachAll(r.revisions)
+	return func() {
+		r.comments = saved
+		r.revisions = revisions
+	}
+}
+
+// findByID ищет комментарий, видимый в организации запроса
+func (r *CommentsRepository) findByID(scope organizationScope, id uuid.UUID) *comments.Comment {
+	for _, comment := range r.comments {
+		if comment.ID == id && scope.contains(comment.OrganizationID) {
+			return comment
+		}
+	}
+	return nil
+}
+
+// removeThreads удаляет комментарии ids, ответы на них на любой глубине и их версии,
+// как внешние ключи ON DELETE CASCADE, и возвращает количество удаленных комментариев
+func (r *CommentsRepository) removeThreads(ids map[uuid.UUID]bool) int64 {
+	// Ответы создаются после родителя, поэтому один проход по порядку создания находит все поддерево
+	for _, comment := range r.comments {
+		if comment.ParentID != nil && ids[*comment.ParentID] {
+			ids[comment.ID] = true
+		}
+	}
+
+	kept := r.comments[:0]
+	for _, comment := range r.comments {
+		if !ids[comment.ID] {
+			kept = append(kept, comment)
+		}
+	}
+	removed := int64(len(r.comments) - len(kept))
+	r.comments = kept
+
+	keptRevisions := r.revisions[:0]
+	for _, revision := range r.revisions {
+		if !ids[revision.CommentID] {
+			keptRevisions = append(keptRevisions, revision)
+		}
+	}
+	r.revisions = keptRevisions
+
+	return removed
+}
@@ -0,0 +1,259 @@
+package dummy
+
+import (
+	"context"
+	"maps"
+	"sort"
+	"sync"
+
+	"crud/internal/domain/labels"
+
+	"github.com/google/uuid"
+)
+
+// LabelsRepository in-memory реализация репозитория меток.
+// Связи задач с метками хранятся как множество ID меток для каждой задачи
+type LabelsRepository struct {
+	mu         sync.RWMutex
+	labels     []*labels.Label
+	taskLabels map[uuid.UUID]map[uuid.UUID]struct{}
+}
+
+// NewLabelsRepository создает новый in-memory репозиторий меток
+func NewLabelsRepository() *LabelsRepository {
+	return &LabelsRepository{
+		labels:     make([]*labels.Label, 0),
+		taskLabels: make(map[uuid.UUID]map[uuid.UUID]struct{}),
+	}
+}
+
+// Create создает новую метку
+func (r *LabelsRepository) Create(ctx context.Context, label *labels.Label) (*labels.Label, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if label == nil {
+		return nil, &labels.InvalidLabelDataError{Field: "label", Message: "label cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&label.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if r.nameTaken(label) {
+		return nil, &labels.LabelAlreadyExistsError{Name: label.Name.Value()}
+	}
+
+	r.labels = append(r.labels, detach(label))
+	return label, nil
+}
+
+// GetByID возвращает метку по ID
+func (r *LabelsRepository) GetByID(ctx context.Context, id uuid.UUID) (*labels.Label, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, label := range r.labels {
+		if label.ID == id && scope.contains(label.OrganizationID) {
+			return detach(label), nil
+		}
+	}
+
+	return nil, &labels.LabelNotFoundError{LabelID: id}
+}
+
+// List возвращает список меток, упорядоченный по имени, с пагинацией
+func (r *LabelsRepository) List(ctx context.Context, page, pageSize int) ([]*labels.Label, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var visible []*labels.Label
+	for _, label := range r.labels {
+		if scope.contains(label.OrganizationID) {
+			visible = append(visible, label)
+		}
+	}
+
+	sorted := sortedLabels(visible)
+	total := int64(len(sorted))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(sorted) {
+		end = len(sorted)
+	}
+
+	if start >= len(sorted) {
+		return []*labels.Label{}, total, nil
+	}
+
+	return detachAll(sorted[start:end]), total, nil
+}
+
+// Update обновляет данные метки
+func (r *LabelsRepository) Update(ctx context.Context, label *labels.Label) (*labels.Label, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if label == nil {
+		return nil, &labels.InvalidLabelDataError{Field: "label", Message: "label cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, l := range r.labels {
+		if l.ID == label.ID && scope.contains(l.OrganizationID) {
+			label.OrganizationID = l.OrganizationID
+			if r.nameTaken(label) {
+				return nil, &labels.LabelAlreadyExistsError{Name: label.Name.Value()}
+			}
+			r.labels[i] = detach(label)
+			return label, nil
+		}
+	}
+
+	return nil, &labels.LabelNotFoundError{LabelID: label.ID}
+}
+
+// Delete удаляет метку по ID вместе с ее связями с задачами
+func (r *LabelsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, label := range r.labels {
+		if label.ID == id && scope.contains(label.OrganizationID) {
+			r.labels = append(r.labels[:i], r.labels[i+1:]...)
+			for _, labelIDs := range r.taskLabels {
+				delete(labelIDs, id)
+			}
+			return nil
+		}
+	}
+
+	return &labels.LabelNotFoundError{LabelID: id}
+}
+
+// ListByTask возвращает метки задачи, упорядоченные по имени
+func (r *LabelsRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*labels.Label, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labelIDs := r.taskLabels[taskID]
+	result := make([]*labels.Label, 0, len(labelIDs))
+	for _, label := range r.labels {
+		if _, ok := labelIDs[label.ID]; ok && scope.contains(label.OrganizationID) {
+			result = append(result, label)
+		}
+	}
+
+	return detachAll(sortedLabels(result)), nil
+}
+
+// AttachToTask добавляет метку к задаче; повторное добавление ничего не меняет
+func (r *LabelsRepository) AttachToTask(ctx context.Context, taskID, labelID uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Проверяем существование метки, как это делает внешний ключ в БД
+	found := false
+	for _, label := range r.labels {
+		if label.ID == labelID && scope.contains(label.OrganizationID) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return &labels.LabelNotFoundError{LabelID: labelID}
+	}
+
+	if r.taskLabels[taskID] == nil {
+		r.taskLabels[taskID] = make(map[uuid.UUID]struct{})
+	}
+	r.taskLabels[taskID][labelID] = struct{}{}
+	return nil
+}
+
+// DetachFromTask снимает метку с задачи; снятие отсутствующей метки ничего не меняет
+func (r *LabelsRepository) DetachFromTask(ctx context.Context, taskID, labelID uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	delete(r.taskLabels[taskID], labelID)
+	return nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *LabelsRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует метки и их связи с задачами и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *LabelsRepository) snapshot() func() {
+	saved := detachAll(r.labels)
+	taskLabels := make(map[uuid.UUID]map[uuid.UUID]struct{}, len(r.taskLabels))
+	for taskID, labelIDs := range r.taskLabels {
+		taskLabels[taskID] = maps.Clone(labelIDs)
+	}
+	return func() {
+		r.labels = saved
+		r.taskLabels = taskLabels
+	}
+}
+
+// nameTaken проверяет, занято ли имя метки другой меткой той же организации,
+// как это делает уникальный индекс в БД
+func (r *LabelsRepository) nameTaken(label *labels.Label) bool {
+	for _, l := range r.labels {
+		if l.ID != label.ID && l.OrganizationID == label.OrganizationID && l.Name.Equals(label.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedLabels возвращает копию списка меток, упорядоченную по имени
+func sortedLabels(list []*labels.Label) []*labels.Label {
+	sorted := make([]*labels.Label, len(list))
+	copy(sorted, list)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name.Value() < sorted[j].Name.Value()
+	})
+	return sorted
+}
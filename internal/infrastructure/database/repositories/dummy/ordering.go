@@ -0,0 +1,30 @@
+package dummy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// createdEarlier упорядочивает строки так же, как ORDER BY created_at ASC, id ASC
+func createdEarlier(left, right time.Time, leftID, rightID uuid.UUID) bool {
+	if !left.Equal(right) {
+		return left.Before(right)
+	}
+	return leftID.String() < rightID.String()
+}
+
+// cursorWindow возвращает limit строк упорядоченного списка, ближайших к позиции курсора:
+// первые при выводе вперед и последние при выводе назад
+func cursorWindow[T any](list []T, backward bool, limit int) []T {
+	if limit < 0 {
+		limit = 0
+	}
+	if len(list) <= limit {
+		return append(make([]T, 0, len(list)), list...)
+	}
+	if backward {
+		return list[len(list)-limit:]
+	}
+	return list[:limit]
+}
@@ -0,0 +1,54 @@
+package dummy
+
+import (
+	"context"
+	"time"
+
+	"crud/internal/domain/organizations"
+
+	"github.com/google/uuid"
+)
+
+// organizationScope ограничивает in-memory данные организацией запроса так же,
+// как плагин tenancy.OrganizationScope ограничивает SQL запросы
+type organizationScope struct {
+	organizationID uuid.UUID
+	scoped         bool
+}
+
+// scopeFromContext возвращает ограничение для организации из контекста
+func scopeFromContext(ctx context.Context) (organizationScope, error) {
+	organizationID, scoped, err := organizations.ScopeFromContext(ctx)
+	if err != nil {
+		return organizationScope{}, err
+	}
+	return organizationScope{organizationID: organizationID, scoped: scoped}, nil
+}
+
+// contains проверяет, видна ли в запросе строка организации organizationID
+func (s organizationScope) contains(organizationID uuid.UUID) bool {
+	return !s.scoped || organizationID == s.organizationID
+}
+
+// active проверяет, видна ли в запросе строка организации organizationID, которая не лежит в корзине,
+// как это делает условие deleted_at IS NULL, добавляемое GORM к запросам
+func (s organizationScope) active(organizationID uuid.UUID, deletedAt *time.Time) bool {
+	return deletedAt == nil && s.contains(organizationID)
+}
+
+// deleted проверяет, видна ли в запросе строка организации organizationID из корзины
+func (s organizationScope) deleted(organizationID uuid.UUID, deletedAt *time.Time) bool {
+	return deletedAt != nil && s.contains(organizationID)
+}
+
+// assign заполняет организацию новой строки; системная операция должна указать ее явно
+func (s organizationScope) assign(organizationID *uuid.UUID) error {
+	if s.scoped {
+		*organizationID = s.organizationID
+		return nil
+	}
+	if *organizationID == uuid.Nil {
+		return &organizations.OrganizationRequiredError{}
+	}
+	return nil
+}
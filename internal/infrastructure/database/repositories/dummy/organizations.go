@@ -0,0 +1,114 @@
+package dummy
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"crud/internal/domain/organizations"
+	vo "crud/internal/domain/organizations/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationsRepository in-memory реализация репозитория организаций.
+// Организация по умолчанию создается сразу, как это делает миграция БД
+type OrganizationsRepository struct {
+	mu            sync.RWMutex
+	organizations []*organizations.Organization
+}
+
+// NewOrganizationsRepository создает новый in-memory репозиторий организаций
+func NewOrganizationsRepository() *OrganizationsRepository {
+	name, err := vo.NewOrganizationNameValueObject(organizations.DefaultOrganizationName)
+	if err != nil {
+		panic(err)
+	}
+
+	now := time.Now()
+	return &OrganizationsRepository{
+		organizations: []*organizations.Organization{{
+			ID:        organizations.DefaultOrganizationID,
+			Name:      name,
+			CreatedAt: now,
+			UpdatedAt: now,
+		}},
+	}
+}
+
+// Create создает новую организацию
+func (r *OrganizationsRepository) Create(ctx context.Context, organization *organizations.Organization) (*organizations.Organization, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if organization == nil {
+		return nil, &organizations.InvalidOrganizationDataError{Field: "organization", Message: "organization cannot be nil"}
+	}
+
+	for _, o := range r.organizations {
+		if o.ID == organization.ID {
+			return nil, &organizations.OrganizationOperationFailedError{Operation: "create", Reason: "duplicate organization ID"}
+		}
+	}
+
+	r.organizations = append(r.organizations, detach(organization))
+	return organization, nil
+}
+
+// GetByID возвращает организацию по ID
+func (r *OrganizationsRepository) GetByID(ctx context.Context, id uuid.UUID) (*organizations.Organization, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	for _, organization := range r.organizations {
+		if organization.ID == id {
+			return detach(organization), nil
+		}
+	}
+
+	return nil, &organizations.OrganizationNotFoundError{OrganizationID: id}
+}
+
+// List возвращает список организаций, упорядоченный по имени, с пагинацией
+func (r *OrganizationsRepository) List(ctx context.Context, page, pageSize int) ([]*organizations.Organization, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	sorted := make([]*organizations.Organization, len(r.organizations))
+	copy(sorted, r.organizations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Name.Value() < sorted[j].Name.Value()
+	})
+	total := int64(len(sorted))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(sorted) {
+		end = len(sorted)
+	}
+
+	if start >= len(sorted) {
+		return []*organizations.Organization{}, total, nil
+	}
+
+	return detachAll(sorted[start:end]), total, nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *OrganizationsRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует организации и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *OrganizationsRepository) snapshot() func() {
+	saved := detachAll(r.organizations)
+	return func() {
+		r.organizations = saved
+	}
+}
@@ -0,0 +1,247 @@
+package dummy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"crud/internal/domain/projects"
+	"crud/internal/domain/users"
+
+	"github.com/google/uuid"
+)
+
+// ProjectsRepository in-memory реализация репозитория проектов.
+// Репозиторий пользователей используется вместо внешнего ключа projects.user_id -> users.id
+type ProjectsRepository struct {
+	mu       sync.RWMutex
+	projects []*projects.Project
+	users    users.BaseUsersRepository
+}
+
+// NewProjectsRepository создает новый in-memory репозиторий проектов
+func NewProjectsRepository(usersRepo users.BaseUsersRepository) *ProjectsRepository {
+	return &ProjectsRepository{
+		projects: make([]*projects.Project, 0),
+		users:    usersRepo,
+	}
+}
+
+// Create создает новый проект
+func (r *ProjectsRepository) Create(ctx context.Context, project *projects.Project) (*projects.Project, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if project == nil {
+		return nil, &projects.InvalidProjectDataError{Field: "project", Message: "project cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&project.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	// Проверяем, не существует ли уже проект с таким ID
+	for _, p := range r.projects {
+		if p.ID == project.ID {
+			return nil, &projects.ProjectAlreadyExistsError{ProjectID: project.ID}
+		}
+	}
+
+	if err := r.checkOwner(ctx, project.UserID); err != nil {
+		return nil, err
+	}
+
+	r.projects = append(r.projects, detach(project))
+	return project, nil
+}
+
+// GetByID возвращает проект по ID
+func (r *ProjectsRepository) GetByID(ctx context.Context, id uuid.UUID) (*projects.Project, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, project := range r.projects {
+		if project.ID == id && scope.contains(project.OrganizationID) {
+			return detach(project), nil
+		}
+	}
+
+	return nil, &projects.ProjectNotFoundError{ProjectID: id}
+}
+
+// List возвращает список проектов, упорядоченный по имени, с фильтрацией и пагинацией
+func (r *ProjectsRepository) List(
+	ctx context.Context,
+	filter projects.ListProjectsFilter,
+	page, pageSize int,
+) ([]*projects.Project, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered := make([]*projects.Project, 0, len(r.projects))
+	for _, project := range r.projects {
+		if !scope.contains(project.OrganizationID) {
+			continue
+		}
+		if filter.UserID == nil || project.UserID == *filter.UserID {
+			filtered = append(filtered, project)
+		}
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return filtered[i].Name.Value() < filtered[j].Name.Value()
+	})
+
+	total := int64(len(filtered))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	if start >= len(filtered) {
+		return []*projects.Project{}, total, nil
+	}
+
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// Update обновляет данные проекта
+func (r *ProjectsRepository) Update(ctx context.Context, project *projects.Project) (*projects.Project, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if project == nil {
+		return nil, &projects.InvalidProjectDataError{Field: "project", Message: "project cannot be nil"}
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range r.projects {
+		if p.ID == project.ID && scope.contains(p.OrganizationID) {
+			project.OrganizationID = p.OrganizationID
+			if p.UserID != project.UserID {
+				if err := r.checkOwner(ctx, project.UserID); err != nil {
+					return nil, err
+				}
+			}
+			r.projects[i] = detach(project)
+			return project, nil
+		}
+	}
+
+	return nil, &projects.ProjectNotFoundError{ProjectID: project.ID}
+}
+
+// Delete удаляет проект по ID
+func (r *ProjectsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, project := range r.projects {
+		if project.ID == id && scope.contains(project.OrganizationID) {
+			r.projects = append(r.projects[:i], r.projects[i+1:]...)
+			return nil
+		}
+	}
+
+	return &projects.ProjectNotFoundError{ProjectID: id}
+}
+
+// DeleteByUser удаляет все проекты пользователя и возвращает их количество
+func (r *ProjectsRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	remaining := make([]*projects.Project, 0, len(r.projects))
+	for _, project := range r.projects {
+		if project.UserID != userID || !scope.contains(project.OrganizationID) {
+			remaining = append(remaining, project)
+		}
+	}
+
+	deleted := int64(len(r.projects) - len(remaining))
+	r.projects = remaining
+	return deleted, nil
+}
+
+// ReassignOwner передает все проекты пользователя fromUserID пользователю toUserID
+func (r *ProjectsRepository) ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if err := r.checkOwner(ctx, toUserID); err != nil {
+		return 0, err
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var reassigned int64
+	for _, project := range r.projects {
+		if project.UserID == fromUserID && scope.contains(project.OrganizationID) {
+			project.UserID = toUserID
+			reassigned++
+		}
+	}
+
+	return reassigned, nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *ProjectsRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует проекты и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *ProjectsRepository) snapshot() func() {
+	saved := detachAll(r.projects)
+	return func() {
+		r.projects = saved
+	}
+}
+
+// checkOwner проверяет существование владельца проекта, как это делает внешний ключ в БД
+func (r *ProjectsRepository) checkOwner(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.users.GetByID(ctx, userID); err != nil {
+		if users.IsUserNotFound(err) {
+			return &projects.ProjectOwnerNotFoundError{UserID: userID}
+		}
+		return &projects.ProjectOperationFailedError{Operation: "check_owner", Reason: err.Error()}
+	}
+	return nil
+}
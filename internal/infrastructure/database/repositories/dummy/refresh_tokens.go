@@ -0,0 +1,102 @@
+package dummy
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"crud/internal/domain/auth"
+
+	"github.com/google/uuid"
+)
+
+// RefreshTokensRepository in-memory реализация репозитория refresh токенов
+type RefreshTokensRepository struct {
+	mu     sync.RWMutex
+	tokens []*auth.RefreshToken
+}
+
+// NewRefreshTokensRepository создает новый in-memory репозиторий refresh токенов
+func NewRefreshTokensRepository() *RefreshTokensRepository {
+	return &RefreshTokensRepository{
+		tokens: make([]*auth.RefreshToken, 0),
+	}
+}
+
+// Create сохраняет новый refresh токен
+func (r *RefreshTokensRepository) Create(ctx context.Context, token *auth.RefreshToken) (*auth.RefreshToken, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if token == nil {
+		return nil, &auth.AuthOperationFailedError{Operation: "create_refresh_token", Reason: "token cannot be nil"}
+	}
+
+	stored := *token
+	r.tokens = append(r.tokens, &stored)
+	return token, nil
+}
+
+// GetByTokenHash возвращает refresh токен по хешу
+func (r *RefreshTokensRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*auth.RefreshToken, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			found := *token
+			return &found, nil
+		}
+	}
+
+	return nil, &auth.InvalidTokenError{Reason: "refresh token not found"}
+}
+
+// Revoke отзывает refresh токен по ID
+func (r *RefreshTokensRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	if r.revokeWhere(ctx, func(token *auth.RefreshToken) bool { return token.ID == id }) == 0 {
+		return &auth.InvalidTokenError{Reason: "refresh token already revoked"}
+	}
+	return nil
+}
+
+// RevokeFamily отзывает все токены цепочки ротаций
+func (r *RefreshTokensRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	r.revokeWhere(ctx, func(token *auth.RefreshToken) bool { return token.FamilyID == familyID })
+	return nil
+}
+
+// RevokeAllForUser отзывает все токены пользователя
+func (r *RefreshTokensRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
+	r.revokeWhere(ctx, func(token *auth.RefreshToken) bool { return token.UserID == userID })
+	return nil
+}
+
+func (r *RefreshTokensRepository) revokeWhere(ctx context.Context, match func(token *auth.RefreshToken) bool) int {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	now := time.Now()
+	revoked := 0
+	for _, token := range r.tokens {
+		if token.RevokedAt == nil && match(token) {
+			token.RevokedAt = &now
+			revoked++
+		}
+	}
+	return revoked
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *RefreshTokensRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует refresh токены и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *RefreshTokensRepository) snapshot() func() {
+	saved := detachAll(r.tokens)
+	return func() {
+		r.tokens = saved
+	}
+}
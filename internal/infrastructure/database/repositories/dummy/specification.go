@@ -0,0 +1,172 @@
+package dummy
+
+import (
+	"cmp"
+	"sort"
+	"strings"
+	"time"
+
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// matchesSpecification проверяет задачу на соответствие условию языка фильтров так же,
+// как это делает условие WHERE, в которое его переводит GORM репозиторий
+func matchesSpecification(task *tasks.Task, spec tasks.TaskSpecification) bool {
+	switch spec := spec.(type) {
+	case tasks.AllOfSpecification:
+		for _, operand := range spec.Operands {
+			if !matchesSpecification(task, operand) {
+				return false
+			}
+		}
+		return true
+	case tasks.AnyOfSpecification:
+		for _, operand := range spec.Operands {
+			if matchesSpecification(task, operand) {
+				return true
+			}
+		}
+		return false
+	case tasks.ComparisonSpecification:
+		return matchesComparison(task, spec)
+	default:
+		return false
+	}
+}
+
+// matchesComparison сравнивает поле задачи со значениями; как и NULL в SQL, отсутствующее
+// значение поля не удовлетворяет ни одному сравнению
+func matchesComparison(task *tasks.Task, spec tasks.ComparisonSpecification) bool {
+	value, ok := taskFieldValue(task, spec.Field)
+	if !ok || len(spec.Values) == 0 {
+		return false
+	}
+
+	switch spec.Operator {
+	case tasks.FilterIn:
+		for _, candidate := range spec.Values {
+			if compareFieldValues(spec.Field, value, candidate) == 0 {
+				return true
+			}
+		}
+		return false
+	case tasks.FilterContains:
+		text, _ := value.(string)
+		needle, _ := spec.Values[0].(string)
+		return strings.Contains(strings.ToLower(text), strings.ToLower(needle))
+	}
+
+	result := compareFieldValues(spec.Field, value, spec.Values[0])
+	switch spec.Operator {
+	case tasks.FilterEqual:
+		return result == 0
+	case tasks.FilterNotEqual:
+		return result != 0
+	case tasks.FilterLess:
+		return result < 0
+	case tasks.FilterLessOrEqual:
+		return result <= 0
+	case tasks.FilterGreater:
+		return result > 0
+	case tasks.FilterGreaterOrEqual:
+		return result >= 0
+	default:
+		return false
+	}
+}
+
+// taskFieldValue возвращает значение поля задачи в типе значений ComparisonSpecification;
+// ok равно false, если у задачи нет значения поля
+func taskFieldValue(task *tasks.Task, field tasks.TaskField) (interface{}, bool) {
+	switch field {
+	case tasks.TaskFieldTitle:
+		return task.Title.Value(), true
+	case tasks.TaskFieldDescription:
+		return task.Description, true
+	case tasks.TaskFieldStatus:
+		return task.Status.Value(), true
+	case tasks.TaskFieldPriority:
+		return task.Priority.Value(), true
+	case tasks.TaskFieldUserID:
+		return task.UserID, true
+	case tasks.TaskFieldProjectID:
+		if task.ProjectID == nil {
+			return nil, false
+		}
+		return *task.ProjectID, true
+	case tasks.TaskFieldStartDate:
+		return optionalTime(task.Schedule.StartDate())
+	case tasks.TaskFieldDueDate:
+		return optionalTime(task.Schedule.DueDate())
+	case tasks.TaskFieldCreatedAt:
+		return task.CreatedAt, true
+	case tasks.TaskFieldUpdatedAt:
+		return task.UpdatedAt, true
+	default:
+		return nil, false
+	}
+}
+
+func optionalTime(t *time.Time) (interface{}, bool) {
+	if t == nil {
+		return nil, false
+	}
+	return *t, true
+}
+
+// compareFieldValues сравнивает два значения поля; приоритеты сравниваются по важности
+func compareFieldValues(field tasks.TaskField, left, right interface{}) int {
+	switch left := left.(type) {
+	case time.Time:
+		right, _ := right.(time.Time)
+		return left.Compare(right)
+	case uuid.UUID:
+		right, _ := right.(uuid.UUID)
+		return strings.Compare(left.String(), right.String())
+	case string:
+		right, _ := right.(string)
+		if field == tasks.TaskFieldPriority {
+			ranks := vo.PriorityRanks()
+			return cmp.Compare(ranks[left], ranks[right])
+		}
+		return strings.Compare(left, right)
+	default:
+		return 0
+	}
+}
+
+// sortTasksByKeys упорядочивает задачи по полям keys так же, как это делает SQL запрос: задачи
+// без значения поля идут последними при любом направлении, равные задачи - по времени создания
+func sortTasksByKeys(list []*tasks.Task, keys []tasks.TaskSortKey) {
+	sort.Slice(list, func(i, j int) bool {
+		for _, key := range keys {
+			if result := compareBySortKey(list[i], list[j], key); result != 0 {
+				return result < 0
+			}
+		}
+		return createdEarlier(list[i].CreatedAt, list[j].CreatedAt, list[i].ID, list[j].ID)
+	})
+}
+
+func compareBySortKey(left, right *tasks.Task, key tasks.TaskSortKey) int {
+	leftValue, leftOK := taskFieldValue(left, key.Field)
+	rightValue, rightOK := taskFieldValue(right, key.Field)
+	if leftOK != rightOK {
+		if leftOK {
+			return -1
+		}
+		return 1
+	}
+	if !leftOK {
+		return 0
+	}
+
+	result := compareFieldValues(key.Field, leftValue, rightValue)
+	if key.Descending {
+		return -result
+	}
+	return result
+}
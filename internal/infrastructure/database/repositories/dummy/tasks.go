@@ -2,35 +2,70 @@ package dummy
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
+	"crud/internal/domain/comments"
+	"crud/internal/domain/labels"
 	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
 
 	"github.com/google/uuid"
 )
 
-// TasksRepository in-memory реализация репозитория задач
+// TasksRepository in-memory реализация репозитория задач.
+// Репозиторий пользователей используется вместо внешнего ключа tasks.user_id -> users.id,
+// репозиторий меток - вместо таблицы task_labels при фильтрации по меткам.
+// Delete, как и GORM, только помечает задачу удаленной; Purge удаляет ее физически вместе
+// с комментариями, метками и зависимостями, как внешние ключи с ON DELETE CASCADE
 type TasksRepository struct {
-	mu    sync.RWMutex
-	tasks []*tasks.Task
+	mu           sync.RWMutex
+	tasks        []*tasks.Task
+	dependencies []taskDependency
+	users        users.BaseUsersRepository
+	labels       labels.BaseLabelsRepository
+	comments     comments.BaseCommentsRepository
+}
+
+// taskDependency связь между задачей и задачей, которая ее блокирует
+type taskDependency struct {
+	taskID    uuid.UUID
+	blockerID uuid.UUID
 }
 
 // NewTasksRepository создает новый in-memory репозиторий задач
-func NewTasksRepository() *TasksRepository {
+func NewTasksRepository(
+	usersRepo users.BaseUsersRepository,
+	labelsRepo labels.BaseLabelsRepository,
+	commentsRepo comments.BaseCommentsRepository,
+) *TasksRepository {
 	return &TasksRepository{
-		tasks: make([]*tasks.Task, 0),
+		tasks:        make([]*tasks.Task, 0),
+		dependencies: make([]taskDependency, 0),
+		users:        usersRepo,
+		labels:       labelsRepo,
+		comments:     commentsRepo,
 	}
 }
 
 // Create создает новую задачу
 func (r *TasksRepository) Create(ctx context.Context, task *tasks.Task) (*tasks.Task, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
 
 	if task == nil {
 		return nil, &tasks.InvalidTaskDataError{Field: "task", Message: "task cannot be nil"}
 	}
 
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&task.OrganizationID); err != nil {
+		return nil, err
+	}
+
 	// Проверяем, не существует ли уже задача с таким ID
 	for _, t := range r.tasks {
 		if t.ID == task.ID {
@@ -38,19 +73,30 @@ func (r *TasksRepository) Create(ctx context.Context, task *tasks.Task) (*tasks.
 		}
 	}
 
-	r.tasks = append(r.tasks, task)
+	if err := r.checkOwner(ctx, task.UserID); err != nil {
+		return nil, err
+	}
+
+	// Как и столбец version в БД, версия новой задачи по умолчанию равна 1
+	if task.Version == 0 {
+		task.Version = 1
+	}
+	r.tasks = append(r.tasks, detach(task))
 	return task, nil
 }
 
 // GetByID возвращает задачу по ID
 func (r *TasksRepository) GetByID(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
 
-	for _, task := range r.tasks {
-		if task.ID == id {
-			return task, nil
-		}
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if task := r.findByID(scope, id); task != nil {
+		return detach(task), nil
 	}
 
 	return nil, &tasks.TaskNotFoundError{TaskID: id}
@@ -59,22 +105,25 @@ func (r *TasksRepository) GetByID(ctx context.Context, id uuid.UUID) (*tasks.Tas
 // List возвращает список задач с фильтрацией и пагинацией
 func (r *TasksRepository) List(
 	ctx context.Context,
-	userID *uuid.UUID,
-	status *string,
+	filter tasks.ListTasksFilter,
 	page, pageSize int,
 ) ([]*tasks.Task, int64, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
 
-	var filtered []*tasks.Task
-	for _, task := range r.tasks {
-		if userID != nil && task.UserID != *userID {
-			continue
-		}
-		if status != nil && task.Status.Value() != *status {
-			continue
-		}
-		filtered = append(filtered, task)
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	filtered, err := r.filterTasks(ctx, scope, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(filter.SortKeys) > 0 {
+		sortTasksByKeys(filtered, filter.SortKeys)
+	} else {
+		sortTasks(filtered, filter.Sort)
 	}
 
 	total := int64(len(filtered))
@@ -93,39 +142,704 @@ func (r *TasksRepository) List(
 		return []*tasks.Task{}, total, nil
 	}
 
-	return filtered[start:end], total, nil
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// ListByCursor возвращает не больше limit задач сразу после позиции cursor или перед ней
+func (r *TasksRepository) ListByCursor(
+	ctx context.Context,
+	filter tasks.ListTasksFilter,
+	cursor *tasks.TaskCursor,
+	limit int,
+) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered, err := r.filterTasks(ctx, scope, filter)
+	if err != nil {
+		return nil, err
+	}
+	sortTasks(filtered, filter.Sort)
+
+	if cursor == nil {
+		return detachAll(cursorWindow(filtered, false, limit)), nil
+	}
+
+	window := make([]*tasks.Task, 0, len(filtered))
+	for _, task := range filtered {
+		position := tasks.NewTaskCursor(task, filter.Sort, false)
+		if cursor.Backward && positionBefore(filter.Sort, position, cursor) ||
+			!cursor.Backward && positionBefore(filter.Sort, cursor, position) {
+			window = append(window, task)
+		}
+	}
+	return detachAll(cursorWindow(window, cursor.Backward, limit)), nil
 }
 
 // Update обновляет данные задачи
 func (r *TasksRepository) Update(ctx context.Context, task *tasks.Task) (*tasks.Task, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
 
 	if task == nil {
 		return nil, &tasks.InvalidTaskDataError{Field: "task", Message: "task cannot be nil"}
 	}
 
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, t := range r.tasks {
-		if t.ID == task.ID {
-			r.tasks[i] = task
-			return task, nil
+		if t.ID == task.ID && scope.active(t.OrganizationID, t.DeletedAt) {
+			if t.Version != task.Version {
+				return nil, &tasks.ConcurrentModificationError{TaskID: task.ID, Version: task.Version}
+			}
+			if t.UserID != task.UserID {
+				if err := r.checkOwner(ctx, task.UserID); err != nil {
+					return nil, err
+				}
+			}
+			// Сохраняем копию, чтобы версия переданной задачи, как и при обновлении в БД, не менялась
+			updated := *task
+			updated.OrganizationID = t.OrganizationID
+			updated.UpdatedAt = time.Now()
+			updated.Version++
+			r.tasks[i] = &updated
+			return detach(&updated), nil
 		}
 	}
 
 	return nil, &tasks.TaskNotFoundError{TaskID: task.ID}
 }
 
-// Delete удаляет задачу по ID
+// Delete перемещает задачу в корзину
 func (r *TasksRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	task := r.findByID(scope, id)
+	if task == nil {
+		return &tasks.TaskNotFoundError{TaskID: id}
+	}
+
+	deletedAt := time.Now()
+	task.DeletedAt = &deletedAt
+	return nil
+}
+
+// ListDeleted возвращает задачи из корзины, начиная с удаленных последними
+func (r *TasksRepository) ListDeleted(
+	ctx context.Context,
+	filter tasks.ListDeletedTasksFilter,
+	page, pageSize int,
+) ([]*tasks.Task, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var filtered []*tasks.Task
+	for _, task := range r.tasks {
+		if !scope.deleted(task.OrganizationID, task.DeletedAt) {
+			continue
+		}
+		if filter.UserID != nil && task.UserID != *filter.UserID {
+			continue
+		}
+		if filter.DeletedBefore != nil && !task.DeletedAt.Before(*filter.DeletedBefore) {
+			continue
+		}
+		filtered = append(filtered, task)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return deletedLater(filtered[i].DeletedAt, filtered[j].DeletedAt, filtered[i].ID, filtered[j].ID)
+	})
+
+	total := int64(len(filtered))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	if start >= len(filtered) {
+		return []*tasks.Task{}, total, nil
+	}
+
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// GetDeletedByID возвращает задачу из корзины по ID
+func (r *TasksRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if task := r.findDeletedByID(scope, id); task != nil {
+		return detach(task), nil
+	}
+
+	return nil, &tasks.TaskNotFoundError{TaskID: id}
+}
+
+// ListDeletedSubtree возвращает потомков задачи из корзины, связанных с ней только через задачи
+// из корзины, упорядоченных по времени создания
+func (r *TasksRepository) ListDeletedSubtree(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return detachAll(r.collectSubtree(id, func(task *tasks.Task) bool {
+		return scope.deleted(task.OrganizationID, task.DeletedAt)
+	})), nil
+}
+
+// Restore возвращает задачу из корзины
+func (r *TasksRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	task := r.findDeletedByID(scope, id)
+	if task == nil {
+		return &tasks.TaskNotFoundError{TaskID: id}
+	}
+
+	task.DeletedAt = nil
+	task.UpdatedAt = time.Now()
+	task.Version++
+	return nil
+}
+
+// Purge окончательно удаляет задачу из корзины. На задачу не должны ссылаться подзадачи,
+// как это требует внешний ключ tasks.parent_id с ON DELETE RESTRICT
+func (r *TasksRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if r.findDeletedByID(scope, id) == nil {
+		return &tasks.TaskNotFoundError{TaskID: id}
+	}
+
+	var subtasks int64
+	for _, task := range r.tasks {
+		if task.ParentID != nil && *task.ParentID == id {
+			subtasks++
+		}
+	}
+	if subtasks > 0 {
+		return &tasks.TaskHasSubtasksError{TaskID: id, Subtasks: subtasks}
+	}
+
+	if _, err := r.comments.DeleteByTask(ctx, id); err != nil {
+		return err
+	}
+	taskLabels, err := r.labels.ListByTask(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, label := range taskLabels {
+		if err := r.labels.DetachFromTask(ctx, id, label.ID); err != nil {
+			return err
+		}
+	}
+
+	dependencies := r.dependencies[:0]
+	for _, dependency := range r.dependencies {
+		if dependency.taskID != id && dependency.blockerID != id {
+			dependencies = append(dependencies, dependency)
+		}
+	}
+	r.dependencies = dependencies
 
 	for i, task := range r.tasks {
 		if task.ID == id {
 			r.tasks = append(r.tasks[:i], r.tasks[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// ListAncestors возвращает предков задачи от родителя до корневой задачи
+func (r *TasksRepository) ListAncestors(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	task := r.findByID(scope, id)
+	if task == nil {
+		return nil, &tasks.TaskNotFoundError{TaskID: id}
+	}
+
+	// visited защищает от бесконечного цикла, как UNION в рекурсивном SQL запросе
+	visited := map[uuid.UUID]bool{id: true}
+	var ancestors []*tasks.Task
+	for parentID := task.ParentID; parentID != nil && !visited[*parentID]; {
+		parent := r.findByID(scope, *parentID)
+		if parent == nil {
+			break
+		}
+		visited[parent.ID] = true
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentID
+	}
+
+	return detachAll(ancestors), nil
+}
+
+// ListSubtree возвращает всех потомков задачи, упорядоченных по времени создания
+func (r *TasksRepository) ListSubtree(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return detachAll(r.collectSubtree(id, func(task *tasks.Task) bool {
+		return scope.active(task.OrganizationID, task.DeletedAt)
+	})), nil
+}
+
+// AddDependency делает blockerID блокирующей задачей для taskID; повторное добавление ничего не меняет
+func (r *TasksRepository) AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if r.findByID(scope, taskID) == nil {
+		return &tasks.TaskNotFoundError{TaskID: taskID}
+	}
+	if r.findByID(scope, blockerID) == nil {
+		return &tasks.BlockerTaskNotFoundError{BlockerID: blockerID}
+	}
+
+	for _, dependency := range r.dependencies {
+		if dependency.taskID == taskID && dependency.blockerID == blockerID {
 			return nil
 		}
 	}
 
-	return &tasks.TaskNotFoundError{TaskID: id}
+	r.dependencies = append(r.dependencies, taskDependency{taskID: taskID, blockerID: blockerID})
+	return nil
+}
+
+// RemoveDependency удаляет зависимость taskID от blockerID; удаление отсутствующей зависимости ничего не меняет
+func (r *TasksRepository) RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	for i, dependency := range r.dependencies {
+		if dependency.taskID == taskID && dependency.blockerID == blockerID {
+			r.dependencies = append(r.dependencies[:i], r.dependencies[i+1:]...)
+			break
+		}
+	}
+	return nil
+}
+
+// ListBlockers возвращает задачи, которые блокируют задачу, упорядоченные по времени создания
+func (r *TasksRepository) ListBlockers(ctx context.Context, taskID uuid.UUID) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := make(map[uuid.UUID]bool)
+	for _, dependency := range r.dependencies {
+		if dependency.taskID == taskID {
+			linked[dependency.blockerID] = true
+		}
+	}
+	return detachAll(r.filterByID(scope, linked)), nil
+}
+
+// ListDependents возвращает задачи, которые блокирует задача, упорядоченные по времени создания
+func (r *TasksRepository) ListDependents(ctx context.Context, taskID uuid.UUID) ([]*tasks.Task, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := make(map[uuid.UUID]bool)
+	for _, dependency := range r.dependencies {
+		if dependency.blockerID == taskID {
+			linked[dependency.taskID] = true
+		}
+	}
+	return detachAll(r.filterByID(scope, linked)), nil
+}
+
+// ListBlockerChain возвращает ID всех задач, от которых задача зависит напрямую или через другие задачи
+func (r *TasksRepository) ListBlockerChain(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	// visited защищает от бесконечного цикла, как UNION в рекурсивном SQL запросе
+	visited := make(map[uuid.UUID]bool)
+	var chain []uuid.UUID
+	level := []uuid.UUID{taskID}
+	for len(level) > 0 {
+		var next []uuid.UUID
+		for _, dependency := range r.dependencies {
+			if visited[dependency.blockerID] {
+				continue
+			}
+			for _, id := range level {
+				if dependency.taskID == id {
+					visited[dependency.blockerID] = true
+					chain = append(chain, dependency.blockerID)
+					next = append(next, dependency.blockerID)
+					break
+				}
+			}
+		}
+		level = next
+	}
+
+	return chain, nil
+}
+
+// CountOpenByUser возвращает количество незавершенных задач пользователя
+func (r *TasksRepository) CountOpenByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	for _, task := range r.tasks {
+		if task.UserID == userID && task.Status.IsOpen() && scope.active(task.OrganizationID, task.DeletedAt) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// DeleteByUser перемещает в корзину все задачи пользователя и возвращает их в состоянии до удаления
+func (r *TasksRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) ([]*tasks.Task, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deletedAt := time.Now()
+	var deleted []*tasks.Task
+	for _, task := range r.tasks {
+		if task.UserID == userID && scope.active(task.OrganizationID, task.DeletedAt) {
+			deleted = append(deleted, detach(task))
+			task.DeletedAt = &deletedAt
+		}
+	}
+
+	sortTasks(deleted, tasks.TaskSortDefault)
+	return deleted, nil
+}
+
+// ReassignOwner передает все задачи пользователя fromUserID пользователю toUserID
+// и возвращает переданные задачи в состоянии до передачи
+func (r *TasksRepository) ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) ([]*tasks.Task, error) {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	if err := r.checkOwner(ctx, toUserID); err != nil {
+		return nil, err
+	}
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var reassigned []*tasks.Task
+	for _, task := range r.tasks {
+		if task.UserID == fromUserID && scope.active(task.OrganizationID, task.DeletedAt) {
+			reassigned = append(reassigned, detach(task))
+			task.UserID = toUserID
+			task.UpdatedAt = time.Now()
+			task.Version++
+		}
+	}
+
+	sortTasks(reassigned, tasks.TaskSortDefault)
+	return reassigned, nil
+}
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *TasksRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует задачи и зависимости и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) snapshot() func() {
+	saved := detachAll(r.tasks)
+	dependencies := append([]taskDependency(nil), r.dependencies...)
+	return func() {
+		r.tasks = saved
+		r.dependencies = dependencies
+	}
+}
+
+// findByID возвращает действующую задачу организации запроса по ID или nil;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) findByID(scope organizationScope, id uuid.UUID) *tasks.Task {
+	for _, task := range r.tasks {
+		if task.ID == id && scope.active(task.OrganizationID, task.DeletedAt) {
+			return task
+		}
+	}
+	return nil
+}
+
+// findDeletedByID возвращает задачу организации запроса из корзины по ID или nil;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) findDeletedByID(scope organizationScope, id uuid.UUID) *tasks.Task {
+	for _, task := range r.tasks {
+		if task.ID == id && scope.deleted(task.OrganizationID, task.DeletedAt) {
+			return task
+		}
+	}
+	return nil
+}
+
+// collectSubtree возвращает потомков задачи id, до которых можно дойти только через задачи,
+// подходящие под visible, упорядоченных по времени создания; вызывающий должен удерживать блокировку
+func (r *TasksRepository) collectSubtree(id uuid.UUID, visible func(task *tasks.Task) bool) []*tasks.Task {
+	inSubtree := map[uuid.UUID]bool{id: true}
+	level := []uuid.UUID{id}
+	for len(level) > 0 {
+		var next []uuid.UUID
+		for _, task := range r.tasks {
+			if task.ParentID == nil || inSubtree[task.ID] || !visible(task) {
+				continue
+			}
+			for _, parentID := range level {
+				if *task.ParentID == parentID {
+					inSubtree[task.ID] = true
+					next = append(next, task.ID)
+					break
+				}
+			}
+		}
+		level = next
+	}
+
+	descendants := make([]*tasks.Task, 0, len(inSubtree)-1)
+	for _, task := range r.tasks {
+		if task.ID != id && inSubtree[task.ID] {
+			descendants = append(descendants, task)
+		}
+	}
+	sortTasks(descendants, tasks.TaskSortDefault)
+	return descendants
+}
+
+// filterByID возвращает задачи организации запроса из ids, упорядоченные по времени создания;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) filterByID(scope organizationScope, ids map[uuid.UUID]bool) []*tasks.Task {
+	filtered := make([]*tasks.Task, 0, len(ids))
+	for _, task := range r.tasks {
+		if ids[task.ID] && scope.active(task.OrganizationID, task.DeletedAt) {
+			filtered = append(filtered, task)
+		}
+	}
+	sortTasks(filtered, tasks.TaskSortDefault)
+	return filtered
+}
+
+// filterTasks возвращает действующие задачи организации запроса, подходящие под фильтр, в порядке вставки;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) filterTasks(
+	ctx context.Context,
+	scope organizationScope,
+	filter tasks.ListTasksFilter,
+) ([]*tasks.Task, error) {
+	var filtered []*tasks.Task
+	for _, task := range r.tasks {
+		if !scope.active(task.OrganizationID, task.DeletedAt) || !matchesFilter(task, filter) {
+			continue
+		}
+		if filter.Blocked != nil && r.isBlocked(scope, task.ID) != *filter.Blocked {
+			continue
+		}
+		matches, err := r.matchesLabels(ctx, task, filter)
+		if err != nil {
+			return nil, err
+		}
+		if matches {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered, nil
+}
+
+// isBlocked проверяет, есть ли у задачи незавершенные блокирующие задачи;
+// вызывающий должен удерживать блокировку
+func (r *TasksRepository) isBlocked(scope organizationScope, taskID uuid.UUID) bool {
+	for _, dependency := range r.dependencies {
+		if dependency.taskID != taskID {
+			continue
+		}
+		if blocker := r.findByID(scope, dependency.blockerID); blocker != nil && blocker.Status.IsOpen() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkOwner проверяет существование владельца задачи, как это делает внешний ключ в БД
+func (r *TasksRepository) checkOwner(ctx context.Context, userID uuid.UUID) error {
+	if _, err := r.users.GetByID(ctx, userID); err != nil {
+		if users.IsUserNotFound(err) {
+			return &tasks.TaskOwnerNotFoundError{UserID: userID}
+		}
+		return &tasks.TaskOperationFailedError{Operation: "check_owner", Reason: err.Error()}
+	}
+	return nil
+}
+
+// matchesLabels проверяет задачу на соответствие фильтру по меткам
+func (r *TasksRepository) matchesLabels(ctx context.Context, task *tasks.Task, filter tasks.ListTasksFilter) (bool, error) {
+	if len(filter.Labels) == 0 {
+		return true, nil
+	}
+
+	taskLabels, err := r.labels.ListByTask(ctx, task.ID)
+	if err != nil {
+		return false, &tasks.TaskOperationFailedError{Operation: "list_labels", Reason: err.Error()}
+	}
+
+	names := make(map[string]struct{}, len(taskLabels))
+	for _, label := range taskLabels {
+		names[label.Name.Value()] = struct{}{}
+	}
+
+	matched := 0
+	for _, name := range filter.Labels {
+		if _, ok := names[name]; ok {
+			matched++
+		}
+	}
+
+	if filter.LabelMatch == tasks.LabelMatchAll {
+		return matched == len(filter.Labels), nil
+	}
+	return matched > 0, nil
+}
+
+// matchesFilter проверяет задачу на соответствие фильтру так же, как это делает SQL запрос
+func matchesFilter(task *tasks.Task, filter tasks.ListTasksFilter) bool {
+	if filter.UserID != nil && task.UserID != *filter.UserID {
+		return false
+	}
+	if filter.ProjectID != nil && (task.ProjectID == nil || *task.ProjectID != *filter.ProjectID) {
+		return false
+	}
+	if filter.Status != nil && task.Status.Value() != *filter.Status {
+		return false
+	}
+	if filter.ParentID != nil && (task.ParentID == nil || *task.ParentID != *filter.ParentID) {
+		return false
+	}
+
+	dueDate := task.Schedule.DueDate()
+	if filter.DueBefore != nil && (dueDate == nil || dueDate.After(*filter.DueBefore)) {
+		return false
+	}
+	if filter.DueAfter != nil && (dueDate == nil || dueDate.Before(*filter.DueAfter)) {
+		return false
+	}
+	if filter.Overdue != nil && task.IsOverdue(filter.Now) != *filter.Overdue {
+		return false
+	}
+	if filter.Specification != nil && !matchesSpecification(task, filter.Specification) {
+		return false
+	}
+
+	return true
+}
+
+// sortTasks упорядочивает задачи так же, как это делает SQL запрос: при любом порядке
+// последними критериями идут время создания и ID
+func sortTasks(list []*tasks.Task, order tasks.TaskSortOrder) {
+	sort.Slice(list, func(i, j int) bool {
+		return positionBefore(order, tasks.NewTaskCursor(list[i], order, false), tasks.NewTaskCursor(list[j], order, false))
+	})
+}
+
+// positionBefore проверяет, что позиция left идет раньше позиции right в списке с порядком order
+func positionBefore(order tasks.TaskSortOrder, left, right *tasks.TaskCursor) bool {
+	if order == tasks.TaskSortPriority {
+		if left.PriorityRank != right.PriorityRank {
+			return left.PriorityRank > right.PriorityRank
+		}
+		if (left.DueDate == nil) != (right.DueDate == nil) {
+			return left.DueDate != nil
+		}
+		if left.DueDate != nil && !left.DueDate.Equal(*right.DueDate) {
+			return left.DueDate.Before(*right.DueDate)
+		}
+	}
+
+	return createdEarlier(left.CreatedAt, right.CreatedAt, left.ID, right.ID)
 }
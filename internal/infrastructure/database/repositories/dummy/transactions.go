@@ -0,0 +1,142 @@
+package dummy
+
+import (
+	"context"
+	"sync"
+
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+	"crud/internal/domain/users"
+)
+
+// txContextKey ключ контекста, под которым хранится текущая транзакция
+type txContextKey struct{}
+
+// transactional in-memory репозиторий, изменения которого откатывает TransactionManager
+type transactional interface {
+	// state возвращает блокировку, защищающую данные репозитория
+	state() *sync.RWMutex
+
+	// snapshot копирует данные репозитория и возвращает функцию, которая их восстанавливает;
+	// вызывающий должен удерживать блокировку
+	snapshot() (restore func())
+}
+
+// transaction открытая транзакция: блокировки репозиториев, которые она удерживает
+type transaction struct {
+	held map[*sync.RWMutex]bool
+}
+
+// TransactionManager in-memory реализация менеджера транзакций.
+// Транзакция на все время выполнения получает монопольный доступ к репозиториям,
+// поэтому видит только свои изменения, а при ошибке восстанавливает их данные из копии,
+// снятой в начале. Блокировки берутся в том же порядке, что и во вложенных вызовах
+// репозиториев: задачи и проекты раньше пользователей, меток и комментариев
+type TransactionManager struct {
+	repos []transactional
+}
+
+// NewTransactionManager создает новый in-memory менеджер транзакций над репозиториями.
+// Репозитории других реализаций транзакция не откатывает
+func NewTransactionManager(
+	tasksRepo tasks.BaseTasksRepository,
+	projectsRepo projects.BaseProjectsRepository,
+	usersRepo users.BaseUsersRepository,
+	labelsRepo labels.BaseLabelsRepository,
+	commentsRepo comments.BaseCommentsRepository,
+	attachmentsRepo attachments.BaseAttachmentsRepository,
+	auditRepo audit.BaseAuditRepository,
+	refreshTokensRepo auth.BaseRefreshTokensRepository,
+	organizationsRepo organizations.BaseOrganizationsRepository,
+) *TransactionManager {
+	m := &TransactionManager{}
+	for _, repo := range []any{
+		tasksRepo, projectsRepo, usersRepo, labelsRepo, commentsRepo,
+		attachmentsRepo, auditRepo, refreshTokensRepo, organizationsRepo,
+	} {
+		if repo, ok := repo.(transactional); ok {
+			m.repos = append(m.repos, repo)
+		}
+	}
+	return m
+}
+
+// WithinTransaction выполняет fn в транзакции; если fn возвращает ошибку или паникует,
+// изменения репозиториев откатываются. Вложенные вызовы используют уже открытую транзакцию
+func (m *TransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if len(m.repos) == 0 || heldByTransaction(ctx, m.repos[0].state()) {
+		return fn(ctx)
+	}
+
+	tx := &transaction{held: make(map[*sync.RWMutex]bool, len(m.repos))}
+	restores := make([]func(), len(m.repos))
+	for i, repo := range m.repos {
+		repo.state().Lock()
+		tx.held[repo.state()] = true
+		restores[i] = repo.snapshot()
+	}
+
+	committed := false
+	defer func() {
+		for i := len(m.repos) - 1; i >= 0; i-- {
+			if !committed {
+				restores[i]()
+			}
+			m.repos[i].state().Unlock()
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+	committed = true
+	return nil
+}
+
+// lock захватывает блокировку на запись и возвращает функцию, которая ее освобождает.
+// Внутри транзакции, которая уже удерживает блокировку, ничего не делает
+func lock(ctx context.Context, mu *sync.RWMutex) (unlock func()) {
+	if heldByTransaction(ctx, mu) {
+		return func() {}
+	}
+	mu.Lock()
+	return mu.Unlock
+}
+
+// rlock захватывает блокировку на чтение и возвращает функцию, которая ее освобождает.
+// Внутри транзакции, которая уже удерживает блокировку, ничего не делает
+func rlock(ctx context.Context, mu *sync.RWMutex) (unlock func()) {
+	if heldByTransaction(ctx, mu) {
+		return func() {}
+	}
+	mu.RLock()
+	return mu.RUnlock
+}
+
+// heldByTransaction проверяет, удерживает ли блокировку транзакция из контекста
+func heldByTransaction(ctx context.Context, mu *sync.RWMutex) bool {
+	tx, ok := ctx.Value(txContextKey{}).(*transaction)
+	return ok && tx.held[mu]
+}
+
+// detach возвращает копию сущности: вызывающий может менять ее, не затрагивая хранилище,
+// как и сущность, загруженную из БД
+func detach[T any](entity *T) *T {
+	detached := *entity
+	return &detached
+}
+
+// detachAll возвращает копии сущностей списка
+func detachAll[T any](entities []*T) []*T {
+	detached := make([]*T, len(entities))
+	for i, entity := range entities {
+		detached[i] = detach(entity)
+	}
+	return detached
+}
@@ -0,0 +1,15 @@
+package dummy
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// deletedLater упорядочивает строки корзины так же, как ORDER BY deleted_at DESC, id DESC
+func deletedLater(left, right *time.Time, leftID, rightID uuid.UUID) bool {
+	if !left.Equal(*right) {
+		return left.After(*right)
+	}
+	return leftID.String() > rightID.String()
+}
@@ -2,14 +2,17 @@ package dummy
 
 import (
 	"context"
+	"sort"
 	"sync"
+	"time"
 
 	"crud/internal/domain/users"
 
 	"github.com/google/uuid"
 )
 
-// UsersRepository in-memory реализация репозитория пользователей
+// UsersRepository in-memory реализация репозитория пользователей.
+// Delete, как и GORM, только помечает пользователя удаленным; Purge удаляет его физически
 type UsersRepository struct {
 	mu    sync.RWMutex
 	users []*users.User
@@ -24,33 +27,45 @@ func NewUsersRepository() *UsersRepository {
 
 // Create создает нового пользователя
 func (r *UsersRepository) Create(ctx context.Context, user *users.User) (*users.User, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
 
 	if user == nil {
 		return nil, &users.InvalidUserDataError{Field: "user", Message: "user cannot be nil"}
 	}
 
-	email := user.Email.Value()
-	for _, u := range r.users {
-		if u.Email.Value() == email {
-			return nil, &users.UserAlreadyExistsError{Email: email}
-		}
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := scope.assign(&user.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if r.emailTaken(user.Email.Value(), uuid.Nil) {
+		return nil, &users.UserAlreadyExistsError{Email: user.Email.Value()}
 	}
 
-	r.users = append(r.users, user)
+	// Как и столбец version в БД, версия нового пользователя по умолчанию равна 1
+	if user.Version == 0 {
+		user.Version = 1
+	}
+	r.users = append(r.users, detach(user))
 	return user, nil
 }
 
 // GetByID возвращает пользователя по ID
 func (r *UsersRepository) GetByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
 
-	for _, user := range r.users {
-		if user.ID == id {
-			return user, nil
-		}
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user := r.findByID(scope, id); user != nil {
+		return detach(user), nil
 	}
 
 	return nil, &users.UserNotFoundError{UserID: id}
@@ -58,24 +73,44 @@ func (r *UsersRepository) GetByID(ctx context.Context, id uuid.UUID) (*users.Use
 
 // GetByEmail возвращает пользователя по email
 func (r *UsersRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	for _, user := range r.users {
-		if user.Email.Value() == email {
-			return user, nil
+		if user.Email.Value() == email && scope.active(user.OrganizationID, user.DeletedAt) {
+			return detach(user), nil
 		}
 	}
 
 	return nil, &users.UserNotFoundError{Email: email}
 }
 
-// List возвращает список пользователей с пагинацией
+// List возвращает список пользователей с пагинацией, упорядоченный по времени создания
 func (r *UsersRepository) List(ctx context.Context, page, pageSize int) ([]*users.User, int64, error) {
-	r.mu.RLock()
-	defer r.mu.RUnlock()
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var filtered []*users.User
+	for _, user := range r.users {
+		if scope.active(user.OrganizationID, user.DeletedAt) {
+			filtered = append(filtered, user)
+		}
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return createdEarlier(filtered[i].CreatedAt, filtered[j].CreatedAt, filtered[i].ID, filtered[j].ID)
+	})
 
-	total := int64(len(r.users))
+	total := int64(len(filtered))
 
 	// Пагинация
 	start := (page - 1) * pageSize
@@ -83,43 +118,202 @@ func (r *UsersRepository) List(ctx context.Context, page, pageSize int) ([]*user
 		start = 0
 	}
 	end := start + pageSize
-	if end > len(r.users) {
-		end = len(r.users)
+	if end > len(filtered) {
+		end = len(filtered)
 	}
 
-	if start >= len(r.users) {
+	if start >= len(filtered) {
 		return []*users.User{}, total, nil
 	}
 
-	return r.users[start:end], total, nil
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// ListByCursor возвращает не больше limit пользователей сразу после позиции cursor или перед ней
+func (r *UsersRepository) ListByCursor(ctx context.Context, cursor *users.UserCursor, limit int) ([]*users.User, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*users.User
+	for _, user := range r.users {
+		if !scope.active(user.OrganizationID, user.DeletedAt) {
+			continue
+		}
+		if cursor != nil {
+			before := createdEarlier(user.CreatedAt, cursor.CreatedAt, user.ID, cursor.ID)
+			after := createdEarlier(cursor.CreatedAt, user.CreatedAt, cursor.ID, user.ID)
+			if (cursor.Backward && !before) || (!cursor.Backward && !after) {
+				continue
+			}
+		}
+		filtered = append(filtered, user)
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return createdEarlier(filtered[i].CreatedAt, filtered[j].CreatedAt, filtered[i].ID, filtered[j].ID)
+	})
+
+	return detachAll(cursorWindow(filtered, cursor != nil && cursor.Backward, limit)), nil
 }
 
 // Update обновляет данные пользователя
 func (r *UsersRepository) Update(ctx context.Context, user *users.User) (*users.User, error) {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
 
 	if user == nil {
 		return nil, &users.InvalidUserDataError{Field: "user", Message: "user cannot be nil"}
 	}
 
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	for i, u := range r.users {
-		if u.ID == user.ID {
-			r.users[i] = user
-			return user, nil
+		if u.ID == user.ID && scope.active(u.OrganizationID, u.DeletedAt) {
+			if u.Version != user.Version {
+				return nil, &users.ConcurrentModificationError{UserID: user.ID, Version: user.Version}
+			}
+			if r.emailTaken(user.Email.Value(), user.ID) {
+				return nil, &users.UserAlreadyExistsError{Email: user.Email.Value()}
+			}
+			// Сохраняем копию, чтобы версия переданного пользователя, как и при обновлении в БД,
+			// не менялась; организация пользователя тоже не меняется
+			updated := *user
+			updated.OrganizationID = u.OrganizationID
+			updated.UpdatedAt = time.Now()
+			updated.Version++
+			r.users[i] = &updated
+			return detach(&updated), nil
 		}
 	}
 
 	return nil, &users.UserNotFoundError{UserID: user.ID}
 }
 
-// Delete удаляет пользователя по ID
+// Delete перемещает пользователя в корзину
 func (r *UsersRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	r.mu.Lock()
-	defer r.mu.Unlock()
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	user := r.findByID(scope, id)
+	if user == nil {
+		return &users.UserNotFoundError{UserID: id}
+	}
+
+	deletedAt := time.Now()
+	user.DeletedAt = &deletedAt
+	return nil
+}
+
+// ListDeleted возвращает пользователей из корзины, начиная с удаленных последними
+func (r *UsersRepository) ListDeleted(
+	ctx context.Context,
+	filter users.ListDeletedUsersFilter,
+	page, pageSize int,
+) ([]*users.User, int64, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var filtered []*users.User
+	for _, user := range r.users {
+		if !scope.deleted(user.OrganizationID, user.DeletedAt) {
+			continue
+		}
+		if filter.DeletedBefore != nil && !user.DeletedAt.Before(*filter.DeletedBefore) {
+			continue
+		}
+		filtered = append(filtered, user)
+	}
+	sort.SliceStable(filtered, func(i, j int) bool {
+		return deletedLater(filtered[i].DeletedAt, filtered[j].DeletedAt, filtered[i].ID, filtered[j].ID)
+	})
+
+	total := int64(len(filtered))
+
+	// Пагинация
+	start := (page - 1) * pageSize
+	if start < 0 {
+		start = 0
+	}
+	end := start + pageSize
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	if start >= len(filtered) {
+		return []*users.User{}, total, nil
+	}
+
+	return detachAll(filtered[start:end]), total, nil
+}
+
+// GetDeletedByID возвращает пользователя из корзины по ID
+func (r *UsersRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	unlock := rlock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if user := r.findDeletedByID(scope, id); user != nil {
+		return detach(user), nil
+	}
+
+	return nil, &users.UserNotFoundError{UserID: id}
+}
+
+// Restore возвращает пользователя из корзины
+func (r *UsersRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	user := r.findDeletedByID(scope, id)
+	if user == nil {
+		return &users.UserNotFoundError{UserID: id}
+	}
+
+	user.DeletedAt = nil
+	user.UpdatedAt = time.Now()
+	user.Version++
+	return nil
+}
+
+// Purge окончательно удаляет пользователя из корзины. In-memory репозиторий не знает о данных,
+// которые ссылаются на пользователя, поэтому их отсутствие проверяет use case
+func (r *UsersRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	unlock := lock(ctx, &r.mu)
+	defer unlock()
+
+	scope, err := scopeFromContext(ctx)
+	if err != nil {
+		return err
+	}
 
 	for i, user := range r.users {
-		if user.ID == id {
+		if user.ID == id && scope.deleted(user.OrganizationID, user.DeletedAt) {
 			r.users = append(r.users[:i], r.users[i+1:]...)
 			return nil
 		}
@@ -127,3 +321,49 @@ func (r *UsersRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 	return &users.UserNotFoundError{UserID: id}
 }
+
+// state возвращает блокировку, защищающую данные репозитория
+func (r *UsersRepository) state() *sync.RWMutex {
+	return &r.mu
+}
+
+// snapshot копирует пользователей и возвращает функцию, которая их восстанавливает;
+// вызывающий должен удерживать блокировку
+func (r *UsersRepository) snapshot() func() {
+	saved := detachAll(r.users)
+	return func() { r.users = saved }
+}
+
+// emailTaken проверяет, занят ли email другим пользователем, кроме exceptID. Email уникален
+// среди всех организаций и пользователей в корзине, как уникальный индекс в БД;
+// вызывающий должен удерживать блокировку
+func (r *UsersRepository) emailTaken(email string, exceptID uuid.UUID) bool {
+	for _, user := range r.users {
+		if user.ID != exceptID && user.Email.Value() == email {
+			return true
+		}
+	}
+	return false
+}
+
+// findByID возвращает действующего пользователя организации запроса по ID или nil;
+// вызывающий должен удерживать блокировку
+func (r *UsersRepository) findByID(scope organizationScope, id uuid.UUID) *users.User {
+	for _, user := range r.users {
+		if user.ID == id && scope.active(user.OrganizationID, user.DeletedAt) {
+			return user
+		}
+	}
+	return nil
+}
+
+// findDeletedByID возвращает пользователя организации запроса из корзины по ID или nil;
+// вызывающий должен удерживать блокировку
+func (r *UsersRepository) findDeletedByID(scope organizationScope, id uuid.UUID) *users.User {
+	for _, user := range r.users {
+		if user.ID == id && scope.deleted(user.OrganizationID, user.DeletedAt) {
+			return user
+		}
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package repositories
+
+import "gorm.io/gorm"
+
+// keysetCondition возвращает условие WHERE, выбирающее строки после позиции (created_at, id)
+// или, с backward, перед ней. Параметры условия: время создания позиции, оно же еще раз и ID позиции
+func keysetCondition(backward bool) string {
+	if backward {
+		return "(created_at < ? OR (created_at = ? AND id < ?))"
+	}
+	return "(created_at > ? OR (created_at = ? AND id > ?))"
+}
+
+// orderByCreation упорядочивает запрос по времени создания и ID; backward переворачивает порядок,
+// чтобы LIMIT выбирал строки, ближайшие к позиции перед ней
+func orderByCreation(query *gorm.DB, backward bool) *gorm.DB {
+	if backward {
+		return query.Order("created_at DESC").Order("id DESC")
+	}
+	return query.Order("created_at ASC").Order("id ASC")
+}
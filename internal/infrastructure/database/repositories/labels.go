@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"crud/internal/domain/labels"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// LabelsRepository GORM реализация репозитория меток
+type LabelsRepository struct {
+	db *gorm.DB
+}
+
+// NewLabelsRepository создает новый GORM репозиторий меток
+func NewLabelsRepository(db *gorm.DB) *LabelsRepository {
+	return &LabelsRepository{db: db}
+}
+
+// Create создает новую метку
+func (r *LabelsRepository) Create(ctx context.Context, label *labels.Label) (*labels.Label, error) {
+	if label == nil {
+		return nil, &labels.InvalidLabelDataError{Field: "label", Message: "label cannot be nil"}
+	}
+
+	model := converters.LabelEntityToModel(label)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, &labels.LabelAlreadyExistsError{Name: label.Name.Value()}
+		}
+		return nil, &labels.LabelOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	return converters.LabelModelToEntity(model)
+}
+
+// GetByID возвращает метку по ID
+func (r *LabelsRepository) GetByID(ctx context.Context, id uuid.UUID) (*labels.Label, error) {
+	var model models.Label
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &labels.LabelNotFoundError{LabelID: id}
+		}
+		return nil, &labels.LabelOperationFailedError{Operation: "get_by_id", Reason: err.Error()}
+	}
+
+	return converters.LabelModelToEntity(&model)
+}
+
+// List возвращает список меток, упорядоченный по имени, с пагинацией
+func (r *LabelsRepository) List(ctx context.Context, page, pageSize int) ([]*labels.Label, int64, error) {
+	var labelModels []*models.Label
+	var total int64
+
+	// Подсчет общего количества
+	if err := dbFromContext(ctx, r.db).Model(&models.Label{}).Count(&total).Error; err != nil {
+		return nil, 0, &labels.LabelOperationFailedError{Operation: "list_count", Reason: err.Error()}
+	}
+
+	// Получение данных с пагинацией
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := dbFromContext(ctx, r.db).
+		Order("name ASC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&labelModels).Error; err != nil {
+		return nil, 0, &labels.LabelOperationFailedError{Operation: "list", Reason: err.Error()}
+	}
+
+	domainLabels, err := labelModelsToEntities(labelModels)
+	if err != nil {
+		return nil, 0, &labels.LabelOperationFailedError{Operation: "list_convert", Reason: err.Error()}
+	}
+
+	return domainLabels, total, nil
+}
+
+// Update обновляет данные метки
+func (r *LabelsRepository) Update(ctx context.Context, label *labels.Label) (*labels.Label, error) {
+	if label == nil {
+		return nil, &labels.InvalidLabelDataError{Field: "label", Message: "label cannot be nil"}
+	}
+
+	model := converters.LabelEntityToModel(label)
+	model.UpdatedAt = label.UpdatedAt
+
+	if err := dbFromContext(ctx, r.db).Save(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &labels.LabelNotFoundError{LabelID: label.ID}
+		}
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, &labels.LabelAlreadyExistsError{Name: label.Name.Value()}
+		}
+		return nil, &labels.LabelOperationFailedError{Operation: "update", Reason: err.Error()}
+	}
+
+	return converters.LabelModelToEntity(model)
+}
+
+// Delete удаляет метку по ID; связи с задачами удаляет внешний ключ ON DELETE CASCADE
+func (r *LabelsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Delete(&models.Label{}, "id = ?", id)
+	if result.Error != nil {
+		return &labels.LabelOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &labels.LabelNotFoundError{LabelID: id}
+	}
+	return nil
+}
+
+// ListByTask возвращает метки задачи, упорядоченные по имени
+func (r *LabelsRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]*labels.Label, error) {
+	var labelModels []*models.Label
+	if err := dbFromContext(ctx, r.db).
+		Joins("JOIN task_labels ON task_labels.label_id = labels.id").
+		Where("task_labels.task_id = ?", taskID).
+		Order("labels.name ASC").
+		Find(&labelModels).Error; err != nil {
+		return nil, &labels.LabelOperationFailedError{Operation: "list_by_task", Reason: err.Error()}
+	}
+
+	domainLabels, err := labelModelsToEntities(labelModels)
+	if err != nil {
+		return nil, &labels.LabelOperationFailedError{Operation: "list_by_task_convert", Reason: err.Error()}
+	}
+
+	return domainLabels, nil
+}
+
+// AttachToTask добавляет метку к задаче; повторное добавление ничего не меняет
+func (r *LabelsRepository) AttachToTask(ctx context.Context, taskID, labelID uuid.UUID) error {
+	// Внешний ключ не видит организаций, поэтому метка ищется запросом, ограниченным организацией
+	var visible int64
+	if err := dbFromContext(ctx, r.db).Model(&models.Label{}).Where("id = ?", labelID).Count(&visible).Error; err != nil {
+		return &labels.LabelOperationFailedError{Operation: "attach_to_task", Reason: err.Error()}
+	}
+	if visible == 0 {
+		return &labels.LabelNotFoundError{LabelID: labelID}
+	}
+
+	model := &models.TaskLabel{TaskID: taskID, LabelID: labelID}
+	if err := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(model).Error; err != nil {
+		// Существование задачи проверяет use case, поэтому нарушение ключа означает удаленную метку
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return &labels.LabelNotFoundError{LabelID: labelID}
+		}
+		return &labels.LabelOperationFailedError{Operation: "attach_to_task", Reason: err.Error()}
+	}
+	return nil
+}
+
+// DetachFromTask снимает метку с задачи; снятие отсутствующей метки ничего не меняет
+func (r *LabelsRepository) DetachFromTask(ctx context.Context, taskID, labelID uuid.UUID) error {
+	if err := dbFromContext(ctx, r.db).
+		Delete(&models.TaskLabel{}, "task_id = ? AND label_id = ?", taskID, labelID).Error; err != nil {
+		return &labels.LabelOperationFailedError{Operation: "detach_from_task", Reason: err.Error()}
+	}
+	return nil
+}
+
+// labelModelsToEntities конвертирует список GORM моделей в domain entities
+func labelModelsToEntities(labelModels []*models.Label) ([]*labels.Label, error) {
+	domainLabels := make([]*labels.Label, 0, len(labelModels))
+	for _, model := range labelModels {
+		label, err := converters.LabelModelToEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		if label != nil {
+			domainLabels = append(domainLabels, label)
+		}
+	}
+	return domainLabels, nil
+}
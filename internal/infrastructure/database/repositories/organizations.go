@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"crud/internal/domain/organizations"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// OrganizationsRepository GORM реализация репозитория организаций
+type OrganizationsRepository struct {
+	db *gorm.DB
+}
+
+// NewOrganizationsRepository создает новый GORM репозиторий организаций
+func NewOrganizationsRepository(db *gorm.DB) *OrganizationsRepository {
+	return &OrganizationsRepository{db: db}
+}
+
+// Create создает новую организацию
+func (r *OrganizationsRepository) Create(ctx context.Context, organization *organizations.Organization) (*organizations.Organization, error) {
+	if organization == nil {
+		return nil, &organizations.InvalidOrganizationDataError{Field: "organization", Message: "organization cannot be nil"}
+	}
+
+	model := converters.OrganizationEntityToModel(organization)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		return nil, &organizations.OrganizationOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	return converters.OrganizationModelToEntity(model)
+}
+
+// GetByID возвращает организацию по ID
+func (r *OrganizationsRepository) GetByID(ctx context.Context, id uuid.UUID) (*organizations.Organization, error) {
+	var model models.Organization
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &organizations.OrganizationNotFoundError{OrganizationID: id}
+		}
+		return nil, &organizations.OrganizationOperationFailedError{Operation: "get_by_id", Reason: err.Error()}
+	}
+
+	return converters.OrganizationModelToEntity(&model)
+}
+
+// List возвращает список организаций, упорядоченный по имени, с пагинацией
+func (r *OrganizationsRepository) List(ctx context.Context, page, pageSize int) ([]*organizations.Organization, int64, error) {
+	var organizationModels []*models.Organization
+	var total int64
+
+	// Подсчет общего количества
+	if err := dbFromContext(ctx, r.db).Model(&models.Organization{}).Count(&total).Error; err != nil {
+		return nil, 0, &organizations.OrganizationOperationFailedError{Operation: "list_count", Reason: err.Error()}
+	}
+
+	// Получение данных с пагинацией
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := dbFromContext(ctx, r.db).
+		Order("name ASC").
+		Order("id ASC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&organizationModels).Error; err != nil {
+		return nil, 0, &organizations.OrganizationOperationFailedError{Operation: "list", Reason: err.Error()}
+	}
+
+	domainOrganizations := make([]*organizations.Organization, 0, len(organizationModels))
+	for _, model := range organizationModels {
+		organization, err := converters.OrganizationModelToEntity(model)
+		if err != nil {
+			return nil, 0, &organizations.OrganizationOperationFailedError{Operation: "list_convert", Reason: err.Error()}
+		}
+		domainOrganizations = append(domainOrganizations, organization)
+	}
+
+	return domainOrganizations, total, nil
+}
@@ -0,0 +1,160 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+
+	"crud/internal/domain/projects"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// ProjectsRepository GORM реализация репозитория проектов
+type ProjectsRepository struct {
+	db *gorm.DB
+}
+
+// NewProjectsRepository создает новый GORM репозиторий проектов
+func NewProjectsRepository(db *gorm.DB) *ProjectsRepository {
+	return &ProjectsRepository{db: db}
+}
+
+// Create создает новый проект
+func (r *ProjectsRepository) Create(ctx context.Context, project *projects.Project) (*projects.Project, error) {
+	if project == nil {
+		return nil, &projects.InvalidProjectDataError{Field: "project", Message: "project cannot be nil"}
+	}
+
+	model := converters.ProjectEntityToModel(project)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, &projects.ProjectAlreadyExistsError{ProjectID: project.ID}
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return nil, &projects.ProjectOwnerNotFoundError{UserID: project.UserID}
+		}
+		return nil, &projects.ProjectOperationFailedError{Operation: "create", Reason: err.Error()}
+	}
+
+	return converters.ProjectModelToEntity(model)
+}
+
+// GetByID возвращает проект по ID
+func (r *ProjectsRepository) GetByID(ctx context.Context, id uuid.UUID) (*projects.Project, error) {
+	var model models.Project
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &projects.ProjectNotFoundError{ProjectID: id}
+		}
+		return nil, &projects.ProjectOperationFailedError{Operation: "get_by_id", Reason: err.Error()}
+	}
+
+	return converters.ProjectModelToEntity(&model)
+}
+
+// List возвращает список проектов, упорядоченный по имени, с фильтрацией и пагинацией
+func (r *ProjectsRepository) List(
+	ctx context.Context,
+	filter projects.ListProjectsFilter,
+	page, pageSize int,
+) ([]*projects.Project, int64, error) {
+	var projectModels []*models.Project
+	var total int64
+
+	query := dbFromContext(ctx, r.db).Model(&models.Project{})
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+
+	// Подсчет общего количества с учетом фильтров
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, &projects.ProjectOperationFailedError{Operation: "list_count", Reason: err.Error()}
+	}
+
+	// Получение данных с пагинацией
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Order("name ASC").
+		Order("id ASC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&projectModels).Error; err != nil {
+		return nil, 0, &projects.ProjectOperationFailedError{Operation: "list", Reason: err.Error()}
+	}
+
+	domainProjects := make([]*projects.Project, 0, len(projectModels))
+	for _, model := range projectModels {
+		project, err := converters.ProjectModelToEntity(model)
+		if err != nil {
+			return nil, 0, &projects.ProjectOperationFailedError{Operation: "list_convert", Reason: err.Error()}
+		}
+		domainProjects = append(domainProjects, project)
+	}
+
+	return domainProjects, total, nil
+}
+
+// Update обновляет данные проекта
+func (r *ProjectsRepository) Update(ctx context.Context, project *projects.Project) (*projects.Project, error) {
+	if project == nil {
+		return nil, &projects.InvalidProjectDataError{Field: "project", Message: "project cannot be nil"}
+	}
+
+	model := converters.ProjectEntityToModel(project)
+	model.UpdatedAt = project.UpdatedAt
+
+	if err := dbFromContext(ctx, r.db).Save(model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &projects.ProjectNotFoundError{ProjectID: project.ID}
+		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return nil, &projects.ProjectOwnerNotFoundError{UserID: project.UserID}
+		}
+		return nil, &projects.ProjectOperationFailedError{Operation: "update", Reason: err.Error()}
+	}
+
+	return converters.ProjectModelToEntity(model)
+}
+
+// Delete удаляет проект по ID
+func (r *ProjectsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).Delete(&models.Project{}, "id = ?", id)
+	if result.Error != nil {
+		return &projects.ProjectOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &projects.ProjectNotFoundError{ProjectID: id}
+	}
+	return nil
+}
+
+// DeleteByUser удаляет все проекты пользователя и возвращает их количество
+func (r *ProjectsRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	result := dbFromContext(ctx, r.db).Delete(&models.Project{}, "user_id = ?", userID)
+	if result.Error != nil {
+		return 0, &projects.ProjectOperationFailedError{Operation: "delete_by_user", Reason: result.Error.Error()}
+	}
+	return result.RowsAffected, nil
+}
+
+// ReassignOwner передает все проекты пользователя fromUserID пользователю toUserID
+func (r *ProjectsRepository) ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) (int64, error) {
+	result := dbFromContext(ctx, r.db).
+		Model(&models.Project{}).
+		Where("user_id = ?", fromUserID).
+		Update("user_id", toUserID)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+			return 0, &projects.ProjectOwnerNotFoundError{UserID: toUserID}
+		}
+		return 0, &projects.ProjectOperationFailedError{Operation: "reassign_owner", Reason: result.Error.Error()}
+	}
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"crud/internal/domain/auth"
+	"crud/internal/infrastructure/database/converters"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// RefreshTokensRepository GORM реализация репозитория refresh токенов
+type RefreshTokensRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokensRepository создает новый GORM репозиторий refresh токенов
+func NewRefreshTokensRepository(db *gorm.DB) *RefreshTokensRepository {
+	return &RefreshTokensRepository{db: db}
+}
+
+// Create сохраняет новый refresh токен
+func (r *RefreshTokensRepository) Create(ctx context.Context, token *auth.RefreshToken) (*auth.RefreshToken, error) {
+	if token == nil {
+		return nil, &auth.AuthOperationFailedError{Operation: "create_refresh_token", Reason: "token cannot be nil"}
+	}
+
+	model := converters.RefreshTokenEntityToModel(token)
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		return nil, &auth.AuthOperationFailedError{Operation: "create_refresh_token", Reason: err.Error()}
+	}
+
+	return converters.RefreshTokenModelToEntity(model), nil
+}
+
+// GetByTokenHash возвращает refresh токен по хешу
+func (r *RefreshTokensRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*auth.RefreshToken, error) {
+	var model models.RefreshToken
+	if err := dbFromContext(ctx, r.db).Where("token_hash = ?", tokenHash).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &auth.InvalidTokenError{Reason: "refresh token not found"}
+		}
+		return nil, &auth.AuthOperationFailedError{Operation: "get_refresh_token", Reason: err.Error()}
+	}
+
+	return converters.RefreshTokenModelToEntity(&model), nil
+}
+
+// Revoke отзывает refresh токен по ID
+func (r *RefreshTokensRepository) Revoke(ctx context.Context, id uuid.UUID) error {
+	result := dbFromContext(ctx, r.db).
+		Model(&models.RefreshToken{}).
+		Where("id = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", time.Now())
+	if result.Error != nil {
+		return &auth.AuthOperationFailedError{Operation: "revoke_refresh_token", Reason: result.Error.Error()}
+	}
+	// Токен уже отозван параллельным запросом
+	if result.RowsAffected == 0 {
+		return &auth.InvalidTokenError{Reason: "refresh token already revoked"}
+	}
+	return nil
+}
+
+// RevokeFamily отзывает все токены цепочки ротаций
+func (r *RefreshTokensRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID) error {
+	return r.revokeWhere(ctx, "revoke_refresh_token_family", "family_id = ?", familyID)
+}
+
+// RevokeAllForUser отзывает все токены пользователя
+func (r *RefreshTokensRepository) RevokeAllForUser(ctx context.Context, userID uuid.UUID) error {
+	return r.revokeWhere(ctx, "revoke_user_refresh_tokens", "user_id = ?", userID)
+}
+
+func (r *RefreshTokensRepository) revokeWhere(ctx context.Context, operation, query string, args ...interface{}) error {
+	err := dbFromContext(ctx, r.db).
+		Model(&models.RefreshToken{}).
+		Where(query, args...).
+		Where("revoked_at IS NULL").
+		Update("revoked_at", time.Now()).Error
+	if err != nil {
+		return &auth.AuthOperationFailedError{Operation: operation, Reason: err.Error()}
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package repositories
+
+import (
+	"fmt"
+	"strings"
+
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"gorm.io/gorm"
+)
+
+// taskColumns столбцы таблицы tasks для полей языка фильтров. Имена столбцов в SQL попадают только
+// отсюда, а значения из фильтра всегда передаются параметрами запроса
+var taskColumns = map[tasks.TaskField]string{
+	tasks.TaskFieldTitle:       "title",
+	tasks.TaskFieldDescription: "description",
+	tasks.TaskFieldStatus:      "status",
+	tasks.TaskFieldPriority:    "priority",
+	tasks.TaskFieldUserID:      "user_id",
+	tasks.TaskFieldProjectID:   "project_id",
+	tasks.TaskFieldStartDate:   "start_date",
+	tasks.TaskFieldDueDate:     "due_date",
+	tasks.TaskFieldCreatedAt:   "created_at",
+	tasks.TaskFieldUpdatedAt:   "updated_at",
+}
+
+// likeEscaper экранирует символы шаблона LIKE, чтобы contains искал значение буквально
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// compileTaskSpecification переводит условие языка фильтров в условие WHERE с параметрами.
+// NULL в столбце не удовлетворяет ни одному сравнению, как и требует спецификация
+func compileTaskSpecification(spec tasks.TaskSpecification) (string, []interface{}, error) {
+	switch spec := spec.(type) {
+	case tasks.AllOfSpecification:
+		return compileTaskSpecifications(spec.Operands, " AND ")
+	case tasks.AnyOfSpecification:
+		return compileTaskSpecifications(spec.Operands, " OR ")
+	case tasks.ComparisonSpecification:
+		return compileTaskComparison(spec)
+	default:
+		return "", nil, fmt.Errorf("unsupported task specification %T", spec)
+	}
+}
+
+// compileTaskSpecifications объединяет условия операндов через separator
+func compileTaskSpecifications(operands []tasks.TaskSpecification, separator string) (string, []interface{}, error) {
+	conditions := make([]string, 0, len(operands))
+	var args []interface{}
+	for _, operand := range operands {
+		condition, operandArgs, err := compileTaskSpecification(operand)
+		if err != nil {
+			return "", nil, err
+		}
+		conditions = append(conditions, condition)
+		args = append(args, operandArgs...)
+	}
+	return "(" + strings.Join(conditions, separator) + ")", args, nil
+}
+
+func compileTaskComparison(spec tasks.ComparisonSpecification) (string, []interface{}, error) {
+	column, ok := taskColumns[spec.Field]
+	if !ok || len(spec.Values) == 0 {
+		return "", nil, fmt.Errorf("unsupported task comparison on field '%s'", spec.Field)
+	}
+
+	switch spec.Operator {
+	case tasks.FilterIn:
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(spec.Values)), ", ")
+		return column + " IN (" + placeholders + ")", spec.Values, nil
+	case tasks.FilterContains:
+		pattern := "%" + likeEscaper.Replace(strings.ToLower(fmt.Sprint(spec.Values[0]))) + "%"
+		return "LOWER(" + column + `) LIKE ? ESCAPE '\'`, []interface{}{pattern}, nil
+	case tasks.FilterEqual, tasks.FilterNotEqual:
+		operator := "="
+		if spec.Operator == tasks.FilterNotEqual {
+			operator = "<>"
+		}
+		return column + " " + operator + " ?", spec.Values[:1], nil
+	case tasks.FilterLess, tasks.FilterLessOrEqual, tasks.FilterGreater, tasks.FilterGreaterOrEqual:
+		// Приоритеты сравниваются по важности, а не по названию
+		if spec.Field == tasks.TaskFieldPriority {
+			rank := vo.PriorityRanks()[fmt.Sprint(spec.Values[0])]
+			return "(" + priorityRankExpression() + ") " + string(spec.Operator) + " ?", []interface{}{rank}, nil
+		}
+		return column + " " + string(spec.Operator) + " ?", spec.Values[:1], nil
+	default:
+		return "", nil, fmt.Errorf("unsupported task filter operator '%s'", spec.Operator)
+	}
+}
+
+// applyTaskSortKeys упорядочивает запрос по полям sort; задачи без дат идут последними при любом
+// направлении, равные по всем полям задачи упорядочены по времени создания
+func applyTaskSortKeys(query *gorm.DB, keys []tasks.TaskSortKey) *gorm.DB {
+	for _, key := range keys {
+		direction := " ASC"
+		if key.Descending {
+			direction = " DESC"
+		}
+
+		switch column := taskColumns[key.Field]; key.Field {
+		case tasks.TaskFieldPriority:
+			query = query.Order(priorityRankExpression() + direction)
+		case tasks.TaskFieldStartDate, tasks.TaskFieldDueDate:
+			query = query.Order(column + " IS NULL ASC").Order(column + direction)
+		default:
+			query = query.Order(column + direction)
+		}
+	}
+	return orderByCreation(query, false)
+}
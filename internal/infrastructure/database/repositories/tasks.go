@@ -3,13 +3,19 @@ package repositories
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 
 	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
 	"crud/internal/infrastructure/database/converters"
 	"crud/internal/infrastructure/database/models"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // TasksRepository GORM реализация репозитория задач
@@ -29,11 +35,14 @@ func (r *TasksRepository) Create(ctx context.Context, task *tasks.Task) (*tasks.
 	}
 
 	model := converters.TaskEntityToModel(task)
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
 		// Проверяем, не является ли это ошибкой дубликата (если есть уникальный индекс)
 		if errors.Is(err, gorm.ErrDuplicatedKey) {
 			return nil, &tasks.TaskAlreadyExistsError{TaskID: task.ID}
 		}
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return nil, &tasks.TaskOwnerNotFoundError{UserID: task.UserID}
+		}
 		return nil, &tasks.TaskOperationFailedError{Operation: "create", Reason: err.Error()}
 	}
 
@@ -43,7 +52,7 @@ func (r *TasksRepository) Create(ctx context.Context, task *tasks.Task) (*tasks.
 // GetByID возвращает задачу по ID
 func (r *TasksRepository) GetByID(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
 	var model models.Task
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, &tasks.TaskNotFoundError{TaskID: id}
 		}
@@ -56,22 +65,13 @@ func (r *TasksRepository) GetByID(ctx context.Context, id uuid.UUID) (*tasks.Tas
 // List возвращает список задач с фильтрацией и пагинацией
 func (r *TasksRepository) List(
 	ctx context.Context,
-	userID *uuid.UUID,
-	status *string,
+	filter tasks.ListTasksFilter,
 	page, pageSize int,
 ) ([]*tasks.Task, int64, error) {
 	var taskModels []*models.Task
 	var total int64
 
-	query := r.db.WithContext(ctx).Model(&models.Task{})
-
-	// Применение фильтров
-	if userID != nil {
-		query = query.Where("user_id = ?", *userID)
-	}
-	if status != nil {
-		query = query.Where("status = ?", *status)
-	}
+	query := r.filteredQuery(ctx, filter)
 
 	// Подсчет общего количества с учетом фильтров
 	if err := query.Count(&total).Error; err != nil {
@@ -84,24 +84,53 @@ func (r *TasksRepository) List(
 		offset = 0
 	}
 
+	if len(filter.SortKeys) > 0 {
+		query = applyTaskSortKeys(query, filter.SortKeys)
+	} else {
+		query = applyTaskSort(query, filter.Sort, false)
+	}
 	if err := query.Offset(offset).Limit(pageSize).Find(&taskModels).Error; err != nil {
 		return nil, 0, &tasks.TaskOperationFailedError{Operation: "list", Reason: err.Error()}
 	}
 
-	domainTasks := make([]*tasks.Task, 0, len(taskModels))
-	for _, model := range taskModels {
-		task, err := converters.TaskModelToEntity(model)
-		if err != nil {
-			return nil, 0, &tasks.TaskOperationFailedError{Operation: "list_convert", Reason: err.Error()}
-		}
-		if task != nil {
-			domainTasks = append(domainTasks, task)
-		}
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, 0, &tasks.TaskOperationFailedError{Operation: "list_convert", Reason: err.Error()}
 	}
 
 	return domainTasks, total, nil
 }
 
+// ListByCursor возвращает не больше limit задач сразу после позиции cursor или перед ней.
+// Условие по ключам сортировки заменяет OFFSET, поэтому страница читается одинаково быстро
+// в любом месте списка
+func (r *TasksRepository) ListByCursor(
+	ctx context.Context,
+	filter tasks.ListTasksFilter,
+	cursor *tasks.TaskCursor,
+	limit int,
+) ([]*tasks.Task, error) {
+	var taskModels []*models.Task
+
+	query := r.filteredQuery(ctx, filter)
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		query = applyTaskCursor(query, filter.Sort, cursor)
+	}
+	if err := applyTaskSort(query, filter.Sort, backward).Limit(limit).Find(&taskModels).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_by_cursor", Reason: err.Error()}
+	}
+	if backward {
+		slices.Reverse(taskModels)
+	}
+
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_by_cursor_convert", Reason: err.Error()}
+	}
+	return domainTasks, nil
+}
+
 // Update обновляет данные задачи
 func (r *TasksRepository) Update(ctx context.Context, task *tasks.Task) (*tasks.Task, error) {
 	if task == nil {
@@ -109,21 +138,39 @@ func (r *TasksRepository) Update(ctx context.Context, task *tasks.Task) (*tasks.
 	}
 
 	model := converters.TaskEntityToModel(task)
-	model.UpdatedAt = task.UpdatedAt
+	model.Version = task.Version + 1
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+	// Условие по версии делает проверку и запись одной операцией: из двух одновременных
+	// изменений одной версии строку обновит только первое
+	result := dbFromContext(ctx, r.db).
+		Model(model).
+		Where("version = ?", task.Version).
+		Select("user_id", "project_id", "parent_id", "title", "description", "status", "priority",
+			"start_date", "due_date", "updated_at", "version").
+		Updates(model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+			return nil, &tasks.TaskOwnerNotFoundError{UserID: task.UserID}
+		}
+		return nil, &tasks.TaskOperationFailedError{Operation: "update", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := dbFromContext(ctx, r.db).Model(&models.Task{}).Where("id = ?", task.ID).Count(&count).Error; err != nil {
+			return nil, &tasks.TaskOperationFailedError{Operation: "update", Reason: err.Error()}
+		}
+		if count == 0 {
 			return nil, &tasks.TaskNotFoundError{TaskID: task.ID}
 		}
-		return nil, &tasks.TaskOperationFailedError{Operation: "update", Reason: err.Error()}
+		return nil, &tasks.ConcurrentModificationError{TaskID: task.ID, Version: task.Version}
 	}
 
 	return converters.TaskModelToEntity(model)
 }
 
-// Delete удаляет задачу по ID
+// Delete перемещает задачу в корзину: GORM заполняет deleted_at вместо удаления строки
 func (r *TasksRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&models.Task{}, "id = ?", id)
+	result := dbFromContext(ctx, r.db).Delete(&models.Task{}, "id = ?", id)
 	if result.Error != nil {
 		return &tasks.TaskOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
 	}
@@ -132,3 +179,499 @@ func (r *TasksRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// ListDeleted возвращает задачи из корзины, начиная с удаленных последними
+func (r *TasksRepository) ListDeleted(
+	ctx context.Context,
+	filter tasks.ListDeletedTasksFilter,
+	page, pageSize int,
+) ([]*tasks.Task, int64, error) {
+	var taskModels []*models.Task
+	var total int64
+
+	query := r.trash(ctx).Model(&models.Task{})
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.DeletedBefore != nil {
+		query = query.Where("deleted_at < ?", filter.DeletedBefore.UTC())
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, &tasks.TaskOperationFailedError{Operation: "list_deleted_count", Reason: err.Error()}
+	}
+
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Order("deleted_at DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&taskModels).Error; err != nil {
+		return nil, 0, &tasks.TaskOperationFailedError{Operation: "list_deleted", Reason: err.Error()}
+	}
+
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, 0, &tasks.TaskOperationFailedError{Operation: "list_deleted_convert", Reason: err.Error()}
+	}
+
+	return domainTasks, total, nil
+}
+
+// GetDeletedByID возвращает задачу из корзины по ID
+func (r *TasksRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
+	var model models.Task
+	if err := r.trash(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &tasks.TaskNotFoundError{TaskID: id}
+		}
+		return nil, &tasks.TaskOperationFailedError{Operation: "get_deleted_by_id", Reason: err.Error()}
+	}
+
+	return converters.TaskModelToEntity(&model)
+}
+
+// ListDeletedSubtree возвращает потомков задачи из корзины, связанных с ней только через задачи
+// из корзины, упорядоченных по времени создания
+func (r *TasksRepository) ListDeletedSubtree(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	const query = `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT id FROM tasks WHERE parent_id = ? AND deleted_at IS NOT NULL
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NOT NULL
+		)
+		SELECT id FROM subtree`
+
+	// Задачи загружаются обычным запросом, чтобы на них распространялось ограничение организацией
+	var taskModels []*models.Task
+	if err := r.trash(ctx).
+		Where("id IN (?) AND id <> ?", dbFromContext(ctx, r.db).Raw(query, id), id).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&taskModels).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_deleted_subtree", Reason: err.Error()}
+	}
+
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_deleted_subtree_convert", Reason: err.Error()}
+	}
+	return domainTasks, nil
+}
+
+// Restore возвращает задачу из корзины
+func (r *TasksRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	result := r.trash(ctx).Model(&models.Task{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return &tasks.TaskOperationFailedError{Operation: "restore", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &tasks.TaskNotFoundError{TaskID: id}
+	}
+	return nil
+}
+
+// Purge окончательно удаляет задачу из корзины; комментарии, вложения, метки и зависимости задачи
+// удаляют внешние ключи с ON DELETE CASCADE
+func (r *TasksRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	result := r.trash(ctx).Delete(&models.Task{}, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+			return &tasks.TaskHasSubtasksError{TaskID: id}
+		}
+		return &tasks.TaskOperationFailedError{Operation: "purge", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &tasks.TaskNotFoundError{TaskID: id}
+	}
+	return nil
+}
+
+// ListAncestors возвращает предков задачи от родителя до корневой задачи.
+// UNION отбрасывает уже найденные ID, поэтому рекурсия конечна даже на испорченных данных
+func (r *TasksRepository) ListAncestors(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	const query = `
+		WITH RECURSIVE chain(id) AS (
+			SELECT id FROM tasks WHERE id = ? AND deleted_at IS NULL
+			UNION
+			SELECT t.parent_id FROM tasks t JOIN chain c ON t.id = c.id
+			WHERE t.parent_id IS NOT NULL AND t.deleted_at IS NULL
+		)
+		SELECT id FROM chain`
+
+	// Задачи загружаются обычным запросом, чтобы на них распространялось ограничение организацией
+	var taskModels []*models.Task
+	if err := dbFromContext(ctx, r.db).Where("id IN (?)", dbFromContext(ctx, r.db).Raw(query, id)).Find(&taskModels).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_ancestors", Reason: err.Error()}
+	}
+
+	chain, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_ancestors_convert", Reason: err.Error()}
+	}
+
+	// Порядок задает цепочка parent_id, а не порядок строк в результате запроса
+	byID := make(map[uuid.UUID]*tasks.Task, len(chain))
+	for _, task := range chain {
+		byID[task.ID] = task
+	}
+
+	start, ok := byID[id]
+	if !ok {
+		return nil, &tasks.TaskNotFoundError{TaskID: id}
+	}
+	delete(byID, id)
+
+	ancestors := make([]*tasks.Task, 0, len(byID))
+	for parentID := start.ParentID; parentID != nil; {
+		parent, ok := byID[*parentID]
+		if !ok {
+			break
+		}
+		delete(byID, *parentID)
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentID
+	}
+
+	return ancestors, nil
+}
+
+// ListSubtree возвращает всех потомков задачи, упорядоченных по времени создания
+func (r *TasksRepository) ListSubtree(ctx context.Context, id uuid.UUID) ([]*tasks.Task, error) {
+	const query = `
+		WITH RECURSIVE subtree(id) AS (
+			SELECT id FROM tasks WHERE parent_id = ? AND deleted_at IS NULL
+			UNION
+			SELECT t.id FROM tasks t JOIN subtree s ON t.parent_id = s.id WHERE t.deleted_at IS NULL
+		)
+		SELECT id FROM subtree`
+
+	// Задачи загружаются обычным запросом, чтобы на них распространялось ограничение организацией
+	var taskModels []*models.Task
+	if err := dbFromContext(ctx, r.db).
+		Where("id IN (?) AND id <> ?", dbFromContext(ctx, r.db).Raw(query, id), id).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&taskModels).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_subtree", Reason: err.Error()}
+	}
+
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_subtree_convert", Reason: err.Error()}
+	}
+	return domainTasks, nil
+}
+
+// AddDependency делает blockerID блокирующей задачей для taskID; повторное добавление ничего не меняет
+func (r *TasksRepository) AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	model := &models.TaskDependency{TaskID: taskID, BlockerID: blockerID}
+	if err := dbFromContext(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(model).Error; err != nil {
+		// Существование задачи проверяет use case, поэтому нарушение ключа означает удаленную блокирующую задачу
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return &tasks.BlockerTaskNotFoundError{BlockerID: blockerID}
+		}
+		return &tasks.TaskOperationFailedError{Operation: "add_dependency", Reason: err.Error()}
+	}
+	return nil
+}
+
+// RemoveDependency удаляет зависимость taskID от blockerID; удаление отсутствующей зависимости ничего не меняет
+func (r *TasksRepository) RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	if err := dbFromContext(ctx, r.db).
+		Delete(&models.TaskDependency{}, "task_id = ? AND blocker_id = ?", taskID, blockerID).Error; err != nil {
+		return &tasks.TaskOperationFailedError{Operation: "remove_dependency", Reason: err.Error()}
+	}
+	return nil
+}
+
+// ListBlockers возвращает задачи, которые блокируют задачу, упорядоченные по времени создания
+func (r *TasksRepository) ListBlockers(ctx context.Context, taskID uuid.UUID) ([]*tasks.Task, error) {
+	return r.listLinkedTasks(ctx, "list_blockers",
+		"JOIN task_dependencies ON task_dependencies.blocker_id = tasks.id AND task_dependencies.task_id = ?", taskID)
+}
+
+// ListDependents возвращает задачи, которые блокирует задача, упорядоченные по времени создания
+func (r *TasksRepository) ListDependents(ctx context.Context, taskID uuid.UUID) ([]*tasks.Task, error) {
+	return r.listLinkedTasks(ctx, "list_dependents",
+		"JOIN task_dependencies ON task_dependencies.task_id = tasks.id AND task_dependencies.blocker_id = ?", taskID)
+}
+
+// ListBlockerChain возвращает ID всех задач, от которых задача зависит напрямую или через другие задачи.
+// UNION отбрасывает уже найденные ID, поэтому рекурсия конечна даже на испорченных данных
+func (r *TasksRepository) ListBlockerChain(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error) {
+	const query = `
+		WITH RECURSIVE chain(id) AS (
+			SELECT blocker_id FROM task_dependencies WHERE task_id = ?
+			UNION
+			SELECT d.blocker_id FROM task_dependencies d JOIN chain c ON d.task_id = c.id
+		)
+		SELECT id FROM chain`
+
+	var ids []uuid.UUID
+	if err := dbFromContext(ctx, r.db).Raw(query, taskID).Scan(&ids).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "list_blocker_chain", Reason: err.Error()}
+	}
+	return ids, nil
+}
+
+// CountOpenByUser возвращает количество незавершенных задач пользователя
+func (r *TasksRepository) CountOpenByUser(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	if err := dbFromContext(ctx, r.db).
+		Model(&models.Task{}).
+		Where("user_id = ? AND status NOT IN ?", userID, vo.ClosedStatuses()).
+		Count(&count).Error; err != nil {
+		return 0, &tasks.TaskOperationFailedError{Operation: "count_open_by_user", Reason: err.Error()}
+	}
+	return count, nil
+}
+
+// DeleteByUser перемещает в корзину все задачи пользователя и возвращает их в состоянии до удаления
+func (r *TasksRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) ([]*tasks.Task, error) {
+	owned, ids, err := r.listOwned(ctx, "delete_by_user", userID)
+	if err != nil || len(owned) == 0 {
+		return owned, err
+	}
+
+	// Удаляются только загруженные задачи, чтобы результат совпадал с тем, что изменилось
+	if err := dbFromContext(ctx, r.db).Delete(&models.Task{}, "id IN ?", ids).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: "delete_by_user", Reason: err.Error()}
+	}
+	return owned, nil
+}
+
+// ReassignOwner передает все задачи пользователя fromUserID пользователю toUserID
+// и возвращает переданные задачи в состоянии до передачи
+func (r *TasksRepository) ReassignOwner(ctx context.Context, fromUserID, toUserID uuid.UUID) ([]*tasks.Task, error) {
+	owned, ids, err := r.listOwned(ctx, "reassign_owner", fromUserID)
+	if err != nil || len(owned) == 0 {
+		return owned, err
+	}
+
+	result := dbFromContext(ctx, r.db).
+		Model(&models.Task{}).
+		Where("id IN ?", ids).
+		Updates(map[string]interface{}{"user_id": toUserID, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+			return nil, &tasks.TaskOwnerNotFoundError{UserID: toUserID}
+		}
+		return nil, &tasks.TaskOperationFailedError{Operation: "reassign_owner", Reason: result.Error.Error()}
+	}
+	return owned, nil
+}
+
+// listOwned возвращает действующие задачи пользователя, упорядоченные по времени создания, и их ID
+func (r *TasksRepository) listOwned(ctx context.Context, operation string, userID uuid.UUID) ([]*tasks.Task, []uuid.UUID, error) {
+	var taskModels []*models.Task
+	if err := dbFromContext(ctx, r.db).
+		Where("user_id = ?", userID).
+		Order("created_at ASC").
+		Order("id ASC").
+		Find(&taskModels).Error; err != nil {
+		return nil, nil, &tasks.TaskOperationFailedError{Operation: operation, Reason: err.Error()}
+	}
+
+	owned, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, nil, &tasks.TaskOperationFailedError{Operation: operation + "_convert", Reason: err.Error()}
+	}
+
+	ids := make([]uuid.UUID, len(owned))
+	for i, task := range owned {
+		ids[i] = task.ID
+	}
+	return owned, ids, nil
+}
+
+// trash возвращает запрос к задачам в корзине: Unscoped отключает условие deleted_at IS NULL,
+// а удаление через такой запрос удаляет строки физически
+func (r *TasksRepository) trash(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.db).Unscoped().Where("deleted_at IS NOT NULL")
+}
+
+// taskModelsToEntities конвертирует список GORM моделей в domain entities
+func taskModelsToEntities(taskModels []*models.Task) ([]*tasks.Task, error) {
+	domainTasks := make([]*tasks.Task, 0, len(taskModels))
+	for _, model := range taskModels {
+		task, err := converters.TaskModelToEntity(model)
+		if err != nil {
+			return nil, err
+		}
+		if task != nil {
+			domainTasks = append(domainTasks, task)
+		}
+	}
+	return domainTasks, nil
+}
+
+// labeledTaskIDs возвращает подзапрос ID задач, у которых есть хотя бы одна из меток names,
+// а для LabelMatchAll - все метки names
+func (r *TasksRepository) labeledTaskIDs(ctx context.Context, names []string, match tasks.LabelMatch) *gorm.DB {
+	subquery := dbFromContext(ctx, r.db).
+		Model(&models.TaskLabel{}).
+		Select("task_labels.task_id").
+		Joins("JOIN labels ON labels.id = task_labels.label_id").
+		Where("labels.name IN ?", names)
+	if match == tasks.LabelMatchAll {
+		subquery = subquery.Group("task_labels.task_id").Having("COUNT(DISTINCT labels.id) = ?", len(names))
+	}
+	return subquery
+}
+
+// blockedTaskIDs возвращает подзапрос с ID задач, у которых есть незавершенные блокирующие задачи
+func (r *TasksRepository) blockedTaskIDs(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.db).
+		Model(&models.TaskDependency{}).
+		Select("task_dependencies.task_id").
+		Joins("JOIN tasks blockers ON blockers.id = task_dependencies.blocker_id AND blockers.deleted_at IS NULL").
+		Where("blockers.status NOT IN ?", vo.ClosedStatuses())
+}
+
+// filteredQuery возвращает запрос задач, ограниченный фильтром списка
+func (r *TasksRepository) filteredQuery(ctx context.Context, filter tasks.ListTasksFilter) *gorm.DB {
+	query := dbFromContext(ctx, r.db).Model(&models.Task{})
+
+	// Применение фильтров
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.ProjectID != nil {
+		query = query.Where("project_id = ?", *filter.ProjectID)
+	}
+	if filter.Status != nil {
+		query = query.Where("status = ?", *filter.Status)
+	}
+	if filter.ParentID != nil {
+		query = query.Where("parent_id = ?", *filter.ParentID)
+	}
+	if filter.DueBefore != nil {
+		query = query.Where("due_date <= ?", filter.DueBefore.UTC())
+	}
+	if filter.DueAfter != nil {
+		query = query.Where("due_date >= ?", filter.DueAfter.UTC())
+	}
+	if filter.Overdue != nil {
+		now := filter.Now.UTC()
+		if *filter.Overdue {
+			query = query.Where("due_date < ? AND status NOT IN ?", now, vo.ClosedStatuses())
+		} else {
+			query = query.Where("(due_date IS NULL OR due_date >= ? OR status IN ?)", now, vo.ClosedStatuses())
+		}
+	}
+	if len(filter.Labels) > 0 {
+		query = query.Where("id IN (?)", r.labeledTaskIDs(ctx, filter.Labels, filter.LabelMatch))
+	}
+	if filter.Blocked != nil {
+		if *filter.Blocked {
+			query = query.Where("id IN (?)", r.blockedTaskIDs(ctx))
+		} else {
+			query = query.Where("id NOT IN (?)", r.blockedTaskIDs(ctx))
+		}
+	}
+	if filter.Specification != nil {
+		condition, args, err := compileTaskSpecification(filter.Specification)
+		if err != nil {
+			query.AddError(err)
+		} else {
+			query = query.Where(condition, args...)
+		}
+	}
+
+	return query
+}
+
+// listLinkedTasks возвращает задачи, связанные с taskID через join по таблице task_dependencies
+func (r *TasksRepository) listLinkedTasks(ctx context.Context, operation, join string, taskID uuid.UUID) ([]*tasks.Task, error) {
+	var taskModels []*models.Task
+	if err := dbFromContext(ctx, r.db).
+		Joins(join, taskID).
+		Order("tasks.created_at ASC").
+		Order("tasks.id ASC").
+		Find(&taskModels).Error; err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: operation, Reason: err.Error()}
+	}
+
+	domainTasks, err := taskModelsToEntities(taskModels)
+	if err != nil {
+		return nil, &tasks.TaskOperationFailedError{Operation: operation + "_convert", Reason: err.Error()}
+	}
+	return domainTasks, nil
+}
+
+// applyTaskSort добавляет к запросу сортировку; created_at и id делают порядок детерминированным.
+// backward переворачивает порядок, чтобы LIMIT выбирал задачи, ближайшие к позиции курсора перед ней
+func applyTaskSort(query *gorm.DB, order tasks.TaskSortOrder, backward bool) *gorm.DB {
+	if order == tasks.TaskSortPriority {
+		rank, nullsLast, due := " DESC", " ASC", " ASC"
+		if backward {
+			rank, nullsLast, due = " ASC", " DESC", " DESC"
+		}
+		query = query.
+			Order(priorityRankExpression() + rank).
+			Order("due_date IS NULL" + nullsLast).
+			Order("due_date" + due)
+	}
+	return orderByCreation(query, backward)
+}
+
+// applyTaskCursor ограничивает запрос задачами после позиции cursor в порядке order или перед ней.
+// Для порядка по приоритету сравнение ключей раскрывается по одному ключу: более важный приоритет,
+// затем задачи со сроком раньше задач без срока, затем более ранний срок
+func applyTaskCursor(query *gorm.DB, order tasks.TaskSortOrder, cursor *tasks.TaskCursor) *gorm.DB {
+	creation := keysetCondition(cursor.Backward)
+	if order != tasks.TaskSortPriority {
+		return query.Where(creation, cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+
+	rank := priorityRankExpression()
+	var dueSQL string
+	var dueArgs []interface{}
+	switch {
+	case cursor.DueDate == nil && !cursor.Backward:
+		dueSQL = "due_date IS NULL AND " + creation
+	case cursor.DueDate == nil && cursor.Backward:
+		dueSQL = "due_date IS NOT NULL OR (due_date IS NULL AND " + creation + ")"
+	case !cursor.Backward:
+		dueSQL = "due_date IS NULL OR due_date > ? OR (due_date = ? AND " + creation + ")"
+		dueArgs = []interface{}{cursor.DueDate.UTC(), cursor.DueDate.UTC()}
+	default:
+		dueSQL = "due_date IS NOT NULL AND (due_date < ? OR (due_date = ? AND " + creation + "))"
+		dueArgs = []interface{}{cursor.DueDate.UTC(), cursor.DueDate.UTC()}
+	}
+	dueArgs = append(dueArgs, cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+
+	rankAfter := " < ?"
+	if cursor.Backward {
+		rankAfter = " > ?"
+	}
+	args := append([]interface{}{cursor.PriorityRank, cursor.PriorityRank}, dueArgs...)
+	return query.Where("("+rank+rankAfter+" OR ("+rank+" = ? AND ("+dueSQL+")))", args...)
+}
+
+// priorityRankExpression возвращает выражение с весом приоритета задачи: чем важнее задача, тем больше вес.
+// Значения приоритетов - константы домена, поэтому подставляются в SQL напрямую
+func priorityRankExpression() string {
+	ranks := vo.PriorityRanks()
+	priorities := make([]string, 0, len(ranks))
+	for priority := range ranks {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return ranks[priorities[i]] > ranks[priorities[j]] })
+
+	var sql strings.Builder
+	sql.WriteString("CASE priority")
+	for _, priority := range priorities {
+		fmt.Fprintf(&sql, " WHEN '%s' THEN %d", priority, ranks[priority])
+	}
+	sql.WriteString(" ELSE 0 END")
+
+	return sql.String()
+}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// txContextKey ключ контекста, под которым хранится текущая транзакция
+type txContextKey struct{}
+
+// TransactionManager GORM реализация менеджера транзакций
+type TransactionManager struct {
+	db *gorm.DB
+}
+
+// NewTransactionManager создает новый GORM менеджер транзакций
+func NewTransactionManager(db *gorm.DB) *TransactionManager {
+	return &TransactionManager{db: db}
+}
+
+// WithinTransaction выполняет fn в транзакции; вложенные вызовы используют уже открытую транзакцию
+func (m *TransactionManager) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return fn(ctx)
+	}
+
+	return m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(context.WithValue(ctx, txContextKey{}, tx))
+	})
+}
+
+// dbFromContext возвращает транзакцию из контекста, если она открыта, иначе db
+func dbFromContext(ctx context.Context, db *gorm.DB) *gorm.DB {
+	if tx, ok := ctx.Value(txContextKey{}).(*gorm.DB); ok {
+		return tx.WithContext(ctx)
+	}
+	return db.WithContext(ctx)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"crud/internal/domain/users"
 	"crud/internal/infrastructure/database/converters"
@@ -29,7 +30,11 @@ func (r *UsersRepository) Create(ctx context.Context, user *users.User) (*users.
 	}
 
 	model := converters.UserEntityToModel(user)
-	if err := r.db.WithContext(ctx).Create(model).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Create(model).Error; err != nil {
+		// Уникальный индекс по email учитывает и пользователей в корзине
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return nil, &users.UserAlreadyExistsError{Email: user.Email.Value()}
+		}
 		return nil, &users.UserOperationFailedError{Operation: "create", Reason: err.Error()}
 	}
 
@@ -39,7 +44,7 @@ func (r *UsersRepository) Create(ctx context.Context, user *users.User) (*users.
 // GetByID возвращает пользователя по ID
 func (r *UsersRepository) GetByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
 	var model models.User
-	if err := r.db.WithContext(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("id = ?", id).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, &users.UserNotFoundError{UserID: id}
 		}
@@ -52,7 +57,7 @@ func (r *UsersRepository) GetByID(ctx context.Context, id uuid.UUID) (*users.Use
 // GetByEmail возвращает пользователя по email
 func (r *UsersRepository) GetByEmail(ctx context.Context, email string) (*users.User, error) {
 	var model models.User
-	if err := r.db.WithContext(ctx).Where("email = ?", email).First(&model).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Where("email = ?", email).First(&model).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, &users.UserNotFoundError{Email: email}
 		}
@@ -62,13 +67,13 @@ func (r *UsersRepository) GetByEmail(ctx context.Context, email string) (*users.
 	return converters.UserModelToEntity(&model)
 }
 
-// List возвращает список пользователей с пагинацией
+// List возвращает список пользователей с пагинацией, упорядоченный по времени создания
 func (r *UsersRepository) List(ctx context.Context, page, pageSize int) ([]*users.User, int64, error) {
 	var userModels []*models.User
 	var total int64
 
 	// Подсчет общего количества
-	if err := r.db.WithContext(ctx).Model(&models.User{}).Count(&total).Error; err != nil {
+	if err := dbFromContext(ctx, r.db).Model(&models.User{}).Count(&total).Error; err != nil {
 		return nil, 0, &users.UserOperationFailedError{Operation: "list_count", Reason: err.Error()}
 	}
 
@@ -78,7 +83,9 @@ func (r *UsersRepository) List(ctx context.Context, page, pageSize int) ([]*user
 		offset = 0
 	}
 
-	if err := r.db.WithContext(ctx).
+	if err := dbFromContext(ctx, r.db).
+		Order("created_at ASC").
+		Order("id ASC").
 		Offset(offset).
 		Limit(pageSize).
 		Find(&userModels).Error; err != nil {
@@ -99,6 +106,36 @@ func (r *UsersRepository) List(ctx context.Context, page, pageSize int) ([]*user
 	return domainUsers, total, nil
 }
 
+// ListByCursor возвращает не больше limit пользователей сразу после позиции cursor или перед ней.
+// Условие по ключам сортировки использует индекс вместо OFFSET, поэтому страница читается
+// одинаково быстро в любом месте списка
+func (r *UsersRepository) ListByCursor(ctx context.Context, cursor *users.UserCursor, limit int) ([]*users.User, error) {
+	var userModels []*models.User
+
+	query := dbFromContext(ctx, r.db)
+	backward := cursor != nil && cursor.Backward
+	if cursor != nil {
+		query = query.Where(keysetCondition(backward), cursor.CreatedAt, cursor.CreatedAt, cursor.ID)
+	}
+	if err := orderByCreation(query, backward).Limit(limit).Find(&userModels).Error; err != nil {
+		return nil, &users.UserOperationFailedError{Operation: "list_by_cursor", Reason: err.Error()}
+	}
+	if backward {
+		slices.Reverse(userModels)
+	}
+
+	domainUsers := make([]*users.User, 0, len(userModels))
+	for _, model := range userModels {
+		user, err := converters.UserModelToEntity(model)
+		if err != nil {
+			return nil, &users.UserOperationFailedError{Operation: "list_by_cursor_convert", Reason: err.Error()}
+		}
+		domainUsers = append(domainUsers, user)
+	}
+
+	return domainUsers, nil
+}
+
 // Update обновляет данные пользователя
 func (r *UsersRepository) Update(ctx context.Context, user *users.User) (*users.User, error) {
 	if user == nil {
@@ -106,21 +143,39 @@ func (r *UsersRepository) Update(ctx context.Context, user *users.User) (*users.
 	}
 
 	model := converters.UserEntityToModel(user)
-	model.UpdatedAt = user.UpdatedAt
+	model.Version = user.Version + 1
 
-	if err := r.db.WithContext(ctx).Save(model).Error; err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
+	// Условие по версии делает проверку и запись одной операцией: из двух одновременных
+	// изменений одной версии строку обновит только первое
+	result := dbFromContext(ctx, r.db).
+		Model(model).
+		Where("version = ?", user.Version).
+		Select("email", "name", "role", "password_hash", "updated_at", "version").
+		Updates(model)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, &users.UserAlreadyExistsError{Email: user.Email.Value()}
+		}
+		return nil, &users.UserOperationFailedError{Operation: "update", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		var count int64
+		if err := dbFromContext(ctx, r.db).Model(&models.User{}).Where("id = ?", user.ID).Count(&count).Error; err != nil {
+			return nil, &users.UserOperationFailedError{Operation: "update", Reason: err.Error()}
+		}
+		if count == 0 {
 			return nil, &users.UserNotFoundError{UserID: user.ID}
 		}
-		return nil, &users.UserOperationFailedError{Operation: "update", Reason: err.Error()}
+		return nil, &users.ConcurrentModificationError{UserID: user.ID, Version: user.Version}
 	}
 
 	return converters.UserModelToEntity(model)
 }
 
-// Delete удаляет пользователя по ID
+// Delete перемещает пользователя в корзину: GORM заполняет deleted_at вместо удаления строки,
+// поэтому уникальный индекс по email продолжает учитывать пользователя
 func (r *UsersRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	result := r.db.WithContext(ctx).Delete(&models.User{}, "id = ?", id)
+	result := dbFromContext(ctx, r.db).Delete(&models.User{}, "id = ?", id)
 	if result.Error != nil {
 		return &users.UserOperationFailedError{Operation: "delete", Reason: result.Error.Error()}
 	}
@@ -129,3 +184,97 @@ func (r *UsersRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// ListDeleted возвращает пользователей из корзины, начиная с удаленных последними
+func (r *UsersRepository) ListDeleted(
+	ctx context.Context,
+	filter users.ListDeletedUsersFilter,
+	page, pageSize int,
+) ([]*users.User, int64, error) {
+	var userModels []*models.User
+	var total int64
+
+	query := r.trash(ctx).Model(&models.User{})
+	if filter.DeletedBefore != nil {
+		query = query.Where("deleted_at < ?", filter.DeletedBefore.UTC())
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, &users.UserOperationFailedError{Operation: "list_deleted_count", Reason: err.Error()}
+	}
+
+	offset := (page - 1) * pageSize
+	if offset < 0 {
+		offset = 0
+	}
+
+	if err := query.
+		Order("deleted_at DESC").
+		Order("id DESC").
+		Offset(offset).
+		Limit(pageSize).
+		Find(&userModels).Error; err != nil {
+		return nil, 0, &users.UserOperationFailedError{Operation: "list_deleted", Reason: err.Error()}
+	}
+
+	domainUsers := make([]*users.User, 0, len(userModels))
+	for _, model := range userModels {
+		user, err := converters.UserModelToEntity(model)
+		if err != nil {
+			return nil, 0, &users.UserOperationFailedError{Operation: "list_deleted_convert", Reason: err.Error()}
+		}
+		if user != nil {
+			domainUsers = append(domainUsers, user)
+		}
+	}
+
+	return domainUsers, total, nil
+}
+
+// GetDeletedByID возвращает пользователя из корзины по ID
+func (r *UsersRepository) GetDeletedByID(ctx context.Context, id uuid.UUID) (*users.User, error) {
+	var model models.User
+	if err := r.trash(ctx).Where("id = ?", id).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, &users.UserNotFoundError{UserID: id}
+		}
+		return nil, &users.UserOperationFailedError{Operation: "get_deleted_by_id", Reason: err.Error()}
+	}
+
+	return converters.UserModelToEntity(&model)
+}
+
+// Restore возвращает пользователя из корзины
+func (r *UsersRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	result := r.trash(ctx).Model(&models.User{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if result.Error != nil {
+		return &users.UserOperationFailedError{Operation: "restore", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &users.UserNotFoundError{UserID: id}
+	}
+	return nil
+}
+
+// Purge окончательно удаляет пользователя из корзины; ссылки из задач, проектов, комментариев
+// и вложений защищены внешними ключами с ON DELETE RESTRICT
+func (r *UsersRepository) Purge(ctx context.Context, id uuid.UUID) error {
+	result := r.trash(ctx).Delete(&models.User{}, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+			return &users.UserInUseError{UserID: id}
+		}
+		return &users.UserOperationFailedError{Operation: "purge", Reason: result.Error.Error()}
+	}
+	if result.RowsAffected == 0 {
+		return &users.UserNotFoundError{UserID: id}
+	}
+	return nil
+}
+
+// trash возвращает запрос к пользователям в корзине: Unscoped отключает условие deleted_at IS NULL,
+// а удаление через такой запрос удаляет строки физически
+func (r *UsersRepository) trash(ctx context.Context) *gorm.DB {
+	return dbFromContext(ctx, r.db).Unscoped().Where("deleted_at IS NOT NULL")
+}
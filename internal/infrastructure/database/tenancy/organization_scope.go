@@ -0,0 +1,150 @@
+package tenancy
+
+import (
+	"reflect"
+
+	"crud/internal/domain/organizations"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+)
+
+// organizationField имя поля моделей, принадлежащих организации
+const organizationField = "OrganizationID"
+
+// OrganizationScope GORM плагин, который ограничивает организацией запроса все обращения к моделям
+// с полем OrganizationID: выборки, подсчеты, обновления и удаления получают условие по organization_id,
+// а новые строки - организацию из контекста. Поэтому репозиторий не может обратиться к данным другой
+// организации, даже если забудет про фильтр. Сырые SQL запросы (Raw) плагин не видит, и они должны
+// возвращать только ID, по которым строки затем загружаются обычными запросами.
+type OrganizationScope struct{}
+
+// Name возвращает имя плагина
+func (OrganizationScope) Name() string {
+	return "tenancy:organization_scope"
+}
+
+// Initialize регистрирует callbacks плагина
+func (OrganizationScope) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+
+	if err := callbacks.Create().Before("gorm:create").Register("tenancy:create", assignOrganization); err != nil {
+		return err
+	}
+	if err := callbacks.Query().Before("gorm:query").Register("tenancy:query", filterByOrganization); err != nil {
+		return err
+	}
+	if err := callbacks.Row().Before("gorm:row").Register("tenancy:row", filterByOrganization); err != nil {
+		return err
+	}
+	if err := callbacks.Update().Before("gorm:update").Register("tenancy:update", protectOrganization); err != nil {
+		return err
+	}
+	return callbacks.Delete().Before("gorm:delete").Register("tenancy:delete", filterByOrganization)
+}
+
+// scopedField возвращает поле организации модели запроса или nil, если модель не принадлежит организации
+func scopedField(db *gorm.DB) *schema.Field {
+	if db.Error != nil || db.Statement.Schema == nil {
+		return nil
+	}
+	return db.Statement.Schema.LookUpField(organizationField)
+}
+
+// organizationCondition возвращает условие на организацию для текущей таблицы запроса
+func organizationCondition(field *schema.Field, organizationID uuid.UUID) clause.Expression {
+	return clause.Eq{Column: clause.Column{Table: clause.CurrentTable, Name: field.DBName}, Value: organizationID}
+}
+
+// filterByOrganization добавляет в запрос условие на организацию из контекста
+func filterByOrganization(db *gorm.DB) {
+	field := scopedField(db)
+	if field == nil {
+		return
+	}
+
+	organizationID, scoped, err := organizations.ScopeFromContext(db.Statement.Context)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+	if !scoped {
+		return
+	}
+
+	db.Statement.AddClause(clause.Where{Exprs: []clause.Expression{organizationCondition(field, organizationID)}})
+}
+
+// protectOrganization ограничивает обновление организацией из контекста и запрещает менять саму организацию
+func protectOrganization(db *gorm.DB) {
+	field := scopedField(db)
+	if field == nil {
+		return
+	}
+
+	filterByOrganization(db)
+	db.Statement.Omits = append(db.Statement.Omits, field.DBName)
+}
+
+// assignOrganization заполняет организацию новых строк из контекста. Save, не нашедший строку
+// для обновления, выполняет INSERT ... ON CONFLICT DO UPDATE: условие на организацию не дает
+// такому upsert перезаписать строку другой организации с тем же первичным ключом
+func assignOrganization(db *gorm.DB) {
+	field := scopedField(db)
+	if field == nil {
+		return
+	}
+
+	organizationID, scoped, err := organizations.ScopeFromContext(db.Statement.Context)
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+
+	ctx := db.Statement.Context
+	err = forEachRow(db.Statement.ReflectValue, func(row reflect.Value) error {
+		if !scoped {
+			// Системная операция должна явно указать организацию строки
+			if _, zero := field.ValueOf(ctx, row); zero {
+				return &organizations.OrganizationRequiredError{}
+			}
+			return nil
+		}
+		return field.Set(ctx, row, organizationID)
+	})
+	if err != nil {
+		db.AddError(err)
+		return
+	}
+
+	if !scoped {
+		return
+	}
+	if c, ok := db.Statement.Clauses["ON CONFLICT"]; ok {
+		if onConflict, ok := c.Expression.(clause.OnConflict); ok && !onConflict.DoNothing {
+			onConflict.Where.Exprs = append(onConflict.Where.Exprs, organizationCondition(field, organizationID))
+			c.Expression = onConflict
+			db.Statement.Clauses["ON CONFLICT"] = c
+		}
+	}
+}
+
+// forEachRow вызывает fn для каждой создаваемой строки: одной структуры или элементов среза
+func forEachRow(value reflect.Value, fn func(row reflect.Value) error) error {
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			row := reflect.Indirect(value.Index(i))
+			if err := fn(row); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Struct:
+		return fn(value)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"crud/config"
+	"crud/internal/domain/attachments"
+)
+
+// LocalBlobStorage хранит содержимое файлов в каталоге локальной файловой системы;
+// ключ "tasks/{task_id}/{id}" соответствует файлу с тем же относительным путем
+type LocalBlobStorage struct {
+	root string
+}
+
+// NewLocalBlobStorage создает хранилище в каталоге ATTACHMENTS_STORAGE_PATH, создавая его при необходимости
+func NewLocalBlobStorage(cfg *config.Config) (*LocalBlobStorage, error) {
+	root, err := filepath.Abs(cfg.AttachmentsStoragePath)
+	if err != nil {
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "open_storage", Reason: err.Error()}
+	}
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "open_storage", Reason: err.Error()}
+	}
+	return &LocalBlobStorage{root: root}, nil
+}
+
+// Put сохраняет содержимое во временный файл и переименовывает его, поэтому
+// читатели никогда не видят частично записанный файл
+func (s *LocalBlobStorage) Put(ctx context.Context, key string, r io.Reader) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(target)
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return &attachments.AttachmentOperationFailedError{Operation: "put_blob", Reason: err.Error()}
+	}
+
+	tmp, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return &attachments.AttachmentOperationFailedError{Operation: "put_blob", Reason: err.Error()}
+	}
+	defer os.Remove(tmp.Name()) // после переименования удалять уже нечего
+
+	if _, err := io.Copy(tmp, &contextReader{ctx: ctx, r: r}); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return &attachments.AttachmentOperationFailedError{Operation: "put_blob", Reason: err.Error()}
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		return &attachments.AttachmentOperationFailedError{Operation: "put_blob", Reason: err.Error()}
+	}
+	return nil
+}
+
+// Get открывает файл с содержимым для чтения
+func (s *LocalBlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(target)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, &attachments.BlobNotFoundError{Key: key}
+		}
+		return nil, &attachments.AttachmentOperationFailedError{Operation: "get_blob", Reason: err.Error()}
+	}
+	return file, nil
+}
+
+// Delete удаляет файл с содержимым
+func (s *LocalBlobStorage) Delete(ctx context.Context, key string) error {
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return &attachments.AttachmentOperationFailedError{Operation: "delete_blob", Reason: err.Error()}
+	}
+	return nil
+}
+
+// path возвращает путь к файлу ключа; ключ не может выходить за пределы каталога хранилища
+func (s *LocalBlobStorage) path(key string) (string, error) {
+	cleaned := path.Clean(key)
+	if key == "" || cleaned != key || path.IsAbs(key) || cleaned == ".." || strings.HasPrefix(cleaned, "../") ||
+		strings.Contains(key, `\`) {
+		return "", &attachments.InvalidAttachmentDataError{Field: "storage_key", Message: "invalid storage key '" + key + "'"}
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
+
+// contextReader прерывает чтение, когда контекст запроса отменен
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (r *contextReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return r.r.Read(p)
+}
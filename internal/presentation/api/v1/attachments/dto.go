@@ -0,0 +1,47 @@
+package attachments
+
+import (
+	"time"
+
+	attachments_domain "crud/internal/domain/attachments"
+)
+
+// AttachmentResponse ответ с описанием вложения
+type AttachmentResponse struct {
+	ID          string `json:"id"`
+	TaskID      string `json:"task_id"`
+	UploaderID  string `json:"uploader_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size"`
+	Checksum    string `json:"checksum"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// AttachmentsResponse ответ со списком вложений задачи
+type AttachmentsResponse struct {
+	Data []AttachmentResponse `json:"data"`
+}
+
+// AttachmentDTOFromEntity создает AttachmentResponse из сущности вложения
+func AttachmentDTOFromEntity(attachment *attachments_domain.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          attachment.ID.String(),
+		TaskID:      attachment.TaskID.String(),
+		UploaderID:  attachment.UploaderID.String(),
+		FileName:    attachment.FileName.Value(),
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		CreatedAt:   attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// AttachmentDTOsFromEntities создает список AttachmentResponse из сущностей вложений
+func AttachmentDTOsFromEntities(attachments []*attachments_domain.Attachment) []AttachmentResponse {
+	response := make([]AttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		response[i] = AttachmentDTOFromEntity(attachment)
+	}
+	return response
+}
@@ -0,0 +1,223 @@
+package attachments
+
+import (
+	"crud/internal/application"
+	attachments_usecases "crud/internal/application/attachments/usecases"
+	attachments_domain "crud/internal/domain/attachments"
+	"crud/internal/presentation/api/v1/problems"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+const (
+	// uploadFormField имя поля multipart формы с файлом
+	uploadFormField = "file"
+	// multipartOverhead запас на заголовки и границы multipart сверх размера файла
+	multipartOverhead = 1 << 20
+)
+
+// Handler обработчик для вложений
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик вложений
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// UploadAttachment загружает файл из поля "file" multipart формы. Файл читается потоком,
+// без буферизации в памяти или на диске. Тип файла определяет по содержимому use case
+// POST /api/v1/tasks/{id}/attachments
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*attachments_usecases.UploadAttachmentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+	limits, err := application.ResolveFromContainer[*attachments_domain.Limits](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, limits.MaxSize()+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		problems.BadRequest(w, r, "Expected multipart/form-data request")
+		return
+	}
+
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			problems.InvalidParameter(w, r, uploadFormField, "Missing '"+uploadFormField+"' form field")
+			return
+		}
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				problems.Error(w, r, err)
+				return
+			}
+			problems.BadRequest(w, r, "Invalid multipart body")
+			return
+		}
+		if part.FormName() != uploadFormField {
+			part.Close()
+			continue
+		}
+
+		attachment, err := useCase.Execute(r.Context(), attachments_usecases.UploadAttachmentInput{
+			TaskID:      taskID,
+			FileName:    part.FileName(),
+			ContentType: part.Header.Get("Content-Type"),
+			Content:     part,
+		})
+		part.Close()
+		if err != nil {
+			problems.Error(w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(AttachmentDTOFromEntity(attachment))
+		return
+	}
+}
+
+// ListAttachments получает вложения задачи
+// GET /api/v1/tasks/{id}/attachments
+func (h *Handler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*attachments_usecases.ListAttachmentsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	attachments, err := useCase.Execute(r.Context(), taskID)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(AttachmentsResponse{Data: AttachmentDTOsFromEntities(attachments)})
+}
+
+// GetAttachment получает описание вложения
+// GET /api/v1/tasks/{id}/attachments/{attachmentID}
+func (h *Handler) GetAttachment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*attachments_usecases.GetAttachmentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, attachmentID, ok := parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	attachment, err := useCase.Execute(r.Context(), taskID, attachmentID)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(AttachmentDTOFromEntity(attachment))
+}
+
+// DownloadAttachment отдает содержимое вложения потоком с исходным типом и именем файла
+// GET /api/v1/tasks/{id}/attachments/{attachmentID}/download
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*attachments_usecases.DownloadAttachmentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, attachmentID, ok := parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	attachment, content, err := useCase.Execute(r.Context(), taskID, attachmentID)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+	defer content.Close()
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": attachment.FileName.Value(),
+	}))
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, content)
+}
+
+// DeleteAttachment удаляет вложение и его содержимое
+// DELETE /api/v1/tasks/{id}/attachments/{attachmentID}
+func (h *Handler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*attachments_usecases.DeleteAttachmentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, attachmentID, ok := parseIDs(w, r)
+	if !ok {
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), taskID, attachmentID); err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// parseIDs разбирает ID задачи и вложения из пути; при ошибке отвечает 400
+func parseIDs(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	attachmentID, err := uuid.Parse(chi.URLParam(r, "attachmentID"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "attachment_id", "Invalid attachment ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return taskID, attachmentID, true
+}
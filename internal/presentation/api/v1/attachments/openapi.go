@@ -0,0 +1,52 @@
+package attachments
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты вложений для документа OpenAPI; пути заданы относительно /tasks/{id}/attachments
+func Operations() []openapi.Operation {
+	taskID := openapi.PathID("id", "ID задачи")
+	attachmentID := openapi.PathID("attachmentID", "ID вложения")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/", ID: "uploadAttachment", Tag: "attachments",
+			Summary:    "Загрузить файл",
+			Parameters: []openapi.Parameter{taskID},
+			Request:    openapi.File(uploadFormField),
+			Responses:  []openapi.Response{openapi.Created(openapi.Of(AttachmentResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/", ID: "listAttachments", Tag: "attachments",
+			Summary:    "Вложения задачи",
+			Parameters: []openapi.Parameter{taskID},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(AttachmentsResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/{attachmentID}", ID: "getAttachment", Tag: "attachments",
+			Summary:    "Получить описание вложения",
+			Parameters: []openapi.Parameter{taskID, attachmentID},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(AttachmentResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/{attachmentID}/download", ID: "downloadAttachment", Tag: "attachments",
+			Summary:    "Скачать содержимое вложения",
+			Parameters: []openapi.Parameter{taskID, attachmentID},
+			Responses: []openapi.Response{{
+				Status:      http.StatusOK,
+				Description: "Содержимое файла с типом, указанным при загрузке",
+				MediaType:   "application/octet-stream",
+				Schema:      openapi.Raw(&openapi.Schema{Type: "string", Format: "binary"}),
+			}},
+		},
+		{
+			Method: http.MethodDelete, Path: "/{attachmentID}", ID: "deleteAttachment", Tag: "attachments",
+			Summary:    "Удалить вложение",
+			Parameters: []openapi.Parameter{taskID, attachmentID},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+	}
+}
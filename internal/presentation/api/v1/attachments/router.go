@@ -0,0 +1,25 @@
+package attachments
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для вложений задачи.
+// Маршруты подключаются внутри /tasks/{id}/attachments, аутентификацию выполняет маршрут задач
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Post("/", handler.UploadAttachment)
+	r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/", handler.ListAttachments)
+	r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{attachmentID}", handler.GetAttachment)
+	r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{attachmentID}/download", handler.DownloadAttachment)
+	r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Delete("/{attachmentID}", handler.DeleteAttachment)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package audit
+
+import (
+	"time"
+
+	audit_domain "crud/internal/domain/audit"
+)
+
+// FieldChangeResponse изменение поля; у секретных полей значения не передаются, а redacted равен true
+type FieldChangeResponse struct {
+	Field    string `json:"field"`
+	Old      any    `json:"old"`
+	New      any    `json:"new"`
+	Redacted bool   `json:"redacted,omitempty"`
+}
+
+// AuditEntryResponse ответ с записью журнала аудита
+type AuditEntryResponse struct {
+	ID         string                `json:"id"`
+	EntityType string                `json:"entity_type"`
+	EntityID   string                `json:"entity_id"`
+	Action     string                `json:"action"`
+	ActorID    *string               `json:"actor_id"`
+	Changes    []FieldChangeResponse `json:"changes"`
+	CreatedAt  string                `json:"created_at"`
+}
+
+// AuditEntryDTOFromEntity создает AuditEntryResponse из записи журнала
+func AuditEntryDTOFromEntity(entry *audit_domain.Entry) AuditEntryResponse {
+	response := AuditEntryResponse{
+		ID:         entry.ID.String(),
+		EntityType: string(entry.EntityType),
+		EntityID:   entry.EntityID.String(),
+		Action:     string(entry.Action),
+		Changes:    make([]FieldChangeResponse, len(entry.Changes)),
+		CreatedAt:  entry.CreatedAt.Format(time.RFC3339),
+	}
+
+	if entry.ActorID != nil {
+		actorID := entry.ActorID.String()
+		response.ActorID = &actorID
+	}
+	for i, change := range entry.Changes {
+		response.Changes[i] = FieldChangeResponse{
+			Field:    change.Field,
+			Old:      change.Old,
+			New:      change.New,
+			Redacted: change.Redacted,
+		}
+	}
+
+	return response
+}
+
+// AuditEntryDTOsFromEntities создает список AuditEntryResponse из записей журнала
+func AuditEntryDTOsFromEntities(entries []*audit_domain.Entry) []AuditEntryResponse {
+	response := make([]AuditEntryResponse, len(entries))
+	for i, entry := range entries {
+		response[i] = AuditEntryDTOFromEntity(entry)
+	}
+	return response
+}
@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"crud/internal/application"
+	audit_usecases "crud/internal/application/audit/usecases"
+	audit_domain "crud/internal/domain/audit"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для журнала аудита
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик журнала аудита
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// ListTaskHistory получает историю изменений задачи от новых записей к старым
+// GET /api/v1/tasks/{id}/history?page=&page_size=
+func (h *Handler) ListTaskHistory(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*audit_usecases.ListTaskHistoryUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	entries, total, err := useCase.Execute(r.Context(), taskID, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	writeEntries(w, entries, total, page, pageSize)
+}
+
+// ListAuditLog получает журнал аудита организации от новых записей к старым.
+// from включает границу, to - нет; обе даты в формате RFC 3339
+// GET /api/v1/audit?actor_id=&entity_type=&entity_id=&from=&to=&page=&page_size=
+func (h *Handler) ListAuditLog(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*audit_usecases.ListAuditLogUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	var filter audit_domain.ListEntriesFilter
+	if entityType := query.Get("entity_type"); entityType != "" {
+		value := audit_domain.EntityType(entityType)
+		filter.EntityType = &value
+	}
+	if filter.EntityID, err = parseOptionalUUID(query, "entity_id"); err != nil {
+		problems.InvalidParameter(w, r, "entity_id", "Invalid entity ID")
+		return
+	}
+	if filter.ActorID, err = parseOptionalUUID(query, "actor_id"); err != nil {
+		problems.InvalidParameter(w, r, "actor_id", "Invalid actor ID")
+		return
+	}
+	if filter.From, err = parseOptionalTime(query, "from"); err != nil {
+		problems.InvalidParameter(w, r, "from", "Invalid 'from' time, expected RFC 3339")
+		return
+	}
+	if filter.To, err = parseOptionalTime(query, "to"); err != nil {
+		problems.InvalidParameter(w, r, "to", "Invalid 'to' time, expected RFC 3339")
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	entries, total, err := useCase.Execute(r.Context(), filter, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	writeEntries(w, entries, total, page, pageSize)
+}
+
+// writeEntries отвечает страницей записей журнала
+func writeEntries(w http.ResponseWriter, entries []*audit_domain.Entry, total int64, page, pageSize int) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      AuditEntryDTOsFromEntities(entries),
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+func parseOptionalUUID(query url.Values, key string) (*uuid.UUID, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+func parseOptionalTime(query url.Values, key string) (*time.Time, error) {
+	value := query.Get(key)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
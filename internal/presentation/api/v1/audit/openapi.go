@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты журнала аудита для документа OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/audit", ID: "listAuditLog", Tag: "audit",
+			Summary: "Журнал аудита организации от новых записей к старым",
+			Parameters: append([]openapi.Parameter{
+				openapi.Query("actor_id", "автор изменений", openapi.UUID()),
+				openapi.Query("entity_type", "тип измененной сущности", openapi.Enum("task", "user")),
+				openapi.Query("entity_id", "ID измененной сущности", openapi.UUID()),
+				openapi.Query("from", "начало периода включительно", openapi.DateTime()),
+				openapi.Query("to", "конец периода, не включая границу", openapi.DateTime()),
+			}, openapi.Pagination()...),
+			Responses: []openapi.Response{openapi.OK(openapi.PageOf(AuditEntryResponse{}))},
+		},
+	}
+}
+
+// TaskOperations описывает маршрут истории задачи; пути заданы относительно /tasks/{id}/history
+func TaskOperations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/", ID: "listTaskHistory", Tag: "tasks",
+			Summary:    "История изменений задачи от новых записей к старым",
+			Parameters: append([]openapi.Parameter{openapi.PathID("id", "ID задачи")}, openapi.Pagination()...),
+			Responses:  []openapi.Response{openapi.OK(openapi.PageOf(AuditEntryResponse{}))},
+		},
+	}
+}
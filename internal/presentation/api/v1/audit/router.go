@@ -0,0 +1,36 @@
+package audit
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты журнала аудита организации
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/audit", func(r chi.Router) {
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionAuditRead)).Get("/", handler.ListAuditLog)
+	})
+
+	return nil
+}
+
+// SetupTaskRoutes настраивает маршрут истории задачи.
+// Маршрут подключается внутри /tasks/{id}/history, аутентификацию выполняет маршрут задач
+func SetupTaskRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/", handler.ListTaskHistory)
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"time"
+
+	auth_domain "crud/internal/domain/auth"
+)
+
+// LoginRequest запрос на вход
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// RefreshRequest запрос на ротацию refresh токена
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LogoutRequest запрос на завершение сессии
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+// TokenResponse ответ с парой токенов
+type TokenResponse struct {
+	AccessToken           string `json:"access_token"`
+	TokenType             string `json:"token_type"`
+	ExpiresIn             int64  `json:"expires_in"`
+	AccessTokenExpiresAt  string `json:"access_token_expires_at"`
+	RefreshToken          string `json:"refresh_token"`
+	RefreshTokenExpiresAt string `json:"refresh_token_expires_at"`
+}
+
+// TokenDTOFromEntity создает TokenResponse из пары токенов
+func TokenDTOFromEntity(pair *auth_domain.TokenPair) TokenResponse {
+	return TokenResponse{
+		AccessToken:           pair.AccessToken,
+		TokenType:             "Bearer",
+		ExpiresIn:             int64(time.Until(pair.AccessTokenExpiresAt).Seconds()),
+		AccessTokenExpiresAt:  pair.AccessTokenExpiresAt.Format(time.RFC3339),
+		RefreshToken:          pair.RefreshToken,
+		RefreshTokenExpiresAt: pair.RefreshTokenExpiresAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,131 @@
+package auth
+
+import (
+	"crud/internal/application"
+	auth_usecases "crud/internal/application/auth/usecases"
+	auth_domain "crud/internal/domain/auth"
+	users_domain "crud/internal/domain/users"
+	"crud/internal/presentation/api/v1/problems"
+	v1_users "crud/internal/presentation/api/v1/users"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для аутентификации
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик аутентификации
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// Login выполняет вход по email и паролю
+// POST /api/v1/auth/login
+func (h *Handler) Login(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*auth_usecases.LoginUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	pair, err := useCase.Execute(r.Context(), req.Email, req.Password)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	writeTokenPair(w, pair)
+}
+
+// Refresh обменивает refresh токен на новую пару токенов
+// POST /api/v1/auth/refresh
+func (h *Handler) Refresh(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*auth_usecases.RefreshTokensUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req RefreshRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	pair, err := useCase.Execute(r.Context(), req.RefreshToken)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	writeTokenPair(w, pair)
+}
+
+// Logout завершает сессию, отзывая refresh токены
+// POST /api/v1/auth/logout
+func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*auth_usecases.LogoutUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req LogoutRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.RefreshToken == "" {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), req.RefreshToken); err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Me возвращает пользователя, выполняющего запрос
+// GET /api/v1/auth/me
+func (h *Handler) Me(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*auth_usecases.GetCurrentUserUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	// Токен пользователя, которого уже нет, больше не подтверждает личность
+	user, err := useCase.Execute(r.Context())
+	if users_domain.IsUserNotFound(err) {
+		err = &auth_domain.UnauthenticatedError{}
+	}
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := v1_users.UserDTOFromEntity(user)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// writeTokenPair пишет пару токенов в ответ, запрещая кеширование
+func writeTokenPair(w http.ResponseWriter, pair *auth_domain.TokenPair) {
+	response := TokenDTOFromEntity(pair)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+	v1_users "crud/internal/presentation/api/v1/users"
+)
+
+// Operations описывает маршруты аутентификации для документа OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/auth/register", ID: "register", Tag: "auth", Public: true,
+			Summary:   "Зарегистрироваться в организации по умолчанию",
+			Request:   openapi.JSON(v1_users.RegisterUserRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(v1_users.UserResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/login", ID: "login", Tag: "auth", Public: true,
+			Summary:   "Войти по email и паролю",
+			Request:   openapi.JSON(LoginRequest{}),
+			Responses: []openapi.Response{openapi.OK(openapi.Of(TokenResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/refresh", ID: "refresh", Tag: "auth", Public: true,
+			Summary:   "Обменять refresh токен на новую пару токенов",
+			Request:   openapi.JSON(RefreshRequest{}),
+			Responses: []openapi.Response{openapi.OK(openapi.Of(TokenResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/auth/logout", ID: "logout", Tag: "auth", Public: true,
+			Summary:   "Отозвать refresh токен",
+			Request:   openapi.JSON(LogoutRequest{}),
+			Responses: []openapi.Response{openapi.NoContent()},
+		},
+		{
+			Method: http.MethodGet, Path: "/auth/me", ID: "getCurrentUser", Tag: "auth",
+			Summary:   "Текущий пользователь",
+			Responses: []openapi.Response{openapi.OK(openapi.Of(v1_users.UserResponse{}))},
+		},
+	}
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	"crud/internal/presentation/api/v1/middleware"
+	v1_users "crud/internal/presentation/api/v1/users"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для аутентификации
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+	usersHandler := v1_users.NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/auth", func(r chi.Router) {
+		r.With(middleware.DefaultOrganization).Post("/register", usersHandler.RegisterUser)
+		r.Post("/login", handler.Login)
+		r.Post("/refresh", handler.Refresh)
+		r.Post("/logout", handler.Logout)
+
+		r.With(middleware.Authenticate(container)).Get("/me", handler.Me)
+	})
+
+	return nil
+}
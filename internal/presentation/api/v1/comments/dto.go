@@ -0,0 +1,108 @@
+package comments
+
+import (
+	"time"
+
+	comments_usecases "crud/internal/application/comments/usecases"
+	comments_domain "crud/internal/domain/comments"
+
+	"github.com/google/uuid"
+)
+
+// CreateCommentRequest запрос на создание комментария; parent_id задается для ответа на комментарий
+type CreateCommentRequest struct {
+	ParentID string `json:"parent_id,omitempty"`
+	Body     string `json:"body"`
+}
+
+// UpdateCommentRequest запрос на изменение текста комментария
+type UpdateCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// CommentResponse ответ с данными комментария
+type CommentResponse struct {
+	ID        string  `json:"id"`
+	TaskID    string  `json:"task_id"`
+	AuthorID  string  `json:"author_id"`
+	ParentID  *string `json:"parent_id"`
+	Body      string  `json:"body"`
+	EditedAt  *string `json:"edited_at"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt string  `json:"updated_at"`
+}
+
+// CommentRevisionResponse ответ с прежней версией текста комментария
+type CommentRevisionResponse struct {
+	ID        string `json:"id"`
+	Body      string `json:"body"`
+	CreatedAt string `json:"created_at"`
+}
+
+// CommentRevisionsResponse ответ с историей изменений комментария
+type CommentRevisionsResponse struct {
+	Data []CommentRevisionResponse `json:"data"`
+}
+
+// CommentDTOFromEntity создает CommentResponse из сущности комментария
+func CommentDTOFromEntity(comment *comments_domain.Comment) CommentResponse {
+	response := CommentResponse{
+		ID:        comment.ID.String(),
+		TaskID:    comment.TaskID.String(),
+		AuthorID:  comment.AuthorID.String(),
+		Body:      comment.Body.Value(),
+		CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: comment.UpdatedAt.Format(time.RFC3339),
+	}
+
+	if comment.ParentID != nil {
+		parentID := comment.ParentID.String()
+		response.ParentID = &parentID
+	}
+	if comment.EditedAt != nil {
+		editedAt := comment.EditedAt.Format(time.RFC3339)
+		response.EditedAt = &editedAt
+	}
+
+	return response
+}
+
+// CommentDTOsFromEntities создает список CommentResponse из сущностей комментариев
+func CommentDTOsFromEntities(comments []*comments_domain.Comment) []CommentResponse {
+	response := make([]CommentResponse, len(comments))
+	for i, comment := range comments {
+		response[i] = CommentDTOFromEntity(comment)
+	}
+	return response
+}
+
+// CommentRevisionDTOsFromEntities создает список CommentRevisionResponse из версий комментария
+func CommentRevisionDTOsFromEntities(revisions []*comments_domain.CommentRevision) []CommentRevisionResponse {
+	response := make([]CommentRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		response[i] = CommentRevisionResponse{
+			ID:        revision.ID.String(),
+			Body:      revision.Body,
+			CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return response
+}
+
+// CreateCommentInputFromRequest преобразует запрос в входные данные use case
+func CreateCommentInputFromRequest(taskID uuid.UUID, req CreateCommentRequest) (comments_usecases.CreateCommentInput, error) {
+	input := comments_usecases.CreateCommentInput{
+		TaskID: taskID,
+		Body:   req.Body,
+	}
+
+	if req.ParentID != "" {
+		parentID, err := uuid.Parse(req.ParentID)
+		if err != nil {
+			return input, &comments_domain.InvalidCommentDataError{Field: "parent_id", Message: "invalid parent comment ID"}
+		}
+		input.ParentID = &parentID
+	}
+
+	return input, nil
+}
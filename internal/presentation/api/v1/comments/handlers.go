@@ -0,0 +1,208 @@
+package comments
+
+import (
+	"crud/internal/application"
+	comments_usecases "crud/internal/application/comments/usecases"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для комментариев
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик комментариев
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// CreateComment создает комментарий к задаче или ответ на комментарий
+// POST /api/v1/tasks/{id}/comments
+func (h *Handler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*comments_usecases.CreateCommentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	var req CreateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	input, err := CreateCommentInputFromRequest(taskID, req)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	comment, err := useCase.Execute(r.Context(), input)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := CommentDTOFromEntity(comment)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListComments получает комментарии задачи верхнего уровня или ответы на комментарий parent_id
+// GET /api/v1/tasks/{id}/comments?parent_id=&page=&page_size=
+func (h *Handler) ListComments(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*comments_usecases.ListCommentsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	query := r.URL.Query()
+
+	var parentID *uuid.UUID
+	if parentIDStr := query.Get("parent_id"); parentIDStr != "" {
+		id, err := uuid.Parse(parentIDStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "parent_id", "Invalid parent comment ID")
+			return
+		}
+		parentID = &id
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	comments, total, err := useCase.Execute(r.Context(), taskID, parentID, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      CommentDTOsFromEntities(comments),
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// UpdateComment изменяет текст комментария; изменить комментарий может только его автор
+// PUT /api/v1/tasks/{id}/comments/{commentID}
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*comments_usecases.UpdateCommentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, commentID, ok := parseCommentPath(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	comment, err := useCase.Execute(r.Context(), taskID, commentID, req.Body)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := CommentDTOFromEntity(comment)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteComment удаляет комментарий вместе с ответами; удалить комментарий может только его автор
+// DELETE /api/v1/tasks/{id}/comments/{commentID}
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*comments_usecases.DeleteCommentUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, commentID, ok := parseCommentPath(w, r)
+	if !ok {
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), taskID, commentID); err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListCommentRevisions возвращает прежние версии текста комментария от старых к новым
+// GET /api/v1/tasks/{id}/comments/{commentID}/revisions
+func (h *Handler) ListCommentRevisions(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*comments_usecases.ListCommentRevisionsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	taskID, commentID, ok := parseCommentPath(w, r)
+	if !ok {
+		return
+	}
+
+	revisions, err := useCase.Execute(r.Context(), taskID, commentID)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(CommentRevisionsResponse{Data: CommentRevisionDTOsFromEntities(revisions)})
+}
+
+// parseCommentPath разбирает ID задачи и комментария из пути; при ошибке отвечает 400
+func parseCommentPath(w http.ResponseWriter, r *http.Request) (uuid.UUID, uuid.UUID, bool) {
+	taskID, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	commentID, err := uuid.Parse(chi.URLParam(r, "commentID"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "comment_id", "Invalid comment ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return taskID, commentID, true
+}
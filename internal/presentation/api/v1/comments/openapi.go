@@ -0,0 +1,51 @@
+package comments
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты комментариев для документа OpenAPI; пути заданы относительно /tasks/{id}/comments
+func Operations() []openapi.Operation {
+	taskID := openapi.PathID("id", "ID задачи")
+	commentID := openapi.PathID("commentID", "ID комментария")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/", ID: "createComment", Tag: "comments",
+			Summary:    "Добавить комментарий или ответ на комментарий",
+			Parameters: []openapi.Parameter{taskID},
+			Request:    openapi.JSON(CreateCommentRequest{}),
+			Responses:  []openapi.Response{openapi.Created(openapi.Of(CommentResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/", ID: "listComments", Tag: "comments",
+			Summary: "Комментарии задачи",
+			Parameters: append([]openapi.Parameter{
+				taskID,
+				openapi.Query("parent_id", "только ответы на комментарий", openapi.UUID()),
+			}, openapi.Pagination()...),
+			Responses: []openapi.Response{openapi.OK(openapi.PageOf(CommentResponse{}))},
+		},
+		{
+			Method: http.MethodPut, Path: "/{commentID}", ID: "updateComment", Tag: "comments",
+			Summary:    "Изменить текст комментария",
+			Parameters: []openapi.Parameter{taskID, commentID},
+			Request:    openapi.JSON(UpdateCommentRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(CommentResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/{commentID}", ID: "deleteComment", Tag: "comments",
+			Summary:    "Удалить комментарий",
+			Parameters: []openapi.Parameter{taskID, commentID},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+		{
+			Method: http.MethodGet, Path: "/{commentID}/revisions", ID: "listCommentRevisions", Tag: "comments",
+			Summary:    "Предыдущие версии текста комментария",
+			Parameters: []openapi.Parameter{taskID, commentID},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(CommentRevisionsResponse{}))},
+		},
+	}
+}
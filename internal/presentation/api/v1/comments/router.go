@@ -0,0 +1,25 @@
+package comments
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для комментариев задачи.
+// Маршруты подключаются внутри /tasks/{id}/comments, аутентификацию выполняет маршрут задач
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.With(middleware.RequirePermission(policies.PermissionCommentsCreate)).Post("/", handler.CreateComment)
+	r.With(middleware.RequirePermission(policies.PermissionCommentsRead)).Get("/", handler.ListComments)
+	r.With(middleware.RequirePermission(policies.PermissionCommentsUpdate)).Put("/{commentID}", handler.UpdateComment)
+	r.With(middleware.RequirePermission(policies.PermissionCommentsDelete)).Delete("/{commentID}", handler.DeleteComment)
+	r.With(middleware.RequirePermission(policies.PermissionCommentsRead)).Get("/{commentID}/revisions", handler.ListCommentRevisions)
+
+	return nil
+}
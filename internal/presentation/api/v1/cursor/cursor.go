@@ -0,0 +1,37 @@
+package cursor
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// ErrInvalid возвращается для значения, которое не было выдано сервером как курсор
+var ErrInvalid = errors.New("invalid cursor")
+
+// Encode возвращает курсор для ответа API: позицию в списке в виде непрозрачной строки,
+// которую можно передать в параметре запроса без экранирования
+func Encode(position interface{}) string {
+	data, err := json.Marshal(position)
+	if err != nil {
+		// Позиции - структуры домена из дат, чисел и строк, их кодирование не завершается ошибкой
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Decode разбирает курсор из запроса в position; для испорченного значения возвращает ErrInvalid
+func Decode(value string, position interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return ErrInvalid
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(position); err != nil {
+		return ErrInvalid
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package etag
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// flagSeparator отделяет от версии признаки представления, например "3-o"
+const flagSeparator = "-"
+
+// Format возвращает ETag для версии сущности, например "3". flags - признаки вычисляемых полей
+// представления, которые меняются без изменения версии; они добавляются к версии через "-"
+func Format(version int64, flags ...string) string {
+	tag := strconv.FormatInt(version, 10)
+	for _, flag := range flags {
+		tag += flagSeparator + flag
+	}
+	return strconv.Quote(tag)
+}
+
+// Set добавляет в ответ заголовок ETag с версией сущности и признаками представления
+func Set(w http.ResponseWriter, version int64, flags ...string) {
+	w.Header().Set("ETag", Format(version, flags...))
+}
+
+// IfMatchVersion возвращает версию из заголовка If-Match. Без заголовка и для "*" возвращает nil:
+// подойдет любая версия существующей сущности. ok равно false, если заголовок не может совпасть
+// с версией: ETag слабый (If-Match сравнивает ETag строго), не содержит версию или их несколько.
+// Признаки представления не учитываются: изменения защищают от потери только чужие изменения версии
+func IfMatchVersion(r *http.Request) (version *int64, ok bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return nil, true
+	}
+
+	tag, err := strconv.Unquote(header)
+	if err != nil {
+		return nil, false
+	}
+	tag, _, _ = strings.Cut(tag, flagSeparator)
+	parsed, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	return &parsed, true
+}
+
+// NoneMatch проверяет, совпадает ли заголовок If-None-Match с версией сущности и признаками
+// представления, то есть у клиента уже есть актуальное представление. If-None-Match сравнивает
+// ETag слабо, поэтому префикс W/ не учитывается
+func NoneMatch(r *http.Request, version int64, flags ...string) bool {
+	header := strings.TrimSpace(r.Header.Get("If-None-Match"))
+	if header == "" {
+		return false
+	}
+	if header == "*" {
+		return true
+	}
+
+	current := Format(version, flags...)
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == current {
+			return true
+		}
+	}
+	return false
+}
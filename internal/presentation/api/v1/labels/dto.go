@@ -0,0 +1,44 @@
+package labels
+
+import (
+	"time"
+
+	labels_domain "crud/internal/domain/labels"
+)
+
+// CreateLabelRequest запрос на создание метки
+type CreateLabelRequest struct {
+	Name string `json:"name"`
+}
+
+// UpdateLabelRequest запрос на переименование метки
+type UpdateLabelRequest struct {
+	Name string `json:"name"`
+}
+
+// LabelResponse ответ с данными метки
+type LabelResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// LabelDTOFromEntity создает LabelResponse из сущности метки
+func LabelDTOFromEntity(label *labels_domain.Label) LabelResponse {
+	return LabelResponse{
+		ID:        label.ID.String(),
+		Name:      label.Name.Value(),
+		CreatedAt: label.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: label.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// LabelDTOsFromEntities создает список LabelResponse из сущностей меток
+func LabelDTOsFromEntities(labels []*labels_domain.Label) []LabelResponse {
+	response := make([]LabelResponse, len(labels))
+	for i, label := range labels {
+		response[i] = LabelDTOFromEntity(label)
+	}
+	return response
+}
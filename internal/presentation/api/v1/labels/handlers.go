@@ -0,0 +1,169 @@
+package labels
+
+import (
+	"crud/internal/application"
+	labels_usecases "crud/internal/application/labels/usecases"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для меток
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик меток
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// CreateLabel создает новую метку
+// POST /api/v1/labels
+func (h *Handler) CreateLabel(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*labels_usecases.CreateLabelUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req CreateLabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	label, err := useCase.Execute(r.Context(), req.Name)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := LabelDTOFromEntity(label)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetLabelByID получает метку по ID
+// GET /api/v1/labels/{id}
+func (h *Handler) GetLabelByID(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*labels_usecases.GetLabelByIDUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid label ID")
+		return
+	}
+
+	label, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := LabelDTOFromEntity(label)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListLabels получает список меток
+// GET /api/v1/labels
+func (h *Handler) ListLabels(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*labels_usecases.ListLabelsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	labels, total, err := useCase.Execute(r.Context(), page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      LabelDTOsFromEntities(labels),
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// UpdateLabel переименовывает метку
+// PUT /api/v1/labels/{id}
+func (h *Handler) UpdateLabel(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*labels_usecases.UpdateLabelUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid label ID")
+		return
+	}
+
+	var req UpdateLabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	label, err := useCase.Execute(r.Context(), id, req.Name)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := LabelDTOFromEntity(label)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteLabel удаляет метку и снимает ее со всех задач
+// DELETE /api/v1/labels/{id}
+func (h *Handler) DeleteLabel(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*labels_usecases.DeleteLabelUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid label ID")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), id); err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
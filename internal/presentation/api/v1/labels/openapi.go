@@ -0,0 +1,46 @@
+package labels
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты меток для документа OpenAPI
+func Operations() []openapi.Operation {
+	id := openapi.PathID("id", "ID метки")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/labels", ID: "createLabel", Tag: "labels",
+			Summary:   "Создать метку",
+			Request:   openapi.JSON(CreateLabelRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(LabelResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/labels", ID: "listLabels", Tag: "labels",
+			Summary:    "Список меток организации",
+			Parameters: openapi.Pagination(),
+			Responses:  []openapi.Response{openapi.OK(openapi.PageOf(LabelResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/labels/{id}", ID: "getLabel", Tag: "labels",
+			Summary:    "Получить метку",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(LabelResponse{}))},
+		},
+		{
+			Method: http.MethodPut, Path: "/labels/{id}", ID: "updateLabel", Tag: "labels",
+			Summary:    "Переименовать метку",
+			Parameters: []openapi.Parameter{id},
+			Request:    openapi.JSON(UpdateLabelRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(LabelResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/labels/{id}", ID: "deleteLabel", Tag: "labels",
+			Summary:    "Удалить метку и снять ее со всех задач",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+	}
+}
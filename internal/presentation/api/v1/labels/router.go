@@ -0,0 +1,28 @@
+package labels
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для меток
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/labels", func(r chi.Router) {
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionLabelsCreate)).Post("/", handler.CreateLabel)
+		r.With(middleware.RequirePermission(policies.PermissionLabelsRead)).Get("/", handler.ListLabels)
+		r.With(middleware.RequirePermission(policies.PermissionLabelsRead)).Get("/{id}", handler.GetLabelByID)
+		r.With(middleware.RequirePermission(policies.PermissionLabelsManage)).Put("/{id}", handler.UpdateLabel)
+		r.With(middleware.RequirePermission(policies.PermissionLabelsManage)).Delete("/{id}", handler.DeleteLabel)
+	})
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"crud/internal/application"
+	auth_domain "crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+	"crud/internal/presentation/api/v1/problems"
+
+	"go.uber.org/dig"
+)
+
+// Authenticate проверяет Bearer access токен и кладет в контекст запроса пользователя и его организацию:
+// все обращения к данным в запросе ограничиваются этой организацией
+func Authenticate(container *dig.Container) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			tokens, err := application.ResolveFromContainer[auth_domain.BaseTokenManager](container)
+			if err != nil {
+				problems.Internal(w, r, err)
+				return
+			}
+
+			token, ok := bearerToken(r)
+			if !ok {
+				unauthorized(w, r, &auth_domain.UnauthenticatedError{})
+				return
+			}
+
+			principal, err := tokens.ParseAccessToken(token)
+			if err != nil {
+				unauthorized(w, r, err)
+				return
+			}
+
+			ctx := auth_domain.ContextWithPrincipal(r.Context(), *principal)
+			ctx = organizations.ContextWithOrganization(ctx, principal.OrganizationID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// DefaultOrganization кладет в контекст анонимного запроса организацию по умолчанию.
+// Используется для самостоятельной регистрации, которая всегда создает пользователя в ней
+func DefaultOrganization(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := organizations.ContextWithOrganization(r.Context(), organizations.DefaultOrganizationID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// bearerToken извлекает токен из заголовка Authorization
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+// unauthorized отвечает 401 с заголовком WWW-Authenticate
+func unauthorized(w http.ResponseWriter, r *http.Request, err error) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	problems.Error(w, r, err)
+}
@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"net/http"
+
+	"crud/internal/application/policies"
+	auth_domain "crud/internal/domain/auth"
+	"crud/internal/presentation/api/v1/openapi"
+	"crud/internal/presentation/api/v1/problems"
+)
+
+// RequirePermission пропускает запрос, только если у роли пользователя есть право permission.
+// После проверки прав выполняет отложенную проверку запроса по документу OpenAPI.
+// Должен использоваться после Authenticate.
+func RequirePermission(permission policies.Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if _, err := policies.RequirePermission(r.Context(), permission); err != nil {
+				if auth_domain.IsUnauthenticated(err) {
+					unauthorized(w, r, err)
+					return
+				}
+				problems.Error(w, r, err)
+				return
+			}
+			if !openapi.ValidatePending(w, r) {
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/audit"
+	"crud/internal/presentation/api/v1/auth"
+	"crud/internal/presentation/api/v1/labels"
+	"crud/internal/presentation/api/v1/openapi"
+	"crud/internal/presentation/api/v1/organizations"
+	"crud/internal/presentation/api/v1/projects"
+	"crud/internal/presentation/api/v1/tasks"
+	"crud/internal/presentation/api/v1/trash"
+	"crud/internal/presentation/api/v1/users"
+)
+
+// Operations собирает объявления операций всех маршрутов API v1 в порядке их подключения
+func Operations() []openapi.Operation {
+	var operations []openapi.Operation
+	operations = append(operations, auth.Operations()...)
+	operations = append(operations, organizations.Operations()...)
+	operations = append(operations, users.Operations()...)
+	operations = append(operations, projects.Operations()...)
+	operations = append(operations, tasks.Operations()...)
+	operations = append(operations, labels.Operations()...)
+	operations = append(operations, audit.Operations()...)
+	operations = append(operations, trash.Operations()...)
+
+	// Сам документ и страница документации
+	operations = append(operations,
+		openapi.Operation{
+			Method: http.MethodGet, Path: "/openapi.json", ID: "getOpenAPIDocument", Tag: "docs", Public: true,
+			Summary: "Документ OpenAPI",
+			Responses: []openapi.Response{
+				openapi.OK(openapi.Raw(&openapi.Schema{Type: "object"})),
+			},
+		},
+		openapi.Operation{
+			Method: http.MethodGet, Path: "/docs", ID: "getDocs", Tag: "docs", Public: true,
+			Summary: "Страница документации",
+			Responses: []openapi.Response{
+				{Status: http.StatusOK, Description: "HTML страница", MediaType: "text/html", Schema: openapi.Raw(openapi.String())},
+			},
+		},
+	)
+	return operations
+}
+
+// Document строит документ OpenAPI для API v1
+func Document() *openapi.Document {
+	return openapi.Build(openapi.Info{
+		Title:       "Go Task Manager CRUD API",
+		Version:     "1.0.0",
+		Description: "REST API для управления задачами и пользователями",
+	}, "/api/v1", Operations())
+}
@@ -0,0 +1,33 @@
+package openapi
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+)
+
+// docsPage страница документации: загружает openapi.json рядом с собой и выводит операции по группам
+//
+//go:embed docs.html
+var docsPage []byte
+
+// Handler отвечает документом в JSON. Документ сериализуется один раз при подключении маршрута
+func Handler(document *Document) (http.HandlerFunc, error) {
+	body, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(body)
+	}, nil
+}
+
+// Docs отвечает страницей документации API
+func Docs(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(docsPage)
+}
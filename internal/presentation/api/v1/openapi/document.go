@@ -0,0 +1,98 @@
+package openapi
+
+// Version версия спецификации OpenAPI, которой соответствует документ
+const Version = "3.1.0"
+
+// Document документ OpenAPI
+type Document struct {
+	OpenAPI    string                `json:"openapi"`
+	Info       Info                  `json:"info"`
+	Servers    []Server              `json:"servers,omitempty"`
+	Security   []SecurityRequirement `json:"security,omitempty"`
+	Tags       []Tag                 `json:"tags,omitempty"`
+	Paths      map[string]PathItem   `json:"paths"`
+	Components Components            `json:"components"`
+}
+
+// Info сведения об API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Server адрес, относительно которого заданы пути документа
+type Server struct {
+	URL string `json:"url"`
+}
+
+// Tag группа операций
+type Tag struct {
+	Name string `json:"name"`
+}
+
+// SecurityRequirement схемы аутентификации операции; пустой список делает операцию публичной
+type SecurityRequirement map[string][]string
+
+// PathItem операции пути по HTTP методам в нижнем регистре
+type PathItem map[string]*OperationObject
+
+// OperationObject описание операции в документе
+type OperationObject struct {
+	OperationID string                    `json:"operationId"`
+	Summary     string                    `json:"summary,omitempty"`
+	Tags        []string                  `json:"tags,omitempty"`
+	Security    *[]SecurityRequirement    `json:"security,omitempty"`
+	Parameters  []Parameter               `json:"parameters,omitempty"`
+	RequestBody *RequestBody              `json:"requestBody,omitempty"`
+	Responses   map[string]ResponseObject `json:"responses"`
+}
+
+// Parameter параметр пути, строки запроса или заголовок
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody тело запроса по типам содержимого
+type RequestBody struct {
+	Required bool                 `json:"required,omitempty"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// ResponseObject ответ операции
+type ResponseObject struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType схема содержимого одного типа
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Components переиспользуемые схемы и схемы аутентификации
+type Components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme схема аутентификации
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Operation находит операцию по методу и пути документа
+func (d *Document) Operation(method, path string) (*OperationObject, bool) {
+	item, ok := d.Paths[path]
+	if !ok {
+		return nil, false
+	}
+	operation, ok := item[lower(method)]
+	return operation, ok
+}
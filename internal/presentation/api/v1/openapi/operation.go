@@ -0,0 +1,229 @@
+package openapi
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/patch"
+	"crud/internal/presentation/api/v1/problems"
+)
+
+// Типы содержимого тел запросов и ответов
+const (
+	JSONMediaType      = "application/json"
+	MultipartMediaType = "multipart/form-data"
+)
+
+// bearerScheme имя схемы аутентификации по access токену
+const bearerScheme = "bearerAuth"
+
+// Operation объявление операции API рядом с ее маршрутом: из объявлений строится документ,
+// а тест сверяет их с маршрутами роутера
+type Operation struct {
+	Method     string
+	Path       string
+	ID         string
+	Summary    string
+	Tag        string
+	Public     bool // операция доступна без access токена
+	Parameters []Parameter
+	Request    Body
+	Responses  []Response
+}
+
+// Body тело запроса: схемы по типам содержимого
+type Body map[string]Source
+
+// Response успешный ответ операции; ответы с ошибками описываются общей схемой problem details
+type Response struct {
+	Status      int
+	Description string
+	MediaType   string
+	Schema      Source
+}
+
+// JSON тело запроса в JSON по схеме DTO
+func JSON(v interface{}) Body {
+	return Body{JSONMediaType: Of(v)}
+}
+
+// Patch тело PATCH запроса: JSON Merge Patch по схеме DTO обновления или JSON Patch со списком операций
+func Patch(v interface{}) Body {
+	return Body{
+		patch.MergePatchMediaType: Of(v),
+		patch.JSONPatchMediaType: Raw(&Schema{
+			Type: "array",
+			Items: &Schema{
+				Type: "object",
+				Properties: map[string]*Schema{
+					"op":    Enum("add", "remove", "replace", "move", "copy", "test"),
+					"path":  String(),
+					"from":  String(),
+					"value": {},
+				},
+				Required: []string{"op", "path"},
+			},
+		}),
+	}
+}
+
+// File тело multipart/form-data с файлом в поле name
+func File(name string) Body {
+	return Body{MultipartMediaType: Raw(&Schema{
+		Type:       "object",
+		Properties: map[string]*Schema{name: {Type: "string", Format: "binary"}},
+		Required:   []string{name},
+	})}
+}
+
+// OK ответ 200 с JSON телом
+func OK(schema Source) Response {
+	return Response{Status: http.StatusOK, Description: "OK", MediaType: JSONMediaType, Schema: schema}
+}
+
+// Created ответ 201 с JSON телом созданной сущности
+func Created(schema Source) Response {
+	return Response{Status: http.StatusCreated, Description: "Created", MediaType: JSONMediaType, Schema: schema}
+}
+
+// NoContent ответ 204 без тела
+func NoContent() Response {
+	return Response{Status: http.StatusNoContent, Description: "No Content"}
+}
+
+// NotModified ответ 304 без тела на условный запрос с актуальной версией
+func NotModified() Response {
+	return Response{Status: http.StatusNotModified, Description: "Not Modified"}
+}
+
+// PathID обязательный параметр пути с UUID
+func PathID(name, description string) Parameter {
+	return Parameter{Name: name, In: "path", Description: description, Required: true, Schema: UUID()}
+}
+
+// PathString обязательный строковый параметр пути
+func PathString(name, description string) Parameter {
+	return Parameter{Name: name, In: "path", Description: description, Required: true, Schema: String()}
+}
+
+// Query необязательный параметр строки запроса
+func Query(name, description string, schema *Schema) Parameter {
+	return Parameter{Name: name, In: "query", Description: description, Schema: schema}
+}
+
+// Header необязательный заголовок запроса
+func Header(name, description string) Parameter {
+	return Parameter{Name: name, In: "header", Description: description, Schema: String()}
+}
+
+// Pagination параметры номера и размера страницы списка
+func Pagination() []Parameter {
+	return []Parameter{
+		Query("page", "номер страницы, начиная с 1", Integer(1)),
+		Query("page_size", "размер страницы", IntegerRange(1, pagination.MaxPageSize)),
+	}
+}
+
+// CursorPagination параметры списка, страницы которого можно получать по номеру или по курсору
+func CursorPagination() []Parameter {
+	return append(Pagination(),
+		Query("cursor", "next_cursor или prev_cursor из предыдущего ответа; не сочетается с page", String()),
+	)
+}
+
+// Prefix добавляет префикс к путям операций вложенного роутера
+func Prefix(prefix string, operations []Operation) []Operation {
+	prefixed := make([]Operation, len(operations))
+	for i, operation := range operations {
+		operation.Path = strings.TrimSuffix(prefix+operation.Path, "/")
+		prefixed[i] = operation
+	}
+	return prefixed
+}
+
+// Build строит документ из объявлений операций. Пути задаются относительно servers,
+// ответы с ошибками ссылаются на схему Problem
+func Build(info Info, server string, operations []Operation) *Document {
+	generator := NewGenerator()
+	problem := Of(problems.Problem{})(generator)
+
+	document := &Document{
+		OpenAPI:  Version,
+		Info:     info,
+		Servers:  []Server{{URL: server}},
+		Security: []SecurityRequirement{{bearerScheme: {}}},
+		Paths:    map[string]PathItem{},
+		Components: Components{
+			SecuritySchemes: map[string]SecurityScheme{
+				bearerScheme: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	tags := map[string]bool{}
+	for _, operation := range operations {
+		item, ok := document.Paths[operation.Path]
+		if !ok {
+			item = PathItem{}
+			document.Paths[operation.Path] = item
+		}
+		item[lower(operation.Method)] = operation.build(generator, problem)
+
+		if operation.Tag != "" && !tags[operation.Tag] {
+			tags[operation.Tag] = true
+			document.Tags = append(document.Tags, Tag{Name: operation.Tag})
+		}
+	}
+	sort.Slice(document.Tags, func(i, j int) bool { return document.Tags[i].Name < document.Tags[j].Name })
+
+	document.Components.Schemas = generator.Schemas()
+	return document
+}
+
+// build преобразует объявление в описание операции документа
+func (o Operation) build(generator *Generator, problem *Schema) *OperationObject {
+	object := &OperationObject{
+		OperationID: o.ID,
+		Summary:     o.Summary,
+		Parameters:  o.Parameters,
+		Responses:   map[string]ResponseObject{},
+	}
+	if o.Tag != "" {
+		object.Tags = []string{o.Tag}
+	}
+	if o.Public {
+		object.Security = &[]SecurityRequirement{}
+	}
+
+	if o.Request != nil {
+		object.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{}}
+		for mediaType, schema := range o.Request {
+			object.RequestBody.Content[mediaType] = MediaType{Schema: schema(generator)}
+		}
+	}
+
+	for _, response := range o.Responses {
+		object.Responses[strconv.Itoa(response.Status)] = response.build(generator)
+	}
+	object.Responses["default"] = ResponseObject{
+		Description: "Ошибка в формате problem details (RFC 7807)",
+		Content:     map[string]MediaType{problems.ContentType: {Schema: problem}},
+	}
+	return object
+}
+
+// build преобразует ответ в описание ответа документа
+func (r Response) build(generator *Generator) ResponseObject {
+	object := ResponseObject{Description: r.Description}
+	if r.MediaType != "" {
+		schema := &Schema{}
+		if r.Schema != nil {
+			schema = r.Schema(generator)
+		}
+		object.Content = map[string]MediaType{r.MediaType: {Schema: schema}}
+	}
+	return object
+}
@@ -0,0 +1,243 @@
+package openapi
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Schema подмножество JSON Schema (draft 2020-12), которое используется в документе
+// и проверяется при валидации запросов. Type - строка или список типов, например ["string", "null"]
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 interface{}        `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Minimum              *int64             `json:"minimum,omitempty"`
+	Maximum              *int64             `json:"maximum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// refPrefix префикс ссылок на схемы из components
+const refPrefix = "#/components/schemas/"
+
+// String схема строки
+func String() *Schema {
+	return &Schema{Type: "string"}
+}
+
+// UUID схема строки с UUID
+func UUID() *Schema {
+	return &Schema{Type: "string", Format: "uuid"}
+}
+
+// DateTime схема строки с датой и временем в RFC 3339
+func DateTime() *Schema {
+	return &Schema{Type: "string", Format: "date-time"}
+}
+
+// Integer схема целого числа не меньше minimum
+func Integer(minimum int64) *Schema {
+	return &Schema{Type: "integer", Minimum: &minimum}
+}
+
+// IntegerRange схема целого числа от minimum до maximum включительно
+func IntegerRange(minimum, maximum int64) *Schema {
+	return &Schema{Type: "integer", Minimum: &minimum, Maximum: &maximum}
+}
+
+// Boolean схема логического значения
+func Boolean() *Schema {
+	return &Schema{Type: "boolean"}
+}
+
+// Enum схема строки из перечисленных значений
+func Enum(values ...string) *Schema {
+	return &Schema{Type: "string", Enum: values}
+}
+
+// types возвращает список типов схемы
+func (s *Schema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	default:
+		return nil
+	}
+}
+
+// Source источник схемы: схема DTO строится генератором, который регистрирует ее в components
+type Source func(g *Generator) *Schema
+
+// Of схема типа значения v, например Of(TaskResponse{})
+func Of(v interface{}) Source {
+	return func(g *Generator) *Schema {
+		return g.Schema(reflect.TypeOf(v))
+	}
+}
+
+// ListOf схема списка {"data": [...], "total": n}
+func ListOf(v interface{}) Source {
+	return func(g *Generator) *Schema {
+		return &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":  {Type: "array", Items: g.Schema(reflect.TypeOf(v))},
+				"total": {Type: "integer"},
+			},
+			Required: []string{"data", "total"},
+		}
+	}
+}
+
+// PageOf схема страницы списка {"data": [...], "total": n, "page": n, "page_size": n}
+func PageOf(v interface{}) Source {
+	return func(g *Generator) *Schema {
+		schema := ListOf(v)(g)
+		schema.Properties["page"] = &Schema{Type: "integer"}
+		schema.Properties["page_size"] = &Schema{Type: "integer"}
+		schema.Required = append(schema.Required, "page", "page_size")
+		return schema
+	}
+}
+
+// CursorPageOf схема страницы списка, которую можно получить по номеру или по курсору:
+// {"data": [...], "page_size": n, "next_cursor": "...", "prev_cursor": "..."}. Номер страницы
+// и общее количество есть только в ответе на запрос по номеру страницы
+func CursorPageOf(v interface{}) Source {
+	return func(g *Generator) *Schema {
+		schema := ListOf(v)(g)
+		schema.Properties["page"] = &Schema{Type: "integer"}
+		schema.Properties["page_size"] = &Schema{Type: "integer"}
+		schema.Properties["next_cursor"] = &Schema{Type: "string", Description: "курсор следующей страницы"}
+		schema.Properties["prev_cursor"] = &Schema{Type: "string", Description: "курсор предыдущей страницы"}
+		schema.Required = []string{"data", "page_size"}
+		return schema
+	}
+}
+
+// Raw готовая схема, не связанная с DTO
+func Raw(schema *Schema) Source {
+	return func(*Generator) *Schema {
+		return schema
+	}
+}
+
+// Generator строит схемы по Go типам DTO: структуры попадают в components и
+// подставляются ссылками, поля берутся из тегов json
+type Generator struct {
+	schemas map[string]*Schema
+	types   map[reflect.Type]string
+}
+
+// NewGenerator создает генератор с пустым набором схем
+func NewGenerator() *Generator {
+	return &Generator{
+		schemas: map[string]*Schema{},
+		types:   map[reflect.Type]string{},
+	}
+}
+
+// Schemas возвращает схемы, зарегистрированные генератором
+func (g *Generator) Schemas() map[string]*Schema {
+	return g.schemas
+}
+
+// Schema возвращает схему типа. Поле без omitempty обязательно, указатель допускает null,
+// встроенная структура без тега json раскрывается в поля внешней
+func (g *Generator) Schema(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := g.Schema(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		nullable := *schema
+		nullable.Type = append(schema.types(), "null")
+		return &nullable
+	case reflect.String:
+		return String()
+	case reflect.Bool:
+		return Boolean()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: g.Schema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.Schema(t.Elem())}
+	case reflect.Struct:
+		return g.ref(t)
+	default:
+		// interface{} и any принимают любое значение
+		return &Schema{}
+	}
+}
+
+// ref регистрирует схему структуры в components и возвращает ссылку на нее
+func (g *Generator) ref(t reflect.Type) *Schema {
+	if name, ok := g.types[t]; ok {
+		return &Schema{Ref: refPrefix + name}
+	}
+
+	name := t.Name()
+	if _, taken := g.schemas[name]; taken || name == "" {
+		// Одноименные DTO разных пакетов различаются именем пакета
+		name = pkgName(t) + name
+	}
+	g.types[t] = name
+
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	g.schemas[name] = schema
+	g.fields(t, schema)
+	return &Schema{Ref: refPrefix + name}
+}
+
+// fields добавляет в схему объекта поля структуры
+func (g *Generator) fields(t reflect.Type, schema *Schema) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag, hasTag := field.Tag.Lookup("json")
+		if tag == "-" {
+			continue
+		}
+		if field.Anonymous && !hasTag && field.Type.Kind() == reflect.Struct {
+			g.fields(field.Type, schema)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		schema.Properties[name] = g.Schema(field.Type)
+		if !strings.Contains(options, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// pkgName возвращает имя пакета типа с заглавной буквы
+func pkgName(t reflect.Type) string {
+	path := t.PkgPath()
+	name := path[strings.LastIndex(path, "/")+1:]
+	if name == "" {
+		return ""
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// lower возвращает HTTP метод в нижнем регистре, как в ключах PathItem
+func lower(method string) string {
+	return strings.ToLower(method)
+}
@@ -0,0 +1,391 @@
+package openapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"crud/internal/presentation/api/v1/problems"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// Коды ошибок полей, которые не прошли проверку по схеме
+const (
+	CodeRequired = "required"
+	CodeType     = "type"
+	CodeFormat   = "format"
+	CodeEnum     = "enum"
+	CodeMinimum  = "minimum"
+	CodeMaximum  = "maximum"
+)
+
+// Validate проверяет параметры и JSON тело запроса по операции документа до вызова обработчика.
+// Публичные операции проверяются сразу. Проверка операций с аутентификацией откладывается
+// до ValidatePending после проверки прав: клиент без доступа получает 401 или 403, а не ошибки схемы.
+// Запросы к путям и методам, которых нет в документе, пропускаются без проверки: на них отвечает роутер
+func Validate(document *Document) func(http.Handler) http.Handler {
+	routes := newRoutes(document)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			operation, params, ok := routes.match(r.Method, routePath(r))
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			validate := func(w http.ResponseWriter, r *http.Request) bool {
+				if problem := validateParameters(operation, params, r); problem != nil {
+					problems.Write(w, r, problem)
+					return false
+				}
+				if problem := validateBody(document, operation, r); problem != nil {
+					problems.Write(w, r, problem)
+					return false
+				}
+				return true
+			}
+
+			if operation.Security != nil && len(*operation.Security) == 0 {
+				if validate(w, r) {
+					next.ServeHTTP(w, r)
+				}
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), pendingKey{}, pending(validate))))
+		})
+	}
+}
+
+// pending отложенная проверка запроса; возвращает false, если ответ с ошибкой уже записан
+type pending func(w http.ResponseWriter, r *http.Request) bool
+
+// pendingKey ключ контекста с отложенной проверкой запроса
+type pendingKey struct{}
+
+// ValidatePending выполняет проверку, отложенную Validate, и возвращает false, если запрос ее не прошел
+// и ответ с ошибкой уже записан. Без отложенной проверки возвращает true
+func ValidatePending(w http.ResponseWriter, r *http.Request) bool {
+	validate, ok := r.Context().Value(pendingKey{}).(pending)
+	if !ok {
+		return true
+	}
+	return validate(w, r)
+}
+
+// routePath возвращает путь запроса относительно роутера, к которому подключен middleware
+func routePath(r *http.Request) string {
+	path := r.URL.Path
+	if rctx := chi.RouteContext(r.Context()); rctx != nil && rctx.RoutePath != "" {
+		path = rctx.RoutePath
+	}
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	return path
+}
+
+// route шаблон пути документа, разбитый на сегменты
+type route struct {
+	segments []string
+	item     PathItem
+}
+
+// routes шаблоны путей документа
+type routes []route
+
+func newRoutes(document *Document) routes {
+	var result routes
+	for path, item := range document.Paths {
+		result = append(result, route{segments: strings.Split(path, "/"), item: item})
+	}
+	return result
+}
+
+// match находит операцию по методу и пути запроса и возвращает значения параметров пути.
+// Как и в роутере, постоянный сегмент пути важнее параметра: /tasks/workflow не считается задачей с ID workflow
+func (rs routes) match(method, path string) (*OperationObject, map[string]string, bool) {
+	segments := strings.Split(path, "/")
+
+	var best *route
+	bestLiterals := -1
+	for i := range rs {
+		literals, ok := rs[i].matches(segments)
+		if ok && literals > bestLiterals {
+			best, bestLiterals = &rs[i], literals
+		}
+	}
+	if best == nil {
+		return nil, nil, false
+	}
+
+	operation, ok := best.item[lower(method)]
+	if !ok {
+		return nil, nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range best.segments {
+		if name, ok := paramName(segment); ok {
+			params[name] = segments[i]
+		}
+	}
+	return operation, params, true
+}
+
+// matches сообщает, подходит ли путь под шаблон, и возвращает число совпавших постоянных сегментов
+func (r route) matches(segments []string) (int, bool) {
+	if len(segments) != len(r.segments) {
+		return 0, false
+	}
+	literals := 0
+	for i, segment := range r.segments {
+		if _, ok := paramName(segment); ok {
+			if segments[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if segment != segments[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+// paramName возвращает имя параметра из сегмента шаблона вида {name}
+func paramName(segment string) (string, bool) {
+	if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+		return segment[1 : len(segment)-1], true
+	}
+	return "", false
+}
+
+// validateParameters проверяет параметры пути и строки запроса; заголовки не проверяются
+func validateParameters(operation *OperationObject, params map[string]string, r *http.Request) *problems.Problem {
+	query := r.URL.Query()
+	for _, parameter := range operation.Parameters {
+		var value string
+		switch parameter.In {
+		case "path":
+			value = params[parameter.Name]
+		case "query":
+			value = query.Get(parameter.Name)
+		default:
+			continue
+		}
+		if value == "" {
+			continue
+		}
+
+		if code, message := checkParameter(parameter.Schema, value); code != "" {
+			detail := fmt.Sprintf("Invalid %s parameter %s", parameter.In, parameter.Name)
+			return problems.New(http.StatusBadRequest, problems.CodeInvalidParameter, detail).
+				WithField(parameter.Name, code, message)
+		}
+	}
+	return nil
+}
+
+// checkParameter проверяет строковое значение параметра по схеме и возвращает код и текст ошибки
+func checkParameter(schema *Schema, value string) (string, string) {
+	if schema == nil {
+		return "", ""
+	}
+	types := schema.types()
+	switch {
+	case slices.Contains(types, "integer"):
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return CodeType, "must be an integer"
+		}
+		return checkRange(schema, n)
+	case slices.Contains(types, "boolean"):
+		if _, err := strconv.ParseBool(value); err != nil {
+			return CodeType, "must be a boolean"
+		}
+	case slices.Contains(types, "string"):
+		return checkString(schema, value)
+	}
+	return "", ""
+}
+
+// validateBody проверяет JSON тело запроса по схеме операции. Тела других типов, пустые тела
+// и тела, которые не удалось разобрать, проверяет обработчик: он же отвечает на них своими ошибками
+func validateBody(document *Document, operation *OperationObject, r *http.Request) *problems.Problem {
+	if operation.RequestBody == nil || r.Body == nil {
+		return nil
+	}
+	content, ok := operation.RequestBody.Content[JSONMediaType]
+	if !ok {
+		return nil
+	}
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || mediaType != JSONMediaType {
+			return nil
+		}
+	}
+
+	data, err := io.ReadAll(r.Body)
+	r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(data))
+	if err != nil || len(bytes.TrimSpace(data)) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil
+	}
+
+	validator := &validator{schemas: document.Components.Schemas}
+	validator.check(content.Schema, value, "")
+	if len(validator.errors) == 0 {
+		return nil
+	}
+
+	// Свойства объекта обходятся в случайном порядке, ошибки сортируются для стабильного ответа
+	slices.SortStableFunc(validator.errors, func(a, b problems.FieldError) int { return strings.Compare(a.Field, b.Field) })
+
+	problem := problems.New(http.StatusBadRequest, problems.CodeInvalidRequest, "Request body does not match the schema")
+	for _, fieldError := range validator.errors {
+		if fieldError.Field == "" {
+			problem.Detail = "Request body " + fieldError.Message
+			continue
+		}
+		problem.WithField(fieldError.Field, fieldError.Code, fieldError.Message)
+	}
+	return problem
+}
+
+// validator проверяет разобранное JSON значение по схеме и собирает ошибки полей
+type validator struct {
+	schemas map[string]*Schema
+	errors  []problems.FieldError
+}
+
+func (v *validator) fail(field, code, message string) {
+	v.errors = append(v.errors, problems.FieldError{Field: field, Code: code, Message: message})
+}
+
+// check проверяет значение; field - путь к значению в теле, например admin.email или items[0]
+func (v *validator) check(schema *Schema, value interface{}, field string) {
+	if schema == nil {
+		return
+	}
+	if schema.Ref != "" {
+		v.check(v.schemas[strings.TrimPrefix(schema.Ref, refPrefix)], value, field)
+		return
+	}
+
+	types := schema.types()
+	if len(types) == 0 {
+		return
+	}
+	kind := kindOf(value)
+	if !slices.Contains(types, kind) && !(kind == "integer" && slices.Contains(types, "number")) {
+		v.fail(field, CodeType, "must be "+strings.Join(types, " or "))
+		return
+	}
+
+	switch value := value.(type) {
+	case string:
+		if code, message := checkString(schema, value); code != "" {
+			v.fail(field, code, message)
+		}
+	case json.Number:
+		if n, err := value.Int64(); err == nil {
+			if code, message := checkRange(schema, n); code != "" {
+				v.fail(field, code, message)
+			}
+		}
+	case map[string]interface{}:
+		for _, name := range schema.Required {
+			if _, ok := value[name]; !ok {
+				v.fail(join(field, name), CodeRequired, "is required")
+			}
+		}
+		for name, property := range value {
+			if propertySchema, ok := schema.Properties[name]; ok {
+				v.check(propertySchema, property, join(field, name))
+			} else if schema.AdditionalProperties != nil {
+				v.check(schema.AdditionalProperties, property, join(field, name))
+			}
+		}
+	case []interface{}:
+		for i, item := range value {
+			v.check(schema.Items, item, fmt.Sprintf("%s[%d]", field, i))
+		}
+	}
+}
+
+// kindOf возвращает тип JSON Schema разобранного значения
+func kindOf(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := value.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case []interface{}:
+		return "array"
+	default:
+		return "object"
+	}
+}
+
+// checkString проверяет формат строки и допустимые значения
+func checkString(schema *Schema, value string) (string, string) {
+	switch schema.Format {
+	case "uuid":
+		if _, err := uuid.Parse(value); err != nil {
+			return CodeFormat, "must be a UUID"
+		}
+	case "date-time":
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			return CodeFormat, "must be an RFC 3339 date-time"
+		}
+	}
+	if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, value) {
+		return CodeEnum, "must be one of " + strings.Join(schema.Enum, ", ")
+	}
+	return "", ""
+}
+
+// checkRange проверяет границы целого числа
+func checkRange(schema *Schema, value int64) (string, string) {
+	if schema.Minimum != nil && value < *schema.Minimum {
+		return CodeMinimum, "must be at least " + strconv.FormatInt(*schema.Minimum, 10)
+	}
+	if schema.Maximum != nil && value > *schema.Maximum {
+		return CodeMaximum, "must be at most " + strconv.FormatInt(*schema.Maximum, 10)
+	}
+	return "", ""
+}
+
+// join добавляет имя свойства к пути поля
+func join(field, name string) string {
+	if field == "" {
+		return name
+	}
+	return field + "." + name
+}
@@ -0,0 +1,60 @@
+package organizations
+
+import (
+	"time"
+
+	organizations_usecases "crud/internal/application/organizations/usecases"
+	organizations_domain "crud/internal/domain/organizations"
+	v1_users "crud/internal/presentation/api/v1/users"
+)
+
+// CreateOrganizationRequest запрос на создание организации вместе с ее администратором
+type CreateOrganizationRequest struct {
+	Name          string `json:"name"`
+	AdminEmail    string `json:"admin_email"`
+	AdminName     string `json:"admin_name"`
+	AdminPassword string `json:"admin_password"`
+}
+
+// OrganizationResponse ответ с данными организации
+type OrganizationResponse struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// CreateOrganizationResponse ответ на создание организации
+type CreateOrganizationResponse struct {
+	Organization OrganizationResponse  `json:"organization"`
+	Admin        v1_users.UserResponse `json:"admin"`
+}
+
+// OrganizationDTOFromEntity создает OrganizationResponse из сущности организации
+func OrganizationDTOFromEntity(organization *organizations_domain.Organization) OrganizationResponse {
+	return OrganizationResponse{
+		ID:        organization.ID.String(),
+		Name:      organization.Name.Value(),
+		CreatedAt: organization.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: organization.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// OrganizationDTOsFromEntities создает список OrganizationResponse из сущностей организаций
+func OrganizationDTOsFromEntities(organizations []*organizations_domain.Organization) []OrganizationResponse {
+	response := make([]OrganizationResponse, len(organizations))
+	for i, organization := range organizations {
+		response[i] = OrganizationDTOFromEntity(organization)
+	}
+	return response
+}
+
+// CreateOrganizationInputFromRequest преобразует запрос в входные данные use case
+func CreateOrganizationInputFromRequest(req CreateOrganizationRequest) organizations_usecases.CreateOrganizationInput {
+	return organizations_usecases.CreateOrganizationInput{
+		Name:          req.Name,
+		AdminEmail:    req.AdminEmail,
+		AdminName:     req.AdminName,
+		AdminPassword: req.AdminPassword,
+	}
+}
@@ -0,0 +1,106 @@
+package organizations
+
+import (
+	"crud/internal/application"
+	organizations_usecases "crud/internal/application/organizations/usecases"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	v1_users "crud/internal/presentation/api/v1/users"
+	"encoding/json"
+	"net/http"
+
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для организаций
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик организаций
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// CreateOrganization создает новую организацию и ее администратора
+// POST /api/v1/organizations
+func (h *Handler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*organizations_usecases.CreateOrganizationUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	organization, admin, err := useCase.Execute(r.Context(), CreateOrganizationInputFromRequest(req))
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := CreateOrganizationResponse{
+		Organization: OrganizationDTOFromEntity(organization),
+		Admin:        v1_users.UserDTOFromEntity(admin),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListOrganizations получает список организаций
+// GET /api/v1/organizations?page=&page_size=
+func (h *Handler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*organizations_usecases.ListOrganizationsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	organizations, total, err := useCase.Execute(r.Context(), page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      OrganizationDTOsFromEntities(organizations),
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// GetCurrentOrganization получает организацию вызывающего пользователя
+// GET /api/v1/organizations/current
+func (h *Handler) GetCurrentOrganization(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*organizations_usecases.GetCurrentOrganizationUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	organization, err := useCase.Execute(r.Context())
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := OrganizationDTOFromEntity(organization)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,30 @@
+package organizations
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты организаций для документа OpenAPI
+func Operations() []openapi.Operation {
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/organizations", ID: "createOrganization", Tag: "organizations",
+			Summary:   "Создать организацию с ее администратором",
+			Request:   openapi.JSON(CreateOrganizationRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(CreateOrganizationResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/organizations", ID: "listOrganizations", Tag: "organizations",
+			Summary:    "Список организаций",
+			Parameters: openapi.Pagination(),
+			Responses:  []openapi.Response{openapi.OK(openapi.PageOf(OrganizationResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/organizations/current", ID: "getCurrentOrganization", Tag: "organizations",
+			Summary:   "Организация текущего пользователя",
+			Responses: []openapi.Response{openapi.OK(openapi.Of(OrganizationResponse{}))},
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package organizations
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для организаций
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/organizations", func(r chi.Router) {
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionOrganizationsManage)).Post("/", handler.CreateOrganization)
+		r.With(middleware.RequirePermission(policies.PermissionOrganizationsManage)).Get("/", handler.ListOrganizations)
+		r.With(middleware.RequirePermission(policies.PermissionOrganizationsRead)).Get("/current", handler.GetCurrentOrganization)
+	})
+
+	return nil
+}
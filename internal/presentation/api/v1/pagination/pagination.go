@@ -0,0 +1,43 @@
+package pagination
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"crud/internal/presentation/api/v1/problems"
+)
+
+// DefaultPageSize размер страницы, если page_size не указан
+const DefaultPageSize = 10
+
+// MaxPageSize наибольший размер страницы списков
+const MaxPageSize = 100
+
+// Parse разбирает параметры page и page_size. Некорректное или выходящее за пределы значение
+// отклоняется ответом 400, и ok равно false: подмена значением по умолчанию скрыла бы ошибку клиента
+func Parse(w http.ResponseWriter, r *http.Request) (page, pageSize int, ok bool) {
+	query := r.URL.Query()
+
+	page = 1
+	if value := query.Get("page"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 {
+			problems.InvalidParameter(w, r, "page", "page must be a positive integer")
+			return 0, 0, false
+		}
+		page = parsed
+	}
+
+	pageSize = DefaultPageSize
+	if value := query.Get("page_size"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 1 || parsed > MaxPageSize {
+			problems.InvalidParameter(w, r, "page_size", fmt.Sprintf("page_size must be an integer between 1 and %d", MaxPageSize))
+			return 0, 0, false
+		}
+		pageSize = parsed
+	}
+
+	return page, pageSize, true
+}
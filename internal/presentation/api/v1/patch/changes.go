@@ -0,0 +1,84 @@
+package patch
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Changes сравнивает JSON объект ресурса до и после патча и возвращает новые значения измененных
+// членов; nil означает, что член удален или стал null. Изменять можно только члены из writable,
+// все они должны быть строками или null. Изменение остальных членов возвращает InvalidPatchError
+func Changes(original, patched []byte, writable ...string) (map[string]*string, error) {
+	before, err := members(original)
+	if err != nil {
+		return nil, err
+	}
+	after, err := members(patched)
+	if err != nil {
+		return nil, &InvalidPatchError{Reason: "patched document must be a JSON object"}
+	}
+
+	allowed := make(map[string]bool, len(writable))
+	for _, name := range writable {
+		allowed[name] = true
+	}
+
+	names := make([]string, 0, len(before)+len(after))
+	for name := range before {
+		names = append(names, name)
+	}
+	for name := range after {
+		if _, ok := before[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	// Порядок нужен, чтобы из нескольких ошибок всегда сообщалась одна и та же
+	sort.Strings(names)
+
+	changes := make(map[string]*string)
+	for _, name := range names {
+		oldValue, hadValue := before[name]
+		newValue, hasValue := after[name]
+		if hadValue && hasValue && equal(oldValue, newValue) {
+			continue
+		}
+
+		if !allowed[name] {
+			if hadValue {
+				return nil, &InvalidPatchError{Reason: fmt.Sprintf("field '%s' is read-only", name)}
+			}
+			return nil, &InvalidPatchError{Reason: fmt.Sprintf("unknown field '%s'", name)}
+		}
+
+		switch value := newValue.(type) {
+		case nil:
+			changes[name] = nil
+		case string:
+			changes[name] = &value
+		default:
+			return nil, &InvalidPatchError{Reason: fmt.Sprintf("field '%s' must be a string or null", name)}
+		}
+	}
+	return changes, nil
+}
+
+// members разбирает JSON объект на члены
+func members(data []byte) (map[string]interface{}, error) {
+	value, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, &MalformedPatchError{Reason: "document must be a JSON object"}
+	}
+	return object, nil
+}
+
+// Required проверяет, что обязательное поле не удалено патчем
+func Required(changes map[string]*string, name string) error {
+	if value, ok := changes[name]; ok && value == nil {
+		return &InvalidPatchError{Reason: fmt.Sprintf("field '%s' cannot be null", name)}
+	}
+	return nil
+}
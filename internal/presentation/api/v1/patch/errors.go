@@ -0,0 +1,68 @@
+package patch
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UnsupportedMediaTypeError представляет ошибку, когда тело PATCH запроса не является ни JSON Merge Patch,
+// ни JSON Patch
+type UnsupportedMediaTypeError struct {
+	ContentType string
+}
+
+func (e *UnsupportedMediaTypeError) Error() string {
+	return fmt.Sprintf("unsupported patch media type '%s': expected %s", e.ContentType, AcceptPatch)
+}
+
+// MalformedPatchError представляет ошибку, когда патч не является корректным документом своего формата
+type MalformedPatchError struct {
+	Reason string
+}
+
+func (e *MalformedPatchError) Error() string {
+	return fmt.Sprintf("malformed patch: %s", e.Reason)
+}
+
+// InvalidPatchError представляет ошибку, когда патч нельзя применить к документу: путь не существует
+// или итоговый документ содержит недопустимые значения
+type InvalidPatchError struct {
+	Reason string
+}
+
+func (e *InvalidPatchError) Error() string {
+	return fmt.Sprintf("invalid patch: %s", e.Reason)
+}
+
+// TestFailedError представляет ошибку, когда значение не совпало с ожидаемым в операции test JSON Patch
+type TestFailedError struct {
+	Path string
+}
+
+func (e *TestFailedError) Error() string {
+	return fmt.Sprintf("patch test failed: value at '%s' does not match", e.Path)
+}
+
+// IsUnsupportedMediaType проверяет, является ли ошибка ошибкой неподдерживаемого формата патча
+func IsUnsupportedMediaType(err error) bool {
+	var mediaTypeErr *UnsupportedMediaTypeError
+	return errors.As(err, &mediaTypeErr)
+}
+
+// IsMalformedPatch проверяет, является ли ошибка ошибкой формата патча
+func IsMalformedPatch(err error) bool {
+	var malformedErr *MalformedPatchError
+	return errors.As(err, &malformedErr)
+}
+
+// IsInvalidPatch проверяет, является ли ошибка ошибкой применения патча
+func IsInvalidPatch(err error) bool {
+	var invalidErr *InvalidPatchError
+	return errors.As(err, &invalidErr)
+}
+
+// IsTestFailed проверяет, является ли ошибка ошибкой операции test
+func IsTestFailed(err error) bool {
+	var testErr *TestFailedError
+	return errors.As(err, &testErr)
+}
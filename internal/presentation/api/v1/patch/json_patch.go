@@ -0,0 +1,363 @@
+package patch
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// operation операция JSON Patch; from задан для move и copy, value - для add, replace и test
+type operation struct {
+	op    string
+	path  string
+	from  string
+	value interface{}
+}
+
+// applyJSONPatch применяет JSON Patch (RFC 6902): операции выполняются по порядку,
+// и ошибка любой из них отменяет весь патч
+func applyJSONPatch(target interface{}, data []byte) (interface{}, error) {
+	operations, err := parseOperations(data)
+	if err != nil {
+		return nil, err
+	}
+
+	document := deepCopy(target)
+	for i, op := range operations {
+		document, err = op.apply(document)
+		if err != nil {
+			if IsTestFailed(err) {
+				return nil, err
+			}
+			return nil, &InvalidPatchError{Reason: fmt.Sprintf("operation %d (%s %s): %s", i, op.op, op.path, err)}
+		}
+	}
+	return document, nil
+}
+
+// parseOperations разбирает массив операций и проверяет обязательные члены каждой из них
+func parseOperations(data []byte) ([]operation, error) {
+	var raw []map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, &MalformedPatchError{Reason: "JSON Patch must be an array of operations"}
+	}
+
+	operations := make([]operation, len(raw))
+	for i, member := range raw {
+		op := operation{}
+		if err := stringMember(member, "op", &op.op); err != nil {
+			return nil, &MalformedPatchError{Reason: fmt.Sprintf("operation %d: %s", i, err)}
+		}
+		if err := stringMember(member, "path", &op.path); err != nil {
+			return nil, &MalformedPatchError{Reason: fmt.Sprintf("operation %d: %s", i, err)}
+		}
+
+		switch op.op {
+		case "add", "replace", "test":
+			rawValue, ok := member["value"]
+			if !ok {
+				return nil, &MalformedPatchError{Reason: fmt.Sprintf("operation %d: member 'value' is required for %s", i, op.op)}
+			}
+			value, err := decode(rawValue)
+			if err != nil {
+				return nil, err
+			}
+			op.value = value
+		case "move", "copy":
+			if err := stringMember(member, "from", &op.from); err != nil {
+				return nil, &MalformedPatchError{Reason: fmt.Sprintf("operation %d: %s", i, err)}
+			}
+		case "remove":
+		default:
+			return nil, &MalformedPatchError{Reason: fmt.Sprintf("operation %d: unknown op '%s'", i, op.op)}
+		}
+		operations[i] = op
+	}
+	return operations, nil
+}
+
+// stringMember читает обязательный строковый член операции
+func stringMember(member map[string]json.RawMessage, name string, value *string) error {
+	raw, ok := member[name]
+	if !ok {
+		return fmt.Errorf("member '%s' is required", name)
+	}
+	if err := json.Unmarshal(raw, value); err != nil {
+		return fmt.Errorf("member '%s' must be a string", name)
+	}
+	return nil
+}
+
+// apply выполняет операцию над документом и возвращает измененный документ
+func (op operation) apply(document interface{}) (interface{}, error) {
+	path, err := parsePointer(op.path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.op {
+	case "add":
+		return add(document, path, op.value)
+	case "remove":
+		document, _, err := remove(document, path)
+		return document, err
+	case "replace":
+		if len(path) == 0 {
+			return op.value, nil
+		}
+		document, _, err := remove(document, path)
+		if err != nil {
+			return nil, err
+		}
+		return add(document, path, op.value)
+	case "move":
+		from, err := parsePointer(op.from)
+		if err != nil {
+			return nil, err
+		}
+		if op.from == op.path {
+			return document, nil
+		}
+		if strings.HasPrefix(op.path, op.from+"/") {
+			return nil, fmt.Errorf("cannot move '%s' into its own child", op.from)
+		}
+		document, value, err := remove(document, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(document, path, value)
+	case "copy":
+		from, err := parsePointer(op.from)
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(document, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(document, path, deepCopy(value))
+	default: // test
+		value, err := get(document, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(value, op.value) {
+			return nil, &TestFailedError{Path: op.path}
+		}
+		return document, nil
+	}
+}
+
+// parsePointer разбирает JSON Pointer (RFC 6901) на экранированные токены
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path '%s' must be empty or start with '/'", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// get возвращает значение по пути
+func get(document interface{}, path []string) (interface{}, error) {
+	value := document
+	for _, token := range path {
+		switch container := value.(type) {
+		case map[string]interface{}:
+			child, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("member '%s' does not exist", token)
+			}
+			value = child
+		case []interface{}:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+			value = container[index]
+		default:
+			return nil, fmt.Errorf("cannot reference '%s' inside a scalar value", token)
+		}
+	}
+	return value, nil
+}
+
+// add добавляет член объекта или вставляет элемент массива; "-" добавляет элемент в конец массива
+func add(document interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return modify(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch container := parent.(type) {
+		case map[string]interface{}:
+			container[token] = value
+			return container, nil
+		case []interface{}:
+			if token == "-" {
+				return append(container, value), nil
+			}
+			index, err := arrayIndex(token, len(container))
+			if err != nil {
+				return nil, err
+			}
+			container = append(container, nil)
+			copy(container[index+1:], container[index:])
+			container[index] = value
+			return container, nil
+		default:
+			return nil, fmt.Errorf("cannot add '%s' to a scalar value", token)
+		}
+	})
+}
+
+// remove удаляет существующий член объекта или элемент массива и возвращает удаленное значение
+func remove(document interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, nil, fmt.Errorf("cannot remove the whole document")
+	}
+
+	var removed interface{}
+	document, err := modify(document, path, func(parent interface{}, token string) (interface{}, error) {
+		switch container := parent.(type) {
+		case map[string]interface{}:
+			value, ok := container[token]
+			if !ok {
+				return nil, fmt.Errorf("member '%s' does not exist", token)
+			}
+			removed = value
+			delete(container, token)
+			return container, nil
+		case []interface{}:
+			index, err := arrayIndex(token, len(container)-1)
+			if err != nil {
+				return nil, err
+			}
+			removed = container[index]
+			return append(container[:index], container[index+1:]...), nil
+		default:
+			return nil, fmt.Errorf("cannot remove '%s' from a scalar value", token)
+		}
+	})
+	return document, removed, err
+}
+
+// modify находит родителя последнего токена пути, передает его в change и сохраняет
+// результат обратно: изменение массива может вернуть новый срез
+func modify(
+	document interface{},
+	path []string,
+	change func(parent interface{}, token string) (interface{}, error),
+) (interface{}, error) {
+	if len(path) == 1 {
+		return change(document, path[0])
+	}
+
+	switch container := document.(type) {
+	case map[string]interface{}:
+		child, ok := container[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("member '%s' does not exist", path[0])
+		}
+		updated, err := modify(child, path[1:], change)
+		if err != nil {
+			return nil, err
+		}
+		container[path[0]] = updated
+		return container, nil
+	case []interface{}:
+		index, err := arrayIndex(path[0], len(container)-1)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := modify(container[index], path[1:], change)
+		if err != nil {
+			return nil, err
+		}
+		container[index] = updated
+		return container, nil
+	default:
+		return nil, fmt.Errorf("cannot reference '%s' inside a scalar value", path[0])
+	}
+}
+
+// arrayIndex разбирает индекс массива без ведущих нулей и проверяет, что он не больше max
+func arrayIndex(token string, max int) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.TrimLeft(token, "0123456789") != "" {
+		return 0, fmt.Errorf("invalid array index '%s'", token)
+	}
+	index, err := strconv.Atoi(token)
+	if err != nil || index > max {
+		return 0, fmt.Errorf("array index %s is out of bounds", token)
+	}
+	return index, nil
+}
+
+// equal сравнивает JSON значения по правилам операции test: числа сравниваются по значению,
+// объекты - без учета порядка членов
+func equal(left, right interface{}) bool {
+	switch l := left.(type) {
+	case map[string]interface{}:
+		r, ok := right.(map[string]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for name, value := range l {
+			other, ok := r[name]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := right.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for i := range l {
+			if !equal(l[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		r, ok := right.(json.Number)
+		if !ok {
+			return false
+		}
+		if l == r {
+			return true
+		}
+		lf, lerr := l.Float64()
+		rf, rerr := r.Float64()
+		return lerr == nil && rerr == nil && lf == rf
+	default:
+		return left == right
+	}
+}
+
+// deepCopy копирует JSON значение, чтобы изменения копии не затрагивали оригинал
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		for name, child := range v {
+			copied[name] = deepCopy(child)
+		}
+		return copied
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i, child := range v {
+			copied[i] = deepCopy(child)
+		}
+		return copied
+	default:
+		return value
+	}
+}
@@ -0,0 +1,32 @@
+package patch
+
+// applyMergePatch применяет JSON Merge Patch (RFC 7396): члены патча заменяют члены документа,
+// вложенные объекты объединяются рекурсивно, а null удаляет член
+func applyMergePatch(target interface{}, data []byte) (interface{}, error) {
+	patch, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return mergePatch(target, patch), nil
+}
+
+// mergePatch реализует алгоритм MergePatch из раздела 2 RFC 7396
+func mergePatch(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+		targetObject[name] = mergePatch(targetObject[name], value)
+	}
+	return targetObject
+}
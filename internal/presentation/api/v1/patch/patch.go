@@ -0,0 +1,61 @@
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"strings"
+)
+
+const (
+	// MergePatchMediaType тип тела JSON Merge Patch (RFC 7396)
+	MergePatchMediaType = "application/merge-patch+json"
+	// JSONPatchMediaType тип тела JSON Patch (RFC 6902)
+	JSONPatchMediaType = "application/json-patch+json"
+	// AcceptPatch значение заголовка Accept-Patch для ресурсов, которые принимают оба формата
+	AcceptPatch = MergePatchMediaType + ", " + JSONPatchMediaType
+)
+
+// Apply применяет патч формата contentType к JSON документу и возвращает новый документ.
+// Патч применяется целиком или не применяется вовсе: исходный документ не меняется
+func Apply(contentType string, document, patch []byte) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, &UnsupportedMediaTypeError{ContentType: contentType}
+	}
+
+	target, err := decode(document)
+	if err != nil {
+		return nil, err
+	}
+
+	var patched interface{}
+	switch strings.ToLower(mediaType) {
+	case MergePatchMediaType:
+		patched, err = applyMergePatch(target, patch)
+	case JSONPatchMediaType:
+		patched, err = applyJSONPatch(target, patch)
+	default:
+		return nil, &UnsupportedMediaTypeError{ContentType: mediaType}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(patched)
+}
+
+// decode разбирает JSON документ; числа сохраняются как json.Number, чтобы не терять точность
+func decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, &MalformedPatchError{Reason: err.Error()}
+	}
+	if decoder.More() {
+		return nil, &MalformedPatchError{Reason: "unexpected data after JSON value"}
+	}
+	return value, nil
+}
@@ -0,0 +1,301 @@
+package problems
+
+import (
+	"errors"
+	"net/http"
+
+	"crud/internal/domain/attachments"
+	attachments_vo "crud/internal/domain/attachments/value_objects"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/comments"
+	comments_vo "crud/internal/domain/comments/value_objects"
+	"crud/internal/domain/labels"
+	labels_vo "crud/internal/domain/labels/value_objects"
+	"crud/internal/domain/organizations"
+	organizations_vo "crud/internal/domain/organizations/value_objects"
+	"crud/internal/domain/projects"
+	projects_vo "crud/internal/domain/projects/value_objects"
+	"crud/internal/domain/tasks"
+	tasks_vo "crud/internal/domain/tasks/value_objects"
+	"crud/internal/domain/users"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/internal/presentation/api/v1/patch"
+)
+
+// Коды ошибок, которые не соответствуют ошибкам домена
+const (
+	CodeInternal           = "internal_error"
+	CodeInvalidRequest     = "invalid_request"
+	CodeInvalidParameter   = "invalid_parameter"
+	CodePreconditionFailed = "precondition_failed"
+	CodeRequestTooLarge    = "request_too_large"
+)
+
+// internalDetail подробности ответа 500: причина ошибки сервера клиенту не раскрывается
+const internalDetail = "internal server error"
+
+// Reference сопоставляет ошибке "не найдено" поле тела запроса, которое ссылается на отсутствующую сущность.
+// Такая ошибка означает неверные данные запроса (422), а не отсутствие ресурса из URL (404)
+type Reference struct {
+	Field string
+	Match func(error) bool
+}
+
+// FromError сопоставляет ошибке описание со статусом и кодом. Ошибки валидации получают ошибку поля,
+// ошибки операций (*OperationFailedError) и неизвестные ошибки - статус 500 без подробностей
+func FromError(err error, references ...Reference) *Problem {
+	problem := fromError(err)
+	for _, reference := range references {
+		if reference.Match(err) {
+			return New(http.StatusUnprocessableEntity, problem.Code, problem.Detail).
+				WithField(reference.Field, problem.Code, problem.Detail)
+		}
+	}
+	return problem
+}
+
+// fromError ищет описание ошибки по очереди среди ошибок каждой области
+func fromError(err error) *Problem {
+	for _, match := range []func(error) *Problem{
+		authProblem,
+		taskProblem,
+		userProblem,
+		projectProblem,
+		labelProblem,
+		commentProblem,
+		attachmentProblem,
+		organizationProblem,
+		auditProblem,
+		patchProblem,
+	} {
+		if problem := match(err); problem != nil {
+			return problem
+		}
+	}
+
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return New(http.StatusRequestEntityTooLarge, CodeRequestTooLarge, err.Error())
+	}
+	return New(http.StatusInternalServerError, CodeInternal, internalDetail)
+}
+
+func authProblem(err error) *Problem {
+	switch {
+	case auth.IsUnauthenticated(err):
+		return New(http.StatusUnauthorized, "unauthenticated", err.Error())
+	case auth.IsInvalidCredentials(err):
+		return New(http.StatusUnauthorized, "invalid_credentials", err.Error())
+	case auth.IsInvalidToken(err):
+		return New(http.StatusUnauthorized, "invalid_token", err.Error())
+	case auth.IsForbidden(err):
+		return New(http.StatusForbidden, "forbidden", err.Error())
+	}
+	return nil
+}
+
+func taskProblem(err error) *Problem {
+	if e, ok := as[*tasks.InvalidTaskDataError](err); ok {
+		return invalid("invalid_task_data", e.Field, e.Message, err)
+	}
+	if e, ok := as[*tasks_vo.InvalidScheduleError](err); ok {
+		return invalid("invalid_schedule", e.Field, e.Message, err)
+	}
+
+	switch {
+	case tasks.IsTaskNotFound(err):
+		return New(http.StatusNotFound, "task_not_found", err.Error())
+	case tasks.IsTaskAlreadyExists(err):
+		return New(http.StatusConflict, "task_already_exists", err.Error())
+	case tasks.IsTaskOwnerNotFound(err):
+		return unprocessable("task_owner_not_found", "user_id", err)
+	case tasks.IsParentTaskNotFound(err):
+		return unprocessable("parent_task_not_found", "parent_id", err)
+	case tasks.IsBlockerTaskNotFound(err):
+		return unprocessable("blocker_task_not_found", "blocker_id", err)
+	case tasks.IsTaskHierarchyCycle(err):
+		return conflict("task_hierarchy_cycle", "parent_id", err)
+	case tasks.IsTaskDepthExceeded(err):
+		return conflict("task_depth_exceeded", "parent_id", err)
+	case tasks.IsTaskDependencyCycle(err):
+		return conflict("task_dependency_cycle", "blocker_id", err)
+	case tasks.IsIllegalTransition(err):
+		return conflict("illegal_transition", "status", err)
+	case tasks.IsTransitionGuardFailed(err):
+		return conflict("transition_guard_failed", "status", err)
+	case tasks.IsTaskHasSubtasks(err):
+		return New(http.StatusConflict, "task_has_subtasks", err.Error())
+	case tasks.IsConcurrentModification(err):
+		return New(http.StatusPreconditionFailed, "concurrent_modification", err.Error())
+	case tasks_vo.IsInvalidTitle(err):
+		return invalid("invalid_title", "title", "", err)
+	case tasks_vo.IsInvalidStatus(err):
+		return invalid("invalid_status", "status", "", err)
+	case tasks_vo.IsInvalidPriority(err):
+		return invalid("invalid_priority", "priority", "", err)
+	}
+	return nil
+}
+
+func userProblem(err error) *Problem {
+	if e, ok := as[*users.InvalidUserDataError](err); ok {
+		return invalid("invalid_user_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case users.IsUserNotFound(err):
+		return New(http.StatusNotFound, "user_not_found", err.Error())
+	case users.IsUserAlreadyExists(err):
+		return conflict("user_already_exists", "email", err)
+	case users.IsUserHasOpenTasks(err):
+		return New(http.StatusConflict, "user_has_open_tasks", err.Error())
+	case users.IsUserInUse(err):
+		return New(http.StatusConflict, "user_in_use", err.Error())
+	case users.IsConcurrentModification(err):
+		return New(http.StatusPreconditionFailed, "concurrent_modification", err.Error())
+	case users_vo.IsInvalidEmail(err):
+		return invalid("invalid_email", "email", "", err)
+	case users_vo.IsInvalidName(err):
+		return invalid("invalid_name", "name", "", err)
+	case users_vo.IsInvalidPassword(err):
+		return invalid("invalid_password", "password", "", err)
+	case users_vo.IsInvalidRole(err):
+		return invalid("invalid_role", "role", "", err)
+	}
+	return nil
+}
+
+func projectProblem(err error) *Problem {
+	if e, ok := as[*projects.InvalidProjectDataError](err); ok {
+		return invalid("invalid_project_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case projects.IsProjectNotFound(err):
+		return New(http.StatusNotFound, "project_not_found", err.Error())
+	case projects.IsProjectAlreadyExists(err):
+		return New(http.StatusConflict, "project_already_exists", err.Error())
+	case projects.IsProjectOwnerNotFound(err):
+		return unprocessable("project_owner_not_found", "user_id", err)
+	case projects.IsProjectHasTasks(err):
+		return New(http.StatusConflict, "project_has_tasks", err.Error())
+	case projects_vo.IsInvalidProjectName(err):
+		return invalid("invalid_project_name", "name", "", err)
+	}
+	return nil
+}
+
+func labelProblem(err error) *Problem {
+	if e, ok := as[*labels.InvalidLabelDataError](err); ok {
+		return invalid("invalid_label_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case labels.IsLabelNotFound(err):
+		return New(http.StatusNotFound, "label_not_found", err.Error())
+	case labels.IsLabelAlreadyExists(err):
+		return conflict("label_already_exists", "name", err)
+	case labels_vo.IsInvalidLabelName(err):
+		return invalid("invalid_label_name", "name", "", err)
+	}
+	return nil
+}
+
+func commentProblem(err error) *Problem {
+	if e, ok := as[*comments.InvalidCommentDataError](err); ok {
+		return invalid("invalid_comment_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case comments.IsCommentNotFound(err):
+		return New(http.StatusNotFound, "comment_not_found", err.Error())
+	case comments.IsParentCommentNotFound(err):
+		return unprocessable("parent_comment_not_found", "parent_id", err)
+	case comments_vo.IsInvalidCommentBody(err):
+		return invalid("invalid_comment_body", "body", "", err)
+	}
+	return nil
+}
+
+func attachmentProblem(err error) *Problem {
+	if e, ok := as[*attachments.InvalidAttachmentDataError](err); ok {
+		return invalid("invalid_attachment_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case attachments.IsAttachmentNotFound(err):
+		return New(http.StatusNotFound, "attachment_not_found", err.Error())
+	case attachments.IsBlobNotFound(err):
+		return New(http.StatusNotFound, "attachment_content_not_found", err.Error())
+	case attachments.IsAttachmentTooLarge(err):
+		return New(http.StatusRequestEntityTooLarge, "attachment_too_large", err.Error())
+	case attachments.IsUnsupportedContentType(err):
+		return New(http.StatusUnsupportedMediaType, "unsupported_content_type", err.Error())
+	case attachments.IsContentTypeMismatch(err):
+		return New(http.StatusUnsupportedMediaType, "content_type_mismatch", err.Error())
+	case attachments_vo.IsInvalidFileName(err):
+		return invalid("invalid_file_name", "file_name", "", err)
+	}
+	return nil
+}
+
+func organizationProblem(err error) *Problem {
+	if e, ok := as[*organizations.InvalidOrganizationDataError](err); ok {
+		return invalid("invalid_organization_data", e.Field, e.Message, err)
+	}
+
+	switch {
+	case organizations.IsOrganizationNotFound(err):
+		return New(http.StatusNotFound, "organization_not_found", err.Error())
+	case organizations_vo.IsInvalidOrganizationName(err):
+		return invalid("invalid_organization_name", "name", "", err)
+	}
+	return nil
+}
+
+func auditProblem(err error) *Problem {
+	if e, ok := as[*audit.InvalidAuditFilterError](err); ok {
+		return invalid("invalid_audit_filter", e.Field, e.Message, err)
+	}
+	return nil
+}
+
+func patchProblem(err error) *Problem {
+	switch {
+	case patch.IsUnsupportedMediaType(err):
+		return New(http.StatusUnsupportedMediaType, "unsupported_patch_media_type", err.Error())
+	case patch.IsMalformedPatch(err):
+		return New(http.StatusBadRequest, "malformed_patch", err.Error())
+	case patch.IsInvalidPatch(err):
+		return New(http.StatusUnprocessableEntity, "invalid_patch", err.Error())
+	case patch.IsTestFailed(err):
+		return New(http.StatusConflict, "patch_test_failed", err.Error())
+	}
+	return nil
+}
+
+// invalid описывает ошибку валидации (400) с ошибкой поля; пустое message заменяется текстом ошибки
+func invalid(code, field, message string, err error) *Problem {
+	if message == "" {
+		message = err.Error()
+	}
+	return New(http.StatusBadRequest, code, err.Error()).WithField(field, code, message)
+}
+
+// unprocessable описывает ссылку поля запроса на несуществующую сущность (422)
+func unprocessable(code, field string, err error) *Problem {
+	return New(http.StatusUnprocessableEntity, code, err.Error()).WithField(field, code, err.Error())
+}
+
+// conflict описывает конфликт с текущим состоянием ресурса (409), вызванный значением поля запроса
+func conflict(code, field string, err error) *Problem {
+	return New(http.StatusConflict, code, err.Error()).WithField(field, code, err.Error())
+}
+
+// as возвращает первую ошибку типа T в цепочке err
+func as[T error](err error) (T, bool) {
+	var target T
+	ok := errors.As(err, &target)
+	return target, ok
+}
@@ -0,0 +1,89 @@
+package problems
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// ContentType тип содержимого ответа с описанием ошибки (RFC 7807)
+const ContentType = "application/problem+json"
+
+// Problem описание ошибки в формате RFC 7807. Type всегда about:blank, поэтому Title совпадает
+// с текстом статуса, а вид ошибки передается машиночитаемым кодом Code
+type Problem struct {
+	Type     string       `json:"type"`
+	Title    string       `json:"title"`
+	Status   int          `json:"status"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty"`
+	Code     string       `json:"code"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError ошибка значения поля тела или параметра запроса: по Field клиент показывает
+// ошибку рядом с соответствующим полем ввода
+type FieldError struct {
+	Field   string `json:"field"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// New создает описание ошибки со статусом, кодом и подробностями
+func New(status int, code, detail string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// WithField добавляет ошибку поля; пустое имя поля не добавляется
+func (p *Problem) WithField(field, code, message string) *Problem {
+	if field != "" {
+		p.Errors = append(p.Errors, FieldError{Field: field, Code: code, Message: message})
+	}
+	return p
+}
+
+// Write отвечает описанием ошибки; Instance - путь запроса
+func Write(w http.ResponseWriter, r *http.Request, problem *Problem) {
+	problem.Instance = r.URL.Path
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(problem.Status)
+	json.NewEncoder(w).Encode(problem)
+}
+
+// Error отвечает описанием ошибки, полученным из err по общему сопоставлению FromError
+func Error(w http.ResponseWriter, r *http.Request, err error, references ...Reference) {
+	problem := FromError(err, references...)
+	if problem.Status >= http.StatusInternalServerError {
+		log.Printf("%s %s failed: %v", r.Method, r.URL.Path, err)
+	}
+	Write(w, r, problem)
+}
+
+// Internal отвечает 500 без подробностей: причина err пишется только в лог
+func Internal(w http.ResponseWriter, r *http.Request, err error) {
+	log.Printf("%s %s failed: %v", r.Method, r.URL.Path, err)
+	Write(w, r, New(http.StatusInternalServerError, CodeInternal, internalDetail))
+}
+
+// BadRequest отвечает 400, когда запрос не удалось разобрать, например тело не является JSON
+func BadRequest(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, New(http.StatusBadRequest, CodeInvalidRequest, detail))
+}
+
+// InvalidParameter отвечает 400 с ошибкой поля или параметра запроса, например неверного идентификатора в пути
+func InvalidParameter(w http.ResponseWriter, r *http.Request, field, detail string) {
+	Write(w, r, New(http.StatusBadRequest, CodeInvalidParameter, detail).WithField(field, CodeInvalidParameter, detail))
+}
+
+// PreconditionFailed отвечает 412, когда условие запроса (If-Match) не может быть выполнено
+func PreconditionFailed(w http.ResponseWriter, r *http.Request, detail string) {
+	Write(w, r, New(http.StatusPreconditionFailed, CodePreconditionFailed, detail))
+}
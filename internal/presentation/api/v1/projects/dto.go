@@ -0,0 +1,80 @@
+package projects
+
+import (
+	"time"
+
+	projects_usecases "crud/internal/application/projects/usecases"
+	projects_domain "crud/internal/domain/projects"
+
+	"github.com/google/uuid"
+)
+
+// CreateProjectRequest запрос на создание проекта; без user_id владельцем становится вызывающий
+type CreateProjectRequest struct {
+	UserID      string `json:"user_id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// UpdateProjectRequest запрос на обновление проекта; отсутствующие поля не меняются
+type UpdateProjectRequest struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ProjectResponse ответ с данными проекта
+type ProjectResponse struct {
+	ID          string `json:"id"`
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// ProjectDTOFromEntity создает ProjectResponse из сущности проекта
+func ProjectDTOFromEntity(project *projects_domain.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:          project.ID.String(),
+		UserID:      project.UserID.String(),
+		Name:        project.Name.Value(),
+		Description: project.Description,
+		CreatedAt:   project.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   project.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// ProjectDTOsFromEntities создает список ProjectResponse из сущностей проектов
+func ProjectDTOsFromEntities(projects []*projects_domain.Project) []ProjectResponse {
+	response := make([]ProjectResponse, len(projects))
+	for i, project := range projects {
+		response[i] = ProjectDTOFromEntity(project)
+	}
+	return response
+}
+
+// CreateProjectInputFromRequest преобразует запрос в входные данные use case
+func CreateProjectInputFromRequest(req CreateProjectRequest) (projects_usecases.CreateProjectInput, error) {
+	input := projects_usecases.CreateProjectInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return input, &projects_domain.InvalidProjectDataError{Field: "user_id", Message: "invalid user ID"}
+		}
+		input.UserID = userID
+	}
+
+	return input, nil
+}
+
+// UpdateProjectInputFromRequest преобразует запрос в входные данные use case
+func UpdateProjectInputFromRequest(req UpdateProjectRequest) projects_usecases.UpdateProjectInput {
+	return projects_usecases.UpdateProjectInput{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+}
@@ -0,0 +1,188 @@
+package projects
+
+import (
+	"crud/internal/application"
+	projects_usecases "crud/internal/application/projects/usecases"
+	projects_domain "crud/internal/domain/projects"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+// Handler обработчик для проектов
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик проектов
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// CreateProject создает новый проект
+// POST /api/v1/projects
+func (h *Handler) CreateProject(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*projects_usecases.CreateProjectUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req CreateProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	input, err := CreateProjectInputFromRequest(req)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	project, err := useCase.Execute(r.Context(), input)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := ProjectDTOFromEntity(project)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetProjectByID получает проект по ID
+// GET /api/v1/projects/{id}
+func (h *Handler) GetProjectByID(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*projects_usecases.GetProjectByIDUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid project ID")
+		return
+	}
+
+	project, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := ProjectDTOFromEntity(project)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ListProjects получает список проектов
+// GET /api/v1/projects?user_id=&page=&page_size=
+func (h *Handler) ListProjects(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*projects_usecases.ListProjectsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	var filter projects_domain.ListProjectsFilter
+	if userIDStr := query.Get("user_id"); userIDStr != "" {
+		id, err := uuid.Parse(userIDStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "user_id", "Invalid user ID")
+			return
+		}
+		filter.UserID = &id
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	projects, total, err := useCase.Execute(r.Context(), filter, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      ProjectDTOsFromEntities(projects),
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// UpdateProject обновляет проект
+// PUT /api/v1/projects/{id}
+func (h *Handler) UpdateProject(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*projects_usecases.UpdateProjectUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid project ID")
+		return
+	}
+
+	var req UpdateProjectRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	project, err := useCase.Execute(r.Context(), id, UpdateProjectInputFromRequest(req))
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := ProjectDTOFromEntity(project)
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// DeleteProject удаляет проект; проект с задачами удалить нельзя
+// DELETE /api/v1/projects/{id}
+func (h *Handler) DeleteProject(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*projects_usecases.DeleteProjectUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid project ID")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), id); err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,48 @@
+package projects
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты проектов для документа OpenAPI
+func Operations() []openapi.Operation {
+	id := openapi.PathID("id", "ID проекта")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/projects", ID: "createProject", Tag: "projects",
+			Summary:   "Создать проект",
+			Request:   openapi.JSON(CreateProjectRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(ProjectResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/projects", ID: "listProjects", Tag: "projects",
+			Summary: "Список проектов",
+			Parameters: append([]openapi.Parameter{
+				openapi.Query("user_id", "только проекты пользователя", openapi.UUID()),
+			}, openapi.Pagination()...),
+			Responses: []openapi.Response{openapi.OK(openapi.PageOf(ProjectResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/projects/{id}", ID: "getProject", Tag: "projects",
+			Summary:    "Получить проект",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(ProjectResponse{}))},
+		},
+		{
+			Method: http.MethodPut, Path: "/projects/{id}", ID: "updateProject", Tag: "projects",
+			Summary:    "Обновить проект",
+			Parameters: []openapi.Parameter{id},
+			Request:    openapi.JSON(UpdateProjectRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(ProjectResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/projects/{id}", ID: "deleteProject", Tag: "projects",
+			Summary:    "Удалить проект",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+	}
+}
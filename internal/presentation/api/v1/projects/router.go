@@ -0,0 +1,28 @@
+package projects
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты для проектов
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/projects", func(r chi.Router) {
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionProjectsCreate)).Post("/", handler.CreateProject)
+		r.With(middleware.RequirePermission(policies.PermissionProjectsRead)).Get("/", handler.ListProjects)
+		r.With(middleware.RequirePermission(policies.PermissionProjectsRead)).Get("/{id}", handler.GetProjectByID)
+		r.With(middleware.RequirePermission(policies.PermissionProjectsUpdate)).Put("/{id}", handler.UpdateProject)
+		r.With(middleware.RequirePermission(policies.PermissionProjectsDelete)).Delete("/{id}", handler.DeleteProject)
+	})
+
+	return nil
+}
@@ -1,7 +1,14 @@
 package v1
 
 import (
+	"crud/internal/presentation/api/v1/audit"
+	"crud/internal/presentation/api/v1/auth"
+	"crud/internal/presentation/api/v1/labels"
+	"crud/internal/presentation/api/v1/openapi"
+	"crud/internal/presentation/api/v1/organizations"
+	"crud/internal/presentation/api/v1/projects"
 	"crud/internal/presentation/api/v1/tasks"
+	"crud/internal/presentation/api/v1/trash"
 	"crud/internal/presentation/api/v1/users"
 
 	"github.com/go-chi/chi/v5"
@@ -10,15 +17,57 @@ import (
 
 // SetupRoutes настраивает маршруты API v1
 func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Проверяем запросы по документу OpenAPI до аутентификации и обработчиков
+	document := Document()
+	r.Use(openapi.Validate(document))
+
+	// Документ и страница документации доступны без аутентификации
+	documentHandler, err := openapi.Handler(document)
+	if err != nil {
+		return err
+	}
+	r.Get("/openapi.json", documentHandler)
+	r.Get("/docs", openapi.Docs)
+
+	// Настраиваем маршруты аутентификации
+	if err := auth.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
+	// Настраиваем маршруты для организаций
+	if err := organizations.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
 	// Настраиваем маршруты для пользователей
 	if err := users.SetupRoutes(r, container); err != nil {
 		return err
 	}
 
+	// Настраиваем маршруты для проектов
+	if err := projects.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
 	// Настраиваем маршруты для задач
 	if err := tasks.SetupRoutes(r, container); err != nil {
 		return err
 	}
 
+	// Настраиваем маршруты для меток
+	if err := labels.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
+	// Настраиваем маршруты журнала аудита
+	if err := audit.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
+	// Настраиваем маршруты корзины
+	if err := trash.SetupRoutes(r, container); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	tasks_domain "crud/internal/domain/tasks"
+	"fmt"
+	"time"
+)
+
+// dateOnlyLayout формат даты без времени
+const dateOnlyLayout = "2006-01-02"
+
+// parseTimezone возвращает часовой пояс по имени IANA (например, Europe/Moscow); пустое имя означает UTC
+func parseTimezone(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, &tasks_domain.InvalidTaskDataError{Field: "timezone", Message: fmt.Sprintf("unknown timezone '%s'", name)}
+	}
+	return loc, nil
+}
+
+// parseDate разбирает дату в формате RFC 3339 или YYYY-MM-DD.
+// Дата без времени трактуется в часовом поясе loc как начало дня, а при endOfDay - как его последний момент
+func parseDate(field, value string, loc *time.Location, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	day, err := time.ParseInLocation(dateOnlyLayout, value, loc)
+	if err != nil {
+		return time.Time{}, &tasks_domain.InvalidTaskDataError{Field: field, Message: fmt.Sprintf("invalid date '%s': expected RFC 3339 or YYYY-MM-DD", value)}
+	}
+	if endOfDay {
+		return day.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+	return day, nil
+}
+
+// parseOptionalDate разбирает необязательную дату; пустая строка означает отсутствие даты
+func parseOptionalDate(field, value string, loc *time.Location, endOfDay bool) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := parseDate(field, value, loc, endOfDay)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// formatDate форматирует необязательную дату в RFC 3339 (UTC)
+func formatDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	formatted := t.UTC().Format(time.RFC3339)
+	return &formatted
+}
@@ -3,33 +3,127 @@ package tasks
 import (
 	"time"
 
+	tasks_usecases "crud/internal/application/tasks/usecases"
 	tasks_domain "crud/internal/domain/tasks"
+	v1_labels "crud/internal/presentation/api/v1/labels"
+	"crud/internal/presentation/api/v1/patch"
+
+	"github.com/google/uuid"
 )
 
-// CreateTaskRequest запрос на создание задачи
+// CreateTaskRequest запрос на создание задачи; без user_id владельцем становится вызывающий.
+// Даты принимаются в RFC 3339 или как YYYY-MM-DD в часовом поясе timezone (по умолчанию UTC)
 type CreateTaskRequest struct {
-	UserID      string `json:"user_id"`
+	UserID      string `json:"user_id,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
 	Title       string `json:"title"`
-	Description string `json:"description"`
+	Description string `json:"description,omitempty"`
 	Status      string `json:"status"`
+	Priority    string `json:"priority,omitempty"`
+	StartDate   string `json:"start_date,omitempty"`
+	DueDate     string `json:"due_date,omitempty"`
+	Timezone    string `json:"timezone,omitempty"`
 }
 
-// UpdateTaskRequest запрос на обновление задачи
+// UpdateTaskRequest запрос на обновление задачи; пустая строка в start_date или due_date снимает дату,
+// пустая строка в project_id убирает задачу из проекта, пустая строка в parent_id делает задачу корневой
 type UpdateTaskRequest struct {
+	ProjectID   *string `json:"project_id,omitempty"`
+	ParentID    *string `json:"parent_id,omitempty"`
 	Title       *string `json:"title,omitempty"`
 	Description *string `json:"description,omitempty"`
 	Status      *string `json:"status,omitempty"`
+	Priority    *string `json:"priority,omitempty"`
+	StartDate   *string `json:"start_date,omitempty"`
+	DueDate     *string `json:"due_date,omitempty"`
+	Timezone    string  `json:"timezone,omitempty"`
+}
+
+// TaskPatchFields поля представления задачи, которые можно изменить через PATCH
+var TaskPatchFields = []string{"title", "description", "status", "priority", "start_date", "due_date", "project_id", "parent_id"}
+
+// TransitionTaskRequest запрос на смену статуса задачи
+type TransitionTaskRequest struct {
+	Status string `json:"status"`
+}
+
+// AttachTaskLabelRequest запрос на добавление метки к задаче
+type AttachTaskLabelRequest struct {
+	LabelID string `json:"label_id"`
+}
+
+// TaskLabelsResponse ответ со списком меток задачи
+type TaskLabelsResponse struct {
+	Data []v1_labels.LabelResponse `json:"data"`
+}
+
+// AddTaskDependencyRequest запрос на добавление блокирующей задачи
+type AddTaskDependencyRequest struct {
+	BlockerID string `json:"blocker_id"`
+}
+
+// TaskDependenciesResponse ответ с задачами, которые блокируют задачу, и задачами, которые она блокирует
+type TaskDependenciesResponse struct {
+	Blockers   []TaskResponse `json:"blockers"`
+	Dependents []TaskResponse `json:"dependents"`
+}
+
+// TaskDependenciesDTOFromEntity создает TaskDependenciesResponse из зависимостей задачи
+func TaskDependenciesDTOFromEntity(dependencies *tasks_domain.TaskDependencies) TaskDependenciesResponse {
+	return TaskDependenciesResponse{
+		Blockers:   TaskDTOsFromEntities(dependencies.Blockers),
+		Dependents: TaskDTOsFromEntities(dependencies.Dependents),
+	}
+}
+
+// TaskProgressResponse ответ с прогрессом задачи по ее подзадачам всех уровней
+type TaskProgressResponse struct {
+	Total   int `json:"total"`
+	Done    int `json:"done"`
+	Percent int `json:"percent"`
+}
+
+// TaskProgressDTOFromEntity создает TaskProgressResponse из прогресса задачи
+func TaskProgressDTOFromEntity(progress tasks_domain.TaskProgress) TaskProgressResponse {
+	return TaskProgressResponse{
+		Total:   progress.Total,
+		Done:    progress.Done,
+		Percent: progress.Percent(),
+	}
+}
+
+// WorkflowResponse ответ с описанием рабочего процесса задач
+type WorkflowResponse struct {
+	Statuses    []string             `json:"statuses"`
+	Initial     []string             `json:"initial"`
+	Closed      []string             `json:"closed"`
+	Transitions []TransitionResponse `json:"transitions"`
+}
+
+// TransitionResponse разрешенный переход между статусами
+type TransitionResponse struct {
+	From   string   `json:"from"`
+	To     string   `json:"to"`
+	Guards []string `json:"guards"`
 }
 
 // TaskResponse ответ с данными задачи
 type TaskResponse struct {
-	ID          string `json:"id"`
-	UserID      string `json:"user_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Status      string `json:"status"`
-	CreatedAt   string `json:"created_at"`
-	UpdatedAt   string `json:"updated_at"`
+	ID          string  `json:"id"`
+	UserID      string  `json:"user_id"`
+	ProjectID   *string `json:"project_id"`
+	ParentID    *string `json:"parent_id"`
+	Title       string  `json:"title"`
+	Description string  `json:"description"`
+	Status      string  `json:"status"`
+	Priority    string  `json:"priority"`
+	StartDate   *string `json:"start_date"`
+	DueDate     *string `json:"due_date"`
+	Overdue     bool    `json:"overdue"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	Version     int64   `json:"version"`
 }
 
 // TaskDTOFromEntity создает TaskResponse из сущности задачи
@@ -37,10 +131,197 @@ func TaskDTOFromEntity(task *tasks_domain.Task) TaskResponse {
 	return TaskResponse{
 		ID:          task.ID.String(),
 		UserID:      task.UserID.String(),
+		ProjectID:   formatOptionalID(task.ProjectID),
+		ParentID:    formatOptionalID(task.ParentID),
 		Title:       task.Title.Value(),
 		Description: task.Description,
 		Status:      task.Status.Value(),
+		Priority:    task.Priority.Value(),
+		StartDate:   formatDate(task.Schedule.StartDate()),
+		DueDate:     formatDate(task.Schedule.DueDate()),
+		Overdue:     task.IsOverdue(time.Now()),
 		CreatedAt:   task.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   task.UpdatedAt.Format(time.RFC3339),
+		Version:     task.Version,
+	}
+}
+
+// etagFlags признаки представления задачи для ETag, которые меняются без изменения версии:
+// "o" - задача просрочена. Признаки берутся из того же ответа, что уходит клиенту
+func etagFlags(response TaskResponse) []string {
+	if response.Overdue {
+		return []string{"o"}
+	}
+	return nil
+}
+
+// formatOptionalID возвращает строковое представление необязательного ID
+func formatOptionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+// TaskDTOsFromEntities создает список TaskResponse из сущностей задач
+func TaskDTOsFromEntities(tasks []*tasks_domain.Task) []TaskResponse {
+	response := make([]TaskResponse, len(tasks))
+	for i, task := range tasks {
+		response[i] = TaskDTOFromEntity(task)
+	}
+	return response
+}
+
+// WorkflowDTOFromEntity создает WorkflowResponse из рабочего процесса задач
+func WorkflowDTOFromEntity(workflow *tasks_domain.Workflow) WorkflowResponse {
+	transitions := workflow.Transitions()
+	response := WorkflowResponse{
+		Statuses:    workflow.Statuses(),
+		Initial:     workflow.InitialStatuses(),
+		Closed:      workflow.ClosedStatuses(),
+		Transitions: make([]TransitionResponse, len(transitions)),
+	}
+	for i, transition := range transitions {
+		guards := transition.Guards
+		if guards == nil {
+			guards = []string{}
+		}
+		response.Transitions[i] = TransitionResponse{From: transition.From, To: transition.To, Guards: guards}
+	}
+	return response
+}
+
+// CreateTaskInputFromRequest преобразует запрос на создание задачи во входные данные use case
+func CreateTaskInputFromRequest(req CreateTaskRequest) (tasks_usecases.CreateTaskInput, error) {
+	input := tasks_usecases.CreateTaskInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Priority:    req.Priority,
+	}
+
+	if req.UserID != "" {
+		userID, err := uuid.Parse(req.UserID)
+		if err != nil {
+			return input, &tasks_domain.InvalidTaskDataError{Field: "user_id", Message: "invalid user ID"}
+		}
+		input.UserID = userID
+	}
+
+	if req.ProjectID != "" {
+		projectID, err := uuid.Parse(req.ProjectID)
+		if err != nil {
+			return input, &tasks_domain.InvalidTaskDataError{Field: "project_id", Message: "invalid project ID"}
+		}
+		input.ProjectID = &projectID
+	}
+
+	if req.ParentID != "" {
+		parentID, err := uuid.Parse(req.ParentID)
+		if err != nil {
+			return input, &tasks_domain.InvalidTaskDataError{Field: "parent_id", Message: "invalid parent task ID"}
+		}
+		input.ParentID = &parentID
+	}
+
+	loc, err := parseTimezone(req.Timezone)
+	if err != nil {
+		return input, err
+	}
+	if input.StartDate, err = parseOptionalDate("start_date", req.StartDate, loc, false); err != nil {
+		return input, err
+	}
+	if input.DueDate, err = parseOptionalDate("due_date", req.DueDate, loc, true); err != nil {
+		return input, err
+	}
+
+	return input, nil
+}
+
+// UpdateTaskInputFromRequest преобразует запрос на обновление задачи во входные данные use case
+func UpdateTaskInputFromRequest(req UpdateTaskRequest) (tasks_usecases.UpdateTaskInput, error) {
+	input := tasks_usecases.UpdateTaskInput{
+		Title:       req.Title,
+		Description: req.Description,
+		Status:      req.Status,
+		Priority:    req.Priority,
+	}
+
+	if req.ProjectID != nil {
+		input.ClearProject = *req.ProjectID == ""
+		if !input.ClearProject {
+			projectID, err := uuid.Parse(*req.ProjectID)
+			if err != nil {
+				return input, &tasks_domain.InvalidTaskDataError{Field: "project_id", Message: "invalid project ID"}
+			}
+			input.ProjectID = &projectID
+		}
+	}
+
+	if req.ParentID != nil {
+		input.ClearParent = *req.ParentID == ""
+		if !input.ClearParent {
+			parentID, err := uuid.Parse(*req.ParentID)
+			if err != nil {
+				return input, &tasks_domain.InvalidTaskDataError{Field: "parent_id", Message: "invalid parent task ID"}
+			}
+			input.ParentID = &parentID
+		}
+	}
+
+	loc, err := parseTimezone(req.Timezone)
+	if err != nil {
+		return input, err
+	}
+	if req.StartDate != nil {
+		input.ClearStartDate = *req.StartDate == ""
+		if input.StartDate, err = parseOptionalDate("start_date", *req.StartDate, loc, false); err != nil {
+			return input, err
+		}
+	}
+	if req.DueDate != nil {
+		input.ClearDueDate = *req.DueDate == ""
+		if input.DueDate, err = parseOptionalDate("due_date", *req.DueDate, loc, true); err != nil {
+			return input, err
+		}
+	}
+
+	return input, nil
+}
+
+// UpdateTaskRequestFromPatch преобразует поля, измененные патчем, в запрос на обновление задачи.
+// null в description, start_date, due_date, project_id и parent_id очищает поле, а title, status
+// и priority обязательны
+func UpdateTaskRequestFromPatch(changes map[string]*string, timezone string) (UpdateTaskRequest, error) {
+	req := UpdateTaskRequest{Timezone: timezone}
+	for _, name := range []string{"title", "status", "priority"} {
+		if err := patch.Required(changes, name); err != nil {
+			return req, err
+		}
+	}
+
+	req.Title = changes["title"]
+	req.Status = changes["status"]
+	req.Priority = changes["priority"]
+	req.Description = clearedOnNull(changes, "description")
+	req.StartDate = clearedOnNull(changes, "start_date")
+	req.DueDate = clearedOnNull(changes, "due_date")
+	req.ProjectID = clearedOnNull(changes, "project_id")
+	req.ParentID = clearedOnNull(changes, "parent_id")
+	return req, nil
+}
+
+// clearedOnNull возвращает новое значение поля или пустую строку, если патч сделал его null;
+// nil означает, что поле не менялось
+func clearedOnNull(changes map[string]*string, name string) *string {
+	value, ok := changes[name]
+	if !ok {
+		return nil
+	}
+	if value == nil {
+		empty := ""
+		return &empty
 	}
+	return value
 }
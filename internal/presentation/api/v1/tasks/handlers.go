@@ -3,15 +3,33 @@ package tasks
 import (
 	"crud/internal/application"
 	tasks_usecases "crud/internal/application/tasks/usecases"
+	labels_domain "crud/internal/domain/labels"
+	projects_domain "crud/internal/domain/projects"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/internal/presentation/api/v1/cursor"
+	"crud/internal/presentation/api/v1/etag"
+	v1_labels "crud/internal/presentation/api/v1/labels"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/patch"
+	"crud/internal/presentation/api/v1/problems"
 	"encoding/json"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
 	"go.uber.org/dig"
 )
 
+// references поля запросов задач, которые ссылаются на проекты и метки: если таких нет,
+// ошибка относится к данным запроса (422), а не к задаче из URL
+var references = []problems.Reference{
+	{Field: "project_id", Match: projects_domain.IsProjectNotFound},
+	{Field: "label_id", Match: labels_domain.IsLabelNotFound},
+}
+
 // Handler обработчик для задач
 type Handler struct {
 	container *dig.Container
@@ -29,102 +47,182 @@ func NewHandler(container *dig.Container) *Handler {
 func (h *Handler) CreateTask(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*tasks_usecases.CreateTaskUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	var req CreateTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problems.BadRequest(w, r, "Invalid request body")
 		return
 	}
 
-	userID, err := uuid.Parse(req.UserID)
+	input, err := CreateTaskInputFromRequest(req)
 	if err != nil {
-		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
-	task, err := useCase.Execute(r.Context(), userID, req.Title, req.Description, req.Status)
+	task, err := useCase.Execute(r.Context(), input)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
 	response := TaskDTOFromEntity(task)
 
+	etag.Set(w, task.Version, etagFlags(response)...)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(response)
 }
 
-// GetTaskByID получает задачу по ID
+// GetTaskByID получает задачу по ID; если ETag из If-None-Match актуален, отвечает 304 без тела
 // GET /api/v1/tasks/{id}
 func (h *Handler) GetTaskByID(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*tasks_usecases.GetTaskByIDUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
 		return
 	}
 
 	task, err := useCase.Execute(r.Context(), id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
 	response := TaskDTOFromEntity(task)
 
+	etag.Set(w, task.Version, etagFlags(response)...)
+	if etag.NoneMatch(r, task.Version, etagFlags(response)...) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
 
-// ListTasks получает список задач
-// GET /api/v1/tasks
+// ListTasks получает список задач по номеру страницы или, с параметром cursor, по курсору
+// GET /api/v1/tasks?user_id=&project_id=&status=&due_before=&due_after=&overdue=&blocked=&timezone=&labels=&labels_match=&filter=&sort=&page=&page_size=&cursor=
 func (h *Handler) ListTasks(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTasksUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
-	page := 1
-	pageSize := 10
-	var userID *uuid.UUID
-	var status *string
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+	var filter tasks_domain.ListTasksFilter
+	query := r.URL.Query()
+
+	if userIDStr := query.Get("user_id"); userIDStr != "" {
+		id, err := uuid.Parse(userIDStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "user_id", "Invalid user ID")
+			return
+		}
+		filter.UserID = &id
+	}
 
-	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = p
+	if projectIDStr := query.Get("project_id"); projectIDStr != "" {
+		id, err := uuid.Parse(projectIDStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "project_id", "Invalid project ID")
+			return
 		}
+		filter.ProjectID = &id
+	}
+
+	if statusStr := query.Get("status"); statusStr != "" {
+		filter.Status = &statusStr
 	}
 
-	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
-		if ps, err := strconv.Atoi(pageSizeStr); err == nil && ps > 0 {
-			pageSize = ps
+	loc, err := parseTimezone(query.Get("timezone"))
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	// Дата без времени в due_before включает весь день, в due_after - начинается с его начала
+	if filter.DueBefore, err = parseOptionalDate("due_before", query.Get("due_before"), loc, true); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+	if filter.DueAfter, err = parseOptionalDate("due_after", query.Get("due_after"), loc, false); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	if overdueStr := query.Get("overdue"); overdueStr != "" {
+		overdue, err := strconv.ParseBool(overdueStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "overdue", "Invalid overdue flag")
+			return
 		}
+		filter.Overdue = &overdue
 	}
 
-	if userIDStr := r.URL.Query().Get("user_id"); userIDStr != "" {
-		if id, err := uuid.Parse(userIDStr); err == nil {
-			userID = &id
+	// blocked=false выбирает задачи, которые не ждут завершения других задач
+	if blockedStr := query.Get("blocked"); blockedStr != "" {
+		blocked, err := strconv.ParseBool(blockedStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "blocked", "Invalid blocked flag")
+			return
 		}
+		filter.Blocked = &blocked
 	}
 
-	if statusStr := r.URL.Query().Get("status"); statusStr != "" {
-		status = &statusStr
+	// labels - имена меток через запятую; labels_match=all требует от задачи всех меток сразу
+	if labelsStr := query.Get("labels"); labelsStr != "" {
+		filter.Labels = strings.Split(labelsStr, ",")
+	}
+	if filter.LabelMatch, err = tasks_domain.ParseLabelMatch(query.Get("labels_match")); err != nil {
+		problems.Error(w, r, err, references...)
+		return
 	}
 
-	tasks, total, err := useCase.Execute(r.Context(), userID, status, page, pageSize)
+	// filter - выражение языка фильтров, например status in (todo, in_progress) and due_date < 2025-07-01
+	if filter.Specification, err = tasks_domain.ParseTaskFilter(query.Get("filter"), loc); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	// sort - priority или список полей через запятую: sort=-updated_at,title
+	if filter.Sort, filter.SortKeys, err = tasks_domain.ParseTaskSort(query.Get("sort")); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	// Курсор продолжает список с позиции из предыдущего ответа вместо смещения page
+	if cursorStr := query.Get("cursor"); cursorStr != "" {
+		if query.Get("page") != "" {
+			problems.InvalidParameter(w, r, "cursor", "cursor cannot be combined with page")
+			return
+		}
+		var position tasks_domain.TaskCursor
+		if err := cursor.Decode(cursorStr, &position); err != nil || position.ID == uuid.Nil {
+			problems.InvalidParameter(w, r, "cursor", "Invalid cursor")
+			return
+		}
+		h.listTasksByCursor(w, r, filter, &position, pageSize)
+		return
+	}
+
+	tasks, total, err := useCase.Execute(r.Context(), filter, page, pageSize)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
@@ -133,67 +231,558 @@ func (h *Handler) ListTasks(w http.ResponseWriter, r *http.Request) {
 		response[i] = TaskDTOFromEntity(task)
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
+	// Курсоры соседних страниц позволяют перейти от номеров страниц к выводу по курсору;
+	// сортировку по списку полей вывод по курсору не поддерживает
+	envelope := map[string]interface{}{
 		"data":      response,
 		"total":     total,
 		"page":      page,
 		"page_size": pageSize,
-	})
+	}
+	cursorable := len(tasks) > 0 && len(filter.SortKeys) == 0
+	if cursorable && int64(page*pageSize) < total {
+		envelope["next_cursor"] = cursor.Encode(tasks_domain.NewTaskCursor(tasks[len(tasks)-1], filter.Sort, false))
+	}
+	if cursorable && page > 1 {
+		envelope["prev_cursor"] = cursor.Encode(tasks_domain.NewTaskCursor(tasks[0], filter.Sort, true))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(envelope)
+}
+
+// listTasksByCursor отвечает страницей списка задач по курсору; общее количество задач не считается,
+// чтобы запрос оставался дешевым на больших таблицах
+func (h *Handler) listTasksByCursor(
+	w http.ResponseWriter,
+	r *http.Request,
+	filter tasks_domain.ListTasksFilter,
+	position *tasks_domain.TaskCursor,
+	pageSize int,
+) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTasksByCursorUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	page, err := useCase.Execute(r.Context(), filter, position, pageSize)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	response := make([]TaskResponse, len(page.Tasks))
+	for i, task := range page.Tasks {
+		response[i] = TaskDTOFromEntity(task)
+	}
+
+	envelope := map[string]interface{}{
+		"data":      response,
+		"page_size": pageSize,
+	}
+	if page.Next != nil {
+		envelope["next_cursor"] = cursor.Encode(page.Next)
+	}
+	if page.Prev != nil {
+		envelope["prev_cursor"] = cursor.Encode(page.Prev)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(envelope)
 }
 
-// UpdateTask обновляет задачу
+// UpdateTask обновляет задачу; с заголовком If-Match - только если версия задачи не изменилась
 // PUT /api/v1/tasks/{id}
 func (h *Handler) UpdateTask(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*tasks_usecases.UpdateTaskUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
 		return
 	}
 
 	var req UpdateTaskRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	input, err := UpdateTaskInputFromRequest(req)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	version, ok := etag.IfMatchVersion(r)
+	if !ok {
+		problems.PreconditionFailed(w, r, "If-Match does not match any task version")
+		return
+	}
+	input.Version = version
+
+	task, err := useCase.Execute(r.Context(), id, input)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	response := TaskDTOFromEntity(task)
+
+	etag.Set(w, task.Version, etagFlags(response)...)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// PatchTask частично обновляет задачу патчем JSON Merge Patch или JSON Patch к ее представлению.
+// Патч применяется к версии задачи, прочитанной в начале запроса: если задачу изменили раньше,
+// чем изменения сохранены, возвращается 412. Даты без времени трактуются в часовом поясе timezone
+// PATCH /api/v1/tasks/{id}?timezone=
+func (h *Handler) PatchTask(w http.ResponseWriter, r *http.Request) {
+	getUseCase, err := application.ResolveFromContainer[*tasks_usecases.GetTaskByIDUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+	updateUseCase, err := application.ResolveFromContainer[*tasks_usecases.UpdateTaskUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	version, ok := etag.IfMatchVersion(r)
+	if !ok {
+		problems.PreconditionFailed(w, r, "If-Match does not match any task version")
+		return
+	}
+
+	task, err := getUseCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+	if version == nil {
+		version = &task.Version
+	}
+
+	document, err := json.Marshal(TaskDTOFromEntity(task))
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+	patched, err := patch.Apply(r.Header.Get("Content-Type"), document, body)
+	if err != nil {
+		if patch.IsUnsupportedMediaType(err) {
+			w.Header().Set("Accept-Patch", patch.AcceptPatch)
+		}
+		problems.Error(w, r, err, references...)
+		return
+	}
+	changes, err := patch.Changes(document, patched, TaskPatchFields...)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	req, err := UpdateTaskRequestFromPatch(changes, r.URL.Query().Get("timezone"))
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+	input, err := UpdateTaskInputFromRequest(req)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+	input.Version = version
+
+	task, err = updateUseCase.Execute(r.Context(), id, input)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	response := TaskDTOFromEntity(task)
+
+	etag.Set(w, task.Version, etagFlags(response)...)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// TransitionTask переводит задачу в другой статус по правилам рабочего процесса
+// POST /api/v1/tasks/{id}/transitions
+func (h *Handler) TransitionTask(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.TransitionTaskUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	var req TransitionTaskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
 		return
 	}
 
-	task, err := useCase.Execute(r.Context(), id, req.Title, req.Description, req.Status)
+	task, err := useCase.Execute(r.Context(), id, req.Status)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
 	response := TaskDTOFromEntity(task)
 
+	etag.Set(w, task.Version, etagFlags(response)...)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
 
+// GetWorkflow возвращает статусы и разрешенные переходы задач
+// GET /api/v1/tasks/workflow
+func (h *Handler) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+	workflow, err := application.ResolveFromContainer[*tasks_domain.Workflow](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(WorkflowDTOFromEntity(workflow))
+}
+
+// ListTaskLabels возвращает метки задачи
+// GET /api/v1/tasks/{id}/labels
+func (h *Handler) ListTaskLabels(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTaskLabelsUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	labels, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TaskLabelsResponse{Data: v1_labels.LabelDTOsFromEntities(labels)})
+}
+
+// AttachTaskLabel добавляет метку к задаче и возвращает все метки задачи
+// POST /api/v1/tasks/{id}/labels
+func (h *Handler) AttachTaskLabel(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.AttachTaskLabelUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	var req AttachTaskLabelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	labelID, err := uuid.Parse(req.LabelID)
+	if err != nil {
+		problems.InvalidParameter(w, r, "label_id", "Invalid label ID")
+		return
+	}
+
+	labels, err := useCase.Execute(r.Context(), id, labelID)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TaskLabelsResponse{Data: v1_labels.LabelDTOsFromEntities(labels)})
+}
+
+// DetachTaskLabel снимает метку с задачи
+// DELETE /api/v1/tasks/{id}/labels/{labelID}
+func (h *Handler) DetachTaskLabel(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.DetachTaskLabelUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	labelID, err := uuid.Parse(chi.URLParam(r, "labelID"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "label_id", "Invalid label ID")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), id, labelID); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListTaskChildren возвращает непосредственные подзадачи задачи
+// GET /api/v1/tasks/{id}/children
+func (h *Handler) ListTaskChildren(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTaskChildrenUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	children, total, err := useCase.Execute(r.Context(), id, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	response := make([]TaskResponse, len(children))
+	for i, task := range children {
+		response[i] = TaskDTOFromEntity(task)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      response,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
+
+// ListTaskSubtree возвращает все подзадачи задачи на любой глубине; дерево восстанавливается по parent_id
+// GET /api/v1/tasks/{id}/subtree
+func (h *Handler) ListTaskSubtree(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTaskSubtreeUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	descendants, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	response := make([]TaskResponse, len(descendants))
+	for i, task := range descendants {
+		response[i] = TaskDTOFromEntity(task)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":  response,
+		"total": len(response),
+	})
+}
+
+// GetTaskProgress возвращает, сколько подзадач задачи на всех уровнях завершено
+// GET /api/v1/tasks/{id}/progress
+func (h *Handler) GetTaskProgress(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.GetTaskProgressUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	progress, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TaskProgressDTOFromEntity(progress))
+}
+
+// ListTaskDependencies возвращает задачи, которые блокируют задачу, и задачи, которые она блокирует
+// GET /api/v1/tasks/{id}/dependencies
+func (h *Handler) ListTaskDependencies(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.ListTaskDependenciesUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	dependencies, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TaskDependenciesDTOFromEntity(dependencies))
+}
+
+// AddTaskDependency добавляет блокирующую задачу и возвращает все зависимости задачи
+// POST /api/v1/tasks/{id}/dependencies
+func (h *Handler) AddTaskDependency(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.AddTaskDependencyUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	var req AddTaskDependencyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	blockerID, err := uuid.Parse(req.BlockerID)
+	if err != nil {
+		problems.InvalidParameter(w, r, "blocker_id", "Invalid blocker ID")
+		return
+	}
+
+	dependencies, err := useCase.Execute(r.Context(), id, blockerID)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(TaskDependenciesDTOFromEntity(dependencies))
+}
+
+// RemoveTaskDependency удаляет блокирующую задачу
+// DELETE /api/v1/tasks/{id}/dependencies/{blockerID}
+func (h *Handler) RemoveTaskDependency(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*tasks_usecases.RemoveTaskDependencyUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	blockerID, err := uuid.Parse(chi.URLParam(r, "blockerID"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "blocker_id", "Invalid blocker ID")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), id, blockerID); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // DeleteTask удаляет задачу
 // DELETE /api/v1/tasks/{id}
 func (h *Handler) DeleteTask(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*tasks_usecases.DeleteTaskUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid task ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
 		return
 	}
 
 	if err := useCase.Execute(r.Context(), id); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problems.Error(w, r, err, references...)
 		return
 	}
 
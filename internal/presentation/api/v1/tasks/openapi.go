@@ -0,0 +1,147 @@
+package tasks
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/attachments"
+	"crud/internal/presentation/api/v1/audit"
+	"crud/internal/presentation/api/v1/comments"
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты задач и вложенных в них ресурсов для документа OpenAPI
+func Operations() []openapi.Operation {
+	id := openapi.PathID("id", "ID задачи")
+	ifMatch := openapi.Header("If-Match", "ETag версии задачи, которую изменяет запрос")
+	timezone := openapi.Query("timezone", "часовой пояс IANA для дат без времени", openapi.String())
+
+	operations := []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/tasks", ID: "createTask", Tag: "tasks",
+			Summary:   "Создать задачу",
+			Request:   openapi.JSON(CreateTaskRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks", ID: "listTasks", Tag: "tasks",
+			Summary: "Список задач с фильтрами",
+			Parameters: append([]openapi.Parameter{
+				openapi.Query("user_id", "только задачи пользователя", openapi.UUID()),
+				openapi.Query("project_id", "только задачи проекта", openapi.UUID()),
+				openapi.Query("status", "только задачи в статусе", openapi.String()),
+				openapi.Query("due_before", "срок не позже даты", openapi.String()),
+				openapi.Query("due_after", "срок не раньше даты", openapi.String()),
+				openapi.Query("overdue", "только просроченные или только непросроченные задачи", openapi.Boolean()),
+				openapi.Query("blocked", "только задачи, которые ждут или не ждут других задач", openapi.Boolean()),
+				timezone,
+				openapi.Query("labels", "имена меток через запятую", openapi.String()),
+				openapi.Query("labels_match", "задача должна иметь любую из меток или все сразу", openapi.Enum("any", "all")),
+				openapi.Query("filter", "выражение языка фильтров: status in (todo, in_progress) and due_date < 2025-07-01", openapi.String()),
+				openapi.Query("sort", "priority или поля через запятую, минус - по убыванию: -updated_at,title", openapi.String()),
+			}, openapi.CursorPagination()...),
+			Responses: []openapi.Response{openapi.OK(openapi.CursorPageOf(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/workflow", ID: "getWorkflow", Tag: "tasks",
+			Summary:   "Статусы и переходы рабочего процесса задач",
+			Responses: []openapi.Response{openapi.OK(openapi.Of(WorkflowResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}", ID: "getTask", Tag: "tasks",
+			Summary: "Получить задачу",
+			Parameters: []openapi.Parameter{
+				id,
+				openapi.Header("If-None-Match", "ETag версии задачи, которая уже есть у клиента"),
+			},
+			Responses: []openapi.Response{openapi.OK(openapi.Of(TaskResponse{})), openapi.NotModified()},
+		},
+		{
+			Method: http.MethodPut, Path: "/tasks/{id}", ID: "updateTask", Tag: "tasks",
+			Summary:    "Обновить задачу",
+			Parameters: []openapi.Parameter{id, ifMatch},
+			Request:    openapi.JSON(UpdateTaskRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodPatch, Path: "/tasks/{id}", ID: "patchTask", Tag: "tasks",
+			Summary:    "Частично обновить задачу",
+			Parameters: []openapi.Parameter{id, ifMatch, timezone},
+			Request:    openapi.Patch(UpdateTaskRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/tasks/{id}", ID: "deleteTask", Tag: "tasks",
+			Summary:    "Удалить задачу в корзину",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+		{
+			Method: http.MethodPost, Path: "/tasks/{id}/transitions", ID: "transitionTask", Tag: "tasks",
+			Summary:    "Перевести задачу в другой статус",
+			Parameters: []openapi.Parameter{id},
+			Request:    openapi.JSON(TransitionTaskRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}/children", ID: "listTaskChildren", Tag: "tasks",
+			Summary:    "Прямые подзадачи",
+			Parameters: append([]openapi.Parameter{id}, openapi.Pagination()...),
+			Responses:  []openapi.Response{openapi.OK(openapi.PageOf(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}/subtree", ID: "listTaskSubtree", Tag: "tasks",
+			Summary:    "Подзадачи всех уровней",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.ListOf(TaskResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}/progress", ID: "getTaskProgress", Tag: "tasks",
+			Summary:    "Прогресс задачи по подзадачам",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskProgressResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}/dependencies", ID: "listTaskDependencies", Tag: "tasks",
+			Summary:    "Блокирующие и блокируемые задачи",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskDependenciesResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/tasks/{id}/dependencies", ID: "addTaskDependency", Tag: "tasks",
+			Summary:    "Добавить блокирующую задачу",
+			Parameters: []openapi.Parameter{id},
+			Request:    openapi.JSON(AddTaskDependencyRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskDependenciesResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/tasks/{id}/dependencies/{blockerID}", ID: "removeTaskDependency", Tag: "tasks",
+			Summary:    "Убрать блокирующую задачу",
+			Parameters: []openapi.Parameter{id, openapi.PathID("blockerID", "ID блокирующей задачи")},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+		{
+			Method: http.MethodGet, Path: "/tasks/{id}/labels", ID: "listTaskLabels", Tag: "tasks",
+			Summary:    "Метки задачи",
+			Parameters: []openapi.Parameter{id},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskLabelsResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/tasks/{id}/labels", ID: "attachTaskLabel", Tag: "tasks",
+			Summary:    "Повесить метку на задачу",
+			Parameters: []openapi.Parameter{id},
+			Request:    openapi.JSON(AttachTaskLabelRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(TaskLabelsResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/tasks/{id}/labels/{labelID}", ID: "detachTaskLabel", Tag: "tasks",
+			Summary:    "Снять метку с задачи",
+			Parameters: []openapi.Parameter{id, openapi.PathID("labelID", "ID метки")},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+	}
+
+	operations = append(operations, openapi.Prefix("/tasks/{id}/comments", comments.Operations())...)
+	operations = append(operations, openapi.Prefix("/tasks/{id}/history", audit.TaskOperations())...)
+	operations = append(operations, openapi.Prefix("/tasks/{id}/attachments", attachments.Operations())...)
+	return operations
+}
@@ -1,6 +1,14 @@
 package tasks
 
 import (
+	"errors"
+
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/attachments"
+	"crud/internal/presentation/api/v1/audit"
+	"crud/internal/presentation/api/v1/comments"
+	"crud/internal/presentation/api/v1/middleware"
+
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/dig"
 )
@@ -10,14 +18,45 @@ func SetupRoutes(r chi.Router, container *dig.Container) error {
 	// Создаем handler с контейнером
 	handler := NewHandler(container)
 
+	var setupErr error
+
 	// Настраиваем маршруты
 	r.Route("/tasks", func(r chi.Router) {
-		r.Post("/", handler.CreateTask)
-		r.Get("/", handler.ListTasks)
-		r.Get("/{id}", handler.GetTaskByID)
-		r.Put("/{id}", handler.UpdateTask)
-		r.Delete("/{id}", handler.DeleteTask)
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionTasksCreate)).Post("/", handler.CreateTask)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/", handler.ListTasks)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/workflow", handler.GetWorkflow)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}", handler.GetTaskByID)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Put("/{id}", handler.UpdateTask)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Patch("/{id}", handler.PatchTask)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Post("/{id}/transitions", handler.TransitionTask)
+		r.With(middleware.RequirePermission(policies.PermissionTasksDelete)).Delete("/{id}", handler.DeleteTask)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}/children", handler.ListTaskChildren)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}/subtree", handler.ListTaskSubtree)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}/progress", handler.GetTaskProgress)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}/dependencies", handler.ListTaskDependencies)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Post("/{id}/dependencies", handler.AddTaskDependency)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Delete("/{id}/dependencies/{blockerID}", handler.RemoveTaskDependency)
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/{id}/labels", handler.ListTaskLabels)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Post("/{id}/labels", handler.AttachTaskLabel)
+		r.With(middleware.RequirePermission(policies.PermissionTasksUpdate)).Delete("/{id}/labels/{labelID}", handler.DetachTaskLabel)
+
+		// Комментарии задачи
+		r.Route("/{id}/comments", func(r chi.Router) {
+			setupErr = errors.Join(setupErr, comments.SetupRoutes(r, container))
+		})
+
+		// История изменений задачи
+		r.Route("/{id}/history", func(r chi.Router) {
+			setupErr = errors.Join(setupErr, audit.SetupTaskRoutes(r, container))
+		})
+
+		// Вложения задачи
+		r.Route("/{id}/attachments", func(r chi.Router) {
+			setupErr = errors.Join(setupErr, attachments.SetupRoutes(r, container))
+		})
 	})
 
-	return nil
+	return setupErr
 }
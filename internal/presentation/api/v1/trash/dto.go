@@ -0,0 +1,53 @@
+package trash
+
+import (
+	"time"
+
+	tasks_domain "crud/internal/domain/tasks"
+	users_domain "crud/internal/domain/users"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+)
+
+// DeletedTaskResponse ответ с данными задачи из корзины
+type DeletedTaskResponse struct {
+	v1_tasks.TaskResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+// DeletedUserResponse ответ с данными пользователя из корзины
+type DeletedUserResponse struct {
+	v1_users.UserResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+// DeletedTaskDTOsFromEntities создает список DeletedTaskResponse из задач корзины
+func DeletedTaskDTOsFromEntities(tasks []*tasks_domain.Task) []DeletedTaskResponse {
+	response := make([]DeletedTaskResponse, len(tasks))
+	for i, task := range tasks {
+		response[i] = DeletedTaskResponse{
+			TaskResponse: v1_tasks.TaskDTOFromEntity(task),
+			DeletedAt:    formatDeletedAt(task.DeletedAt),
+		}
+	}
+	return response
+}
+
+// DeletedUserDTOsFromEntities создает список DeletedUserResponse из пользователей корзины
+func DeletedUserDTOsFromEntities(users []*users_domain.User) []DeletedUserResponse {
+	response := make([]DeletedUserResponse, len(users))
+	for i, user := range users {
+		response[i] = DeletedUserResponse{
+			UserResponse: v1_users.UserDTOFromEntity(user),
+			DeletedAt:    formatDeletedAt(user.DeletedAt),
+		}
+	}
+	return response
+}
+
+func formatDeletedAt(deletedAt *time.Time) string {
+	if deletedAt == nil {
+		return ""
+	}
+	return deletedAt.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,200 @@
+package trash
+
+import (
+	"crud/internal/application"
+	trash_usecases "crud/internal/application/trash/usecases"
+	projects_domain "crud/internal/domain/projects"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+	"go.uber.org/dig"
+)
+
+// references поля восстанавливаемой задачи, которые ссылаются на проект: если его нет,
+// задачу нельзя восстановить (422), хотя сама она в корзине
+var references = []problems.Reference{
+	{Field: "project_id", Match: projects_domain.IsProjectNotFound},
+}
+
+// Handler обработчик для корзины
+type Handler struct {
+	container *dig.Container
+}
+
+// NewHandler создает новый обработчик корзины
+func NewHandler(container *dig.Container) *Handler {
+	return &Handler{
+		container: container,
+	}
+}
+
+// ListDeletedTasks получает задачи из корзины, начиная с удаленных последними
+// GET /api/v1/trash/tasks?user_id=&page=&page_size=
+func (h *Handler) ListDeletedTasks(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.ListDeletedTasksUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	query := r.URL.Query()
+
+	var userID *uuid.UUID
+	if userIDStr := query.Get("user_id"); userIDStr != "" {
+		id, err := uuid.Parse(userIDStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "user_id", "Invalid user ID")
+			return
+		}
+		userID = &id
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	tasks, total, err := useCase.Execute(r.Context(), userID, page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	writePage(w, DeletedTaskDTOsFromEntities(tasks), total, page, pageSize)
+}
+
+// RestoreTask возвращает задачу из корзины
+// POST /api/v1/trash/tasks/{id}/restore
+func (h *Handler) RestoreTask(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.RestoreTaskUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	task, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v1_tasks.TaskDTOFromEntity(task))
+}
+
+// PurgeTask окончательно удаляет задачу из корзины вместе с ее подзадачами из корзины
+// DELETE /api/v1/trash/tasks/{id}
+func (h *Handler) PurgeTask(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.PurgeTaskUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid task ID")
+		return
+	}
+
+	if _, err := useCase.Execute(r.Context(), id); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListDeletedUsers получает пользователей из корзины, начиная с удаленных последними
+// GET /api/v1/trash/users?page=&page_size=
+func (h *Handler) ListDeletedUsers(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.ListDeletedUsersUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
+	}
+
+	users, total, err := useCase.Execute(r.Context(), page, pageSize)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	writePage(w, DeletedUserDTOsFromEntities(users), total, page, pageSize)
+}
+
+// RestoreUser возвращает пользователя из корзины
+// POST /api/v1/trash/users/{id}/restore
+func (h *Handler) RestoreUser(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.RestoreUserUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
+		return
+	}
+
+	user, err := useCase.Execute(r.Context(), id)
+	if err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v1_users.UserDTOFromEntity(user))
+}
+
+// PurgeUser окончательно удаляет пользователя из корзины
+// DELETE /api/v1/trash/users/{id}
+func (h *Handler) PurgeUser(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*trash_usecases.PurgeUserUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	id, err := uuid.Parse(chi.URLParam(r, "id"))
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
+		return
+	}
+
+	if err := useCase.Execute(r.Context(), id); err != nil {
+		problems.Error(w, r, err, references...)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writePage отвечает страницей записей корзины
+func writePage(w http.ResponseWriter, data any, total int64, page, pageSize int) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"data":      data,
+		"total":     total,
+		"page":      page,
+		"page_size": pageSize,
+	})
+}
@@ -0,0 +1,56 @@
+package trash
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+)
+
+// Operations описывает маршруты корзины для документа OpenAPI
+func Operations() []openapi.Operation {
+	taskID := openapi.PathID("id", "ID удаленной задачи")
+	userID := openapi.PathID("id", "ID удаленного пользователя")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodGet, Path: "/trash/tasks", ID: "listDeletedTasks", Tag: "trash",
+			Summary: "Удаленные задачи",
+			Parameters: append([]openapi.Parameter{
+				openapi.Query("user_id", "только задачи пользователя", openapi.UUID()),
+			}, openapi.Pagination()...),
+			Responses: []openapi.Response{openapi.OK(openapi.PageOf(DeletedTaskResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/trash/tasks/{id}/restore", ID: "restoreTask", Tag: "trash",
+			Summary:    "Восстановить задачу из корзины",
+			Parameters: []openapi.Parameter{taskID},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(v1_tasks.TaskResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/trash/tasks/{id}", ID: "purgeTask", Tag: "trash",
+			Summary:    "Удалить задачу из корзины окончательно",
+			Parameters: []openapi.Parameter{taskID},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+		{
+			Method: http.MethodGet, Path: "/trash/users", ID: "listDeletedUsers", Tag: "trash",
+			Summary:    "Удаленные пользователи",
+			Parameters: openapi.Pagination(),
+			Responses:  []openapi.Response{openapi.OK(openapi.PageOf(DeletedUserResponse{}))},
+		},
+		{
+			Method: http.MethodPost, Path: "/trash/users/{id}/restore", ID: "restoreUser", Tag: "trash",
+			Summary:    "Восстановить пользователя из корзины",
+			Parameters: []openapi.Parameter{userID},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(v1_users.UserResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/trash/users/{id}", ID: "purgeUser", Tag: "trash",
+			Summary:    "Удалить пользователя из корзины окончательно",
+			Parameters: []openapi.Parameter{userID},
+			Responses:  []openapi.Response{openapi.NoContent()},
+		},
+	}
+}
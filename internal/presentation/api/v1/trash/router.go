@@ -0,0 +1,30 @@
+package trash
+
+import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/dig"
+)
+
+// SetupRoutes настраивает маршруты корзины удаленных задач и пользователей
+func SetupRoutes(r chi.Router, container *dig.Container) error {
+	// Создаем handler с контейнером
+	handler := NewHandler(container)
+
+	// Настраиваем маршруты
+	r.Route("/trash", func(r chi.Router) {
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionTasksRead)).Get("/tasks", handler.ListDeletedTasks)
+		r.With(middleware.RequirePermission(policies.PermissionTasksDelete)).Post("/tasks/{id}/restore", handler.RestoreTask)
+		r.With(middleware.RequirePermission(policies.PermissionTrashPurge)).Delete("/tasks/{id}", handler.PurgeTask)
+
+		r.With(middleware.RequirePermission(policies.PermissionUsersDelete)).Get("/users", handler.ListDeletedUsers)
+		r.With(middleware.RequirePermission(policies.PermissionUsersDelete)).Post("/users/{id}/restore", handler.RestoreUser)
+		r.With(middleware.RequirePermission(policies.PermissionTrashPurge)).Delete("/users/{id}", handler.PurgeUser)
+	})
+
+	return nil
+}
@@ -4,36 +4,75 @@ import (
 	"time"
 
 	users_domain "crud/internal/domain/users"
+	"crud/internal/presentation/api/v1/patch"
 )
 
 // CreateUserRequest запрос на создание пользователя
 type CreateUserRequest struct {
-	Email string `json:"email"`
-	Name  string `json:"name"`
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	Role     string `json:"role,omitempty"`
+}
+
+// RegisterUserRequest запрос на самостоятельную регистрацию
+type RegisterUserRequest struct {
+	Email    string `json:"email"`
+	Name     string `json:"name"`
+	Password string `json:"password"`
 }
 
 // UpdateUserRequest запрос на обновление пользователя
 type UpdateUserRequest struct {
-	Email *string `json:"email,omitempty"`
-	Name  *string `json:"name,omitempty"`
+	Email    *string `json:"email,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Password *string `json:"password,omitempty"`
+	Role     *string `json:"role,omitempty"`
+}
+
+// UserPatchFields поля пользователя, которые можно изменить через PATCH; password нет в представлении
+// пользователя, поэтому патч добавляет его
+var UserPatchFields = []string{"email", "name", "role", "password"}
+
+// UpdateUserRequestFromPatch преобразует поля, измененные патчем, в запрос на обновление пользователя;
+// все поля обязательны, поэтому null в любом из них - ошибка
+func UpdateUserRequestFromPatch(changes map[string]*string) (UpdateUserRequest, error) {
+	for _, name := range UserPatchFields {
+		if err := patch.Required(changes, name); err != nil {
+			return UpdateUserRequest{}, err
+		}
+	}
+
+	return UpdateUserRequest{
+		Email:    changes["email"],
+		Name:     changes["name"],
+		Password: changes["password"],
+		Role:     changes["role"],
+	}, nil
 }
 
 // UserResponse ответ с данными пользователя
 type UserResponse struct {
-	ID        string `json:"id"`
-	Email     string `json:"email"`
-	Name      string `json:"name"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID             string `json:"id"`
+	Email          string `json:"email"`
+	Name           string `json:"name"`
+	Role           string `json:"role"`
+	OrganizationID string `json:"organization_id"`
+	CreatedAt      string `json:"created_at"`
+	UpdatedAt      string `json:"updated_at"`
+	Version        int64  `json:"version"`
 }
 
 // UserDTOFromEntity создает UserResponse из сущности пользователя
 func UserDTOFromEntity(user *users_domain.User) UserResponse {
 	return UserResponse{
-		ID:        user.ID.String(),
-		Email:     user.Email.Value(),
-		Name:      user.Name.Value(),
-		CreatedAt: user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+		ID:             user.ID.String(),
+		Email:          user.Email.Value(),
+		Name:           user.Name.Value(),
+		Role:           user.Role.Value(),
+		OrganizationID: user.OrganizationID.String(),
+		CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      user.UpdatedAt.Format(time.RFC3339),
+		Version:        user.Version,
 	}
 }
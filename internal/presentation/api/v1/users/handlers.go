@@ -3,9 +3,15 @@ package users
 import (
 	"crud/internal/application"
 	users_usecases "crud/internal/application/users/usecases"
+	users_domain "crud/internal/domain/users"
+	"crud/internal/presentation/api/v1/cursor"
+	"crud/internal/presentation/api/v1/etag"
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/patch"
+	"crud/internal/presentation/api/v1/problems"
 	"encoding/json"
+	"io"
 	"net/http"
-	"strconv"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/google/uuid"
@@ -29,48 +35,84 @@ func NewHandler(container *dig.Container) *Handler {
 func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.CreateUserUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	var req CreateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problems.BadRequest(w, r, "Invalid request body")
 		return
 	}
 
-	user, err := useCase.Execute(r.Context(), req.Email, req.Name)
+	user, err := useCase.Execute(r.Context(), req.Email, req.Name, req.Password, req.Role)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problems.Error(w, r, err)
 		return
 	}
 
 	response := UserDTOFromEntity(user)
 
+	etag.Set(w, user.Version)
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusCreated)
 	json.NewEncoder(w).Encode(response)
 }
 
-// GetUserByID получает пользователя по ID
+// RegisterUser регистрирует нового пользователя с ролью по умолчанию
+// POST /api/v1/auth/register
+func (h *Handler) RegisterUser(w http.ResponseWriter, r *http.Request) {
+	useCase, err := application.ResolveFromContainer[*users_usecases.RegisterUserUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	var req RegisterUserRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	user, err := useCase.Execute(r.Context(), req.Email, req.Name, req.Password)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := UserDTOFromEntity(user)
+
+	etag.Set(w, user.Version)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(response)
+}
+
+// GetUserByID получает пользователя по ID; если версия из If-None-Match актуальна, отвечает 304 без тела
 // GET /api/v1/users/{id}
 func (h *Handler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.GetUserByIDUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
 		return
 	}
 
 	user, err := useCase.Execute(r.Context(), id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problems.Error(w, r, err)
+		return
+	}
+
+	etag.Set(w, user.Version)
+	if etag.NoneMatch(r, user.Version) {
+		w.WriteHeader(http.StatusNotModified)
 		return
 	}
 
@@ -85,19 +127,19 @@ func (h *Handler) GetUserByID(w http.ResponseWriter, r *http.Request) {
 func (h *Handler) GetUserByEmail(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.GetUserByEmailUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	email := chi.URLParam(r, "email")
 	if email == "" {
-		http.Error(w, "Email parameter is required", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "email", "Email parameter is required")
 		return
 	}
 
 	user, err := useCase.Execute(r.Context(), email)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problems.Error(w, r, err)
 		return
 	}
 
@@ -107,33 +149,38 @@ func (h *Handler) GetUserByEmail(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
-// ListUsers получает список пользователей
-// GET /api/v1/users
+// ListUsers получает список пользователей по номеру страницы или, с параметром cursor, по курсору
+// GET /api/v1/users?page=&page_size=&cursor=
 func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.ListUsersUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
-	page := 1
-	pageSize := 10
-
-	if pageStr := r.URL.Query().Get("page"); pageStr != "" {
-		if p, err := strconv.Atoi(pageStr); err == nil && p > 0 {
-			page = p
-		}
+	page, pageSize, ok := pagination.Parse(w, r)
+	if !ok {
+		return
 	}
 
-	if pageSizeStr := r.URL.Query().Get("page_size"); pageSizeStr != "" {
-		if ps, err := strconv.Atoi(pageSizeStr); err == nil && ps > 0 {
-			pageSize = ps
+	// Курсор продолжает список с позиции из предыдущего ответа вместо смещения page
+	if cursorStr := r.URL.Query().Get("cursor"); cursorStr != "" {
+		if r.URL.Query().Get("page") != "" {
+			problems.InvalidParameter(w, r, "cursor", "cursor cannot be combined with page")
+			return
+		}
+		var position users_domain.UserCursor
+		if err := cursor.Decode(cursorStr, &position); err != nil || position.ID == uuid.Nil {
+			problems.InvalidParameter(w, r, "cursor", "Invalid cursor")
+			return
 		}
+		h.listUsersByCursor(w, r, &position, pageSize)
+		return
 	}
 
 	users, total, err := useCase.Execute(r.Context(), page, pageSize)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problems.Error(w, r, err)
 		return
 	}
 
@@ -142,67 +189,208 @@ func (h *Handler) ListUsers(w http.ResponseWriter, r *http.Request) {
 		response[i] = UserDTOFromEntity(user)
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]interface{}{
+	// Курсоры соседних страниц позволяют перейти от номеров страниц к выводу по курсору
+	envelope := map[string]interface{}{
 		"data":      response,
 		"total":     total,
 		"page":      page,
 		"page_size": pageSize,
-	})
+	}
+	if len(users) > 0 && int64(page*pageSize) < total {
+		envelope["next_cursor"] = cursor.Encode(users_domain.NewUserCursor(users[len(users)-1], false))
+	}
+	if len(users) > 0 && page > 1 {
+		envelope["prev_cursor"] = cursor.Encode(users_domain.NewUserCursor(users[0], true))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(envelope)
 }
 
-// UpdateUser обновляет пользователя
+// listUsersByCursor отвечает страницей списка пользователей по курсору без подсчета общего количества
+func (h *Handler) listUsersByCursor(w http.ResponseWriter, r *http.Request, position *users_domain.UserCursor, pageSize int) {
+	useCase, err := application.ResolveFromContainer[*users_usecases.ListUsersByCursorUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	page, err := useCase.Execute(r.Context(), position, pageSize)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := make([]UserResponse, len(page.Users))
+	for i, user := range page.Users {
+		response[i] = UserDTOFromEntity(user)
+	}
+
+	envelope := map[string]interface{}{
+		"data":      response,
+		"page_size": pageSize,
+	}
+	if page.Next != nil {
+		envelope["next_cursor"] = cursor.Encode(page.Next)
+	}
+	if page.Prev != nil {
+		envelope["prev_cursor"] = cursor.Encode(page.Prev)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(envelope)
+}
+
+// UpdateUser обновляет пользователя; с заголовком If-Match - только если версия пользователя не изменилась
 // PUT /api/v1/users/{id}
 func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.UpdateUserUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
 		return
 	}
 
 	var req UpdateUserRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problems.BadRequest(w, r, "Invalid request body")
+		return
+	}
+
+	version, ok := etag.IfMatchVersion(r)
+	if !ok {
+		problems.PreconditionFailed(w, r, "If-Match does not match any user version")
+		return
+	}
+
+	user, err := useCase.Execute(r.Context(), id, req.Email, req.Name, req.Password, req.Role, version)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	response := UserDTOFromEntity(user)
+
+	etag.Set(w, user.Version)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// PatchUser частично обновляет пользователя патчем JSON Merge Patch или JSON Patch к его представлению.
+// Патч применяется к версии пользователя, прочитанной в начале запроса: если пользователя изменили
+// раньше, чем изменения сохранены, возвращается 412
+// PATCH /api/v1/users/{id}
+func (h *Handler) PatchUser(w http.ResponseWriter, r *http.Request) {
+	getUseCase, err := application.ResolveFromContainer[*users_usecases.GetUserByIDUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+	updateUseCase, err := application.ResolveFromContainer[*users_usecases.UpdateUserUseCase](h.container)
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+
+	idStr := chi.URLParam(r, "id")
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		problems.BadRequest(w, r, "Invalid request body")
 		return
 	}
 
-	user, err := useCase.Execute(r.Context(), id, req.Email, req.Name)
+	version, ok := etag.IfMatchVersion(r)
+	if !ok {
+		problems.PreconditionFailed(w, r, "If-Match does not match any user version")
+		return
+	}
+
+	user, err := getUseCase.Execute(r.Context(), id)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problems.Error(w, r, err)
+		return
+	}
+	if version == nil {
+		version = &user.Version
+	}
+
+	document, err := json.Marshal(UserDTOFromEntity(user))
+	if err != nil {
+		problems.Internal(w, r, err)
+		return
+	}
+	patched, err := patch.Apply(r.Header.Get("Content-Type"), document, body)
+	if err != nil {
+		if patch.IsUnsupportedMediaType(err) {
+			w.Header().Set("Accept-Patch", patch.AcceptPatch)
+		}
+		problems.Error(w, r, err)
+		return
+	}
+	changes, err := patch.Changes(document, patched, UserPatchFields...)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+	req, err := UpdateUserRequestFromPatch(changes)
+	if err != nil {
+		problems.Error(w, r, err)
+		return
+	}
+
+	user, err = updateUseCase.Execute(r.Context(), id, req.Email, req.Name, req.Password, req.Role, version)
+	if err != nil {
+		problems.Error(w, r, err)
 		return
 	}
 
 	response := UserDTOFromEntity(user)
 
+	etag.Set(w, user.Version)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(response)
 }
 
-// DeleteUser удаляет пользователя
-// DELETE /api/v1/users/{id}
+// DeleteUser удаляет пользователя; при политике reassign задачи передаются пользователю reassign_to
+// DELETE /api/v1/users/{id}?reassign_to={user_id}
 func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 	useCase, err := application.ResolveFromContainer[*users_usecases.DeleteUserUseCase](h.container)
 	if err != nil {
-		http.Error(w, "Failed to resolve use case", http.StatusInternalServerError)
+		problems.Internal(w, r, err)
 		return
 	}
 
 	idStr := chi.URLParam(r, "id")
 	id, err := uuid.Parse(idStr)
 	if err != nil {
-		http.Error(w, "Invalid user ID", http.StatusBadRequest)
+		problems.InvalidParameter(w, r, "id", "Invalid user ID")
 		return
 	}
 
-	if err := useCase.Execute(r.Context(), id); err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+	var reassignTo *uuid.UUID
+	if reassignToStr := r.URL.Query().Get("reassign_to"); reassignToStr != "" {
+		parsed, err := uuid.Parse(reassignToStr)
+		if err != nil {
+			problems.InvalidParameter(w, r, "reassign_to", "Invalid reassign_to user ID")
+			return
+		}
+		reassignTo = &parsed
+	}
+
+	if err := useCase.Execute(r.Context(), id, reassignTo); err != nil {
+		problems.Error(w, r, err)
 		return
 	}
 
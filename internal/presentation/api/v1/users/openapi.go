@@ -0,0 +1,66 @@
+package users
+
+import (
+	"net/http"
+
+	"crud/internal/presentation/api/v1/openapi"
+)
+
+// Operations описывает маршруты пользователей для документа OpenAPI
+func Operations() []openapi.Operation {
+	id := openapi.PathID("id", "ID пользователя")
+	ifMatch := openapi.Header("If-Match", "ETag версии пользователя, которую изменяет запрос")
+
+	return []openapi.Operation{
+		{
+			Method: http.MethodPost, Path: "/users", ID: "createUser", Tag: "users",
+			Summary:   "Создать пользователя",
+			Request:   openapi.JSON(CreateUserRequest{}),
+			Responses: []openapi.Response{openapi.Created(openapi.Of(UserResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/users", ID: "listUsers", Tag: "users",
+			Summary:    "Список пользователей организации",
+			Parameters: openapi.CursorPagination(),
+			Responses:  []openapi.Response{openapi.OK(openapi.CursorPageOf(UserResponse{}))},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/{id}", ID: "getUser", Tag: "users",
+			Summary: "Получить пользователя",
+			Parameters: []openapi.Parameter{
+				id,
+				openapi.Header("If-None-Match", "ETag версии пользователя, которая уже есть у клиента"),
+			},
+			Responses: []openapi.Response{openapi.OK(openapi.Of(UserResponse{})), openapi.NotModified()},
+		},
+		{
+			Method: http.MethodGet, Path: "/users/email/{email}", ID: "getUserByEmail", Tag: "users",
+			Summary:    "Получить пользователя по email",
+			Parameters: []openapi.Parameter{openapi.PathString("email", "email пользователя")},
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(UserResponse{}))},
+		},
+		{
+			Method: http.MethodPut, Path: "/users/{id}", ID: "updateUser", Tag: "users",
+			Summary:    "Обновить пользователя",
+			Parameters: []openapi.Parameter{id, ifMatch},
+			Request:    openapi.JSON(UpdateUserRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(UserResponse{}))},
+		},
+		{
+			Method: http.MethodPatch, Path: "/users/{id}", ID: "patchUser", Tag: "users",
+			Summary:    "Частично обновить пользователя",
+			Parameters: []openapi.Parameter{id, ifMatch},
+			Request:    openapi.Patch(UpdateUserRequest{}),
+			Responses:  []openapi.Response{openapi.OK(openapi.Of(UserResponse{}))},
+		},
+		{
+			Method: http.MethodDelete, Path: "/users/{id}", ID: "deleteUser", Tag: "users",
+			Summary: "Удалить пользователя в корзину",
+			Parameters: []openapi.Parameter{
+				id,
+				openapi.Query("reassign_to", "пользователь, которому передаются задачи удаляемого", openapi.UUID()),
+			},
+			Responses: []openapi.Response{openapi.NoContent()},
+		},
+	}
+}
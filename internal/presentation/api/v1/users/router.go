@@ -1,6 +1,9 @@
 package users
 
 import (
+	"crud/internal/application/policies"
+	"crud/internal/presentation/api/v1/middleware"
+
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/dig"
 )
@@ -12,12 +15,15 @@ func SetupRoutes(r chi.Router, container *dig.Container) error {
 
 	// Настраиваем маршруты
 	r.Route("/users", func(r chi.Router) {
-		r.Post("/", handler.CreateUser)
-		r.Get("/", handler.ListUsers)
-		r.Get("/{id}", handler.GetUserByID)
-		r.Get("/email/{email}", handler.GetUserByEmail)
-		r.Put("/{id}", handler.UpdateUser)
-		r.Delete("/{id}", handler.DeleteUser)
+		r.Use(middleware.Authenticate(container))
+
+		r.With(middleware.RequirePermission(policies.PermissionUsersCreate)).Post("/", handler.CreateUser)
+		r.With(middleware.RequirePermission(policies.PermissionUsersRead)).Get("/", handler.ListUsers)
+		r.With(middleware.RequirePermission(policies.PermissionUsersRead)).Get("/{id}", handler.GetUserByID)
+		r.With(middleware.RequirePermission(policies.PermissionUsersRead)).Get("/email/{email}", handler.GetUserByEmail)
+		r.With(middleware.RequirePermission(policies.PermissionUsersUpdate)).Put("/{id}", handler.UpdateUser)
+		r.With(middleware.RequirePermission(policies.PermissionUsersUpdate)).Patch("/{id}", handler.PatchUser)
+		r.With(middleware.RequirePermission(policies.PermissionUsersDelete)).Delete("/{id}", handler.DeleteUser)
 	})
 
 	return nil
@@ -0,0 +1,202 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"strings"
+	"testing"
+
+	"crud/config"
+	attachments "crud/internal/application/attachments/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	attachments_domain "crud/internal/domain/attachments"
+	attachments_vo "crud/internal/domain/attachments/value_objects"
+	auth_domain "crud/internal/domain/auth"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/internal/infrastructure/database/repositories/dummy"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testAttachmentMaxSize = 64
+
+func TestAttachmentUseCases(t *testing.T) {
+	// Создаем новый контейнер для теста с маленьким лимитом размера
+	container := tests.NewTestContainer()
+	require.NoError(t, container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.AttachmentMaxSize = testAttachmentMaxSize
+		cfg.AttachmentAllowedTypes = []string{"text/plain", "image/*"}
+		return cfg
+	}))
+
+	uploadUseCase, err := tests.ResolveFromContainer[*attachments.UploadAttachmentUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*attachments.ListAttachmentsUseCase](container)
+	require.NoError(t, err)
+
+	getUseCase, err := tests.ResolveFromContainer[*attachments.GetAttachmentUseCase](container)
+	require.NoError(t, err)
+
+	downloadUseCase, err := tests.ResolveFromContainer[*attachments.DownloadAttachmentUseCase](container)
+	require.NoError(t, err)
+
+	deleteUseCase, err := tests.ResolveFromContainer[*attachments.DeleteAttachmentUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	blobStorage, err := tests.ResolveFromContainer[attachments_domain.BaseBlobStorage](container)
+	require.NoError(t, err)
+	blobs := blobStorage.(*dummy.BlobStorage)
+
+	ownerID := tests.CreateTestUser(t, container, "attachment-owner@example.com").ID
+	ownerCtx := tests.ContextWithUser(context.Background(), ownerID)
+	otherID := tests.CreateTestUser(t, container, "attachment-other@example.com").ID
+	otherCtx := tests.ContextWithUser(context.Background(), otherID)
+	viewerCtx := tests.ContextWithRole(context.Background(), uuid.New(), users_vo.RoleViewer)
+
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Task with files", Status: "todo"})
+	require.NoError(t, err)
+	otherTask, err := createTaskUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Other task", Status: "todo"})
+	require.NoError(t, err)
+
+	content := "hello, attachments"
+	checksum := sha256.Sum256([]byte(content))
+
+	attachment, err := uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+		TaskID:      task.ID,
+		FileName:    `C:\docs\notes.txt`,
+		ContentType: "text/plain; charset=utf-8",
+		Content:     strings.NewReader(content),
+	})
+	require.NoError(t, err)
+
+	t.Run("upload stores content with size and checksum", func(t *testing.T) {
+		assert.Equal(t, "notes.txt", attachment.FileName.Value())
+		assert.Equal(t, "text/plain; charset=utf-8", attachment.ContentType)
+		assert.Equal(t, int64(len(content)), attachment.Size)
+		assert.Equal(t, hex.EncodeToString(checksum[:]), attachment.Checksum)
+		assert.Equal(t, ownerID, attachment.UploaderID)
+		assert.Equal(t, 1, blobs.Len())
+
+		list, err := listUseCase.Execute(ownerCtx, task.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.True(t, attachment.Equals(list[0]))
+	})
+
+	t.Run("download", func(t *testing.T) {
+		found, reader, err := downloadUseCase.Execute(ownerCtx, task.ID, attachment.ID)
+		require.NoError(t, err)
+		defer reader.Close()
+
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(data))
+		assert.True(t, attachment.Equals(found))
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		_, err := uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "big.txt",
+			ContentType: "text/plain",
+			Content:     bytes.NewReader(bytes.Repeat([]byte("a"), testAttachmentMaxSize+1)),
+		})
+		assert.True(t, attachments_domain.IsAttachmentTooLarge(err))
+
+		// Ровно максимальный размер допустим
+		exact, err := uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "exact.txt",
+			ContentType: "text/plain",
+			Content:     bytes.NewReader(bytes.Repeat([]byte("a"), testAttachmentMaxSize)),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, int64(testAttachmentMaxSize), exact.Size)
+		require.NoError(t, deleteUseCase.Execute(ownerCtx, task.ID, exact.ID))
+
+		_, err = uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "page.html",
+			ContentType: "text/html",
+			Content:     strings.NewReader("<html></html>"),
+		})
+		assert.True(t, attachments_domain.IsUnsupportedContentType(err))
+
+		// Заявленный тип не совпадает с содержимым
+		_, err = uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "image.png",
+			ContentType: "image/png",
+			Content:     strings.NewReader("<html><script>alert(1)</script></html>"),
+		})
+		assert.True(t, attachments_domain.IsContentTypeMismatch(err))
+
+		_, err = uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "empty.txt",
+			ContentType: "text/plain",
+			Content:     strings.NewReader(""),
+		})
+		assert.True(t, attachments_domain.IsInvalidAttachmentData(err))
+
+		_, err = uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "..",
+			ContentType: "text/plain",
+			Content:     strings.NewReader(content),
+		})
+		assert.True(t, attachments_vo.IsInvalidFileName(err))
+
+		// Отклоненные файлы не остаются в хранилище
+		assert.Equal(t, 1, blobs.Len())
+	})
+
+	t.Run("access follows the task", func(t *testing.T) {
+		_, err := uploadUseCase.Execute(otherCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "intrusion.txt",
+			ContentType: "text/plain",
+			Content:     strings.NewReader(content),
+		})
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, _, err = downloadUseCase.Execute(otherCtx, task.ID, attachment.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		// Наблюдатель читает вложения, но не загружает их
+		_, err = listUseCase.Execute(viewerCtx, task.ID)
+		assert.NoError(t, err)
+		_, err = uploadUseCase.Execute(viewerCtx, attachments.UploadAttachmentInput{
+			TaskID:      task.ID,
+			FileName:    "viewer.txt",
+			ContentType: "text/plain",
+			Content:     strings.NewReader(content),
+		})
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		// Вложение другой задачи не найдено
+		_, err = getUseCase.Execute(otherCtx, otherTask.ID, attachment.ID)
+		assert.True(t, attachments_domain.IsAttachmentNotFound(err))
+	})
+
+	t.Run("delete removes description and content", func(t *testing.T) {
+		err := deleteUseCase.Execute(viewerCtx, task.ID, attachment.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		require.NoError(t, deleteUseCase.Execute(ownerCtx, task.ID, attachment.ID))
+		assert.Equal(t, 0, blobs.Len())
+
+		_, err = getUseCase.Execute(ownerCtx, task.ID, attachment.ID)
+		assert.True(t, attachments_domain.IsAttachmentNotFound(err))
+	})
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	audit "crud/internal/application/audit/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	users "crud/internal/application/users/usecases"
+	audit_domain "crud/internal/domain/audit"
+	auth_domain "crud/internal/domain/auth"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTrail(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	historyUseCase, err := tests.ResolveFromContainer[*audit.ListTaskHistoryUseCase](container)
+	require.NoError(t, err)
+
+	logUseCase, err := tests.ResolveFromContainer[*audit.ListAuditLogUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	updateTaskUseCase, err := tests.ResolveFromContainer[*tasks.UpdateTaskUseCase](container)
+	require.NoError(t, err)
+
+	transitionTaskUseCase, err := tests.ResolveFromContainer[*tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	deleteTaskUseCase, err := tests.ResolveFromContainer[*tasks.DeleteTaskUseCase](container)
+	require.NoError(t, err)
+
+	updateUserUseCase, err := tests.ResolveFromContainer[*users.UpdateUserUseCase](container)
+	require.NoError(t, err)
+
+	ownerID := tests.CreateTestUser(t, container, "audit-owner@example.com").ID
+	ownerCtx := tests.ContextWithUser(context.Background(), ownerID)
+	otherCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "audit-other@example.com").ID)
+	adminID := uuid.New()
+	adminCtx := tests.ContextWithRole(context.Background(), adminID, users_vo.RoleAdmin)
+
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Audited", Status: "todo"})
+	require.NoError(t, err)
+
+	title := "Audited task"
+	_, err = updateTaskUseCase.Execute(ownerCtx, task.ID, tasks.UpdateTaskInput{Title: &title})
+	require.NoError(t, err)
+
+	// Изменение без отличий не попадает в журнал
+	_, err = updateTaskUseCase.Execute(ownerCtx, task.ID, tasks.UpdateTaskInput{Title: &title})
+	require.NoError(t, err)
+
+	_, err = transitionTaskUseCase.Execute(adminCtx, task.ID, "done")
+	require.NoError(t, err)
+
+	t.Run("task history records field-level diffs", func(t *testing.T) {
+		history, total, err := historyUseCase.Execute(ownerCtx, task.ID, 1, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(3), total)
+
+		// От новых записей к старым
+		transition, update, create := history[0], history[1], history[2]
+
+		assert.Equal(t, audit_domain.ActionCreate, create.Action)
+		assert.Equal(t, ownerID, *create.ActorID)
+		assert.Contains(t, create.Changes, audit_domain.FieldChange{Field: "title", Old: nil, New: "Audited"})
+
+		assert.Equal(t, audit_domain.ActionUpdate, update.Action)
+		assert.Equal(t, []audit_domain.FieldChange{{Field: "title", Old: "Audited", New: "Audited task"}}, update.Changes)
+
+		assert.Equal(t, adminID, *transition.ActorID)
+		assert.Equal(t, []audit_domain.FieldChange{{Field: "status", Old: "todo", New: "done"}}, transition.Changes)
+	})
+
+	t.Run("task history follows task access", func(t *testing.T) {
+		_, _, err := historyUseCase.Execute(otherCtx, task.ID, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("deleted task stays in the audit log", func(t *testing.T) {
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, task.ID))
+
+		entityType := audit_domain.EntityTask
+		entries, total, err := logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{EntityType: &entityType, EntityID: &task.ID}, 1, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), total)
+		assert.Equal(t, audit_domain.ActionDelete, entries[0].Action)
+		assert.Contains(t, entries[0].Changes, audit_domain.FieldChange{Field: "title", Old: "Audited task", New: nil})
+	})
+
+	t.Run("user changes redact the password", func(t *testing.T) {
+		mark := time.Now()
+
+		name, password := "Renamed Owner", "new-password-123"
+		_, err := updateUserUseCase.Execute(ownerCtx, ownerID, nil, &name, &password, nil, nil)
+		require.NoError(t, err)
+
+		entries, total, err := logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{From: &mark}, 1, 10)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), total)
+		assert.Equal(t, audit_domain.EntityUser, entries[0].EntityType)
+		assert.Equal(t, ownerID, entries[0].EntityID)
+		assert.Equal(t, []audit_domain.FieldChange{
+			{Field: "name", Old: "Test User", New: "Renamed Owner"},
+			{Field: "password", Redacted: true},
+		}, entries[0].Changes)
+	})
+
+	t.Run("audit log filters", func(t *testing.T) {
+		// Регистрация выполняется без аутентифицированного пользователя
+		entityType := audit_domain.EntityUser
+		registrations, _, err := logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{EntityType: &entityType, EntityID: &ownerID}, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, registrations, 2)
+		assert.Nil(t, registrations[1].ActorID)
+
+		entries, total, err := logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{ActorID: &adminID}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, task.ID, entries[0].EntityID)
+
+		future := time.Now().Add(time.Hour)
+		_, total, err = logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{From: &future}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+
+		unknown := audit_domain.EntityType("label")
+		_, _, err = logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{EntityType: &unknown}, 1, 10)
+		assert.True(t, audit_domain.IsInvalidAuditFilter(err))
+	})
+
+	t.Run("audit log requires admin", func(t *testing.T) {
+		_, _, err := logUseCase.Execute(ownerCtx, audit_domain.ListEntriesFilter{}, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+}
@@ -0,0 +1,43 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auth "crud/internal/application/auth/usecases"
+	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCurrentUserUseCase_Execute(t *testing.T) {
+	ctx := tests.DefaultOrganizationContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	registerUseCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	getCurrentUserUseCase, err := tests.ResolveFromContainer[*auth.GetCurrentUserUseCase](container)
+	require.NoError(t, err)
+
+	user, err := registerUseCase.Execute(ctx, "me@example.com", "Current User", "password123")
+	require.NoError(t, err)
+
+	t.Run("user from context", func(t *testing.T) {
+		userCtx := auth_domain.ContextWithPrincipal(ctx, auth_domain.Principal{UserID: user.ID, Role: user.Role.Value()})
+
+		currentUser, err := getCurrentUserUseCase.Execute(userCtx)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, currentUser.ID)
+	})
+
+	t.Run("no principal in context", func(t *testing.T) {
+		_, err := getCurrentUserUseCase.Execute(ctx)
+		assert.True(t, auth_domain.IsUnauthenticated(err))
+	})
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auth "crud/internal/application/auth/usecases"
+	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginUseCase_Execute(t *testing.T) {
+	ctx := tests.DefaultOrganizationContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	registerUseCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	loginUseCase, err := tests.ResolveFromContainer[*auth.LoginUseCase](container)
+	require.NoError(t, err)
+
+	tokens, err := tests.ResolveFromContainer[auth_domain.BaseTokenManager](container)
+	require.NoError(t, err)
+
+	user, err := registerUseCase.Execute(ctx, "login@example.com", "Login User", "password123")
+	require.NoError(t, err)
+
+	t.Run("successful login", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "login@example.com", "password123")
+		require.NoError(t, err)
+		assert.NotEmpty(t, pair.AccessToken)
+		assert.NotEmpty(t, pair.RefreshToken)
+		assert.True(t, pair.AccessTokenExpiresAt.Before(pair.RefreshTokenExpiresAt))
+
+		principal, err := tokens.ParseAccessToken(pair.AccessToken)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, principal.UserID)
+		assert.Equal(t, "member", principal.Role)
+	})
+
+	t.Run("email is case insensitive", func(t *testing.T) {
+		_, err := loginUseCase.Execute(ctx, "  LOGIN@example.com ", "password123")
+		require.NoError(t, err)
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "login@example.com", "wrong-password")
+		assert.Nil(t, pair)
+		assert.True(t, auth_domain.IsInvalidCredentials(err))
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "unknown@example.com", "password123")
+		assert.Nil(t, pair)
+		assert.True(t, auth_domain.IsInvalidCredentials(err))
+	})
+}
@@ -0,0 +1,65 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auth "crud/internal/application/auth/usecases"
+	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogoutUseCase_Execute(t *testing.T) {
+	ctx := tests.DefaultOrganizationContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	registerUseCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	loginUseCase, err := tests.ResolveFromContainer[*auth.LoginUseCase](container)
+	require.NoError(t, err)
+
+	refreshUseCase, err := tests.ResolveFromContainer[*auth.RefreshTokensUseCase](container)
+	require.NoError(t, err)
+
+	logoutUseCase, err := tests.ResolveFromContainer[*auth.LogoutUseCase](container)
+	require.NoError(t, err)
+
+	_, err = registerUseCase.Execute(ctx, "logout@example.com", "Logout User", "password123")
+	require.NoError(t, err)
+
+	t.Run("logout revokes refresh token", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "logout@example.com", "password123")
+		require.NoError(t, err)
+
+		err = logoutUseCase.Execute(ctx, pair.RefreshToken)
+		require.NoError(t, err)
+
+		_, err = refreshUseCase.Execute(ctx, pair.RefreshToken)
+		assert.True(t, auth_domain.IsInvalidToken(err))
+	})
+
+	t.Run("logout keeps other sessions", func(t *testing.T) {
+		first, err := loginUseCase.Execute(ctx, "logout@example.com", "password123")
+		require.NoError(t, err)
+		second, err := loginUseCase.Execute(ctx, "logout@example.com", "password123")
+		require.NoError(t, err)
+
+		err = logoutUseCase.Execute(ctx, first.RefreshToken)
+		require.NoError(t, err)
+
+		_, err = refreshUseCase.Execute(ctx, second.RefreshToken)
+		assert.NoError(t, err)
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		err := logoutUseCase.Execute(ctx, "unknown-token")
+		assert.True(t, auth_domain.IsInvalidToken(err))
+	})
+}
@@ -0,0 +1,64 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	auth "crud/internal/application/auth/usecases"
+	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokensUseCase_Execute(t *testing.T) {
+	ctx := tests.DefaultOrganizationContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	registerUseCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	loginUseCase, err := tests.ResolveFromContainer[*auth.LoginUseCase](container)
+	require.NoError(t, err)
+
+	refreshUseCase, err := tests.ResolveFromContainer[*auth.RefreshTokensUseCase](container)
+	require.NoError(t, err)
+
+	_, err = registerUseCase.Execute(ctx, "refresh@example.com", "Refresh User", "password123")
+	require.NoError(t, err)
+
+	t.Run("rotation issues new tokens", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "refresh@example.com", "password123")
+		require.NoError(t, err)
+
+		rotated, err := refreshUseCase.Execute(ctx, pair.RefreshToken)
+		require.NoError(t, err)
+		assert.NotEmpty(t, rotated.AccessToken)
+		assert.NotEqual(t, pair.RefreshToken, rotated.RefreshToken)
+	})
+
+	t.Run("reuse of rotated token revokes the session", func(t *testing.T) {
+		pair, err := loginUseCase.Execute(ctx, "refresh@example.com", "password123")
+		require.NoError(t, err)
+
+		rotated, err := refreshUseCase.Execute(ctx, pair.RefreshToken)
+		require.NoError(t, err)
+
+		// Повторное использование старого токена
+		_, err = refreshUseCase.Execute(ctx, pair.RefreshToken)
+		assert.True(t, auth_domain.IsInvalidToken(err))
+
+		// Вся цепочка отозвана, включая последний выданный токен
+		_, err = refreshUseCase.Execute(ctx, rotated.RefreshToken)
+		assert.True(t, auth_domain.IsInvalidToken(err))
+	})
+
+	t.Run("unknown token", func(t *testing.T) {
+		_, err := refreshUseCase.Execute(ctx, "unknown-token")
+		assert.True(t, auth_domain.IsInvalidToken(err))
+	})
+}
@@ -0,0 +1,226 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	comments "crud/internal/application/comments/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	trash "crud/internal/application/trash/usecases"
+	auth_domain "crud/internal/domain/auth"
+	comments_domain "crud/internal/domain/comments"
+	vo "crud/internal/domain/comments/value_objects"
+	tasks_domain "crud/internal/domain/tasks"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentUseCases(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*comments.CreateCommentUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*comments.ListCommentsUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*comments.UpdateCommentUseCase](container)
+	require.NoError(t, err)
+
+	revisionsUseCase, err := tests.ResolveFromContainer[*comments.ListCommentRevisionsUseCase](container)
+	require.NoError(t, err)
+
+	deleteUseCase, err := tests.ResolveFromContainer[*comments.DeleteCommentUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	ownerID := tests.CreateTestUser(t, container, "comment-owner@example.com").ID
+	ownerCtx := tests.ContextWithUser(context.Background(), ownerID)
+	otherID := tests.CreateTestUser(t, container, "comment-other@example.com").ID
+	otherCtx := tests.ContextWithUser(context.Background(), otherID)
+	adminID := tests.CreateTestUser(t, container, "comment-admin@example.com").ID
+	adminCtx := tests.ContextWithRole(context.Background(), adminID, users_vo.RoleAdmin)
+	viewerCtx := tests.ContextWithRole(context.Background(), uuid.New(), users_vo.RoleViewer)
+
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Discussed task", Status: "todo"})
+	require.NoError(t, err)
+	otherTask, err := createTaskUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Other task", Status: "todo"})
+	require.NoError(t, err)
+
+	root, err := createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, Body: " What about tests? "})
+	require.NoError(t, err)
+
+	t.Run("create comment and reply", func(t *testing.T) {
+		assert.Equal(t, "What about tests?", root.Body.Value())
+		assert.Equal(t, ownerID, root.AuthorID)
+		assert.Equal(t, task.ID, root.TaskID)
+
+		reply, err := createUseCase.Execute(adminCtx, comments.CreateCommentInput{TaskID: task.ID, ParentID: &root.ID, Body: "Added"})
+		require.NoError(t, err)
+		assert.Equal(t, root.ID, *reply.ParentID)
+		assert.Equal(t, adminID, reply.AuthorID)
+
+		list, total, err := listUseCase.Execute(ownerCtx, task.ID, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.True(t, root.Equals(list[0]))
+
+		replies, total, err := listUseCase.Execute(ownerCtx, task.ID, &root.ID, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.True(t, reply.Equals(replies[0]))
+	})
+
+	t.Run("validation", func(t *testing.T) {
+		_, err := createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, Body: "  "})
+		assert.True(t, vo.IsInvalidCommentBody(err))
+
+		missing := uuid.New()
+		_, err = createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, ParentID: &missing, Body: "Reply"})
+		assert.True(t, comments_domain.IsParentCommentNotFound(err))
+
+		_, err = createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: uuid.New(), Body: "Lost"})
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+	})
+
+	t.Run("reply must belong to the same task", func(t *testing.T) {
+		_, err := createUseCase.Execute(otherCtx, comments.CreateCommentInput{TaskID: otherTask.ID, ParentID: &root.ID, Body: "Cross"})
+		assert.True(t, comments_domain.IsParentCommentNotFound(err))
+
+		_, _, err = listUseCase.Execute(otherCtx, otherTask.ID, &root.ID, 1, 10)
+		assert.True(t, comments_domain.IsCommentNotFound(err))
+	})
+
+	t.Run("access follows the task", func(t *testing.T) {
+		_, err := createUseCase.Execute(otherCtx, comments.CreateCommentInput{TaskID: task.ID, Body: "Intrusion"})
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, _, err = listUseCase.Execute(otherCtx, task.ID, nil, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		// Наблюдатель читает комментарии, но не пишет их
+		_, _, err = listUseCase.Execute(viewerCtx, task.ID, nil, 1, 10)
+		assert.NoError(t, err)
+		_, err = createUseCase.Execute(viewerCtx, comments.CreateCommentInput{TaskID: task.ID, Body: "Hi"})
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("author-only editing keeps history", func(t *testing.T) {
+		_, err := updateUseCase.Execute(adminCtx, task.ID, root.ID, "Hijacked")
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		updated, err := updateUseCase.Execute(ownerCtx, task.ID, root.ID, "What about integration tests?")
+		require.NoError(t, err)
+		assert.Equal(t, "What about integration tests?", updated.Body.Value())
+		assert.NotNil(t, updated.EditedAt)
+
+		// Тот же текст не добавляет версию
+		_, err = updateUseCase.Execute(ownerCtx, task.ID, root.ID, "What about integration tests?")
+		require.NoError(t, err)
+
+		revisions, err := revisionsUseCase.Execute(ownerCtx, task.ID, root.ID)
+		require.NoError(t, err)
+		require.Len(t, revisions, 1)
+		assert.Equal(t, "What about tests?", revisions[0].Body)
+
+		_, err = updateUseCase.Execute(otherCtx, otherTask.ID, root.ID, "Wrong task")
+		assert.True(t, comments_domain.IsCommentNotFound(err))
+	})
+
+	t.Run("author-only deletion removes replies", func(t *testing.T) {
+		err := deleteUseCase.Execute(adminCtx, task.ID, root.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		require.NoError(t, deleteUseCase.Execute(ownerCtx, task.ID, root.ID))
+
+		_, total, err := listUseCase.Execute(ownerCtx, task.ID, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+
+		_, err = revisionsUseCase.Execute(ownerCtx, task.ID, root.ID)
+		assert.True(t, comments_domain.IsCommentNotFound(err))
+	})
+}
+
+func TestCommentsPagination(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*comments.CreateCommentUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*comments.ListCommentsUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	ownerCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "paged@example.com").ID)
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Busy task", Status: "todo"})
+	require.NoError(t, err)
+
+	bodies := []string{"one", "two", "three"}
+	for _, body := range bodies {
+		_, err := createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, Body: body})
+		require.NoError(t, err)
+	}
+
+	page, total, err := listUseCase.Execute(ownerCtx, task.ID, nil, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), total)
+	require.Len(t, page, 1)
+	assert.Equal(t, "three", page[0].Body.Value())
+}
+
+func TestPurgeTaskRemovesComments(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*comments.CreateCommentUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	deleteTaskUseCase, err := tests.ResolveFromContainer[*tasks.DeleteTaskUseCase](container)
+	require.NoError(t, err)
+
+	purgeTaskUseCase, err := tests.ResolveFromContainer[*trash.PurgeTaskUseCase](container)
+	require.NoError(t, err)
+
+	repo, err := tests.ResolveFromContainer[comments_domain.BaseCommentsRepository](container)
+	require.NoError(t, err)
+
+	ownerCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "cleanup@example.com").ID)
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Temporary", Status: "todo"})
+	require.NoError(t, err)
+
+	comment, err := createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, Body: "Soon gone"})
+	require.NoError(t, err)
+	_, err = createUseCase.Execute(ownerCtx, comments.CreateCommentInput{TaskID: task.ID, ParentID: &comment.ID, Body: "Reply"})
+	require.NoError(t, err)
+
+	require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, task.ID))
+
+	// Задача в корзине сохраняет комментарии, чтобы их можно было восстановить вместе с ней
+	_, total, err := repo.List(ownerCtx, comments_domain.ListCommentsFilter{TaskID: task.ID}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+
+	_, err = purgeTaskUseCase.Execute(tests.AdminContext(context.Background()), task.ID)
+	require.NoError(t, err)
+
+	_, total, err = repo.List(ownerCtx, comments_domain.ListCommentsFilter{TaskID: task.ID}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), total)
+
+	_, err = repo.GetByID(ownerCtx, comment.ID)
+	assert.True(t, comments_domain.IsCommentNotFound(err))
+}
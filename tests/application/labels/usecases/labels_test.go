@@ -0,0 +1,108 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	labels "crud/internal/application/labels/usecases"
+	auth_domain "crud/internal/domain/auth"
+	labels_domain "crud/internal/domain/labels"
+	vo "crud/internal/domain/labels/value_objects"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelUseCases(t *testing.T) {
+	adminCtx := tests.AdminContext(context.Background())
+	memberCtx := tests.ContextWithUser(context.Background(), uuid.New())
+	viewerCtx := tests.ContextWithRole(context.Background(), uuid.New(), "viewer")
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*labels.CreateLabelUseCase](container)
+	require.NoError(t, err)
+
+	getUseCase, err := tests.ResolveFromContainer[*labels.GetLabelByIDUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*labels.ListLabelsUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*labels.UpdateLabelUseCase](container)
+	require.NoError(t, err)
+
+	deleteUseCase, err := tests.ResolveFromContainer[*labels.DeleteLabelUseCase](container)
+	require.NoError(t, err)
+
+	t.Run("create and get", func(t *testing.T) {
+		label, err := createUseCase.Execute(memberCtx, " Bug ")
+		require.NoError(t, err)
+		assert.Equal(t, "bug", label.Name.Value())
+		assert.NotEqual(t, uuid.Nil, label.ID)
+
+		found, err := getUseCase.Execute(viewerCtx, label.ID)
+		require.NoError(t, err)
+		assert.True(t, label.Equals(found))
+	})
+
+	t.Run("duplicate name", func(t *testing.T) {
+		_, err := createUseCase.Execute(memberCtx, "BUG")
+		assert.True(t, labels_domain.IsLabelAlreadyExists(err))
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := createUseCase.Execute(memberCtx, "")
+		assert.True(t, vo.IsInvalidLabelName(err))
+	})
+
+	t.Run("viewer cannot create", func(t *testing.T) {
+		_, err := createUseCase.Execute(viewerCtx, "frontend")
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("list sorted by name", func(t *testing.T) {
+		_, err := createUseCase.Execute(memberCtx, "frontend")
+		require.NoError(t, err)
+		_, err = createUseCase.Execute(memberCtx, "customer-x")
+		require.NoError(t, err)
+
+		list, total, err := listUseCase.Execute(viewerCtx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		require.Len(t, list, 3)
+		assert.Equal(t, "bug", list[0].Name.Value())
+		assert.Equal(t, "customer-x", list[1].Name.Value())
+		assert.Equal(t, "frontend", list[2].Name.Value())
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		label, err := createUseCase.Execute(memberCtx, "backend")
+		require.NoError(t, err)
+
+		_, err = updateUseCase.Execute(memberCtx, label.ID, "api")
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, err = updateUseCase.Execute(adminCtx, label.ID, "frontend")
+		assert.True(t, labels_domain.IsLabelAlreadyExists(err))
+
+		renamed, err := updateUseCase.Execute(adminCtx, label.ID, "API")
+		require.NoError(t, err)
+		assert.Equal(t, "api", renamed.Name.Value())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		label, err := createUseCase.Execute(memberCtx, "obsolete")
+		require.NoError(t, err)
+
+		assert.True(t, auth_domain.IsForbidden(deleteUseCase.Execute(memberCtx, label.ID)))
+		require.NoError(t, deleteUseCase.Execute(adminCtx, label.ID))
+
+		_, err = getUseCase.Execute(adminCtx, label.ID)
+		assert.True(t, labels_domain.IsLabelNotFound(err))
+		assert.True(t, labels_domain.IsLabelNotFound(deleteUseCase.Execute(adminCtx, label.ID)))
+	})
+}
@@ -0,0 +1,195 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	labels "crud/internal/application/labels/usecases"
+	organizations "crud/internal/application/organizations/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
+	organizations_domain "crud/internal/domain/organizations"
+	org_vo "crud/internal/domain/organizations/value_objects"
+	tasks_domain "crud/internal/domain/tasks"
+	users_domain "crud/internal/domain/users"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationUseCases(t *testing.T) {
+	adminCtx := tests.AdminContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*organizations.CreateOrganizationUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*organizations.ListOrganizationsUseCase](container)
+	require.NoError(t, err)
+
+	currentUseCase, err := tests.ResolveFromContainer[*organizations.GetCurrentOrganizationUseCase](container)
+	require.NoError(t, err)
+
+	getUserUseCase, err := tests.ResolveFromContainer[*users.GetUserByIDUseCase](container)
+	require.NoError(t, err)
+
+	organization, admin, err := createUseCase.Execute(adminCtx, organizations.CreateOrganizationInput{
+		Name:          " Acme ",
+		AdminEmail:    "acme-admin@example.com",
+		AdminName:     "Acme Admin",
+		AdminPassword: "password123",
+	})
+	require.NoError(t, err)
+	orgAdminCtx := tests.ContextInOrganization(context.Background(), organization.ID, admin.ID, users_vo.RoleAdmin)
+
+	t.Run("create with admin", func(t *testing.T) {
+		assert.Equal(t, "Acme", organization.Name.Value())
+		assert.False(t, organization.IsDefault())
+		assert.Equal(t, organization.ID, admin.OrganizationID)
+		assert.Equal(t, users_vo.RoleAdmin, admin.Role.Value())
+
+		found, err := getUserUseCase.Execute(orgAdminCtx, admin.ID)
+		require.NoError(t, err)
+		assert.Equal(t, admin.ID, found.ID)
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, _, err := createUseCase.Execute(adminCtx, organizations.CreateOrganizationInput{
+			Name:          " ",
+			AdminEmail:    "blank-admin@example.com",
+			AdminName:     "Blank Admin",
+			AdminPassword: "password123",
+		})
+		assert.True(t, org_vo.IsInvalidOrganizationName(err))
+	})
+
+	t.Run("only default organization admins manage organizations", func(t *testing.T) {
+		_, _, err := listUseCase.Execute(orgAdminCtx, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		memberID := tests.CreateTestUser(t, container, "org-member@example.com").ID
+		_, _, err = listUseCase.Execute(tests.ContextWithUser(context.Background(), memberID), 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		list, total, err := listUseCase.Execute(adminCtx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		assert.Equal(t, "Acme", list[0].Name.Value())
+		assert.Equal(t, organizations_domain.DefaultOrganizationName, list[1].Name.Value())
+	})
+
+	t.Run("admin email is globally unique", func(t *testing.T) {
+		_, _, err := createUseCase.Execute(adminCtx, organizations.CreateOrganizationInput{
+			Name:          "Duplicate",
+			AdminEmail:    "acme-admin@example.com",
+			AdminName:     "Duplicate Admin",
+			AdminPassword: "password123",
+		})
+		assert.True(t, users_domain.IsUserAlreadyExists(err))
+	})
+
+	t.Run("current organization", func(t *testing.T) {
+		current, err := currentUseCase.Execute(orgAdminCtx)
+		require.NoError(t, err)
+		assert.True(t, organization.Equals(current))
+
+		current, err = currentUseCase.Execute(adminCtx)
+		require.NoError(t, err)
+		assert.True(t, current.IsDefault())
+	})
+}
+
+func TestOrganizationIsolation(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createOrganizationUseCase, err := tests.ResolveFromContainer[*organizations.CreateOrganizationUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	getTaskUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
+	require.NoError(t, err)
+
+	listTasksUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	listUsersUseCase, err := tests.ResolveFromContainer[*users.ListUsersUseCase](container)
+	require.NoError(t, err)
+
+	getUserUseCase, err := tests.ResolveFromContainer[*users.GetUserByIDUseCase](container)
+	require.NoError(t, err)
+
+	createLabelUseCase, err := tests.ResolveFromContainer[*labels.CreateLabelUseCase](container)
+	require.NoError(t, err)
+
+	organization, otherAdmin, err := createOrganizationUseCase.Execute(tests.AdminContext(context.Background()), organizations.CreateOrganizationInput{
+		Name:          "Other",
+		AdminEmail:    "other-admin@example.com",
+		AdminName:     "Other Admin",
+		AdminPassword: "password123",
+	})
+	require.NoError(t, err)
+	otherCtx := tests.ContextInOrganization(context.Background(), organization.ID, otherAdmin.ID, users_vo.RoleAdmin)
+
+	defaultUser := tests.CreateTestUser(t, container, "default-user@example.com")
+	defaultCtx := tests.ContextWithRole(context.Background(), defaultUser.ID, users_vo.RoleAdmin)
+
+	task, err := createTaskUseCase.Execute(defaultCtx, tasks.CreateTaskInput{Title: "Default task", Status: "todo"})
+	require.NoError(t, err)
+	assert.Equal(t, organizations_domain.DefaultOrganizationID, task.OrganizationID)
+
+	t.Run("tasks of another organization are invisible", func(t *testing.T) {
+		_, err := getTaskUseCase.Execute(otherCtx, task.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+
+		_, total, err := listTasksUseCase.Execute(otherCtx, tasks_domain.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+
+		_, total, err = listTasksUseCase.Execute(defaultCtx, tasks_domain.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("users of another organization are invisible", func(t *testing.T) {
+		_, err := getUserUseCase.Execute(otherCtx, defaultUser.ID)
+		assert.True(t, users_domain.IsUserNotFound(err))
+
+		list, total, err := listUsersUseCase.Execute(otherCtx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, otherAdmin.ID, list[0].ID)
+	})
+
+	t.Run("tasks cannot be assigned across organizations", func(t *testing.T) {
+		_, err := createTaskUseCase.Execute(otherCtx, tasks.CreateTaskInput{UserID: defaultUser.ID, Title: "Foreign", Status: "todo"})
+		assert.True(t, tasks_domain.IsTaskOwnerNotFound(err))
+	})
+
+	t.Run("label names are unique per organization", func(t *testing.T) {
+		defaultLabel, err := createLabelUseCase.Execute(defaultCtx, "bug")
+		require.NoError(t, err)
+
+		otherLabel, err := createLabelUseCase.Execute(otherCtx, "bug")
+		require.NoError(t, err)
+		assert.NotEqual(t, defaultLabel.ID, otherLabel.ID)
+		assert.Equal(t, organization.ID, otherLabel.OrganizationID)
+	})
+
+	t.Run("queries without organization fail closed", func(t *testing.T) {
+		ctx := auth_domain.ContextWithPrincipal(context.Background(), auth_domain.Principal{UserID: defaultUser.ID, Role: users_vo.RoleAdmin})
+
+		_, _, err := listTasksUseCase.Execute(ctx, tasks_domain.ListTasksFilter{}, 1, 10)
+		assert.True(t, organizations_domain.IsOrganizationRequired(err))
+
+		_, err = getUserUseCase.Execute(ctx, defaultUser.ID)
+		assert.True(t, organizations_domain.IsOrganizationRequired(err))
+	})
+}
@@ -0,0 +1,103 @@
+package application
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"crud/internal/application/policies"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// containerSource файл с application.initContainer
+const containerSource = "../../../internal/application/container.go"
+
+// unrestrictedUseCases use cases без прав: аутентификация и системные use cases
+var unrestrictedUseCases = map[string]bool{
+	"LoginUseCase":             true,
+	"RefreshTokensUseCase":     true,
+	"LogoutUseCase":            true,
+	"GetCurrentUserUseCase":    true,
+	"RegisterUserUseCase":      true,
+	"EnsureAdminUseCase":       true,
+	"PurgeExpiredTrashUseCase": true,
+}
+
+// registeredUseCases возвращает имена use cases, конструкторы которых передаются в Provide в container.go
+func registeredUseCases(t *testing.T) []string {
+	t.Helper()
+
+	file, err := parser.ParseFile(token.NewFileSet(), containerSource, nil, 0)
+	require.NoError(t, err)
+
+	var useCases []string
+	ast.Inspect(file, func(node ast.Node) bool {
+		call, ok := node.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if method, ok := call.Fun.(*ast.SelectorExpr); !ok || method.Sel.Name != "Provide" {
+			return true
+		}
+		constructor, ok := call.Args[0].(*ast.SelectorExpr)
+		if ok && strings.HasPrefix(constructor.Sel.Name, "New") && strings.HasSuffix(constructor.Sel.Name, "UseCase") {
+			useCases = append(useCases, strings.TrimPrefix(constructor.Sel.Name, "New"))
+		}
+		return true
+	})
+	return useCases
+}
+
+func TestPermissionMatrix(t *testing.T) {
+	// Администратор может выполнять любой use case
+	for useCase, permission := range policies.UseCasePermissions {
+		assert.True(t, policies.HasPermission("admin", permission), "admin must be allowed to run %s", useCase)
+	}
+
+	// Участник работает с задачами, но не управляет пользователями
+	assert.True(t, policies.HasPermission("member", policies.PermissionTasksCreate))
+	assert.True(t, policies.HasPermission("member", policies.PermissionTasksUpdate))
+	assert.False(t, policies.HasPermission("member", policies.PermissionUsersCreate))
+	assert.False(t, policies.HasPermission("member", policies.PermissionUsersDelete))
+	assert.False(t, policies.HasPermission("member", policies.PermissionTasksReadAny))
+
+	// Наблюдатель только читает задачи
+	assert.True(t, policies.HasPermission("viewer", policies.PermissionTasksRead))
+	assert.True(t, policies.HasPermission("viewer", policies.PermissionTasksReadAny))
+	assert.False(t, policies.HasPermission("viewer", policies.PermissionTasksCreate))
+	assert.False(t, policies.HasPermission("viewer", policies.PermissionTasksUpdate))
+	assert.False(t, policies.HasPermission("viewer", policies.PermissionTasksDelete))
+
+	// Метки создают участники, а переименовывают и удаляют только администраторы
+	assert.True(t, policies.HasPermission("member", policies.PermissionLabelsCreate))
+	assert.False(t, policies.HasPermission("member", policies.PermissionLabelsManage))
+	assert.True(t, policies.HasPermission("viewer", policies.PermissionLabelsRead))
+	assert.False(t, policies.HasPermission("viewer", policies.PermissionLabelsCreate))
+
+	// Неизвестная роль не имеет прав
+	assert.False(t, policies.HasPermission("superuser", policies.PermissionTasksRead))
+}
+
+func TestEveryUseCaseHasPermission(t *testing.T) {
+	useCases := registeredUseCases(t)
+	require.NotEmpty(t, useCases)
+
+	registered := make(map[string]bool, len(useCases))
+	for _, useCase := range useCases {
+		registered[useCase] = true
+		if unrestrictedUseCases[useCase] {
+			continue
+		}
+		_, ok := policies.UseCasePermissions[useCase]
+		assert.True(t, ok, "%s is registered in the container but has no entry in UseCasePermissions", useCase)
+	}
+
+	// Матрица не должна ссылаться на удаленные или переименованные use cases
+	for useCase := range policies.UseCasePermissions {
+		assert.True(t, registered[useCase], "%s is in UseCasePermissions but not registered in the container", useCase)
+	}
+}
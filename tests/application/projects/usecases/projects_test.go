@@ -0,0 +1,150 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	projects "crud/internal/application/projects/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	projects_domain "crud/internal/domain/projects"
+	vo "crud/internal/domain/projects/value_objects"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectUseCases(t *testing.T) {
+	adminCtx := tests.AdminContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*projects.CreateProjectUseCase](container)
+	require.NoError(t, err)
+
+	getUseCase, err := tests.ResolveFromContainer[*projects.GetProjectByIDUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*projects.ListProjectsUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*projects.UpdateProjectUseCase](container)
+	require.NoError(t, err)
+
+	deleteUseCase, err := tests.ResolveFromContainer[*projects.DeleteProjectUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	ownerID := tests.CreateTestUser(t, container, "project-owner@example.com").ID
+	ownerCtx := tests.ContextWithUser(context.Background(), ownerID)
+	otherID := tests.CreateTestUser(t, container, "project-other@example.com").ID
+	otherCtx := tests.ContextWithUser(context.Background(), otherID)
+
+	t.Run("create and get", func(t *testing.T) {
+		project, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: " Website ", Description: "Redesign"})
+		require.NoError(t, err)
+		assert.Equal(t, ownerID, project.UserID)
+		assert.Equal(t, "Website", project.Name.Value())
+		assert.Equal(t, "Redesign", project.Description)
+		assert.NotEqual(t, uuid.Nil, project.ID)
+
+		found, err := getUseCase.Execute(ownerCtx, project.ID)
+		require.NoError(t, err)
+		assert.True(t, project.Equals(found))
+	})
+
+	t.Run("invalid name", func(t *testing.T) {
+		_, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "  "})
+		assert.True(t, vo.IsInvalidProjectName(err))
+	})
+
+	t.Run("cannot create project for another user", func(t *testing.T) {
+		_, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{UserID: otherID, Name: "Foreign"})
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("owner must exist", func(t *testing.T) {
+		_, err := createUseCase.Execute(adminCtx, projects.CreateProjectInput{UserID: uuid.New(), Name: "Orphan"})
+		assert.True(t, projects_domain.IsProjectOwnerNotFound(err))
+	})
+
+	t.Run("other users cannot access project", func(t *testing.T) {
+		project, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "Private"})
+		require.NoError(t, err)
+
+		_, err = getUseCase.Execute(otherCtx, project.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		name := "Stolen"
+		_, err = updateUseCase.Execute(otherCtx, project.ID, projects.UpdateProjectInput{Name: &name})
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		err = deleteUseCase.Execute(otherCtx, project.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		// Администратор видит любой проект
+		_, err = getUseCase.Execute(adminCtx, project.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("list is scoped to caller and sorted by name", func(t *testing.T) {
+		_, err := createUseCase.Execute(otherCtx, projects.CreateProjectInput{Name: "Other Project"})
+		require.NoError(t, err)
+
+		list, total, err := listUseCase.Execute(ownerCtx, projects_domain.ListProjectsFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+		require.Len(t, list, 2)
+		assert.Equal(t, "Private", list[0].Name.Value())
+		assert.Equal(t, "Website", list[1].Name.Value())
+
+		_, _, err = listUseCase.Execute(ownerCtx, projects_domain.ListProjectsFilter{UserID: &otherID}, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, total, err = listUseCase.Execute(adminCtx, projects_domain.ListProjectsFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("update changes only provided fields", func(t *testing.T) {
+		project, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "Draft", Description: "Keep me"})
+		require.NoError(t, err)
+
+		name := "Final"
+		updated, err := updateUseCase.Execute(ownerCtx, project.ID, projects.UpdateProjectInput{Name: &name})
+		require.NoError(t, err)
+		assert.Equal(t, "Final", updated.Name.Value())
+		assert.Equal(t, "Keep me", updated.Description)
+
+		empty := ""
+		_, err = updateUseCase.Execute(ownerCtx, project.ID, projects.UpdateProjectInput{Name: &empty})
+		assert.True(t, vo.IsInvalidProjectName(err))
+	})
+
+	t.Run("project with tasks cannot be deleted", func(t *testing.T) {
+		project, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "Busy"})
+		require.NoError(t, err)
+
+		_, err = createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{ProjectID: &project.ID, Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		err = deleteUseCase.Execute(ownerCtx, project.ID)
+		assert.True(t, projects_domain.IsProjectHasTasks(err))
+	})
+
+	t.Run("delete empty project", func(t *testing.T) {
+		project, err := createUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "Empty"})
+		require.NoError(t, err)
+
+		err = deleteUseCase.Execute(ownerCtx, project.ID)
+		require.NoError(t, err)
+
+		_, err = getUseCase.Execute(ownerCtx, project.ID)
+		assert.True(t, projects_domain.IsProjectNotFound(err))
+	})
+}
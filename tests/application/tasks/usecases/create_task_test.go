@@ -3,8 +3,11 @@ package application
 import (
 	"context"
 	"testing"
+	"time"
 
 	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
 	vo "crud/internal/domain/tasks/value_objects"
 	"crud/tests"
 
@@ -24,10 +27,11 @@ func TestCreateTaskUseCase_Execute(t *testing.T) {
 	require.NoError(t, err)
 
 	// Создаем пользователя для теста
-	userID := uuid.New()
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
 
 	t.Run("successful creation", func(t *testing.T) {
-		task, err := useCase.Execute(ctx, userID, "Test Task", "Test Description", "todo")
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Test Task", Description: "Test Description", Status: "todo"})
 		require.NoError(t, err)
 		assert.NotNil(t, task)
 		assert.Equal(t, userID, task.UserID)
@@ -39,24 +43,106 @@ func TestCreateTaskUseCase_Execute(t *testing.T) {
 		assert.False(t, task.UpdatedAt.IsZero())
 	})
 
+	t.Run("owner defaults to caller", func(t *testing.T) {
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Test Task", Description: "Test Description", Status: "todo"})
+		require.NoError(t, err)
+		assert.Equal(t, userID, task.UserID)
+	})
+
+	t.Run("cannot create task for another user", func(t *testing.T) {
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{UserID: uuid.New(), Title: "Test Task", Description: "Test Description", Status: "todo"})
+		assert.Nil(t, task)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("viewer cannot create tasks", func(t *testing.T) {
+		viewerID := uuid.New()
+		viewerCtx := tests.ContextWithRole(context.Background(), viewerID, "viewer")
+		task, err := useCase.Execute(viewerCtx, tasks.CreateTaskInput{UserID: viewerID, Title: "Test Task", Description: "Test Description", Status: "todo"})
+		assert.Nil(t, task)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("admin can create task for another user", func(t *testing.T) {
+		adminCtx := tests.AdminContext(context.Background())
+		task, err := useCase.Execute(adminCtx, tasks.CreateTaskInput{UserID: userID, Title: "Assigned Task", Description: "Test Description", Status: "todo"})
+		require.NoError(t, err)
+		assert.Equal(t, userID, task.UserID)
+	})
+
+	t.Run("owner must exist", func(t *testing.T) {
+		adminCtx := tests.AdminContext(context.Background())
+		task, err := useCase.Execute(adminCtx, tasks.CreateTaskInput{UserID: uuid.New(), Title: "Orphan Task", Description: "Test Description", Status: "todo"})
+		assert.Nil(t, task)
+		assert.True(t, tasks_domain.IsTaskOwnerNotFound(err))
+	})
+
+	t.Run("caller without user record cannot create tasks", func(t *testing.T) {
+		ghostCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		task, err := useCase.Execute(ghostCtx, tasks.CreateTaskInput{Title: "Orphan Task", Description: "Test Description", Status: "todo"})
+		assert.Nil(t, task)
+		assert.True(t, tasks_domain.IsTaskOwnerNotFound(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		task, err := useCase.Execute(context.Background(), tasks.CreateTaskInput{UserID: userID, Title: "Test Task", Description: "Test Description", Status: "todo"})
+		assert.Nil(t, task)
+		assert.True(t, auth_domain.IsUnauthenticated(err))
+	})
+
 	t.Run("invalid title", func(t *testing.T) {
-		task, err := useCase.Execute(ctx, userID, "", "Test Description", "todo")
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "", Description: "Test Description", Status: "todo"})
 		assert.Nil(t, task)
 		assert.True(t, vo.IsInvalidTitle(err))
 	})
 
 	t.Run("invalid status", func(t *testing.T) {
-		task, err := useCase.Execute(ctx, userID, "Test Task", "Test Description", "invalid_status")
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Test Task", Description: "Test Description", Status: "invalid_status"})
 		assert.Nil(t, task)
 		assert.True(t, vo.IsInvalidStatus(err))
 	})
 
-	t.Run("valid statuses", func(t *testing.T) {
-		validStatuses := []string{"todo", "in_progress", "done"}
-		for _, status := range validStatuses {
-			task, err := useCase.Execute(ctx, userID, "Test Task", "Test Description", status)
-			require.NoError(t, err, "status: %s", status)
-			assert.Equal(t, status, task.Status.Value())
+	t.Run("status outside workflow initial statuses", func(t *testing.T) {
+		for _, status := range []string{"in_progress", "done"} {
+			task, err := useCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Test Task", Description: "Test Description", Status: status})
+			assert.Nil(t, task, "status: %s", status)
+			assert.True(t, vo.IsInvalidStatus(err), "status: %s", status)
 		}
 	})
+
+	t.Run("with schedule", func(t *testing.T) {
+		start := time.Date(2026, 5, 1, 9, 0, 0, 0, time.FixedZone("UTC+5", 5*60*60))
+		due := start.Add(48 * time.Hour)
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Planned Task", Status: "todo", StartDate: &start, DueDate: &due})
+		require.NoError(t, err)
+		require.NotNil(t, task.Schedule.DueDate())
+		assert.True(t, due.Equal(*task.Schedule.DueDate()))
+		assert.Equal(t, time.UTC, task.Schedule.StartDate().Location())
+	})
+
+	t.Run("due date before start date", func(t *testing.T) {
+		start := time.Now()
+		due := start.Add(-time.Hour)
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Planned Task", Status: "todo", StartDate: &start, DueDate: &due})
+		assert.Nil(t, task)
+		assert.True(t, vo.IsInvalidSchedule(err))
+	})
+
+	t.Run("priority defaults to medium", func(t *testing.T) {
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Test Task", Status: "todo"})
+		require.NoError(t, err)
+		assert.Equal(t, "medium", task.Priority.Value())
+	})
+
+	t.Run("with priority", func(t *testing.T) {
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Test Task", Status: "todo", Priority: "urgent"})
+		require.NoError(t, err)
+		assert.Equal(t, "urgent", task.Priority.Value())
+	})
+
+	t.Run("invalid priority", func(t *testing.T) {
+		task, err := useCase.Execute(ctx, tasks.CreateTaskInput{Title: "Test Task", Status: "todo", Priority: "critical"})
+		assert.Nil(t, task)
+		assert.True(t, vo.IsInvalidPriority(err))
+	})
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
 	tasks_domain "crud/internal/domain/tasks"
 	"crud/tests"
 
@@ -28,10 +29,11 @@ func TestDeleteTaskUseCase_Execute(t *testing.T) {
 	getUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
 	require.NoError(t, err)
 
-	userID := uuid.New()
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
 
 	t.Run("successful deletion", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Task to Delete", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Task to Delete", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		err = deleteUseCase.Execute(ctx, task.ID)
@@ -42,6 +44,18 @@ func TestDeleteTaskUseCase_Execute(t *testing.T) {
 		assert.True(t, tasks_domain.IsTaskNotFound(err))
 	})
 
+	t.Run("task of another user is forbidden", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Task to Keep", Description: "Description", Status: "todo"})
+		require.NoError(t, err)
+
+		otherCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		err = deleteUseCase.Execute(otherCtx, task.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, err = getUseCase.Execute(ctx, task.ID)
+		assert.NoError(t, err)
+	})
+
 	t.Run("task not found", func(t *testing.T) {
 		nonExistentID := uuid.New()
 		err := deleteUseCase.Execute(ctx, nonExistentID)
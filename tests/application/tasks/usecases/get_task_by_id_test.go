@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
 	tasks_domain "crud/internal/domain/tasks"
 	"crud/tests"
 
@@ -26,11 +27,12 @@ func TestGetTaskByIDUseCase_Execute(t *testing.T) {
 	getUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
 	require.NoError(t, err)
 
-	userID := uuid.New()
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
 
 	t.Run("successful retrieval", func(t *testing.T) {
 		// Создаем задачу
-		createdTask, err := createUseCase.Execute(ctx, userID, "Test Task", "Test Description", "todo")
+		createdTask, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Test Task", Description: "Test Description", Status: "todo"})
 		require.NoError(t, err)
 		require.NotNil(t, createdTask)
 
@@ -45,6 +47,28 @@ func TestGetTaskByIDUseCase_Execute(t *testing.T) {
 		assert.Equal(t, createdTask.Status.Value(), task.Status.Value())
 	})
 
+	t.Run("task of another user is forbidden", func(t *testing.T) {
+		createdTask, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Private Task", Description: "Description", Status: "todo"})
+		require.NoError(t, err)
+
+		otherCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		task, err := getUseCase.Execute(otherCtx, createdTask.ID)
+		assert.Nil(t, task)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("admin and viewer can view any task", func(t *testing.T) {
+		createdTask, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Shared Task", Description: "Description", Status: "todo"})
+		require.NoError(t, err)
+
+		for _, role := range []string{"admin", "viewer"} {
+			roleCtx := tests.ContextWithRole(context.Background(), uuid.New(), role)
+			task, err := getUseCase.Execute(roleCtx, createdTask.ID)
+			require.NoError(t, err, "role: %s", role)
+			assert.Equal(t, createdTask.ID, task.ID)
+		}
+	})
+
 	t.Run("task not found", func(t *testing.T) {
 		nonExistentID := uuid.New()
 		task, err := getUseCase.Execute(ctx, nonExistentID)
@@ -3,8 +3,11 @@ package application
 import (
 	"context"
 	"testing"
+	"time"
 
 	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
 	"crud/tests"
 
 	"github.com/google/uuid"
@@ -24,38 +27,57 @@ func TestListTasksUseCase_Execute(t *testing.T) {
 	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
 	require.NoError(t, err)
 
-	userID1 := uuid.New()
-	userID2 := uuid.New()
+	userID1 := tests.CreateTestUser(t, container, "owner1@example.com").ID
+	userID2 := tests.CreateTestUser(t, container, "owner2@example.com").ID
+	ctx1 := tests.ContextWithUser(ctx, userID1)
+	ctx2 := tests.ContextWithUser(ctx, userID2)
 
 	// Создаем несколько задач
-	task1, err := createUseCase.Execute(ctx, userID1, "Task 1", "Description 1", "todo")
+	task1, err := createUseCase.Execute(ctx1, tasks.CreateTaskInput{UserID: userID1, Title: "Task 1", Description: "Description 1", Status: "todo"})
 	require.NoError(t, err)
 
-	task2, err := createUseCase.Execute(ctx, userID1, "Task 2", "Description 2", "in_progress")
-	require.NoError(t, err)
+	task2 := tests.CreateTestTask(t, container, ctx1, tasks.CreateTaskInput{UserID: userID1, Title: "Task 2", Description: "Description 2", Status: "in_progress"})
 
-	_, err = createUseCase.Execute(ctx, userID2, "Task 3", "Description 3", "done")
-	require.NoError(t, err)
+	task4 := tests.CreateTestTask(t, container, ctx1, tasks.CreateTaskInput{UserID: userID1, Title: "Task 4", Description: "Description 4", Status: "done"})
 
-	t.Run("list all tasks", func(t *testing.T) {
-		result, total, err := listUseCase.Execute(ctx, nil, nil, 1, 10)
+	tests.CreateTestTask(t, container, ctx2, tasks.CreateTaskInput{UserID: userID2, Title: "Task 3", Description: "Description 3", Status: "done"})
+
+	t.Run("list defaults to own tasks", func(t *testing.T) {
+		result, total, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{}, 1, 10)
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total)
 		assert.Len(t, result, 3)
+
+		result, total, err = listUseCase.Execute(ctx2, tasks_domain.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Len(t, result, 1)
 	})
 
-	t.Run("filter by userID", func(t *testing.T) {
-		result, total, err := listUseCase.Execute(ctx, &userID1, nil, 1, 10)
+	t.Run("filter by own userID", func(t *testing.T) {
+		result, total, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{UserID: &userID1}, 1, 10)
 		require.NoError(t, err)
-		assert.Equal(t, int64(2), total)
-		assert.Len(t, result, 2)
-		assert.Contains(t, []uuid.UUID{result[0].ID, result[1].ID}, task1.ID)
-		assert.Contains(t, []uuid.UUID{result[0].ID, result[1].ID}, task2.ID)
+		assert.Equal(t, int64(3), total)
+		assert.Len(t, result, 3)
+		ids := []uuid.UUID{result[0].ID, result[1].ID, result[2].ID}
+		assert.Contains(t, ids, task1.ID)
+		assert.Contains(t, ids, task2.ID)
+		assert.Contains(t, ids, task4.ID)
+	})
+
+	t.Run("filter by another userID is forbidden", func(t *testing.T) {
+		_, _, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{UserID: &userID2}, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		_, _, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{}, 1, 10)
+		assert.True(t, auth_domain.IsUnauthenticated(err))
 	})
 
 	t.Run("filter by status", func(t *testing.T) {
 		status := "todo"
-		result, total, err := listUseCase.Execute(ctx, nil, &status, 1, 10)
+		result, total, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{Status: &status}, 1, 10)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, result, 1)
@@ -64,7 +86,7 @@ func TestListTasksUseCase_Execute(t *testing.T) {
 
 	t.Run("filter by userID and status", func(t *testing.T) {
 		status := "in_progress"
-		result, total, err := listUseCase.Execute(ctx, &userID1, &status, 1, 10)
+		result, total, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{UserID: &userID1, Status: &status}, 1, 10)
 		require.NoError(t, err)
 		assert.Equal(t, int64(1), total)
 		assert.Len(t, result, 1)
@@ -72,14 +94,219 @@ func TestListTasksUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("pagination", func(t *testing.T) {
-		result, total, err := listUseCase.Execute(ctx, nil, nil, 1, 2)
+		result, total, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{}, 1, 2)
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total)
 		assert.Len(t, result, 2)
 
-		result2, total2, err := listUseCase.Execute(ctx, nil, nil, 2, 2)
+		result2, total2, err := listUseCase.Execute(ctx1, tasks_domain.ListTasksFilter{}, 2, 2)
 		require.NoError(t, err)
 		assert.Equal(t, int64(3), total2)
 		assert.Len(t, result2, 1)
 	})
 }
+
+func TestListTasksUseCase_DueDateFilters(t *testing.T) {
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "dates@example.com").ID
+	ctx := tests.ContextWithUser(context.Background(), userID)
+
+	now := time.Date(2026, 6, 15, 12, 0, 0, 0, time.UTC)
+	yesterday := now.Add(-24 * time.Hour)
+	tomorrow := now.Add(24 * time.Hour)
+
+	overdue, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Overdue", Status: "todo", DueDate: &yesterday})
+	require.NoError(t, err)
+	tests.CreateTestTask(t, container, ctx, tasks.CreateTaskInput{Title: "Done late", Status: "done", DueDate: &yesterday})
+	upcoming, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Upcoming", Status: "todo", DueDate: &tomorrow})
+	require.NoError(t, err)
+	_, err = createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "No due date", Status: "todo"})
+	require.NoError(t, err)
+
+	t.Run("overdue excludes completed tasks", func(t *testing.T) {
+		isOverdue := true
+		result, total, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Overdue: &isOverdue, Now: now}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, overdue.ID, result[0].ID)
+	})
+
+	t.Run("not overdue", func(t *testing.T) {
+		isOverdue := false
+		_, total, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Overdue: &isOverdue, Now: now}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+
+	t.Run("overdue is evaluated in UTC regardless of zone of now", func(t *testing.T) {
+		isOverdue := true
+		localNow := now.In(time.FixedZone("UTC-10", -10*60*60))
+		_, total, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Overdue: &isOverdue, Now: localNow}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+	})
+
+	t.Run("due before and after", func(t *testing.T) {
+		result, total, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{DueAfter: &now}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, upcoming.ID, result[0].ID)
+
+		_, total, err = listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{DueBefore: &now}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+
+		// Границы включаются в выборку
+		_, total, err = listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{DueAfter: &yesterday, DueBefore: &tomorrow}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+	})
+}
+
+func TestListTasksUseCase_SortByPriority(t *testing.T) {
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "triage@example.com").ID
+	ctx := tests.ContextWithUser(context.Background(), userID)
+
+	soon := time.Now().Add(time.Hour)
+	later := time.Now().Add(48 * time.Hour)
+
+	inputs := []tasks.CreateTaskInput{
+		{Title: "Low", Status: "todo", Priority: "low", DueDate: &soon},
+		{Title: "High later", Status: "todo", Priority: "high", DueDate: &later},
+		{Title: "High without due date", Status: "todo", Priority: "high"},
+		{Title: "Urgent", Status: "todo", Priority: "urgent"},
+		{Title: "High soon", Status: "todo", Priority: "high", DueDate: &soon},
+	}
+	for _, input := range inputs {
+		_, err := createUseCase.Execute(ctx, input)
+		require.NoError(t, err)
+	}
+
+	result, total, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Sort: tasks_domain.TaskSortPriority}, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+
+	titles := make([]string, len(result))
+	for i, task := range result {
+		titles[i] = task.Title.Value()
+	}
+	assert.Equal(t, []string{"Urgent", "High soon", "High later", "High without due date", "Low"}, titles)
+
+	t.Run("unknown sort order", func(t *testing.T) {
+		_, err := tasks_domain.ParseTaskSortOrder("title")
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+	})
+}
+
+func TestListTasksByCursorUseCase_Execute(t *testing.T) {
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	cursorUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksByCursorUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "pager@example.com").ID
+	otherID := tests.CreateTestUser(t, container, "other-pager@example.com").ID
+	ctx := tests.ContextWithUser(context.Background(), userID)
+
+	soon := time.Now().Add(time.Hour)
+	inputs := []tasks.CreateTaskInput{
+		{Title: "Low", Status: "todo", Priority: "low"},
+		{Title: "Urgent", Status: "todo", Priority: "urgent"},
+		{Title: "High", Status: "todo", Priority: "high", DueDate: &soon},
+		{Title: "Medium", Status: "todo", Priority: "medium"},
+		{Title: "High without due date", Status: "todo", Priority: "high"},
+	}
+	for _, input := range inputs {
+		_, err := createUseCase.Execute(ctx, input)
+		require.NoError(t, err)
+	}
+	_, err = createUseCase.Execute(tests.ContextWithUser(context.Background(), otherID),
+		tasks.CreateTaskInput{Title: "Foreign", Status: "todo"})
+	require.NoError(t, err)
+
+	// walk собирает заголовки всех страниц, переходя по курсорам в одну сторону
+	walk := func(t *testing.T, filter tasks_domain.ListTasksFilter, cursor *tasks_domain.TaskCursor, forward bool) []string {
+		var titles []string
+		for pages := 0; pages < 10; pages++ {
+			page, err := cursorUseCase.Execute(ctx, filter, cursor, 2)
+			require.NoError(t, err)
+
+			pageTitles := make([]string, len(page.Tasks))
+			for i, task := range page.Tasks {
+				pageTitles[i] = task.Title.Value()
+			}
+			if forward {
+				titles = append(titles, pageTitles...)
+				cursor = page.Next
+			} else {
+				titles = append(pageTitles, titles...)
+				cursor = page.Prev
+			}
+			if cursor == nil {
+				return titles
+			}
+		}
+		t.Fatal("cursor walk did not finish")
+		return nil
+	}
+
+	t.Run("creation order", func(t *testing.T) {
+		titles := walk(t, tasks_domain.ListTasksFilter{}, nil, true)
+		assert.Equal(t, []string{"Low", "Urgent", "High", "Medium", "High without due date"}, titles)
+	})
+
+	t.Run("priority order both ways", func(t *testing.T) {
+		filter := tasks_domain.ListTasksFilter{Sort: tasks_domain.TaskSortPriority}
+		expected := []string{"Urgent", "High", "High without due date", "Medium", "Low"}
+
+		assert.Equal(t, expected, walk(t, filter, nil, true))
+
+		last, err := cursorUseCase.Execute(ctx, filter, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, last.Tasks, 5)
+		end := tasks_domain.NewTaskCursor(last.Tasks[4], filter.Sort, false)
+
+		// Перед последней задачей идут все остальные
+		back := walk(t, filter, tasks_domain.NewTaskCursor(last.Tasks[4], filter.Sort, true), false)
+		assert.Equal(t, expected[:4], back)
+
+		page, err := cursorUseCase.Execute(ctx, filter, end, 2)
+		require.NoError(t, err)
+		assert.Empty(t, page.Tasks)
+		assert.Nil(t, page.Next)
+		assert.Nil(t, page.Prev)
+	})
+
+	t.Run("cursor of another sort order", func(t *testing.T) {
+		page, err := cursorUseCase.Execute(ctx, tasks_domain.ListTasksFilter{}, nil, 2)
+		require.NoError(t, err)
+		require.NotNil(t, page.Next)
+
+		_, err = cursorUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Sort: tasks_domain.TaskSortPriority}, page.Next, 2)
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+	})
+
+	t.Run("sort by fields", func(t *testing.T) {
+		filter := tasks_domain.ListTasksFilter{SortKeys: []tasks_domain.TaskSortKey{{Field: tasks_domain.TaskFieldTitle}}}
+		_, err := cursorUseCase.Execute(ctx, filter, nil, 2)
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+	})
+}
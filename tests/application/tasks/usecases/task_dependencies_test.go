@@ -0,0 +1,177 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskDependenciesUseCases(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	transitionUseCase, err := tests.ResolveFromContainer[*tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	listTasksUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	addUseCase, err := tests.ResolveFromContainer[*tasks.AddTaskDependencyUseCase](container)
+	require.NoError(t, err)
+
+	removeUseCase, err := tests.ResolveFromContainer[*tasks.RemoveTaskDependencyUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTaskDependenciesUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
+
+	create := func(t *testing.T, title string) *tasks_domain.Task {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: title, Status: "todo"})
+		require.NoError(t, err)
+		return task
+	}
+
+	t.Run("add and list", func(t *testing.T) {
+		task := create(t, "Deploy")
+		blocker := create(t, "Review")
+
+		dependencies, err := addUseCase.Execute(ctx, task.ID, blocker.ID)
+		require.NoError(t, err)
+		require.Len(t, dependencies.Blockers, 1)
+		assert.Equal(t, blocker.ID, dependencies.Blockers[0].ID)
+		assert.Empty(t, dependencies.Dependents)
+
+		// Повторное добавление ничего не меняет
+		_, err = addUseCase.Execute(ctx, task.ID, blocker.ID)
+		require.NoError(t, err)
+
+		dependencies, err = listUseCase.Execute(ctx, blocker.ID)
+		require.NoError(t, err)
+		assert.Empty(t, dependencies.Blockers)
+		require.Len(t, dependencies.Dependents, 1)
+		assert.Equal(t, task.ID, dependencies.Dependents[0].ID)
+
+		require.NoError(t, removeUseCase.Execute(ctx, task.ID, blocker.ID))
+		require.NoError(t, removeUseCase.Execute(ctx, task.ID, blocker.ID))
+
+		dependencies, err = listUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Empty(t, dependencies.Blockers)
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		first := create(t, "First")
+		second := create(t, "Second")
+		third := create(t, "Third")
+
+		_, err := addUseCase.Execute(ctx, first.ID, first.ID)
+		assert.True(t, tasks_domain.IsTaskDependencyCycle(err))
+
+		// first <- second <- third
+		_, err = addUseCase.Execute(ctx, first.ID, second.ID)
+		require.NoError(t, err)
+		_, err = addUseCase.Execute(ctx, second.ID, third.ID)
+		require.NoError(t, err)
+
+		_, err = addUseCase.Execute(ctx, second.ID, first.ID)
+		assert.True(t, tasks_domain.IsTaskDependencyCycle(err))
+		_, err = addUseCase.Execute(ctx, third.ID, first.ID)
+		assert.True(t, tasks_domain.IsTaskDependencyCycle(err))
+	})
+
+	t.Run("unknown blocker", func(t *testing.T) {
+		task := create(t, "Task")
+		_, err := addUseCase.Execute(ctx, task.ID, uuid.New())
+		assert.True(t, tasks_domain.IsBlockerTaskNotFound(err))
+	})
+
+	t.Run("blocked transitions", func(t *testing.T) {
+		task := create(t, "Release")
+		blocker := create(t, "Fix bugs")
+
+		_, err := addUseCase.Execute(ctx, task.ID, blocker.ID)
+		require.NoError(t, err)
+
+		_, err = transitionUseCase.Execute(ctx, task.ID, "in_progress")
+		assert.True(t, tasks_domain.IsTransitionGuardFailed(err))
+		assert.Contains(t, err.Error(), blocker.ID.String())
+
+		_, err = transitionUseCase.Execute(ctx, task.ID, "done")
+		assert.True(t, tasks_domain.IsTransitionGuardFailed(err))
+
+		_, err = transitionUseCase.Execute(ctx, blocker.ID, "done")
+		require.NoError(t, err)
+
+		updated, err := transitionUseCase.Execute(ctx, task.ID, "in_progress")
+		require.NoError(t, err)
+		assert.Equal(t, "in_progress", updated.Status.Value())
+	})
+
+	t.Run("unblocked tasks", func(t *testing.T) {
+		otherID := tests.CreateTestUser(t, container, "unblocked@example.com").ID
+		otherCtx := tests.ContextWithUser(context.Background(), otherID)
+
+		free, err := createUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Free", Status: "todo"})
+		require.NoError(t, err)
+		waiting, err := createUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Waiting", Status: "todo"})
+		require.NoError(t, err)
+		_, err = addUseCase.Execute(otherCtx, waiting.ID, free.ID)
+		require.NoError(t, err)
+
+		blocked := false
+		list, total, err := listTasksUseCase.Execute(otherCtx, tasks_domain.ListTasksFilter{Blocked: &blocked}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, free.ID, list[0].ID)
+
+		blocked = true
+		list, total, err = listTasksUseCase.Execute(otherCtx, tasks_domain.ListTasksFilter{Blocked: &blocked}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, waiting.ID, list[0].ID)
+
+		// Завершенная блокирующая задача больше не блокирует
+		_, err = transitionUseCase.Execute(otherCtx, free.ID, "done")
+		require.NoError(t, err)
+		_, total, err = listTasksUseCase.Execute(otherCtx, tasks_domain.ListTasksFilter{Blocked: &blocked}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		task := create(t, "Private")
+
+		otherID := tests.CreateTestUser(t, container, "other@example.com").ID
+		otherCtx := tests.ContextWithUser(context.Background(), otherID)
+		foreign, err := createUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Foreign", Status: "todo"})
+		require.NoError(t, err)
+
+		_, err = listUseCase.Execute(otherCtx, task.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, err = addUseCase.Execute(otherCtx, task.ID, foreign.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, err = addUseCase.Execute(otherCtx, foreign.ID, task.ID)
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+
+		err = removeUseCase.Execute(otherCtx, task.ID, foreign.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+}
@@ -0,0 +1,180 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"crud/config"
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskHierarchyUseCases(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста с ограничением глубины в 3 уровня
+	container := tests.NewTestContainer()
+	err := container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.TaskMaxDepth = 3
+		return cfg
+	})
+	require.NoError(t, err)
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*tasks.UpdateTaskUseCase](container)
+	require.NoError(t, err)
+
+	deleteUseCase, err := tests.ResolveFromContainer[*tasks.DeleteTaskUseCase](container)
+	require.NoError(t, err)
+
+	childrenUseCase, err := tests.ResolveFromContainer[*tasks.ListTaskChildrenUseCase](container)
+	require.NoError(t, err)
+
+	subtreeUseCase, err := tests.ResolveFromContainer[*tasks.ListTaskSubtreeUseCase](container)
+	require.NoError(t, err)
+
+	progressUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskProgressUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
+
+	create := func(t *testing.T, title string, parentID *uuid.UUID) *tasks_domain.Task {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: title, Status: "todo", ParentID: parentID})
+		require.NoError(t, err)
+		return task
+	}
+
+	t.Run("create subtasks", func(t *testing.T) {
+		root := create(t, "Root", nil)
+		child := create(t, "Child", &root.ID)
+		grandchild := create(t, "Grandchild", &child.ID)
+
+		require.NotNil(t, grandchild.ParentID)
+		assert.Equal(t, child.ID, *grandchild.ParentID)
+		assert.True(t, grandchild.IsSubtask())
+		assert.False(t, root.IsSubtask())
+
+		// Четвертый уровень превышает лимит
+		_, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Too deep", Status: "todo", ParentID: &grandchild.ID})
+		assert.True(t, tasks_domain.IsTaskDepthExceeded(err))
+
+		children, total, err := childrenUseCase.Execute(ctx, root.ID, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, children, 1)
+		assert.Equal(t, child.ID, children[0].ID)
+
+		subtree, err := subtreeUseCase.Execute(ctx, root.ID)
+		require.NoError(t, err)
+		require.Len(t, subtree, 2)
+		assert.Equal(t, child.ID, subtree[0].ID)
+		assert.Equal(t, grandchild.ID, subtree[1].ID)
+	})
+
+	t.Run("unknown parent", func(t *testing.T) {
+		missing := uuid.New()
+		_, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Orphan", Status: "todo", ParentID: &missing})
+		assert.True(t, tasks_domain.IsParentTaskNotFound(err))
+	})
+
+	t.Run("cycle via update", func(t *testing.T) {
+		root := create(t, "Root", nil)
+		child := create(t, "Child", &root.ID)
+
+		_, err := updateUseCase.Execute(ctx, root.ID, tasks.UpdateTaskInput{ParentID: &child.ID})
+		assert.True(t, tasks_domain.IsTaskHierarchyCycle(err))
+
+		_, err = updateUseCase.Execute(ctx, root.ID, tasks.UpdateTaskInput{ParentID: &root.ID})
+		assert.True(t, tasks_domain.IsTaskHierarchyCycle(err))
+	})
+
+	t.Run("move subtree", func(t *testing.T) {
+		root := create(t, "Root", nil)
+		child := create(t, "Child", &root.ID)
+		other := create(t, "Other", nil)
+		otherChild := create(t, "Other child", &other.ID)
+
+		// Поддерево из двух уровней под подзадачей дает четыре уровня
+		_, err := updateUseCase.Execute(ctx, root.ID, tasks.UpdateTaskInput{ParentID: &otherChild.ID})
+		assert.True(t, tasks_domain.IsTaskDepthExceeded(err))
+
+		moved, err := updateUseCase.Execute(ctx, root.ID, tasks.UpdateTaskInput{ParentID: &other.ID})
+		require.NoError(t, err)
+		require.NotNil(t, moved.ParentID)
+		assert.Equal(t, other.ID, *moved.ParentID)
+
+		subtree, err := subtreeUseCase.Execute(ctx, other.ID)
+		require.NoError(t, err)
+		assert.Len(t, subtree, 3)
+
+		// Отвязываем поддерево обратно
+		detached, err := updateUseCase.Execute(ctx, root.ID, tasks.UpdateTaskInput{ClearParent: true})
+		require.NoError(t, err)
+		assert.Nil(t, detached.ParentID)
+
+		subtree, err = subtreeUseCase.Execute(ctx, root.ID)
+		require.NoError(t, err)
+		require.Len(t, subtree, 1)
+		assert.Equal(t, child.ID, subtree[0].ID)
+	})
+
+	t.Run("progress", func(t *testing.T) {
+		root := create(t, "Root", nil)
+		child := create(t, "Child", &root.ID)
+		create(t, "Grandchild", &child.ID)
+		create(t, "Second child", &root.ID)
+
+		progress, err := progressUseCase.Execute(ctx, root.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 3, progress.Total)
+		assert.Equal(t, 0, progress.Done)
+
+		done := "in_progress"
+		_, err = updateUseCase.Execute(ctx, child.ID, tasks.UpdateTaskInput{Status: &done})
+		require.NoError(t, err)
+		done = "done"
+		_, err = updateUseCase.Execute(ctx, child.ID, tasks.UpdateTaskInput{Status: &done})
+		require.NoError(t, err)
+
+		progress, err = progressUseCase.Execute(ctx, root.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, progress.Done)
+		assert.Equal(t, 33, progress.Percent())
+	})
+
+	t.Run("delete with subtasks", func(t *testing.T) {
+		root := create(t, "Root", nil)
+		child := create(t, "Child", &root.ID)
+
+		err := deleteUseCase.Execute(ctx, root.ID)
+		assert.True(t, tasks_domain.IsTaskHasSubtasks(err))
+
+		require.NoError(t, deleteUseCase.Execute(ctx, child.ID))
+		require.NoError(t, deleteUseCase.Execute(ctx, root.ID))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		root := create(t, "Root", nil)
+
+		otherID := tests.CreateTestUser(t, container, "other@example.com").ID
+		otherCtx := tests.ContextWithUser(context.Background(), otherID)
+
+		_, err := createUseCase.Execute(otherCtx, tasks.CreateTaskInput{Title: "Foreign", Status: "todo", ParentID: &root.ID})
+		assert.Error(t, err)
+
+		_, err = subtreeUseCase.Execute(otherCtx, root.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		_, err = progressUseCase.Execute(otherCtx, root.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+}
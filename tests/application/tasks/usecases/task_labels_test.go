@@ -0,0 +1,202 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	application_labels "crud/internal/application/labels/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	labels_domain "crud/internal/domain/labels"
+	labels_vo "crud/internal/domain/labels/value_objects"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskLabelsUseCases(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	createLabelUseCase, err := tests.ResolveFromContainer[*application_labels.CreateLabelUseCase](container)
+	require.NoError(t, err)
+
+	deleteLabelUseCase, err := tests.ResolveFromContainer[*application_labels.DeleteLabelUseCase](container)
+	require.NoError(t, err)
+
+	attachUseCase, err := tests.ResolveFromContainer[*tasks.AttachTaskLabelUseCase](container)
+	require.NoError(t, err)
+
+	detachUseCase, err := tests.ResolveFromContainer[*tasks.DetachTaskLabelUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTaskLabelsUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
+
+	bug, err := createLabelUseCase.Execute(ctx, "bug")
+	require.NoError(t, err)
+	frontend, err := createLabelUseCase.Execute(ctx, "frontend")
+	require.NoError(t, err)
+
+	t.Run("attach and detach", func(t *testing.T) {
+		task, err := createTaskUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		attached, err := attachUseCase.Execute(ctx, task.ID, frontend.ID)
+		require.NoError(t, err)
+		require.Len(t, attached, 1)
+
+		// Повторное добавление ничего не меняет
+		attached, err = attachUseCase.Execute(ctx, task.ID, bug.ID)
+		require.NoError(t, err)
+		attached, err = attachUseCase.Execute(ctx, task.ID, bug.ID)
+		require.NoError(t, err)
+		require.Len(t, attached, 2)
+		assert.Equal(t, "bug", attached[0].Name.Value())
+		assert.Equal(t, "frontend", attached[1].Name.Value())
+
+		require.NoError(t, detachUseCase.Execute(ctx, task.ID, bug.ID))
+		require.NoError(t, detachUseCase.Execute(ctx, task.ID, bug.ID))
+
+		list, err := listUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.True(t, frontend.Equals(list[0]))
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		task, err := createTaskUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		_, err = attachUseCase.Execute(ctx, task.ID, uuid.New())
+		assert.True(t, labels_domain.IsLabelNotFound(err))
+	})
+
+	t.Run("unknown task", func(t *testing.T) {
+		_, err := attachUseCase.Execute(ctx, uuid.New(), bug.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+	})
+
+	t.Run("deleted label is detached", func(t *testing.T) {
+		task, err := createTaskUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		temporary, err := createLabelUseCase.Execute(ctx, "temporary")
+		require.NoError(t, err)
+		_, err = attachUseCase.Execute(ctx, task.ID, temporary.ID)
+		require.NoError(t, err)
+
+		require.NoError(t, deleteLabelUseCase.Execute(tests.AdminContext(context.Background()), temporary.ID))
+
+		list, err := listUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+
+	t.Run("other user forbidden", func(t *testing.T) {
+		task, err := createTaskUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		otherCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "other@example.com").ID)
+		_, err = attachUseCase.Execute(otherCtx, task.ID, bug.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+		assert.True(t, auth_domain.IsForbidden(detachUseCase.Execute(otherCtx, task.ID, bug.ID)))
+		_, err = listUseCase.Execute(otherCtx, task.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+}
+
+func TestListTasksUseCase_LabelFilters(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	createLabelUseCase, err := tests.ResolveFromContainer[*application_labels.CreateLabelUseCase](container)
+	require.NoError(t, err)
+
+	attachUseCase, err := tests.ResolveFromContainer[*tasks.AttachTaskLabelUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
+
+	labelIDs := map[string]uuid.UUID{}
+	for _, name := range []string{"bug", "frontend", "customer-x"} {
+		label, err := createLabelUseCase.Execute(ctx, name)
+		require.NoError(t, err)
+		labelIDs[name] = label.ID
+	}
+
+	// Задачи с разными наборами меток
+	taskLabels := map[string][]string{
+		"frontend bug": {"bug", "frontend"},
+		"backend bug":  {"bug"},
+		"customer":     {"customer-x"},
+		"unlabeled":    nil,
+	}
+	for title, names := range taskLabels {
+		task, err := createTaskUseCase.Execute(ctx, tasks.CreateTaskInput{Title: title, Status: "todo"})
+		require.NoError(t, err)
+		for _, name := range names {
+			_, err := attachUseCase.Execute(ctx, task.ID, labelIDs[name])
+			require.NoError(t, err)
+		}
+	}
+
+	titles := func(filter tasks_domain.ListTasksFilter) []string {
+		list, total, err := listUseCase.Execute(ctx, filter, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(len(list)), total)
+		result := make([]string, len(list))
+		for i, task := range list {
+			result[i] = task.Title.Value()
+		}
+		return result
+	}
+
+	t.Run("any of", func(t *testing.T) {
+		assert.ElementsMatch(t,
+			[]string{"frontend bug", "backend bug", "customer"},
+			titles(tasks_domain.ListTasksFilter{Labels: []string{"frontend", "Bug", "customer-x"}}),
+		)
+		assert.ElementsMatch(t,
+			[]string{"frontend bug", "backend bug"},
+			titles(tasks_domain.ListTasksFilter{Labels: []string{"bug"}, LabelMatch: tasks_domain.LabelMatchAny}),
+		)
+	})
+
+	t.Run("all of", func(t *testing.T) {
+		assert.ElementsMatch(t,
+			[]string{"frontend bug"},
+			titles(tasks_domain.ListTasksFilter{Labels: []string{"bug", "frontend", "BUG"}, LabelMatch: tasks_domain.LabelMatchAll}),
+		)
+		assert.Empty(t, titles(tasks_domain.ListTasksFilter{Labels: []string{"bug", "customer-x"}, LabelMatch: tasks_domain.LabelMatchAll}))
+	})
+
+	t.Run("unknown label", func(t *testing.T) {
+		assert.Empty(t, titles(tasks_domain.ListTasksFilter{Labels: []string{"design"}}))
+	})
+
+	t.Run("invalid label", func(t *testing.T) {
+		_, _, err := listUseCase.Execute(ctx, tasks_domain.ListTasksFilter{Labels: []string{" "}}, 1, 10)
+		assert.True(t, labels_vo.IsInvalidLabelName(err))
+	})
+}
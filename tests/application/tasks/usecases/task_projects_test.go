@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	projects "crud/internal/application/projects/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	projects_domain "crud/internal/domain/projects"
+	tasks_domain "crud/internal/domain/tasks"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskProjects(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createProjectUseCase, err := tests.ResolveFromContainer[*projects.CreateProjectUseCase](container)
+	require.NoError(t, err)
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*tasks.UpdateTaskUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*tasks.ListTasksUseCase](container)
+	require.NoError(t, err)
+
+	ownerID := tests.CreateTestUser(t, container, "task-projects@example.com").ID
+	ownerCtx := tests.ContextWithUser(context.Background(), ownerID)
+	otherID := tests.CreateTestUser(t, container, "task-projects-other@example.com").ID
+	otherCtx := tests.ContextWithUser(context.Background(), otherID)
+
+	project, err := createProjectUseCase.Execute(ownerCtx, projects.CreateProjectInput{Name: "Backend"})
+	require.NoError(t, err)
+	foreignProject, err := createProjectUseCase.Execute(otherCtx, projects.CreateProjectInput{Name: "Foreign"})
+	require.NoError(t, err)
+
+	t.Run("create task in project", func(t *testing.T) {
+		task, err := createUseCase.Execute(ownerCtx, tasks.CreateTaskInput{ProjectID: &project.ID, Title: "In Project", Status: "todo"})
+		require.NoError(t, err)
+		require.NotNil(t, task.ProjectID)
+		assert.Equal(t, project.ID, *task.ProjectID)
+	})
+
+	t.Run("project must belong to task owner", func(t *testing.T) {
+		_, err := createUseCase.Execute(ownerCtx, tasks.CreateTaskInput{ProjectID: &foreignProject.ID, Title: "Leak", Status: "todo"})
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+	})
+
+	t.Run("unknown project", func(t *testing.T) {
+		missing := uuid.New()
+		_, err := createUseCase.Execute(ownerCtx, tasks.CreateTaskInput{ProjectID: &missing, Title: "Lost", Status: "todo"})
+		assert.True(t, projects_domain.IsProjectNotFound(err))
+	})
+
+	t.Run("list scoped to project", func(t *testing.T) {
+		_, err := createUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Outside", Status: "todo"})
+		require.NoError(t, err)
+
+		list, total, err := listUseCase.Execute(ownerCtx, tasks_domain.ListTasksFilter{ProjectID: &project.ID}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, list, 1)
+		assert.Equal(t, "In Project", list[0].Title.Value())
+
+		// Чужой проект недоступен для фильтрации
+		_, _, err = listUseCase.Execute(otherCtx, tasks_domain.ListTasksFilter{ProjectID: &project.ID}, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("move task between projects", func(t *testing.T) {
+		task, err := createUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Movable", Status: "todo"})
+		require.NoError(t, err)
+		assert.Nil(t, task.ProjectID)
+
+		updated, err := updateUseCase.Execute(ownerCtx, task.ID, tasks.UpdateTaskInput{ProjectID: &project.ID})
+		require.NoError(t, err)
+		require.NotNil(t, updated.ProjectID)
+		assert.Equal(t, project.ID, *updated.ProjectID)
+
+		_, err = updateUseCase.Execute(ownerCtx, task.ID, tasks.UpdateTaskInput{ProjectID: &foreignProject.ID})
+		assert.True(t, tasks_domain.IsInvalidTaskData(err))
+
+		cleared, err := updateUseCase.Execute(ownerCtx, task.ID, tasks.UpdateTaskInput{ClearProject: true})
+		require.NoError(t, err)
+		assert.Nil(t, cleared.ProjectID)
+	})
+}
@@ -0,0 +1,211 @@
+package application
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"crud/config"
+	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransitionTaskUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	transitionUseCase, err := tests.ResolveFromContainer[*tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	updateUseCase, err := tests.ResolveFromContainer[*tasks.UpdateTaskUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
+
+	t.Run("legal transition", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		task, err = transitionUseCase.Execute(ctx, task.ID, "in_progress")
+		require.NoError(t, err)
+		assert.Equal(t, "in_progress", task.Status.Value())
+
+		task, err = transitionUseCase.Execute(ctx, task.ID, "done")
+		require.NoError(t, err)
+		assert.Equal(t, "done", task.Status.Value())
+	})
+
+	t.Run("illegal transition", func(t *testing.T) {
+		task := tests.CreateTestTask(t, container, ctx, tasks.CreateTaskInput{Title: "Task", Status: "done"})
+
+		_, err = transitionUseCase.Execute(ctx, task.ID, "todo")
+		assert.True(t, tasks_domain.IsIllegalTransition(err))
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		_, err = transitionUseCase.Execute(ctx, task.ID, "archived")
+		assert.True(t, vo.IsInvalidStatus(err))
+	})
+
+	t.Run("start date not reached", func(t *testing.T) {
+		start := time.Now().Add(48 * time.Hour)
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo", StartDate: &start})
+		require.NoError(t, err)
+
+		_, err = transitionUseCase.Execute(ctx, task.ID, "in_progress")
+		assert.True(t, tasks_domain.IsTransitionGuardFailed(err))
+
+		// Переход без условий остается доступным
+		task, err = transitionUseCase.Execute(ctx, task.ID, "done")
+		require.NoError(t, err)
+		assert.Equal(t, "done", task.Status.Value())
+	})
+
+	t.Run("update applies workflow", func(t *testing.T) {
+		task := tests.CreateTestTask(t, container, ctx, tasks.CreateTaskInput{Title: "Task", Status: "done"})
+
+		newTitle := "Reopened"
+		newStatus := "todo"
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &newTitle, Status: &newStatus})
+		assert.True(t, tasks_domain.IsIllegalTransition(err))
+	})
+
+	t.Run("other user forbidden", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		otherID := tests.CreateTestUser(t, container, "other@example.com").ID
+		_, err = transitionUseCase.Execute(tests.ContextWithUser(context.Background(), otherID), task.ID, "in_progress")
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+}
+
+func TestTransitionTaskUseCase_CustomWorkflow(t *testing.T) {
+	defaultStatuses, defaultClosed := vo.AllowedStatuses(), vo.ClosedStatuses()
+	t.Cleanup(func() { require.NoError(t, vo.SetAllowedStatuses(defaultStatuses, defaultClosed)) })
+
+	path := filepath.Join(t.TempDir(), "workflow.json")
+	workflowJSON := `{
+		"statuses": ["todo", "review", "done"],
+		"closed": ["done"],
+		"transitions": [
+			{"from": "todo", "to": "review", "guards": ["has_description"]},
+			{"from": "review", "to": "done"},
+			{"from": "review", "to": "todo"}
+		]
+	}`
+	require.NoError(t, os.WriteFile(path, []byte(workflowJSON), 0o600))
+
+	container := tests.NewTestContainer()
+	err := container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.TaskWorkflowPath = path
+		return cfg
+	})
+	require.NoError(t, err)
+
+	workflow, err := tests.ResolveFromContainer[*tasks_domain.Workflow](container)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo", "review", "done"}, workflow.Statuses())
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	transitionUseCase, err := tests.ResolveFromContainer[*tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx := tests.ContextWithUser(context.Background(), userID)
+
+	task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "todo"})
+	require.NoError(t, err)
+
+	_, err = transitionUseCase.Execute(ctx, task.ID, "in_progress")
+	assert.True(t, vo.IsInvalidStatus(err))
+
+	_, err = transitionUseCase.Execute(ctx, task.ID, "done")
+	assert.True(t, tasks_domain.IsIllegalTransition(err))
+
+	_, err = transitionUseCase.Execute(ctx, task.ID, "review")
+	assert.True(t, tasks_domain.IsTransitionGuardFailed(err))
+
+	task, err = createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Description: "Details", Status: "todo"})
+	require.NoError(t, err)
+
+	task, err = transitionUseCase.Execute(ctx, task.ID, "review")
+	require.NoError(t, err)
+	assert.Equal(t, "review", task.Status.Value())
+}
+
+func TestCreateTaskUseCase_CustomInitialStatuses(t *testing.T) {
+	defaultStatuses, defaultClosed := vo.AllowedStatuses(), vo.ClosedStatuses()
+	t.Cleanup(func() { require.NoError(t, vo.SetAllowedStatuses(defaultStatuses, defaultClosed)) })
+
+	path := filepath.Join(t.TempDir(), "workflow.json")
+	workflowJSON := `{
+		"statuses": ["backlog", "todo", "done"],
+		"initial": ["backlog", "todo"],
+		"closed": ["done"],
+		"transitions": [
+			{"from": "backlog", "to": "todo"},
+			{"from": "todo", "to": "done"}
+		]
+	}`
+	require.NoError(t, os.WriteFile(path, []byte(workflowJSON), 0o600))
+
+	container := tests.NewTestContainer()
+	err := container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.TaskWorkflowPath = path
+		return cfg
+	})
+	require.NoError(t, err)
+
+	createUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx := tests.ContextWithUser(context.Background(), userID)
+
+	for _, status := range []string{"backlog", "todo"} {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: status})
+		require.NoError(t, err, "status: %s", status)
+		assert.Equal(t, status, task.Status.Value())
+	}
+
+	_, err = createUseCase.Execute(ctx, tasks.CreateTaskInput{Title: "Task", Status: "done"})
+	assert.True(t, vo.IsInvalidStatus(err))
+}
+
+func TestNewTaskWorkflow_StatusTooLong(t *testing.T) {
+	defaultStatuses, defaultClosed := vo.AllowedStatuses(), vo.ClosedStatuses()
+	t.Cleanup(func() { require.NoError(t, vo.SetAllowedStatuses(defaultStatuses, defaultClosed)) })
+
+	// Статус длиннее колонки tasks.status отклоняется при загрузке рабочего процесса
+	path := filepath.Join(t.TempDir(), "workflow.json")
+	workflowJSON := `{
+		"statuses": ["todo", "waiting_for_customer_reply", "done"],
+		"closed": ["done"],
+		"transitions": [{"from": "todo", "to": "done"}]
+	}`
+	require.NoError(t, os.WriteFile(path, []byte(workflowJSON), 0o600))
+
+	_, err := tasks.NewTaskWorkflow(&config.Config{TaskWorkflowPath: path}, tasks_domain.NewBuiltinTransitionGuards())
+	assert.True(t, vo.IsInvalidStatus(err))
+	assert.Equal(t, defaultStatuses, vo.AllowedStatuses())
+}
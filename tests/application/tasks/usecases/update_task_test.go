@@ -3,8 +3,10 @@ package application
 import (
 	"context"
 	"testing"
+	"time"
 
 	tasks "crud/internal/application/tasks/usecases"
+	auth_domain "crud/internal/domain/auth"
 	tasks_domain "crud/internal/domain/tasks"
 	vo "crud/internal/domain/tasks/value_objects"
 	"crud/tests"
@@ -29,14 +31,15 @@ func TestUpdateTaskUseCase_Execute(t *testing.T) {
 	getUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
 	require.NoError(t, err)
 
-	userID := uuid.New()
+	userID := tests.CreateTestUser(t, container, "owner@example.com").ID
+	ctx = tests.ContextWithUser(ctx, userID)
 
 	t.Run("update title", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Original Title", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Original Title", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		newTitle := "Updated Title"
-		updatedTask, err := updateUseCase.Execute(ctx, task.ID, &newTitle, nil, nil)
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &newTitle})
 		require.NoError(t, err)
 		assert.Equal(t, "Updated Title", updatedTask.Title.Value())
 		assert.Equal(t, "Description", updatedTask.Description)
@@ -44,11 +47,11 @@ func TestUpdateTaskUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("update description", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Title", "Original Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Original Description", Status: "todo"})
 		require.NoError(t, err)
 
 		newDescription := "Updated Description"
-		updatedTask, err := updateUseCase.Execute(ctx, task.ID, nil, &newDescription, nil)
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Description: &newDescription})
 		require.NoError(t, err)
 		assert.Equal(t, "Title", updatedTask.Title.Value())
 		assert.Equal(t, "Updated Description", updatedTask.Description)
@@ -56,11 +59,11 @@ func TestUpdateTaskUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("update status", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Title", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		newStatus := "in_progress"
-		updatedTask, err := updateUseCase.Execute(ctx, task.ID, nil, nil, &newStatus)
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Status: &newStatus})
 		require.NoError(t, err)
 		assert.Equal(t, "Title", updatedTask.Title.Value())
 		assert.Equal(t, "Description", updatedTask.Description)
@@ -68,13 +71,13 @@ func TestUpdateTaskUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("update all fields", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Title", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		newTitle := "New Title"
 		newDescription := "New Description"
 		newStatus := "done"
-		updatedTask, err := updateUseCase.Execute(ctx, task.ID, &newTitle, &newDescription, &newStatus)
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &newTitle, Description: &newDescription, Status: &newStatus})
 		require.NoError(t, err)
 		assert.Equal(t, "New Title", updatedTask.Title.Value())
 		assert.Equal(t, "New Description", updatedTask.Description)
@@ -89,27 +92,118 @@ func TestUpdateTaskUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("invalid title", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Title", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		invalidTitle := ""
-		_, err = updateUseCase.Execute(ctx, task.ID, &invalidTitle, nil, nil)
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &invalidTitle})
 		assert.True(t, vo.IsInvalidTitle(err))
 	})
 
 	t.Run("invalid status", func(t *testing.T) {
-		task, err := createUseCase.Execute(ctx, userID, "Title", "Description", "todo")
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Description", Status: "todo"})
 		require.NoError(t, err)
 
 		invalidStatus := "invalid_status"
-		_, err = updateUseCase.Execute(ctx, task.ID, nil, nil, &invalidStatus)
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Status: &invalidStatus})
 		assert.True(t, vo.IsInvalidStatus(err))
 	})
 
+	t.Run("task of another user is forbidden", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Description: "Description", Status: "todo"})
+		require.NoError(t, err)
+
+		otherCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		newTitle := "Hijacked"
+		_, err = updateUseCase.Execute(otherCtx, task.ID, tasks.UpdateTaskInput{Title: &newTitle})
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		retrievedTask, err := getUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Title", retrievedTask.Title.Value())
+	})
+
 	t.Run("task not found", func(t *testing.T) {
 		nonExistentID := uuid.New()
 		newTitle := "New Title"
-		_, err := updateUseCase.Execute(ctx, nonExistentID, &newTitle, nil, nil)
+		_, err := updateUseCase.Execute(ctx, nonExistentID, tasks.UpdateTaskInput{Title: &newTitle})
 		assert.True(t, tasks_domain.IsTaskNotFound(err))
 	})
+
+	t.Run("set and clear dates", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Status: "todo"})
+		require.NoError(t, err)
+
+		start := time.Now().UTC().Truncate(time.Second)
+		due := start.Add(24 * time.Hour)
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{StartDate: &start, DueDate: &due})
+		require.NoError(t, err)
+		assert.True(t, due.Equal(*updatedTask.Schedule.DueDate()))
+
+		// Срок выполнения не может оказаться раньше уже заданной даты начала
+		early := start.Add(-time.Hour)
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{DueDate: &early})
+		assert.True(t, vo.IsInvalidSchedule(err))
+
+		updatedTask, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{ClearDueDate: true})
+		require.NoError(t, err)
+		assert.Nil(t, updatedTask.Schedule.DueDate())
+		assert.NotNil(t, updatedTask.Schedule.StartDate())
+	})
+
+	t.Run("update priority", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Title", Status: "todo"})
+		require.NoError(t, err)
+
+		newPriority := "high"
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Priority: &newPriority})
+		require.NoError(t, err)
+		assert.Equal(t, "high", updatedTask.Priority.Value())
+
+		invalidPriority := "someday"
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Priority: &invalidPriority})
+		assert.True(t, vo.IsInvalidPriority(err))
+	})
+
+	t.Run("version increases on every update", func(t *testing.T) {
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Versioned", Status: "todo"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), task.Version)
+
+		title := "Versioned twice"
+		updatedTask, err := updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &title, Version: &task.Version})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), updatedTask.Version)
+
+		// Изменение по устаревшей версии отклоняется и ничего не меняет
+		stale := int64(1)
+		title = "Lost update"
+		_, err = updateUseCase.Execute(ctx, task.ID, tasks.UpdateTaskInput{Title: &title, Version: &stale})
+		assert.True(t, tasks_domain.IsConcurrentModification(err))
+
+		retrievedTask, err := getUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Versioned twice", retrievedTask.Title.Value())
+		assert.Equal(t, int64(2), retrievedTask.Version)
+	})
+
+	t.Run("repository rejects update of a stale copy", func(t *testing.T) {
+		repo, err := tests.ResolveFromContainer[tasks_domain.BaseTasksRepository](container)
+		require.NoError(t, err)
+
+		task, err := createUseCase.Execute(ctx, tasks.CreateTaskInput{UserID: userID, Title: "Contended", Status: "todo"})
+		require.NoError(t, err)
+
+		// Оба редактора прочитали задачу в версии 1
+		first, err := repo.GetByID(ctx, task.ID)
+		require.NoError(t, err)
+		second := *first
+
+		updated, err := repo.Update(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, first.Version+1, updated.Version)
+
+		_, err = repo.Update(ctx, &second)
+		assert.True(t, tasks_domain.IsConcurrentModification(err))
+	})
 }
@@ -0,0 +1,362 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"crud/config"
+	attachments "crud/internal/application/attachments/usecases"
+	audit "crud/internal/application/audit/usecases"
+	tasks "crud/internal/application/tasks/usecases"
+	trash "crud/internal/application/trash/usecases"
+	users "crud/internal/application/users/usecases"
+	attachments_domain "crud/internal/domain/attachments"
+	audit_domain "crud/internal/domain/audit"
+	auth_domain "crud/internal/domain/auth"
+	tasks_domain "crud/internal/domain/tasks"
+	users_domain "crud/internal/domain/users"
+	users_vo "crud/internal/domain/users/value_objects"
+	"crud/internal/infrastructure/database/repositories/dummy"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskTrash(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	getTaskUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
+	require.NoError(t, err)
+
+	deleteTaskUseCase, err := tests.ResolveFromContainer[*tasks.DeleteTaskUseCase](container)
+	require.NoError(t, err)
+
+	listDeletedUseCase, err := tests.ResolveFromContainer[*trash.ListDeletedTasksUseCase](container)
+	require.NoError(t, err)
+
+	restoreUseCase, err := tests.ResolveFromContainer[*trash.RestoreTaskUseCase](container)
+	require.NoError(t, err)
+
+	purgeUseCase, err := tests.ResolveFromContainer[*trash.PurgeTaskUseCase](container)
+	require.NoError(t, err)
+
+	historyUseCase, err := tests.ResolveFromContainer[*audit.ListTaskHistoryUseCase](container)
+	require.NoError(t, err)
+
+	ownerCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "trash-owner@example.com").ID)
+	otherCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "trash-other@example.com").ID)
+	adminCtx := tests.AdminContext(context.Background())
+
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Trashed", Status: "todo"})
+	require.NoError(t, err)
+	require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, task.ID))
+
+	t.Run("deleted task is hidden but listed in trash", func(t *testing.T) {
+		_, err := getTaskUseCase.Execute(ownerCtx, task.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+
+		list, total, err := listDeletedUseCase.Execute(ownerCtx, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, list, 1)
+		assert.Equal(t, task.ID, list[0].ID)
+		require.NotNil(t, list[0].DeletedAt)
+
+		// Участник видит в корзине только свои задачи
+		_, total, err = listDeletedUseCase.Execute(otherCtx, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("only owner can restore", func(t *testing.T) {
+		_, err := restoreUseCase.Execute(otherCtx, task.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		restored, err := restoreUseCase.Execute(ownerCtx, task.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+
+		_, err = getTaskUseCase.Execute(ownerCtx, task.ID)
+		require.NoError(t, err)
+
+		// Действующую задачу нельзя восстановить повторно
+		_, err = restoreUseCase.Execute(ownerCtx, task.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+
+		entries, _, err := historyUseCase.Execute(ownerCtx, task.ID, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, audit_domain.ActionRestore, entries[0].Action)
+	})
+
+	t.Run("subtask cannot be restored into a deleted parent", func(t *testing.T) {
+		parent, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Parent", Status: "todo"})
+		require.NoError(t, err)
+		child, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Child", Status: "todo", ParentID: &parent.ID})
+		require.NoError(t, err)
+
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, child.ID))
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, parent.ID))
+
+		_, err = restoreUseCase.Execute(ownerCtx, child.ID)
+		assert.True(t, tasks_domain.IsParentTaskNotFound(err))
+
+		_, err = restoreUseCase.Execute(ownerCtx, parent.ID)
+		require.NoError(t, err)
+		_, err = restoreUseCase.Execute(ownerCtx, child.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge requires permission", func(t *testing.T) {
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, task.ID))
+
+		_, err := purgeUseCase.Execute(ownerCtx, task.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		purged, err := purgeUseCase.Execute(adminCtx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), purged)
+
+		_, err = restoreUseCase.Execute(ownerCtx, task.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+
+		// Окончательное удаление тоже попадает в журнал
+		logUseCase, err := tests.ResolveFromContainer[*audit.ListAuditLogUseCase](container)
+		require.NoError(t, err)
+		entityType := audit_domain.EntityTask
+		entries, _, err := logUseCase.Execute(adminCtx, audit_domain.ListEntriesFilter{EntityType: &entityType, EntityID: &task.ID}, 1, 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, audit_domain.ActionPurge, entries[0].Action)
+	})
+
+	t.Run("purge removes deleted subtree", func(t *testing.T) {
+		parent, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Root", Status: "todo"})
+		require.NoError(t, err)
+		child, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Child", Status: "todo", ParentID: &parent.ID})
+		require.NoError(t, err)
+		grandchild, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Grandchild", Status: "todo", ParentID: &child.ID})
+		require.NoError(t, err)
+
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, grandchild.ID))
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, child.ID))
+		require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, parent.ID))
+
+		purged, err := purgeUseCase.Execute(adminCtx, parent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), purged)
+
+		_, total, err := listDeletedUseCase.Execute(ownerCtx, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+
+	t.Run("active task cannot be purged", func(t *testing.T) {
+		active, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Active", Status: "todo"})
+		require.NoError(t, err)
+
+		_, err = purgeUseCase.Execute(adminCtx, active.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+
+		_, err = getTaskUseCase.Execute(ownerCtx, active.ID)
+		require.NoError(t, err)
+	})
+}
+
+func TestPurgeTaskRemovesAttachments(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	deleteTaskUseCase, err := tests.ResolveFromContainer[*tasks.DeleteTaskUseCase](container)
+	require.NoError(t, err)
+
+	uploadUseCase, err := tests.ResolveFromContainer[*attachments.UploadAttachmentUseCase](container)
+	require.NoError(t, err)
+
+	purgeUseCase, err := tests.ResolveFromContainer[*trash.PurgeTaskUseCase](container)
+	require.NoError(t, err)
+
+	blobStorage, err := tests.ResolveFromContainer[attachments_domain.BaseBlobStorage](container)
+	require.NoError(t, err)
+	blobs := blobStorage.(*dummy.BlobStorage)
+
+	ownerCtx := tests.ContextWithUser(context.Background(), tests.CreateTestUser(t, container, "trash-files@example.com").ID)
+
+	task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "With files", Status: "todo"})
+	require.NoError(t, err)
+	_, err = uploadUseCase.Execute(ownerCtx, attachments.UploadAttachmentInput{
+		TaskID:      task.ID,
+		FileName:    "notes.txt",
+		ContentType: "text/plain",
+		Content:     strings.NewReader("kept until purge"),
+	})
+	require.NoError(t, err)
+
+	// Вложения задачи в корзине сохраняются
+	require.NoError(t, deleteTaskUseCase.Execute(ownerCtx, task.ID))
+	assert.Equal(t, 1, blobs.Len())
+
+	_, err = purgeUseCase.Execute(tests.AdminContext(context.Background()), task.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, blobs.Len())
+}
+
+func TestUserTrash(t *testing.T) {
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	registerUseCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	getUserUseCase, err := tests.ResolveFromContainer[*users.GetUserByIDUseCase](container)
+	require.NoError(t, err)
+
+	deleteUserUseCase, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+	require.NoError(t, err)
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	transitionTaskUseCase, err := tests.ResolveFromContainer[*tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	listDeletedUseCase, err := tests.ResolveFromContainer[*trash.ListDeletedUsersUseCase](container)
+	require.NoError(t, err)
+
+	restoreUseCase, err := tests.ResolveFromContainer[*trash.RestoreUserUseCase](container)
+	require.NoError(t, err)
+
+	purgeUseCase, err := tests.ResolveFromContainer[*trash.PurgeUserUseCase](container)
+	require.NoError(t, err)
+
+	adminCtx := tests.AdminContext(context.Background())
+	memberCtx := tests.ContextWithRole(context.Background(), uuid.New(), users_vo.RoleMember)
+
+	user := tests.CreateTestUser(t, container, "trashed-user@example.com")
+	require.NoError(t, deleteUserUseCase.Execute(adminCtx, user.ID, nil))
+
+	t.Run("deleted user is hidden but keeps email", func(t *testing.T) {
+		_, err := getUserUseCase.Execute(adminCtx, user.ID)
+		assert.True(t, users_domain.IsUserNotFound(err))
+
+		_, err = registerUseCase.Execute(tests.DefaultOrganizationContext(context.Background()), "trashed-user@example.com", "Copy", "password123")
+		assert.True(t, users_domain.IsUserAlreadyExists(err))
+
+		list, total, err := listDeletedUseCase.Execute(adminCtx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		require.Len(t, list, 1)
+		assert.Equal(t, user.ID, list[0].ID)
+
+		_, _, err = listDeletedUseCase.Execute(memberCtx, 1, 10)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		_, err := restoreUseCase.Execute(memberCtx, user.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		restored, err := restoreUseCase.Execute(adminCtx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, user.ID, restored.ID)
+
+		_, err = getUserUseCase.Execute(adminCtx, user.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("purge is blocked by owned tasks", func(t *testing.T) {
+		ownerCtx := tests.ContextWithUser(context.Background(), user.ID)
+		task, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Finished", Status: "todo"})
+		require.NoError(t, err)
+		_, err = transitionTaskUseCase.Execute(ownerCtx, task.ID, "done")
+		require.NoError(t, err)
+
+		// Политика restrict разрешает удалить пользователя только с завершенными задачами
+		require.NoError(t, deleteUserUseCase.Execute(adminCtx, user.ID, nil))
+
+		err = purgeUseCase.Execute(adminCtx, user.ID)
+		assert.True(t, users_domain.IsUserInUse(err))
+	})
+
+	t.Run("purge requires permission", func(t *testing.T) {
+		other := tests.CreateTestUser(t, container, "purged-user@example.com")
+		require.NoError(t, deleteUserUseCase.Execute(adminCtx, other.ID, nil))
+
+		err := purgeUseCase.Execute(memberCtx, other.ID)
+		assert.True(t, auth_domain.IsForbidden(err))
+
+		require.NoError(t, purgeUseCase.Execute(adminCtx, other.ID))
+
+		_, err = restoreUseCase.Execute(adminCtx, other.ID)
+		assert.True(t, users_domain.IsUserNotFound(err))
+
+		// После окончательного удаления email снова свободен
+		_, err = registerUseCase.Execute(tests.DefaultOrganizationContext(context.Background()), "purged-user@example.com", "Again", "password123")
+		require.NoError(t, err)
+	})
+}
+
+func TestPurgeExpiredTrash(t *testing.T) {
+	// Создаем новый контейнер для теста со сроком хранения в корзине 24 часа
+	container := tests.NewTestContainer()
+	require.NoError(t, container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.TrashRetention = 24 * time.Hour
+		cfg.UserDeletionPolicy = "cascade"
+		return cfg
+	}))
+
+	createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+
+	deleteUserUseCase, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+	require.NoError(t, err)
+
+	listDeletedTasksUseCase, err := tests.ResolveFromContainer[*trash.ListDeletedTasksUseCase](container)
+	require.NoError(t, err)
+
+	purgeExpiredUseCase, err := tests.ResolveFromContainer[*trash.PurgeExpiredTrashUseCase](container)
+	require.NoError(t, err)
+
+	adminCtx := tests.AdminContext(context.Background())
+
+	user := tests.CreateTestUser(t, container, "expired@example.com")
+	ownerCtx := tests.ContextWithUser(context.Background(), user.ID)
+	parent, err := createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Parent", Status: "todo"})
+	require.NoError(t, err)
+	_, err = createTaskUseCase.Execute(ownerCtx, tasks.CreateTaskInput{Title: "Child", Status: "todo", ParentID: &parent.ID})
+	require.NoError(t, err)
+
+	// Политика cascade перемещает в корзину пользователя вместе с его задачами
+	require.NoError(t, deleteUserUseCase.Execute(adminCtx, user.ID, nil))
+
+	t.Run("fresh items are kept", func(t *testing.T) {
+		result, err := purgeExpiredUseCase.Execute(context.Background(), time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, trash.PurgeExpiredTrashResult{}, result)
+
+		_, total, err := listDeletedTasksUseCase.Execute(adminCtx, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), total)
+	})
+
+	t.Run("expired items are purged", func(t *testing.T) {
+		result, err := purgeExpiredUseCase.Execute(context.Background(), time.Now().Add(25*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, trash.PurgeExpiredTrashResult{Tasks: 2, Users: 1}, result)
+
+		_, total, err := listDeletedTasksUseCase.Execute(adminCtx, nil, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), total)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
 	users_domain "crud/internal/domain/users"
 	vo "crud/internal/domain/users/value_objects"
 	"crud/tests"
@@ -15,7 +16,7 @@ import (
 )
 
 func TestCreateUserUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -25,7 +26,7 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("successful creation", func(t *testing.T) {
-		user, err := useCase.Execute(ctx, "test@example.com", "Test User")
+		user, err := useCase.Execute(ctx, "test@example.com", "Test User", "password123", "")
 		require.NoError(t, err)
 		assert.NotNil(t, user)
 		assert.Equal(t, "test@example.com", user.Email.Value())
@@ -36,30 +37,68 @@ func TestCreateUserUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("invalid email", func(t *testing.T) {
-		user, err := useCase.Execute(ctx, "invalid-email", "Test User")
+		user, err := useCase.Execute(ctx, "invalid-email", "Test User", "password123", "")
 		assert.Nil(t, user)
 		assert.True(t, vo.IsInvalidEmail(err))
 	})
 
 	t.Run("empty email", func(t *testing.T) {
-		user, err := useCase.Execute(ctx, "", "Test User")
+		user, err := useCase.Execute(ctx, "", "Test User", "password123", "")
 		assert.Nil(t, user)
 		assert.True(t, vo.IsInvalidEmail(err))
 	})
 
 	t.Run("empty name", func(t *testing.T) {
-		user, err := useCase.Execute(ctx, "test@example.com", "")
+		user, err := useCase.Execute(ctx, "test@example.com", "", "password123", "")
 		assert.Nil(t, user)
 		assert.True(t, vo.IsInvalidName(err))
 	})
 
+	t.Run("short password", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "short@example.com", "Test User", "short", "")
+		assert.Nil(t, user)
+		assert.True(t, vo.IsInvalidPassword(err))
+	})
+
+	t.Run("password is stored hashed", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "hashed@example.com", "Test User", "password123", "")
+		require.NoError(t, err)
+		assert.True(t, user.HasPassword())
+		assert.NotEqual(t, "password123", user.PasswordHash)
+	})
+
+	t.Run("explicit role", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "viewer@example.com", "Viewer User", "password123", "viewer")
+		require.NoError(t, err)
+		assert.Equal(t, "viewer", user.Role.Value())
+	})
+
+	t.Run("default role", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "member@example.com", "Member User", "password123", "")
+		require.NoError(t, err)
+		assert.Equal(t, "member", user.Role.Value())
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "superuser@example.com", "Super User", "password123", "superuser")
+		assert.Nil(t, user)
+		assert.True(t, vo.IsInvalidRole(err))
+	})
+
+	t.Run("member cannot create users", func(t *testing.T) {
+		memberCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		user, err := useCase.Execute(memberCtx, "forbidden@example.com", "Forbidden User", "password123", "")
+		assert.Nil(t, user)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
 	t.Run("duplicate email", func(t *testing.T) {
 		email := "duplicate@example.com"
-		user1, err := useCase.Execute(ctx, email, "User 1")
+		user1, err := useCase.Execute(ctx, email, "User 1", "password123", "")
 		require.NoError(t, err)
 		require.NotNil(t, user1)
 
-		user2, err := useCase.Execute(ctx, email, "User 2")
+		user2, err := useCase.Execute(ctx, email, "User 2", "password123", "")
 		assert.Nil(t, user2)
 		assert.True(t, users_domain.IsUserAlreadyExists(err))
 	})
@@ -4,17 +4,37 @@ import (
 	"context"
 	"testing"
 
+	"crud/config"
+	audit "crud/internal/application/audit/usecases"
+	projects "crud/internal/application/projects/usecases"
+	tasks "crud/internal/application/tasks/usecases"
 	users "crud/internal/application/users/usecases"
+	audit_domain "crud/internal/domain/audit"
+	auth_domain "crud/internal/domain/auth"
+	projects_domain "crud/internal/domain/projects"
+	tasks_domain "crud/internal/domain/tasks"
 	users_domain "crud/internal/domain/users"
 	"crud/tests"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
 )
 
+// newContainerWithDeletionPolicy создает тестовый контейнер с заданной политикой удаления пользователей
+func newContainerWithDeletionPolicy(t *testing.T, policy string) *dig.Container {
+	container := tests.NewTestContainer()
+	err := container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.UserDeletionPolicy = policy
+		return cfg
+	})
+	require.NoError(t, err)
+	return container
+}
+
 func TestDeleteUserUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -29,10 +49,10 @@ func TestDeleteUserUseCase_Execute(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("successful deletion", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "delete-test@example.com", "User to Delete")
+		user, err := createUseCase.Execute(ctx, "delete-test@example.com", "User to Delete", "password123", "")
 		require.NoError(t, err)
 
-		err = deleteUseCase.Execute(ctx, user.ID)
+		err = deleteUseCase.Execute(ctx, user.ID, nil)
 		require.NoError(t, err)
 
 		// Проверяем, что пользователь удален
@@ -40,9 +60,151 @@ func TestDeleteUserUseCase_Execute(t *testing.T) {
 		assert.True(t, users_domain.IsUserNotFound(err))
 	})
 
+	t.Run("member cannot delete users", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "keep@example.com", "User to Keep", "password123", "")
+		require.NoError(t, err)
+
+		memberCtx := tests.ContextWithUser(context.Background(), user.ID)
+		err = deleteUseCase.Execute(memberCtx, user.ID, nil)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
 	t.Run("user not found", func(t *testing.T) {
 		nonExistentID := uuid.New()
-		err := deleteUseCase.Execute(ctx, nonExistentID)
+		err := deleteUseCase.Execute(ctx, nonExistentID, nil)
 		assert.True(t, users_domain.IsUserNotFound(err))
 	})
+
+	t.Run("reassign_to is rejected by other policies", func(t *testing.T) {
+		user := tests.CreateTestUser(t, container, "no-reassign@example.com")
+		target := tests.CreateTestUser(t, container, "no-reassign-target@example.com")
+
+		err := deleteUseCase.Execute(ctx, user.ID, &target.ID)
+		assert.True(t, users_domain.IsInvalidUserData(err))
+	})
+}
+
+func TestDeleteUserUseCase_DeletionPolicies(t *testing.T) {
+	ctx := tests.AdminContext(context.Background())
+
+	t.Run("restrict refuses while open tasks remain", func(t *testing.T) {
+		container := newContainerWithDeletionPolicy(t, "restrict")
+		deleteUseCase, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+		require.NoError(t, err)
+		updateTaskUseCase, err := tests.ResolveFromContainer[*tasks.UpdateTaskUseCase](container)
+		require.NoError(t, err)
+
+		user := tests.CreateTestUser(t, container, "restrict@example.com")
+		userCtx := tests.ContextWithUser(context.Background(), user.ID)
+		task := tests.CreateTestTask(t, container, userCtx, tasks.CreateTaskInput{UserID: user.ID, Title: "Open Task", Status: "in_progress"})
+
+		err = deleteUseCase.Execute(ctx, user.ID, nil)
+		assert.True(t, users_domain.IsUserHasOpenTasks(err))
+
+		// После завершения задачи пользователя можно удалить
+		done := "done"
+		_, err = updateTaskUseCase.Execute(userCtx, task.ID, tasks.UpdateTaskInput{Status: &done})
+		require.NoError(t, err)
+
+		err = deleteUseCase.Execute(ctx, user.ID, nil)
+		require.NoError(t, err)
+	})
+
+	t.Run("cascade deletes tasks and projects", func(t *testing.T) {
+		container := newContainerWithDeletionPolicy(t, "cascade")
+		deleteUseCase, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+		require.NoError(t, err)
+		createProjectUseCase, err := tests.ResolveFromContainer[*projects.CreateProjectUseCase](container)
+		require.NoError(t, err)
+		getProjectUseCase, err := tests.ResolveFromContainer[*projects.GetProjectByIDUseCase](container)
+		require.NoError(t, err)
+		createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+		require.NoError(t, err)
+		getTaskUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
+		require.NoError(t, err)
+		listAuditLogUseCase, err := tests.ResolveFromContainer[*audit.ListAuditLogUseCase](container)
+		require.NoError(t, err)
+
+		user := tests.CreateTestUser(t, container, "cascade@example.com")
+		userCtx := tests.ContextWithUser(context.Background(), user.ID)
+		project, err := createProjectUseCase.Execute(userCtx, projects.CreateProjectInput{Name: "Project"})
+		require.NoError(t, err)
+		task, err := createTaskUseCase.Execute(userCtx, tasks.CreateTaskInput{UserID: user.ID, ProjectID: &project.ID, Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		err = deleteUseCase.Execute(ctx, user.ID, nil)
+		require.NoError(t, err)
+
+		_, err = getTaskUseCase.Execute(ctx, task.ID)
+		assert.True(t, tasks_domain.IsTaskNotFound(err))
+		_, err = getProjectUseCase.Execute(ctx, project.ID)
+		assert.True(t, projects_domain.IsProjectNotFound(err))
+
+		// Удаление задачи вместе с владельцем попадает в ее историю
+		entityType := audit_domain.EntityTask
+		history, _, err := listAuditLogUseCase.Execute(ctx, audit_domain.ListEntriesFilter{EntityType: &entityType, EntityID: &task.ID}, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, audit_domain.ActionDelete, history[0].Action)
+		assert.Contains(t, history[0].Changes, audit_domain.FieldChange{Field: "title", Old: "Task"})
+	})
+
+	t.Run("reassign transfers tasks and projects", func(t *testing.T) {
+		container := newContainerWithDeletionPolicy(t, "reassign")
+		deleteUseCase, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+		require.NoError(t, err)
+		createProjectUseCase, err := tests.ResolveFromContainer[*projects.CreateProjectUseCase](container)
+		require.NoError(t, err)
+		getProjectUseCase, err := tests.ResolveFromContainer[*projects.GetProjectByIDUseCase](container)
+		require.NoError(t, err)
+		createTaskUseCase, err := tests.ResolveFromContainer[*tasks.CreateTaskUseCase](container)
+		require.NoError(t, err)
+		getTaskUseCase, err := tests.ResolveFromContainer[*tasks.GetTaskByIDUseCase](container)
+		require.NoError(t, err)
+		listTaskHistoryUseCase, err := tests.ResolveFromContainer[*audit.ListTaskHistoryUseCase](container)
+		require.NoError(t, err)
+
+		user := tests.CreateTestUser(t, container, "reassign@example.com")
+		target := tests.CreateTestUser(t, container, "reassign-target@example.com")
+		userCtx := tests.ContextWithUser(context.Background(), user.ID)
+		project, err := createProjectUseCase.Execute(userCtx, projects.CreateProjectInput{Name: "Project"})
+		require.NoError(t, err)
+		task, err := createTaskUseCase.Execute(userCtx, tasks.CreateTaskInput{UserID: user.ID, ProjectID: &project.ID, Title: "Task", Status: "todo"})
+		require.NoError(t, err)
+
+		// Без получателя удаление невозможно
+		err = deleteUseCase.Execute(ctx, user.ID, nil)
+		assert.True(t, users_domain.IsInvalidUserData(err))
+
+		// Получатель должен существовать и отличаться от удаляемого пользователя
+		missing := uuid.New()
+		err = deleteUseCase.Execute(ctx, user.ID, &missing)
+		assert.True(t, users_domain.IsInvalidUserData(err))
+		err = deleteUseCase.Execute(ctx, user.ID, &user.ID)
+		assert.True(t, users_domain.IsInvalidUserData(err))
+
+		err = deleteUseCase.Execute(ctx, user.ID, &target.ID)
+		require.NoError(t, err)
+
+		reassigned, err := getTaskUseCase.Execute(ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, reassigned.UserID)
+
+		// Передача задачи записывается в ее историю как смена владельца
+		history, _, err := listTaskHistoryUseCase.Execute(ctx, task.ID, 1, 10)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, audit_domain.ActionUpdate, history[0].Action)
+		assert.Equal(t, []audit_domain.FieldChange{{Field: "user_id", Old: user.ID.String(), New: target.ID.String()}}, history[0].Changes)
+
+		reassignedProject, err := getProjectUseCase.Execute(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, reassignedProject.UserID)
+	})
+
+	t.Run("unknown policy fails to resolve", func(t *testing.T) {
+		container := newContainerWithDeletionPolicy(t, "orphan")
+		_, err := tests.ResolveFromContainer[*users.DeleteUserUseCase](container)
+		assert.Error(t, err)
+	})
 }
@@ -0,0 +1,32 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	users "crud/internal/application/users/usecases"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnsureAdminUseCase_Execute(t *testing.T) {
+	ctx := context.Background()
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	useCase, err := tests.ResolveFromContainer[*users.EnsureAdminUseCase](container)
+	require.NoError(t, err)
+
+	admin, err := useCase.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, tests.TestAdminEmail, admin.Email.Value())
+	assert.True(t, admin.Role.IsAdmin())
+
+	// Повторный вызов не создает дубликат
+	again, err := useCase.Execute(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, admin.ID, again.ID)
+}
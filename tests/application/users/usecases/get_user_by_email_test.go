@@ -13,7 +13,7 @@ import (
 )
 
 func TestGetUserByEmailUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -26,7 +26,7 @@ func TestGetUserByEmailUseCase_Execute(t *testing.T) {
 
 	t.Run("successful retrieval", func(t *testing.T) {
 		email := "getbyemail-test@example.com"
-		createdUser, err := createUseCase.Execute(ctx, email, "Test User")
+		createdUser, err := createUseCase.Execute(ctx, email, "Test User", "password123", "")
 		require.NoError(t, err)
 		require.NotNil(t, createdUser)
 
@@ -14,7 +14,7 @@ import (
 )
 
 func TestGetUserByIDUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -29,7 +29,7 @@ func TestGetUserByIDUseCase_Execute(t *testing.T) {
 	t.Run("successful retrieval", func(t *testing.T) {
 		// Создаем пользователя с уникальным email
 		email := "getbyid-test@example.com"
-		createdUser, err := createUseCase.Execute(ctx, email, "Test User")
+		createdUser, err := createUseCase.Execute(ctx, email, "Test User", "password123", "")
 		require.NoError(t, err)
 		require.NotNil(t, createdUser)
 
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	users "crud/internal/application/users/usecases"
+	users_domain "crud/internal/domain/users"
 	"crud/tests"
 
 	"github.com/stretchr/testify/assert"
@@ -12,7 +13,7 @@ import (
 )
 
 func TestListUsersUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -24,13 +25,13 @@ func TestListUsersUseCase_Execute(t *testing.T) {
 	require.NoError(t, err)
 
 	// Создаем несколько пользователей
-	_, err = createUseCase.Execute(ctx, "user1@example.com", "User 1")
+	_, err = createUseCase.Execute(ctx, "user1@example.com", "User 1", "password123", "")
 	require.NoError(t, err)
 
-	_, err = createUseCase.Execute(ctx, "user2@example.com", "User 2")
+	_, err = createUseCase.Execute(ctx, "user2@example.com", "User 2", "password123", "")
 	require.NoError(t, err)
 
-	_, err = createUseCase.Execute(ctx, "user3@example.com", "User 3")
+	_, err = createUseCase.Execute(ctx, "user3@example.com", "User 3", "password123", "")
 	require.NoError(t, err)
 
 	t.Run("list all users", func(t *testing.T) {
@@ -61,3 +62,59 @@ func TestListUsersUseCase_Execute(t *testing.T) {
 		assert.Len(t, result, 0)
 	})
 }
+
+func TestListUsersByCursorUseCase_Execute(t *testing.T) {
+	ctx := tests.AdminContext(context.Background())
+
+	container := tests.NewTestContainer()
+
+	createUseCase, err := tests.ResolveFromContainer[*users.CreateUserUseCase](container)
+	require.NoError(t, err)
+
+	listUseCase, err := tests.ResolveFromContainer[*users.ListUsersUseCase](container)
+	require.NoError(t, err)
+
+	cursorUseCase, err := tests.ResolveFromContainer[*users.ListUsersByCursorUseCase](container)
+	require.NoError(t, err)
+
+	for _, email := range []string{"user1@example.com", "user2@example.com", "user3@example.com"} {
+		_, err = createUseCase.Execute(ctx, email, "User", "password123", "")
+		require.NoError(t, err)
+	}
+
+	expected, _, err := listUseCase.Execute(ctx, 1, 10)
+	require.NoError(t, err)
+
+	t.Run("walk forward and back", func(t *testing.T) {
+		first, err := cursorUseCase.Execute(ctx, nil, 2)
+		require.NoError(t, err)
+		require.Len(t, first.Users, 2)
+		assert.Equal(t, expected[0].ID, first.Users[0].ID)
+		assert.Equal(t, expected[1].ID, first.Users[1].ID)
+		assert.Nil(t, first.Prev)
+		require.NotNil(t, first.Next)
+
+		second, err := cursorUseCase.Execute(ctx, first.Next, 2)
+		require.NoError(t, err)
+		require.Len(t, second.Users, 1)
+		assert.Equal(t, expected[2].ID, second.Users[0].ID)
+		assert.Nil(t, second.Next)
+		require.NotNil(t, second.Prev)
+
+		back, err := cursorUseCase.Execute(ctx, second.Prev, 2)
+		require.NoError(t, err)
+		require.Len(t, back.Users, 2)
+		assert.Equal(t, expected[0].ID, back.Users[0].ID)
+		assert.Equal(t, expected[1].ID, back.Users[1].ID)
+		assert.Nil(t, back.Prev)
+		assert.NotNil(t, back.Next)
+	})
+
+	t.Run("past the end", func(t *testing.T) {
+		page, err := cursorUseCase.Execute(ctx, users_domain.NewUserCursor(expected[2], false), 2)
+		require.NoError(t, err)
+		assert.Empty(t, page.Users)
+		assert.Nil(t, page.Next)
+		assert.Nil(t, page.Prev)
+	})
+}
@@ -0,0 +1,42 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	users "crud/internal/application/users/usecases"
+	users_domain "crud/internal/domain/users"
+	vo "crud/internal/domain/users/value_objects"
+	"crud/tests"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisterUserUseCase_Execute(t *testing.T) {
+	ctx := tests.DefaultOrganizationContext(context.Background())
+
+	// Создаем новый контейнер для теста
+	container := tests.NewTestContainer()
+
+	useCase, err := tests.ResolveFromContainer[*users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	t.Run("registered user is a member", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "register@example.com", "Registered User", "password123")
+		require.NoError(t, err)
+		assert.Equal(t, "member", user.Role.Value())
+		assert.True(t, user.HasPassword())
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		user, err := useCase.Execute(ctx, "weak@example.com", "Weak User", "short")
+		assert.Nil(t, user)
+		assert.True(t, vo.IsInvalidPassword(err))
+	})
+
+	t.Run("duplicate email", func(t *testing.T) {
+		_, err := useCase.Execute(ctx, "register@example.com", "Another User", "password123")
+		assert.True(t, users_domain.IsUserAlreadyExists(err))
+	})
+}
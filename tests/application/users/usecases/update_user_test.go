@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	users "crud/internal/application/users/usecases"
+	auth_domain "crud/internal/domain/auth"
 	users_domain "crud/internal/domain/users"
 	vo "crud/internal/domain/users/value_objects"
 	"crud/tests"
@@ -15,7 +16,7 @@ import (
 )
 
 func TestUpdateUserUseCase_Execute(t *testing.T) {
-	ctx := context.Background()
+	ctx := tests.AdminContext(context.Background())
 
 	// Создаем новый контейнер для теста
 	container := tests.NewTestContainer()
@@ -30,34 +31,34 @@ func TestUpdateUserUseCase_Execute(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("update email", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "original@example.com", "Original Name")
+		user, err := createUseCase.Execute(ctx, "original@example.com", "Original Name", "password123", "")
 		require.NoError(t, err)
 
 		newEmail := "updated@example.com"
-		updatedUser, err := updateUseCase.Execute(ctx, user.ID, &newEmail, nil)
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, &newEmail, nil, nil, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, "updated@example.com", updatedUser.Email.Value())
 		assert.Equal(t, "Original Name", updatedUser.Name.Value())
 	})
 
 	t.Run("update name", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "name-test@example.com", "Original Name")
+		user, err := createUseCase.Execute(ctx, "name-test@example.com", "Original Name", "password123", "")
 		require.NoError(t, err)
 
 		newName := "Updated Name"
-		updatedUser, err := updateUseCase.Execute(ctx, user.ID, nil, &newName)
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, nil, &newName, nil, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, "name-test@example.com", updatedUser.Email.Value())
 		assert.Equal(t, "Updated Name", updatedUser.Name.Value())
 	})
 
 	t.Run("update all fields", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "all-test@example.com", "Original Name")
+		user, err := createUseCase.Execute(ctx, "all-test@example.com", "Original Name", "password123", "")
 		require.NoError(t, err)
 
 		newEmail := "all-updated@example.com"
 		newName := "Updated Name"
-		updatedUser, err := updateUseCase.Execute(ctx, user.ID, &newEmail, &newName)
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, &newEmail, &newName, nil, nil, nil)
 		require.NoError(t, err)
 		assert.Equal(t, "all-updated@example.com", updatedUser.Email.Value())
 		assert.Equal(t, "Updated Name", updatedUser.Name.Value())
@@ -70,27 +71,107 @@ func TestUpdateUserUseCase_Execute(t *testing.T) {
 	})
 
 	t.Run("invalid email", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "invalid-test@example.com", "Name")
+		user, err := createUseCase.Execute(ctx, "invalid-test@example.com", "Name", "password123", "")
 		require.NoError(t, err)
 
 		invalidEmail := "invalid-email"
-		_, err = updateUseCase.Execute(ctx, user.ID, &invalidEmail, nil)
+		_, err = updateUseCase.Execute(ctx, user.ID, &invalidEmail, nil, nil, nil, nil)
 		assert.True(t, vo.IsInvalidEmail(err))
 	})
 
 	t.Run("invalid name", func(t *testing.T) {
-		user, err := createUseCase.Execute(ctx, "invalid-name-test@example.com", "Name")
+		user, err := createUseCase.Execute(ctx, "invalid-name-test@example.com", "Name", "password123", "")
 		require.NoError(t, err)
 
 		invalidName := ""
-		_, err = updateUseCase.Execute(ctx, user.ID, nil, &invalidName)
+		_, err = updateUseCase.Execute(ctx, user.ID, nil, &invalidName, nil, nil, nil)
 		assert.True(t, vo.IsInvalidName(err))
 	})
 
+	t.Run("update password", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "password-test@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+		oldHash := user.PasswordHash
+
+		newPassword := "new-password456"
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, nil, nil, &newPassword, nil, nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, oldHash, updatedUser.PasswordHash)
+	})
+
+	t.Run("invalid password", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "invalid-password-test@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		invalidPassword := "short"
+		_, err = updateUseCase.Execute(ctx, user.ID, nil, nil, &invalidPassword, nil, nil)
+		assert.True(t, vo.IsInvalidPassword(err))
+	})
+
+	t.Run("admin changes role", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "promote@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		newRole := "admin"
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, nil, nil, nil, &newRole, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "admin", updatedUser.Role.Value())
+	})
+
+	t.Run("member updates own profile", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "self@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		selfCtx := tests.ContextWithUser(context.Background(), user.ID)
+		newName := "Self Updated"
+		updatedUser, err := updateUseCase.Execute(selfCtx, user.ID, nil, &newName, nil, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "Self Updated", updatedUser.Name.Value())
+	})
+
+	t.Run("member cannot change own role", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "escalate@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		selfCtx := tests.ContextWithUser(context.Background(), user.ID)
+		newRole := "admin"
+		_, err = updateUseCase.Execute(selfCtx, user.ID, nil, nil, nil, &newRole, nil)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("member cannot update another user", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "victim@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		otherCtx := tests.ContextWithUser(context.Background(), uuid.New())
+		newName := "Hijacked"
+		_, err = updateUseCase.Execute(otherCtx, user.ID, nil, &newName, nil, nil, nil)
+		assert.True(t, auth_domain.IsForbidden(err))
+	})
+
+	t.Run("stale version", func(t *testing.T) {
+		user, err := createUseCase.Execute(ctx, "versioned@example.com", "Name", "password123", "")
+		require.NoError(t, err)
+
+		newName := "First"
+		updatedUser, err := updateUseCase.Execute(ctx, user.ID, nil, &newName, nil, nil, &user.Version)
+		require.NoError(t, err)
+		assert.Equal(t, user.Version+1, updatedUser.Version)
+
+		// Второй редактор тоже прочитал первую версию
+		newName = "Second"
+		_, err = updateUseCase.Execute(ctx, user.ID, nil, &newName, nil, nil, &user.Version)
+		assert.True(t, users_domain.IsConcurrentModification(err))
+
+		retrievedUser, err := getUseCase.Execute(ctx, user.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "First", retrievedUser.Name.Value())
+	})
+
 	t.Run("user not found", func(t *testing.T) {
 		nonExistentID := uuid.New()
 		newEmail := "new@example.com"
-		_, err := updateUseCase.Execute(ctx, nonExistentID, &newEmail, nil)
+		_, err := updateUseCase.Execute(ctx, nonExistentID, &newEmail, nil, nil, nil, nil)
 		assert.True(t, users_domain.IsUserNotFound(err))
 	})
 }
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"crud/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfig(t *testing.T) {
+	t.Run("missing jwt secret", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", "")
+
+		cfg, err := config.NewConfig()
+		require.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "JWT_SECRET is not set")
+	})
+
+	t.Run("short jwt secret", func(t *testing.T) {
+		t.Setenv("JWT_SECRET", strings.Repeat("x", config.MinJWTSecretLength-1))
+
+		cfg, err := config.NewConfig()
+		require.Error(t, err)
+		assert.Nil(t, cfg)
+		assert.Contains(t, err.Error(), "at least 32 bytes")
+	})
+
+	t.Run("valid jwt secret", func(t *testing.T) {
+		secret := strings.Repeat("x", config.MinJWTSecretLength)
+		t.Setenv("JWT_SECRET", secret)
+
+		cfg, err := config.NewConfig()
+		require.NoError(t, err)
+		assert.Equal(t, secret, cfg.JWTSecret)
+	})
+}
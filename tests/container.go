@@ -2,15 +2,42 @@ package tests
 
 import (
 	"crud/config"
+	application_attachments "crud/internal/application/attachments/usecases"
+	application_audit "crud/internal/application/audit/usecases"
+	application_auth "crud/internal/application/auth/usecases"
+	application_comments "crud/internal/application/comments/usecases"
+	"crud/internal/application/common"
+	application_labels "crud/internal/application/labels/usecases"
+	application_organizations "crud/internal/application/organizations/usecases"
+	"crud/internal/application/policies"
+	application_projects "crud/internal/application/projects/usecases"
 	application_tasks "crud/internal/application/tasks/usecases"
+	application_trash "crud/internal/application/trash/usecases"
 	application_users "crud/internal/application/users/usecases"
+	"crud/internal/domain/attachments"
+	"crud/internal/domain/audit"
+	"crud/internal/domain/auth"
+	"crud/internal/domain/comments"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/projects"
 	"crud/internal/domain/tasks"
 	"crud/internal/domain/users"
+	auth_infrastructure "crud/internal/infrastructure/auth"
 	"crud/internal/infrastructure/database/repositories/dummy"
 
 	"go.uber.org/dig"
 )
 
+const (
+	// TestAdminEmail email администратора, создаваемого EnsureAdminUseCase в тестах
+	TestAdminEmail = "admin@example.com"
+	// TestAdminPassword пароль тестового администратора
+	TestAdminPassword = "admin-password"
+	// TestJWTSecret ключ подписи токенов в тестах
+	TestJWTSecret = "test-jwt-secret-at-least-32-bytes-long"
+)
+
 // NewTestContainer создает новый тестовый контейнер зависимостей для каждого теста
 func NewTestContainer() *dig.Container {
 	c := dig.New()
@@ -20,25 +47,115 @@ func NewTestContainer() *dig.Container {
 
 // initTestContainer регистрирует все зависимости в тестовом контейнере
 func initTestContainer(c *dig.Container) {
-	// Регистрируем конфиг
-	c.Provide(config.NewConfig)
+	// Регистрируем конфиг с тестовым администратором и ключом подписи токенов
+	c.Provide(func() *config.Config {
+		cfg := config.Load()
+		cfg.JWTSecret = TestJWTSecret
+		cfg.AdminEmail = TestAdminEmail
+		cfg.AdminName = "Test Admin"
+		cfg.AdminPassword = TestAdminPassword
+		return cfg
+	})
 
 	// Регистрируем in-memory репозитории
+	c.Provide(dummy.NewOrganizationsRepository, dig.As(new(organizations.BaseOrganizationsRepository)))
 	c.Provide(dummy.NewTasksRepository, dig.As(new(tasks.BaseTasksRepository)))
+	c.Provide(dummy.NewProjectsRepository, dig.As(new(projects.BaseProjectsRepository)))
 	c.Provide(dummy.NewUsersRepository, dig.As(new(users.BaseUsersRepository)))
+	c.Provide(dummy.NewLabelsRepository, dig.As(new(labels.BaseLabelsRepository)))
+	c.Provide(dummy.NewCommentsRepository, dig.As(new(comments.BaseCommentsRepository)))
+	c.Provide(dummy.NewAttachmentsRepository, dig.As(new(attachments.BaseAttachmentsRepository)))
+	c.Provide(dummy.NewBlobStorage, dig.As(new(attachments.BaseBlobStorage)))
+	c.Provide(dummy.NewAuditRepository, dig.As(new(audit.BaseAuditRepository)))
+	c.Provide(dummy.NewRefreshTokensRepository, dig.As(new(auth.BaseRefreshTokensRepository)))
+	c.Provide(dummy.NewTransactionManager, dig.As(new(common.BaseTransactionManager)))
+
+	// Регистрируем сервисы аутентификации
+	c.Provide(auth_infrastructure.NewBcryptPasswordHasher, dig.As(new(auth.BasePasswordHasher)))
+	c.Provide(auth_infrastructure.NewJWTTokenManager, dig.As(new(auth.BaseTokenManager)))
+
+	// Регистрируем политики доступа
+	c.Provide(policies.NewTaskPolicy)
+	c.Provide(policies.NewProjectPolicy)
+	c.Provide(policies.NewUserPolicy)
+	c.Provide(policies.NewLabelPolicy)
+	c.Provide(policies.NewCommentPolicy)
+	c.Provide(policies.NewOrganizationPolicy)
+	c.Provide(policies.NewAuditPolicy)
+	c.Provide(policies.NewTrashPolicy)
+
+	// Регистрируем журнал аудита
+	c.Provide(application_audit.NewAuditRecorder)
+
+	// Регистрируем рабочий процесс и иерархию задач
+	c.Provide(application_tasks.NewTaskTransitionGuards)
+	c.Provide(application_tasks.NewTaskWorkflow)
+	c.Provide(application_tasks.NewTaskHierarchy)
+	c.Provide(application_attachments.NewAttachmentLimits)
 
 	// Регистрируем use cases
+	c.Provide(application_auth.NewLoginUseCase)
+	c.Provide(application_auth.NewRefreshTokensUseCase)
+	c.Provide(application_auth.NewLogoutUseCase)
+	c.Provide(application_auth.NewGetCurrentUserUseCase)
 	c.Provide(application_tasks.NewCreateTaskUseCase)
 	c.Provide(application_tasks.NewGetTaskByIDUseCase)
 	c.Provide(application_tasks.NewListTasksUseCase)
+	c.Provide(application_tasks.NewListTasksByCursorUseCase)
 	c.Provide(application_tasks.NewUpdateTaskUseCase)
+	c.Provide(application_tasks.NewTransitionTaskUseCase)
 	c.Provide(application_tasks.NewDeleteTaskUseCase)
+	c.Provide(application_tasks.NewListTaskLabelsUseCase)
+	c.Provide(application_tasks.NewAttachTaskLabelUseCase)
+	c.Provide(application_tasks.NewDetachTaskLabelUseCase)
+	c.Provide(application_tasks.NewListTaskChildrenUseCase)
+	c.Provide(application_tasks.NewListTaskSubtreeUseCase)
+	c.Provide(application_tasks.NewGetTaskProgressUseCase)
+	c.Provide(application_tasks.NewListTaskDependenciesUseCase)
+	c.Provide(application_tasks.NewAddTaskDependencyUseCase)
+	c.Provide(application_tasks.NewRemoveTaskDependencyUseCase)
+	c.Provide(application_projects.NewCreateProjectUseCase)
+	c.Provide(application_projects.NewGetProjectByIDUseCase)
+	c.Provide(application_projects.NewListProjectsUseCase)
+	c.Provide(application_projects.NewUpdateProjectUseCase)
+	c.Provide(application_projects.NewDeleteProjectUseCase)
+	c.Provide(application_labels.NewCreateLabelUseCase)
+	c.Provide(application_labels.NewGetLabelByIDUseCase)
+	c.Provide(application_labels.NewListLabelsUseCase)
+	c.Provide(application_labels.NewUpdateLabelUseCase)
+	c.Provide(application_labels.NewDeleteLabelUseCase)
 	c.Provide(application_users.NewCreateUserUseCase)
+	c.Provide(application_users.NewRegisterUserUseCase)
+	c.Provide(application_users.NewEnsureAdminUseCase)
 	c.Provide(application_users.NewGetUserByIDUseCase)
 	c.Provide(application_users.NewGetUserByEmailUseCase)
 	c.Provide(application_users.NewListUsersUseCase)
+	c.Provide(application_users.NewListUsersByCursorUseCase)
 	c.Provide(application_users.NewUpdateUserUseCase)
 	c.Provide(application_users.NewDeleteUserUseCase)
+	c.Provide(application_comments.NewCreateCommentUseCase)
+	c.Provide(application_comments.NewListCommentsUseCase)
+	c.Provide(application_comments.NewListCommentRevisionsUseCase)
+	c.Provide(application_comments.NewUpdateCommentUseCase)
+	c.Provide(application_comments.NewDeleteCommentUseCase)
+	c.Provide(application_attachments.NewUploadAttachmentUseCase)
+	c.Provide(application_attachments.NewListAttachmentsUseCase)
+	c.Provide(application_attachments.NewGetAttachmentUseCase)
+	c.Provide(application_attachments.NewDownloadAttachmentUseCase)
+	c.Provide(application_attachments.NewDeleteAttachmentUseCase)
+	c.Provide(application_audit.NewListTaskHistoryUseCase)
+	c.Provide(application_audit.NewListAuditLogUseCase)
+	c.Provide(application_trash.NewTrashPurger)
+	c.Provide(application_trash.NewListDeletedTasksUseCase)
+	c.Provide(application_trash.NewRestoreTaskUseCase)
+	c.Provide(application_trash.NewPurgeTaskUseCase)
+	c.Provide(application_trash.NewListDeletedUsersUseCase)
+	c.Provide(application_trash.NewRestoreUserUseCase)
+	c.Provide(application_trash.NewPurgeUserUseCase)
+	c.Provide(application_trash.NewPurgeExpiredTrashUseCase)
+	c.Provide(application_organizations.NewCreateOrganizationUseCase)
+	c.Provide(application_organizations.NewListOrganizationsUseCase)
+	c.Provide(application_organizations.NewGetCurrentOrganizationUseCase)
 }
 
 // ResolveFromContainer получает зависимость из тестового контейнера по типу
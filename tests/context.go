@@ -0,0 +1,40 @@
+package tests
+
+import (
+	"context"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/organizations"
+	vo "crud/internal/domain/users/value_objects"
+
+	"github.com/google/uuid"
+)
+
+// ContextWithUser возвращает контекст, в котором запрос выполняет участник (member) userID
+func ContextWithUser(ctx context.Context, userID uuid.UUID) context.Context {
+	return ContextWithRole(ctx, userID, vo.RoleMember)
+}
+
+// ContextWithRole возвращает контекст, в котором запрос выполняет пользователь userID с ролью role
+// из организации по умолчанию
+func ContextWithRole(ctx context.Context, userID uuid.UUID, role string) context.Context {
+	return ContextInOrganization(ctx, organizations.DefaultOrganizationID, userID, role)
+}
+
+// ContextInOrganization возвращает контекст, в котором запрос выполняет пользователь userID
+// с ролью role из организации organizationID
+func ContextInOrganization(ctx context.Context, organizationID, userID uuid.UUID, role string) context.Context {
+	ctx = organizations.ContextWithOrganization(ctx, organizationID)
+	return auth.ContextWithPrincipal(ctx, auth.Principal{UserID: userID, Role: role, OrganizationID: organizationID})
+}
+
+// AdminContext возвращает контекст, в котором запрос выполняет администратор
+func AdminContext(ctx context.Context) context.Context {
+	return ContextWithRole(ctx, uuid.New(), vo.RoleAdmin)
+}
+
+// DefaultOrganizationContext возвращает контекст анонимного запроса в организации по умолчанию,
+// как у самостоятельной регистрации
+func DefaultOrganizationContext(ctx context.Context) context.Context {
+	return organizations.ContextWithOrganization(ctx, organizations.DefaultOrganizationID)
+}
@@ -0,0 +1,100 @@
+package entities
+
+import (
+	"testing"
+
+	"crud/internal/domain/attachments"
+	vo "crud/internal/domain/attachments/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentEntity_Creation(t *testing.T) {
+	fileName, err := vo.NewFileNameValueObject("notes.txt")
+	require.NoError(t, err)
+
+	taskID, uploaderID := uuid.New(), uuid.New()
+	attachment := attachments.NewAttachment(taskID, uploaderID, fileName, "text/plain")
+
+	assert.NotEqual(t, uuid.Nil, attachment.ID)
+	assert.Equal(t, taskID, attachment.TaskID)
+	assert.Equal(t, uploaderID, attachment.UploaderID)
+	assert.Equal(t, "tasks/"+taskID.String()+"/"+attachment.ID.String(), attachment.StorageKey)
+
+	other := attachments.NewAttachment(taskID, uploaderID, fileName, "text/plain")
+	assert.False(t, attachment.Equals(other))
+	assert.NotEqual(t, attachment.StorageKey, other.StorageKey)
+}
+
+func TestAttachmentLimits(t *testing.T) {
+	limits, err := attachments.NewLimits(1024, []string{"image/*", "Text/Plain", "application/pdf"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), limits.MaxSize())
+
+	// Тест разрешенных типов: параметры отбрасываются, кроме charset текстовых типов
+	for input, expected := range map[string]string{
+		"image/png":                  "image/png",
+		"IMAGE/SVG+XML":              "image/svg+xml",
+		"text/plain; charset=utf-8":  "text/plain; charset=utf-8",
+		"application/pdf; version=2": "application/pdf",
+	} {
+		contentType, err := limits.NormalizeContentType(input)
+		require.NoError(t, err, "Unexpected error for %q", input)
+		assert.Equal(t, expected, contentType)
+	}
+
+	// Тест запрещенных и некорректных типов
+	for _, invalid := range []string{"text/html", "application/x-msdownload", "imagepng", ""} {
+		_, err := limits.NormalizeContentType(invalid)
+		assert.True(t, attachments.IsUnsupportedContentType(err), "Expected error for content type %q", invalid)
+	}
+
+	// Тест невалидной конфигурации
+	_, err = attachments.NewLimits(0, []string{"image/*"})
+	assert.True(t, attachments.IsInvalidAttachmentData(err))
+	_, err = attachments.NewLimits(1024, nil)
+	assert.True(t, attachments.IsInvalidAttachmentData(err))
+
+	anyType, err := attachments.NewLimits(1, []string{"*/*"})
+	require.NoError(t, err)
+	_, err = anyType.NormalizeContentType("application/octet-stream")
+	assert.NoError(t, err)
+}
+
+func TestResolveAttachmentContentType(t *testing.T) {
+	limits, err := attachments.NewLimits(1024, []string{"image/*", "text/plain", "text/csv", "application/json"})
+	require.NoError(t, err)
+
+	// Тест типов, согласованных с содержимым
+	for _, tc := range []struct{ declared, detected, expected string }{
+		{"", "image/png", "image/png"},
+		{"application/octet-stream", "text/plain; charset=utf-8", "text/plain; charset=utf-8"},
+		{"image/png", "image/png", "image/png"},
+		{"text/plain; charset=windows-1251", "text/plain; charset=utf-8", "text/plain; charset=windows-1251"},
+		{"text/csv", "text/plain; charset=utf-8", "text/csv"},
+		{"application/json", "text/plain; charset=utf-8", "application/json"},
+	} {
+		contentType, err := limits.ResolveContentType(tc.declared, tc.detected)
+		require.NoError(t, err, "Unexpected error for %q detected as %q", tc.declared, tc.detected)
+		assert.Equal(t, tc.expected, contentType)
+	}
+
+	// Тест типов, не совпадающих с содержимым
+	for _, tc := range []struct{ declared, detected string }{
+		{"image/png", "text/html; charset=utf-8"},
+		{"text/plain", "text/html; charset=utf-8"},
+		{"image/gif", "image/png"},
+		{"text/csv", "application/octet-stream"},
+	} {
+		_, err := limits.ResolveContentType(tc.declared, tc.detected)
+		assert.True(t, attachments.IsContentTypeMismatch(err), "Expected mismatch for %q detected as %q", tc.declared, tc.detected)
+	}
+
+	// Определенный по содержимому тип тоже проверяется по списку разрешенных
+	_, err = limits.ResolveContentType("", "text/html; charset=utf-8")
+	assert.True(t, attachments.IsUnsupportedContentType(err))
+	_, err = limits.ResolveContentType("", "application/pdf")
+	assert.True(t, attachments.IsUnsupportedContentType(err))
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"testing"
+
+	"crud/internal/domain/audit"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditDiff(t *testing.T) {
+	before := audit.Snapshot{"title": "Draft", "status": "todo", "due_date": nil, "password": audit.Secret("hash-1")}
+	after := audit.Snapshot{"title": "Final", "status": "todo", "due_date": "2026-01-02T00:00:00Z", "password": audit.Secret("hash-2")}
+
+	// Изменения упорядочены по имени поля, секретные значения не сохраняются
+	assert.Equal(t, []audit.FieldChange{
+		{Field: "due_date", Old: nil, New: "2026-01-02T00:00:00Z"},
+		{Field: "password", Redacted: true},
+		{Field: "title", Old: "Draft", New: "Final"},
+	}, audit.Diff(before, after))
+
+	assert.Empty(t, audit.Diff(before, before))
+
+	// Создание и удаление: отсутствующий снимок считается пустым
+	created := audit.Diff(nil, audit.Snapshot{"title": "New"})
+	assert.Equal(t, []audit.FieldChange{{Field: "title", Old: nil, New: "New"}}, created)
+	deleted := audit.Diff(audit.Snapshot{"title": "Old"}, nil)
+	assert.Equal(t, []audit.FieldChange{{Field: "title", Old: "Old", New: nil}}, deleted)
+}
+
+func TestAuditEntry_Creation(t *testing.T) {
+	entityID, actorID := uuid.New(), uuid.New()
+	entry := audit.NewEntry(audit.EntityTask, entityID, audit.ActionUpdate, &actorID,
+		audit.Snapshot{"title": "A"}, audit.Snapshot{"title": "B"})
+
+	assert.NotEqual(t, uuid.Nil, entry.ID)
+	assert.Equal(t, entityID, entry.EntityID)
+	assert.Equal(t, actorID, *entry.ActorID)
+	require.True(t, entry.HasChanges())
+
+	unchanged := audit.NewEntry(audit.EntityTask, entityID, audit.ActionUpdate, nil, audit.Snapshot{"title": "A"}, audit.Snapshot{"title": "A"})
+	assert.False(t, unchanged.HasChanges())
+	assert.False(t, entry.Equals(unchanged))
+}
+
+func TestAuditListEntriesFilter_Validate(t *testing.T) {
+	assert.NoError(t, audit.ListEntriesFilter{}.Validate())
+
+	unknown := audit.EntityType("project")
+	assert.True(t, audit.IsInvalidAuditFilter(audit.ListEntriesFilter{EntityType: &unknown}.Validate()))
+
+	entry := audit.NewEntry(audit.EntityUser, uuid.New(), audit.ActionCreate, nil, nil, nil)
+	from, to := entry.CreatedAt, entry.CreatedAt
+	assert.True(t, audit.IsInvalidAuditFilter(audit.ListEntriesFilter{From: &from, To: &to}.Validate()))
+}
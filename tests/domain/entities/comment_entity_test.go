@@ -0,0 +1,49 @@
+package entities
+
+import (
+	"testing"
+
+	"crud/internal/domain/comments"
+	vo "crud/internal/domain/comments/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentEntity_Creation(t *testing.T) {
+	body, err := vo.NewCommentBodyValueObject("First!")
+	require.NoError(t, err)
+
+	taskID, authorID := uuid.New(), uuid.New()
+	comment := comments.NewComment(taskID, authorID, nil, body)
+
+	assert.NotEqual(t, uuid.Nil, comment.ID)
+	assert.Equal(t, taskID, comment.TaskID)
+	assert.Equal(t, authorID, comment.AuthorID)
+	assert.False(t, comment.IsReply())
+	assert.Nil(t, comment.EditedAt)
+
+	reply := comments.NewComment(taskID, authorID, &comment.ID, body)
+	assert.True(t, reply.IsReply())
+	assert.False(t, comment.Equals(reply))
+}
+
+func TestCommentEntity_Edit(t *testing.T) {
+	original, _ := vo.NewCommentBodyValueObject("Draft")
+	edited, _ := vo.NewCommentBodyValueObject("Final")
+
+	comment := comments.NewComment(uuid.New(), uuid.New(), nil, original)
+
+	// Тот же текст не создает версию
+	assert.Nil(t, comment.Edit(original))
+	assert.Nil(t, comment.EditedAt)
+
+	revision := comment.Edit(edited)
+	require.NotNil(t, revision)
+	assert.Equal(t, comment.ID, revision.CommentID)
+	assert.Equal(t, "Draft", revision.Body)
+	assert.Equal(t, "Final", comment.Body.Value())
+	require.NotNil(t, comment.EditedAt)
+	assert.Equal(t, revision.CreatedAt, *comment.EditedAt)
+}
@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"testing"
+
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDependency(t *testing.T) {
+	task := newWorkflowTask(t, "todo")
+	blocker := newWorkflowTask(t, "todo")
+	blocker.UserID = task.UserID
+
+	assert.NoError(t, tasks.ValidateDependency(task, blocker, []uuid.UUID{uuid.New()}))
+
+	t.Run("self", func(t *testing.T) {
+		assert.True(t, tasks.IsTaskDependencyCycle(tasks.ValidateDependency(task, task, nil)))
+	})
+
+	t.Run("transitive cycle", func(t *testing.T) {
+		err := tasks.ValidateDependency(task, blocker, []uuid.UUID{uuid.New(), task.ID})
+		var cycleErr *tasks.TaskDependencyCycleError
+		require.ErrorAs(t, err, &cycleErr)
+		assert.Equal(t, task.ID, cycleErr.TaskID)
+		assert.Equal(t, blocker.ID, cycleErr.BlockerID)
+	})
+
+	t.Run("different owner", func(t *testing.T) {
+		foreign := newWorkflowTask(t, "todo")
+		assert.True(t, tasks.IsInvalidTaskData(tasks.ValidateDependency(task, foreign, nil)))
+	})
+}
+
+func TestOpenBlockers(t *testing.T) {
+	open := newWorkflowTask(t, "in_progress")
+	done := newWorkflowTask(t, "done")
+
+	blockers := tasks.OpenBlockers([]*tasks.Task{done, open})
+	require.Len(t, blockers, 1)
+	assert.Equal(t, open.ID, blockers[0].ID)
+	assert.Empty(t, tasks.OpenBlockers(nil))
+}
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"testing"
+	"time"
 
 	"crud/internal/domain/tasks"
 	vo "crud/internal/domain/tasks/value_objects"
@@ -42,3 +43,24 @@ func TestTaskEntity_Equality(t *testing.T) {
 
 	assert.False(t, task1.Equals(task2), "Expected task1 and task2 to be different")
 }
+
+func TestTaskEntity_IsOverdue(t *testing.T) {
+	title, _ := vo.NewTaskTitleValueObject("Ship release")
+	todo, _ := vo.NewTaskStatusValueObject("todo")
+	done, _ := vo.NewTaskStatusValueObject("done")
+
+	now := time.Now()
+	due := now.Add(-time.Hour)
+	schedule, err := vo.NewTaskScheduleValueObject(nil, &due)
+	require.NoError(t, err)
+
+	task := tasks.NewTask(uuid.New(), title, "", todo)
+	assert.False(t, task.IsOverdue(now), "Expected task without due date not to be overdue")
+
+	task.Schedule = schedule
+	assert.True(t, task.IsOverdue(now))
+	assert.False(t, task.IsOverdue(due.Add(-time.Minute)))
+
+	task.Status = done
+	assert.False(t, task.IsOverdue(now), "Expected completed task not to be overdue")
+}
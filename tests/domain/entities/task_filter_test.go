@@ -0,0 +1,153 @@
+package entities
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func comparison(field tasks.TaskField, operator tasks.FilterOperator, values ...interface{}) tasks.ComparisonSpecification {
+	return tasks.ComparisonSpecification{Field: field, Operator: operator, Values: values}
+}
+
+func TestParseTaskFilter(t *testing.T) {
+	t.Run("empty filter", func(t *testing.T) {
+		spec, err := tasks.ParseTaskFilter("  ", time.UTC)
+		require.NoError(t, err)
+		assert.Nil(t, spec)
+	})
+
+	t.Run("and binds tighter than or", func(t *testing.T) {
+		spec, err := tasks.ParseTaskFilter(`status = todo OR priority in (high, urgent) and title contains "weekly report"`, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, tasks.AnyOfSpecification{Operands: []tasks.TaskSpecification{
+			comparison(tasks.TaskFieldStatus, tasks.FilterEqual, "todo"),
+			tasks.AllOfSpecification{Operands: []tasks.TaskSpecification{
+				comparison(tasks.TaskFieldPriority, tasks.FilterIn, "high", "urgent"),
+				comparison(tasks.TaskFieldTitle, tasks.FilterContains, "weekly report"),
+			}},
+		}}, spec)
+	})
+
+	t.Run("parentheses", func(t *testing.T) {
+		spec, err := tasks.ParseTaskFilter(`(status = todo or status = done) and title != "say \"hi\""`, time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, tasks.AllOfSpecification{Operands: []tasks.TaskSpecification{
+			tasks.AnyOfSpecification{Operands: []tasks.TaskSpecification{
+				comparison(tasks.TaskFieldStatus, tasks.FilterEqual, "todo"),
+				comparison(tasks.TaskFieldStatus, tasks.FilterEqual, "done"),
+			}},
+			comparison(tasks.TaskFieldTitle, tasks.FilterNotEqual, `say "hi"`),
+		}}, spec)
+	})
+
+	t.Run("typed values", func(t *testing.T) {
+		id := uuid.New()
+		spec, err := tasks.ParseTaskFilter("project_id = "+id.String()+" and updated_at >= 2025-07-01T12:00:00+03:00", time.UTC)
+		require.NoError(t, err)
+		assert.Equal(t, tasks.AllOfSpecification{Operands: []tasks.TaskSpecification{
+			comparison(tasks.TaskFieldProjectID, tasks.FilterEqual, id),
+			comparison(tasks.TaskFieldUpdatedAt, tasks.FilterGreaterOrEqual, time.Date(2025, 7, 1, 9, 0, 0, 0, time.UTC)),
+		}}, spec)
+	})
+
+	t.Run("date without time covers the whole day", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/Moscow")
+		require.NoError(t, err)
+		start := time.Date(2025, 6, 30, 21, 0, 0, 0, time.UTC)
+		end := start.Add(24 * time.Hour)
+
+		testCases := []struct {
+			expression string
+			expected   tasks.TaskSpecification
+		}{
+			{"due_date = 2025-07-01", tasks.AllOfSpecification{Operands: []tasks.TaskSpecification{
+				comparison(tasks.TaskFieldDueDate, tasks.FilterGreaterOrEqual, start),
+				comparison(tasks.TaskFieldDueDate, tasks.FilterLess, end),
+			}}},
+			{"due_date != 2025-07-01", tasks.AnyOfSpecification{Operands: []tasks.TaskSpecification{
+				comparison(tasks.TaskFieldDueDate, tasks.FilterLess, start),
+				comparison(tasks.TaskFieldDueDate, tasks.FilterGreaterOrEqual, end),
+			}}},
+			{"due_date < 2025-07-01", comparison(tasks.TaskFieldDueDate, tasks.FilterLess, start)},
+			{"due_date <= 2025-07-01", comparison(tasks.TaskFieldDueDate, tasks.FilterLess, end)},
+			{"due_date > 2025-07-01", comparison(tasks.TaskFieldDueDate, tasks.FilterGreaterOrEqual, end)},
+			{"due_date >= 2025-07-01", comparison(tasks.TaskFieldDueDate, tasks.FilterGreaterOrEqual, start)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.expression, func(t *testing.T) {
+				spec, err := tasks.ParseTaskFilter(tc.expression, loc)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, spec)
+			})
+		}
+	})
+
+	t.Run("malformed filters", func(t *testing.T) {
+		testCases := []struct {
+			expression string
+			message    string
+		}{
+			{"status", "expected comparison operator, got end of filter at position 7"},
+			{"status = todo and", "expected field name, got end of filter at position 18"},
+			{"status = todo)", "expected and, or or end of filter, got ')' at position 14"},
+			{"(status = todo", "expected ')', got end of filter at position 15"},
+			{"color = red", "unknown field 'color' at position 1"},
+			{"status contains to", "operator 'contains' cannot be applied to field 'status' at position 8"},
+			{"title < b", "operator '<' cannot be applied to field 'title' at position 7"},
+			{"status = archived", "invalid task status 'archived'"},
+			{"priority in (high,)", "expected value, got ')' at position 19"},
+			{"priority in high", "expected '(' after in, got 'high' at position 13"},
+			{"user_id = 42", "invalid UUID '42' at position 11"},
+			{"due_date > tomorrow", "invalid date 'tomorrow'"},
+			{`title = "open`, "unterminated string at position 9"},
+			{`title = "a\nb"`, "unknown escape sequence"},
+			{"title ! x", "unexpected '!', expected '!=' at position 7"},
+			{strings.Repeat("(", 11) + "status = todo" + strings.Repeat(")", 11), "nested deeper than 10 levels"},
+			{"title = " + strings.Repeat("x", 1000), "filter is longer than 1000 bytes"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.expression, func(t *testing.T) {
+				_, err := tasks.ParseTaskFilter(tc.expression, time.UTC)
+				require.Error(t, err)
+				assert.True(t, tasks.IsInvalidTaskData(err))
+				assert.Contains(t, err.Error(), "field 'filter'")
+				assert.Contains(t, err.Error(), tc.message)
+			})
+		}
+	})
+}
+
+func TestParseTaskSort(t *testing.T) {
+	t.Run("named order", func(t *testing.T) {
+		order, keys, err := tasks.ParseTaskSort("priority")
+		require.NoError(t, err)
+		assert.Equal(t, tasks.TaskSortPriority, order)
+		assert.Nil(t, keys)
+	})
+
+	t.Run("field list", func(t *testing.T) {
+		order, keys, err := tasks.ParseTaskSort("-updated_at, title")
+		require.NoError(t, err)
+		assert.Equal(t, tasks.TaskSortDefault, order)
+		assert.Equal(t, []tasks.TaskSortKey{
+			{Field: tasks.TaskFieldUpdatedAt, Descending: true},
+			{Field: tasks.TaskFieldTitle},
+		}, keys)
+	})
+
+	t.Run("invalid field lists", func(t *testing.T) {
+		for _, value := range []string{"color", "title,", "user_id", "title,-title"} {
+			_, _, err := tasks.ParseTaskSort(value)
+			assert.True(t, tasks.IsInvalidTaskData(err), value)
+		}
+	})
+}
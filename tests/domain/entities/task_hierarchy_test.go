@@ -0,0 +1,102 @@
+package entities
+
+import (
+	"testing"
+
+	"crud/internal/domain/tasks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// childOf делает задачу подзадачей parent
+func childOf(t *testing.T, parent *tasks.Task) *tasks.Task {
+	task := newWorkflowTask(t, "todo")
+	task.UserID = parent.UserID
+	task.ParentID = &parent.ID
+	return task
+}
+
+func TestHierarchy_ValidateParent(t *testing.T) {
+	hierarchy, err := tasks.NewHierarchy(3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, hierarchy.MaxDepth())
+
+	_, err = tasks.NewHierarchy(0)
+	assert.True(t, tasks.IsInvalidTaskData(err))
+
+	// root -> child -> grandchild
+	root := newWorkflowTask(t, "todo")
+	child := childOf(t, root)
+	grandchild := childOf(t, child)
+
+	t.Run("valid parent", func(t *testing.T) {
+		task := newWorkflowTask(t, "todo")
+		task.UserID = root.UserID
+		assert.NoError(t, hierarchy.ValidateParent(task, child, []*tasks.Task{root}, nil))
+	})
+
+	t.Run("self as parent", func(t *testing.T) {
+		assert.True(t, tasks.IsTaskHierarchyCycle(hierarchy.ValidateParent(root, root, nil, nil)))
+	})
+
+	t.Run("descendant as parent", func(t *testing.T) {
+		err := hierarchy.ValidateParent(root, grandchild, []*tasks.Task{child, root}, []*tasks.Task{child, grandchild})
+		assert.True(t, tasks.IsTaskHierarchyCycle(err))
+	})
+
+	t.Run("too deep", func(t *testing.T) {
+		task := newWorkflowTask(t, "todo")
+		task.UserID = root.UserID
+		err := hierarchy.ValidateParent(task, grandchild, []*tasks.Task{child, root}, nil)
+		assert.True(t, tasks.IsTaskDepthExceeded(err))
+	})
+
+	t.Run("moved subtree too deep", func(t *testing.T) {
+		other := newWorkflowTask(t, "todo")
+		other.UserID = root.UserID
+		// Поддерево child высотой 2 под корнем other дает 3 уровня, под его подзадачей - 4
+		assert.NoError(t, hierarchy.ValidateParent(child, other, nil, []*tasks.Task{grandchild}))
+
+		otherChild := childOf(t, other)
+		err := hierarchy.ValidateParent(child, otherChild, []*tasks.Task{other}, []*tasks.Task{grandchild})
+		var depthErr *tasks.TaskDepthExceededError
+		require.ErrorAs(t, err, &depthErr)
+		assert.Equal(t, 4, depthErr.Depth)
+		assert.Equal(t, 3, depthErr.MaxDepth)
+	})
+
+	t.Run("different owner", func(t *testing.T) {
+		task := newWorkflowTask(t, "todo")
+		task.UserID = uuid.New()
+		assert.True(t, tasks.IsInvalidTaskData(hierarchy.ValidateParent(task, root, nil, nil)))
+	})
+}
+
+func TestSubtreeHeight(t *testing.T) {
+	root := newWorkflowTask(t, "todo")
+	assert.Equal(t, 1, tasks.SubtreeHeight(root.ID, nil))
+
+	child := childOf(t, root)
+	sibling := childOf(t, root)
+	grandchild := childOf(t, child)
+	assert.Equal(t, 3, tasks.SubtreeHeight(root.ID, []*tasks.Task{child, sibling, grandchild}))
+	assert.Equal(t, 2, tasks.SubtreeHeight(child.ID, []*tasks.Task{grandchild}))
+}
+
+func TestTaskProgress(t *testing.T) {
+	progress := tasks.NewTaskProgress(nil)
+	assert.Equal(t, 0, progress.Total)
+	assert.Equal(t, 0, progress.Percent())
+
+	progress = tasks.NewTaskProgress([]*tasks.Task{
+		newWorkflowTask(t, "done"),
+		newWorkflowTask(t, "in_progress"),
+		newWorkflowTask(t, "todo"),
+		newWorkflowTask(t, "done"),
+	})
+	assert.Equal(t, 4, progress.Total)
+	assert.Equal(t, 2, progress.Done)
+	assert.Equal(t, 50, progress.Percent())
+}
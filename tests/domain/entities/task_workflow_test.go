@@ -0,0 +1,162 @@
+package entities
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newWorkflowTask(t *testing.T, status string) *tasks.Task {
+	title, err := vo.NewTaskTitleValueObject("Workflow task")
+	require.NoError(t, err)
+	statusVO, err := vo.NewTaskStatusValueObject(status)
+	require.NoError(t, err)
+	return tasks.NewTask(uuid.New(), title, "", statusVO)
+}
+
+func TestWorkflow_Validation(t *testing.T) {
+	statuses := []string{"todo", "done"}
+	guards := tasks.NewBuiltinTransitionGuards()
+
+	_, err := tasks.NewWorkflow(statuses, nil, []string{"done"}, []tasks.Transition{{From: "todo", To: "done"}}, guards)
+	require.NoError(t, err)
+
+	invalid := map[string]func() error{
+		"no statuses": func() error {
+			_, err := tasks.NewWorkflow(nil, nil, []string{"done"}, nil, guards)
+			return err
+		},
+		"duplicate status": func() error {
+			_, err := tasks.NewWorkflow([]string{"todo", "todo", "done"}, nil, []string{"done"}, nil, guards)
+			return err
+		},
+		"no closed status": func() error {
+			_, err := tasks.NewWorkflow(statuses, nil, nil, nil, guards)
+			return err
+		},
+		"unknown initial status": func() error {
+			_, err := tasks.NewWorkflow(statuses, []string{"backlog"}, []string{"done"}, nil, guards)
+			return err
+		},
+		"unknown closed status": func() error {
+			_, err := tasks.NewWorkflow(statuses, nil, []string{"archived"}, nil, guards)
+			return err
+		},
+		"unknown transition status": func() error {
+			_, err := tasks.NewWorkflow(statuses, nil, []string{"done"}, []tasks.Transition{{From: "todo", To: "review"}}, guards)
+			return err
+		},
+		"duplicate transition": func() error {
+			transitions := []tasks.Transition{{From: "todo", To: "done"}, {From: "todo", To: "done"}}
+			_, err := tasks.NewWorkflow(statuses, nil, []string{"done"}, transitions, guards)
+			return err
+		},
+		"unknown guard": func() error {
+			transitions := []tasks.Transition{{From: "todo", To: "done", Guards: []string{"approved_by_manager"}}}
+			_, err := tasks.NewWorkflow(statuses, nil, []string{"done"}, transitions, guards)
+			return err
+		},
+	}
+	for name, build := range invalid {
+		assert.True(t, tasks.IsInvalidWorkflow(build()), name)
+	}
+}
+
+func TestWorkflow_CheckInitialStatus(t *testing.T) {
+	statuses := []string{"todo", "in_progress", "done"}
+	guards := tasks.NewBuiltinTransitionGuards()
+
+	todo, _ := vo.NewTaskStatusValueObject("todo")
+	inProgress, _ := vo.NewTaskStatusValueObject("in_progress")
+	done, _ := vo.NewTaskStatusValueObject("done")
+
+	// Без начальных статусов задача создается только в первом статусе
+	workflow, err := tasks.NewWorkflow(statuses, nil, []string{"done"}, nil, guards)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo"}, workflow.InitialStatuses())
+	assert.NoError(t, workflow.CheckInitialStatus(todo))
+	err = workflow.CheckInitialStatus(done)
+	assert.True(t, vo.IsInvalidStatus(err))
+	assert.Contains(t, err.Error(), "cannot be created in status 'done'")
+
+	workflow, err = tasks.NewWorkflow(statuses, []string{"todo", "in_progress"}, []string{"done"}, nil, guards)
+	require.NoError(t, err)
+	assert.NoError(t, workflow.CheckInitialStatus(todo))
+	assert.NoError(t, workflow.CheckInitialStatus(inProgress))
+	assert.True(t, vo.IsInvalidStatus(workflow.CheckInitialStatus(done)))
+}
+
+func TestWorkflow_CheckTransition(t *testing.T) {
+	ctx := context.Background()
+	guards := tasks.TransitionGuards{
+		"has_description": tasks.NewBuiltinTransitionGuards()[tasks.GuardHasDescription],
+		"never": tasks.TransitionGuardFunc(func(ctx context.Context, task *tasks.Task, to string) error {
+			return errors.New("closed for maintenance")
+		}),
+	}
+	workflow, err := tasks.NewWorkflow(
+		[]string{"todo", "in_progress", "done"},
+		nil,
+		[]string{"done"},
+		[]tasks.Transition{
+			{From: "todo", To: "in_progress"},
+			{From: "in_progress", To: "done", Guards: []string{"has_description"}},
+			{From: "done", To: "todo", Guards: []string{"never"}},
+		},
+		guards,
+	)
+	require.NoError(t, err)
+
+	todo, _ := vo.NewTaskStatusValueObject("todo")
+	inProgress, _ := vo.NewTaskStatusValueObject("in_progress")
+	done, _ := vo.NewTaskStatusValueObject("done")
+
+	// Разрешенный переход и переход в текущий статус
+	task := newWorkflowTask(t, "todo")
+	assert.NoError(t, workflow.CheckTransition(ctx, task, inProgress))
+	assert.NoError(t, workflow.CheckTransition(ctx, task, todo))
+
+	// Переход, которого нет в рабочем процессе
+	err = workflow.CheckTransition(ctx, task, done)
+	assert.True(t, tasks.IsIllegalTransition(err))
+	var illegalErr *tasks.IllegalTransitionError
+	require.ErrorAs(t, err, &illegalErr)
+	assert.Equal(t, []string{"in_progress"}, illegalErr.Allowed)
+
+	// Условие перехода
+	task = newWorkflowTask(t, "in_progress")
+	assert.True(t, tasks.IsTransitionGuardFailed(workflow.CheckTransition(ctx, task, done)))
+	task.Description = "Ready for review"
+	assert.NoError(t, workflow.CheckTransition(ctx, task, done))
+
+	task = newWorkflowTask(t, "done")
+	err = workflow.CheckTransition(ctx, task, todo)
+	var guardErr *tasks.TransitionGuardError
+	require.ErrorAs(t, err, &guardErr)
+	assert.Equal(t, "never", guardErr.Guard)
+	assert.Equal(t, "closed for maintenance", guardErr.Reason)
+
+	assert.Equal(t, []string{"todo", "in_progress", "done"}, workflow.Statuses())
+	assert.Equal(t, []string{"in_progress"}, workflow.AllowedTransitions("todo"))
+}
+
+func TestBuiltinTransitionGuards_StartDateReached(t *testing.T) {
+	guard := tasks.NewBuiltinTransitionGuards()[tasks.GuardStartDateReached]
+	task := newWorkflowTask(t, "todo")
+
+	assert.NoError(t, guard.Check(context.Background(), task, "in_progress"))
+
+	future := time.Now().Add(time.Hour)
+	schedule, err := vo.NewTaskScheduleValueObject(&future, nil)
+	require.NoError(t, err)
+	task.Schedule = schedule
+	assert.Error(t, guard.Check(context.Background(), task, "in_progress"))
+}
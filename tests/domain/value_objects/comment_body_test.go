@@ -0,0 +1,32 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/comments/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentBodyValueObject(t *testing.T) {
+	// Тест обрезки пробелов
+	body, err := vo.NewCommentBodyValueObject("  Looks good to me \n")
+	require.NoError(t, err)
+	assert.Equal(t, "Looks good to me", body.Value())
+
+	same, err := vo.NewCommentBodyValueObject("Looks good to me")
+	require.NoError(t, err)
+	assert.True(t, body.Equals(same))
+
+	// Тест невалидных текстов
+	for _, invalid := range []string{"", " \n\t ", strings.Repeat("a", vo.MaxCommentBodyLength+1)} {
+		_, err := vo.NewCommentBodyValueObject(invalid)
+		assert.True(t, vo.IsInvalidCommentBody(err), "Expected error for comment body of length %d", len(invalid))
+	}
+
+	// Тест граничной длины в символах, а не байтах
+	_, err = vo.NewCommentBodyValueObject(strings.Repeat("я", vo.MaxCommentBodyLength))
+	assert.NoError(t, err)
+}
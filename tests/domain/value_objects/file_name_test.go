@@ -0,0 +1,36 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/attachments/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileNameValueObject(t *testing.T) {
+	// Тест отбрасывания пути, который передают некоторые браузеры
+	for input, expected := range map[string]string{
+		"report.pdf":             "report.pdf",
+		" report.pdf ":           "report.pdf",
+		`C:\Users\me\report.pdf`: "report.pdf",
+		"../../etc/passwd":       "passwd",
+		"отчет за квартал.xlsx":  "отчет за квартал.xlsx",
+	} {
+		name, err := vo.NewFileNameValueObject(input)
+		require.NoError(t, err, "Unexpected error for %q", input)
+		assert.Equal(t, expected, name.Value())
+	}
+
+	// Тест невалидных имен
+	for _, invalid := range []string{"", "  ", ".", "..", "dir/", "bad\x00name", strings.Repeat("a", 256)} {
+		_, err := vo.NewFileNameValueObject(invalid)
+		assert.True(t, vo.IsInvalidFileName(err), "Expected error for file name %q", invalid)
+	}
+
+	// Тест граничной длины в символах, а не байтах
+	_, err := vo.NewFileNameValueObject(strings.Repeat("я", 255))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/labels/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelNameValueObject(t *testing.T) {
+	// Тест нормализации имени
+	name, err := vo.NewLabelNameValueObject("  Customer-X ")
+	require.NoError(t, err)
+	assert.Equal(t, "customer-x", name.Value())
+
+	same, err := vo.NewLabelNameValueObject("customer-x")
+	require.NoError(t, err)
+	assert.True(t, name.Equals(same))
+
+	// Тест невалидных имен
+	for _, invalid := range []string{"", "   ", "bug,frontend", strings.Repeat("a", 51)} {
+		_, err := vo.NewLabelNameValueObject(invalid)
+		assert.True(t, vo.IsInvalidLabelName(err), "Expected error for label name '%s'", invalid)
+	}
+
+	// Тест граничной длины в символах, а не байтах
+	_, err = vo.NewLabelNameValueObject(strings.Repeat("я", 50))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,32 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/organizations/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationNameValueObject(t *testing.T) {
+	// Тест обрезки пробелов
+	name, err := vo.NewOrganizationNameValueObject("  Acme Corp ")
+	require.NoError(t, err)
+	assert.Equal(t, "Acme Corp", name.Value())
+
+	same, err := vo.NewOrganizationNameValueObject("Acme Corp")
+	require.NoError(t, err)
+	assert.True(t, name.Equals(same))
+
+	// Тест невалидных имен
+	for _, invalid := range []string{"", "   ", strings.Repeat("a", 101)} {
+		_, err := vo.NewOrganizationNameValueObject(invalid)
+		assert.True(t, vo.IsInvalidOrganizationName(err), "Expected error for organization name '%s'", invalid)
+	}
+
+	// Тест граничной длины в символах, а не байтах
+	_, err = vo.NewOrganizationNameValueObject(strings.Repeat("я", 100))
+	assert.NoError(t, err)
+}
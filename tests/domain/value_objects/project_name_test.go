@@ -0,0 +1,32 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/projects/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectNameValueObject(t *testing.T) {
+	// Тест обрезки пробелов
+	name, err := vo.NewProjectNameValueObject("  Website Redesign ")
+	require.NoError(t, err)
+	assert.Equal(t, "Website Redesign", name.Value())
+
+	same, err := vo.NewProjectNameValueObject("Website Redesign")
+	require.NoError(t, err)
+	assert.True(t, name.Equals(same))
+
+	// Тест невалидных имен
+	for _, invalid := range []string{"", "   ", strings.Repeat("a", 101)} {
+		_, err := vo.NewProjectNameValueObject(invalid)
+		assert.True(t, vo.IsInvalidProjectName(err), "Expected error for project name '%s'", invalid)
+	}
+
+	// Тест граничной длины в символах, а не байтах
+	_, err = vo.NewProjectNameValueObject(strings.Repeat("я", 100))
+	assert.NoError(t, err)
+}
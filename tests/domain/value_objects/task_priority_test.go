@@ -0,0 +1,42 @@
+package value_objects
+
+import (
+	"testing"
+
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskPriorityValueObject(t *testing.T) {
+	// Тест валидных приоритетов в порядке возрастания важности
+	validPriorities := []string{"low", "medium", "high", "urgent"}
+	previousRank := 0
+	for _, priorityStr := range validPriorities {
+		priority, err := vo.NewTaskPriorityValueObject(priorityStr)
+		require.NoError(t, err, "Expected no error for valid priority '%s'", priorityStr)
+		assert.Equal(t, priorityStr, priority.Value())
+		assert.True(t, priority.IsValid())
+		assert.Greater(t, priority.Rank(), previousRank, "Expected '%s' to outrank previous priority", priorityStr)
+		previousRank = priority.Rank()
+	}
+
+	// Тест приоритета по умолчанию
+	assert.Equal(t, "medium", vo.NewDefaultTaskPriorityValueObject().Value())
+
+	// Тест пустого и невалидного приоритета
+	_, err := vo.NewTaskPriorityValueObject("")
+	assert.True(t, vo.IsInvalidPriority(err))
+
+	_, err = vo.NewTaskPriorityValueObject("critical")
+	assert.True(t, vo.IsInvalidPriority(err))
+
+	// Тест сравнения приоритетов
+	high1, _ := vo.NewTaskPriorityValueObject("high")
+	high2, _ := vo.NewTaskPriorityValueObject("high")
+	low, _ := vo.NewTaskPriorityValueObject("low")
+
+	assert.True(t, high1.Equals(high2))
+	assert.False(t, high1.Equals(low))
+}
@@ -0,0 +1,51 @@
+package value_objects
+
+import (
+	"testing"
+	"time"
+
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskScheduleValueObject(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	start := time.Date(2026, 3, 1, 9, 0, 0, 0, moscow)
+	due := time.Date(2026, 3, 10, 18, 0, 0, 0, moscow)
+
+	// Тест пустого расписания
+	empty, err := vo.NewTaskScheduleValueObject(nil, nil)
+	require.NoError(t, err)
+	assert.Nil(t, empty.StartDate())
+	assert.Nil(t, empty.DueDate())
+	assert.False(t, empty.IsPastDue(time.Now()))
+
+	// Тест приведения дат к UTC без изменения момента времени
+	schedule, err := vo.NewTaskScheduleValueObject(&start, &due)
+	require.NoError(t, err)
+	require.NotNil(t, schedule.DueDate())
+	assert.Equal(t, time.UTC, schedule.DueDate().Location())
+	assert.True(t, due.Equal(*schedule.DueDate()))
+	assert.True(t, start.Equal(*schedule.StartDate()))
+
+	// Тест просрочки: сравниваются моменты времени, а не локальные даты
+	assert.False(t, schedule.IsPastDue(due))
+	assert.True(t, schedule.IsPastDue(due.Add(time.Second).In(time.UTC)))
+
+	// Тест срока выполнения раньше даты начала
+	_, err = vo.NewTaskScheduleValueObject(&due, &start)
+	assert.True(t, vo.IsInvalidSchedule(err))
+
+	// Тест нулевой даты
+	var zero time.Time
+	_, err = vo.NewTaskScheduleValueObject(nil, &zero)
+	assert.True(t, vo.IsInvalidSchedule(err))
+
+	// Тест сравнения расписаний
+	dueUTC := due.UTC()
+	same, _ := vo.NewTaskScheduleValueObject(&start, &dueUTC)
+	assert.True(t, schedule.Equals(same))
+	assert.False(t, schedule.Equals(empty))
+}
@@ -1,6 +1,7 @@
 package value_objects
 
 import (
+	"strings"
 	"testing"
 
 	vo "crud/internal/domain/tasks/value_objects"
@@ -35,3 +36,36 @@ func TestTaskStatusValueObject(t *testing.T) {
 	assert.True(t, status1.Equals(status2), "Expected status1 and status2 to be equal")
 	assert.False(t, status1.Equals(status3), "Expected status1 and status3 to be different")
 }
+
+func TestTaskStatusValueObject_AllowedStatuses(t *testing.T) {
+	defaultStatuses, defaultClosed := vo.AllowedStatuses(), vo.ClosedStatuses()
+	t.Cleanup(func() { require.NoError(t, vo.SetAllowedStatuses(defaultStatuses, defaultClosed)) })
+
+	require.NoError(t, vo.SetAllowedStatuses([]string{"backlog", "review", "shipped"}, []string{"shipped"}))
+
+	review, err := vo.NewTaskStatusValueObject("review")
+	require.NoError(t, err)
+	assert.True(t, review.IsOpen())
+
+	shipped, err := vo.NewTaskStatusValueObject("shipped")
+	require.NoError(t, err)
+	assert.False(t, shipped.IsOpen())
+
+	_, err = vo.NewTaskStatusValueObject("todo")
+	assert.True(t, vo.IsInvalidStatus(err))
+}
+
+func TestTaskStatusValueObject_SetAllowedStatusesTooLong(t *testing.T) {
+	defaultStatuses, defaultClosed := vo.AllowedStatuses(), vo.ClosedStatuses()
+
+	// Статус длиннее колонки tasks.status отклоняется, а прежний набор остается в силе
+	tooLong := strings.Repeat("x", vo.MaxStatusLength+1)
+	err := vo.SetAllowedStatuses([]string{"todo", tooLong}, []string{"todo"})
+	assert.True(t, vo.IsInvalidStatus(err))
+	assert.Equal(t, defaultStatuses, vo.AllowedStatuses())
+	assert.Equal(t, defaultClosed, vo.ClosedStatuses())
+
+	// Длина считается в символах, как в varchar
+	t.Cleanup(func() { require.NoError(t, vo.SetAllowedStatuses(defaultStatuses, defaultClosed)) })
+	require.NoError(t, vo.SetAllowedStatuses([]string{strings.Repeat("я", vo.MaxStatusLength)}, nil))
+}
@@ -0,0 +1,30 @@
+package value_objects
+
+import (
+	"strings"
+	"testing"
+
+	vo "crud/internal/domain/users/value_objects"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordValueObject(t *testing.T) {
+	// Тест валидного пароля
+	password, err := vo.NewPasswordValueObject("password123")
+	require.NoError(t, err)
+	assert.Equal(t, "password123", password.Value())
+
+	// Тест пустого пароля
+	_, err = vo.NewPasswordValueObject("")
+	assert.True(t, vo.IsInvalidPassword(err))
+
+	// Тест короткого пароля
+	_, err = vo.NewPasswordValueObject("short")
+	assert.True(t, vo.IsInvalidPassword(err))
+
+	// Тест слишком длинного пароля
+	_, err = vo.NewPasswordValueObject(strings.Repeat("a", 73))
+	assert.True(t, vo.IsInvalidPassword(err))
+}
@@ -0,0 +1,173 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"crud/internal/infrastructure/database/migrations"
+	"crud/internal/infrastructure/database/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// migration создает файлы пары скриптов миграции
+func migration(files fstest.MapFS, name string) fstest.MapFS {
+	files[name+".up.sql"] = &fstest.MapFile{Data: []byte("-- up " + name)}
+	files[name+".down.sql"] = &fstest.MapFile{Data: []byte("-- down " + name)}
+	return files
+}
+
+func TestPostgresMigrations(t *testing.T) {
+	loaded, err := migrations.Postgres()
+	require.NoError(t, err)
+	require.NotEmpty(t, loaded)
+
+	for i, m := range loaded {
+		assert.Equal(t, int64(i+1), m.Version, "versions must go without gaps")
+		assert.NotEmpty(t, m.Up)
+		assert.NotEmpty(t, m.Down)
+	}
+}
+
+func TestPostgresMigrationsCreateEveryModelTable(t *testing.T) {
+	loaded, err := migrations.Postgres()
+	require.NoError(t, err)
+
+	var up, down string
+	for _, m := range loaded {
+		up += m.Up
+		down += m.Down
+	}
+
+	tables := []string{
+		models.Organization{}.TableName(),
+		models.User{}.TableName(),
+		models.Project{}.TableName(),
+		models.Task{}.TableName(),
+		models.Label{}.TableName(),
+		models.TaskLabel{}.TableName(),
+		models.TaskDependency{}.TableName(),
+		models.Comment{}.TableName(),
+		models.CommentRevision{}.TableName(),
+		models.Attachment{}.TableName(),
+		models.AuditEntry{}.TableName(),
+		models.RefreshToken{}.TableName(),
+	}
+	for _, table := range tables {
+		assert.Contains(t, up, "CREATE TABLE IF NOT EXISTS "+table+" (", "no migration creates %s", table)
+		assert.Contains(t, down, "DROP TABLE IF EXISTS "+table+";", "no migration drops %s", table)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	files := fstest.MapFS{}
+	migration(files, "0002_add_column")
+	migration(files, "0001_initial")
+	migration(files, "0010_backfill")
+
+	loaded, err := migrations.Load(files)
+	require.NoError(t, err)
+	require.Len(t, loaded, 3)
+
+	assert.Equal(t, int64(1), loaded[0].Version)
+	assert.Equal(t, "initial", loaded[0].Name)
+	assert.Equal(t, "-- up 0001_initial", loaded[0].Up)
+	assert.Equal(t, "-- down 0001_initial", loaded[0].Down)
+	assert.Equal(t, int64(2), loaded[1].Version)
+	assert.Equal(t, int64(10), loaded[2].Version)
+	assert.Equal(t, int64(10), migrations.Latest(loaded))
+}
+
+func TestLoadRejectsInvalidSets(t *testing.T) {
+	testCases := []struct {
+		name  string
+		files fstest.MapFS
+	}{
+		{
+			name:  "missing down script",
+			files: fstest.MapFS{"0001_initial.up.sql": {Data: []byte("-- up")}},
+		},
+		{
+			name:  "invalid file name",
+			files: migration(fstest.MapFS{"initial.sql": {Data: []byte("-- up")}}, "0001_initial"),
+		},
+		{
+			name:  "same version with different names",
+			files: migration(migration(fstest.MapFS{}, "0001_initial"), "0001_other"),
+		},
+		{
+			name:  "zero version",
+			files: migration(fstest.MapFS{}, "0000_initial"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := migrations.Load(tc.files)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPending(t *testing.T) {
+	loaded, err := migrations.Load(migration(migration(migration(fstest.MapFS{}, "0001_a"), "0002_b"), "0003_c"))
+	require.NoError(t, err)
+
+	pending, err := migrations.Pending(loaded, nil)
+	require.NoError(t, err)
+	assert.Len(t, pending, 3)
+
+	pending, err = migrations.Pending(loaded, []int64{1, 2})
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, int64(3), pending[0].Version)
+
+	pending, err = migrations.Pending(loaded, []int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Базу обновила более новая версия приложения
+	_, err = migrations.Pending(loaded, []int64{1, 2, 3, 4})
+	require.Error(t, err)
+	assert.True(t, migrations.IsSchemaTooNew(err))
+
+	var tooNew *migrations.SchemaTooNewError
+	require.ErrorAs(t, err, &tooNew)
+	assert.Equal(t, int64(4), tooNew.Version)
+	assert.Equal(t, int64(3), tooNew.Latest)
+}
+
+func TestPendingRejectsUnknownOlderMigration(t *testing.T) {
+	loaded, err := migrations.Load(migration(migration(fstest.MapFS{}, "0001_a"), "0003_c"))
+	require.NoError(t, err)
+
+	_, err = migrations.Pending(loaded, []int64{1, 2})
+	require.Error(t, err)
+	assert.False(t, migrations.IsSchemaTooNew(err))
+
+	var unknown *migrations.UnknownMigrationError
+	require.ErrorAs(t, err, &unknown)
+	assert.Equal(t, int64(2), unknown.Version)
+}
+
+func TestRollback(t *testing.T) {
+	loaded, err := migrations.Load(migration(migration(migration(fstest.MapFS{}, "0001_a"), "0002_b"), "0003_c"))
+	require.NoError(t, err)
+
+	rollback, err := migrations.Rollback(loaded, []int64{1, 2, 3}, 2)
+	require.NoError(t, err)
+	require.Len(t, rollback, 2)
+	assert.Equal(t, int64(3), rollback[0].Version)
+	assert.Equal(t, int64(2), rollback[1].Version)
+
+	// Больше шагов, чем применено миграций, откатывает все
+	rollback, err = migrations.Rollback(loaded, []int64{1}, 5)
+	require.NoError(t, err)
+	require.Len(t, rollback, 1)
+	assert.Equal(t, "-- down 0001_a", rollback[0].Down)
+
+	// Миграции новее приложения откатывает только версия приложения, которая их знает
+	_, err = migrations.Rollback(loaded, []int64{1, 2, 3, 4}, 1)
+	assert.True(t, migrations.IsSchemaTooNew(err))
+}
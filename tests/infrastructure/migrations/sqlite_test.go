@@ -0,0 +1,192 @@
+package migrations
+
+import (
+	"context"
+	"testing"
+
+	"crud/config"
+	"crud/internal/infrastructure/database/gateways"
+	"crud/internal/infrastructure/database/migrations"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSQLiteMigrator создает Migrator над пустой базой SQLite в памяти
+func newSQLiteMigrator(t *testing.T) (*migrations.Migrator, *gateways.SQLiteGateway) {
+	t.Helper()
+
+	gateway, err := gateways.NewSQLiteGateway(&config.Config{SQLitePath: ":memory:"})
+	require.NoError(t, err)
+	t.Cleanup(func() { gateway.Close() })
+
+	migrator, err := migrations.NewDialectMigrator(gateway.DB())
+	require.NoError(t, err)
+	return migrator, gateway
+}
+
+func TestSQLiteMigrationsMirrorPostgres(t *testing.T) {
+	postgres, err := migrations.Postgres()
+	require.NoError(t, err)
+	sqlite, err := migrations.SQLite()
+	require.NoError(t, err)
+
+	require.Len(t, sqlite, len(postgres))
+	for i := range postgres {
+		assert.Equal(t, postgres[i].Version, sqlite[i].Version)
+		assert.Equal(t, postgres[i].Name, sqlite[i].Name)
+	}
+}
+
+func TestForDialect(t *testing.T) {
+	_, err := migrations.ForDialect("mysql")
+	assert.Error(t, err)
+}
+
+func TestSQLiteMigrator(t *testing.T) {
+	ctx := context.Background()
+	migrator, gateway := newSQLiteMigrator(t)
+	latest := migrations.Latest(migrator.Migrations())
+
+	// До первой миграции таблицы schema_migrations нет, все миграции ожидают применения
+	err := migrator.Check(ctx)
+	assert.True(t, migrations.IsPendingMigrations(err))
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.Migrations()))
+	require.NoError(t, migrator.Check(ctx))
+	assert.True(t, gateway.DB().Migrator().HasTable("tasks"))
+
+	// Повторный запуск ничего не применяет
+	applied, err = migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+
+	statuses, err := migrator.Status(ctx)
+	require.NoError(t, err)
+	for _, status := range statuses {
+		assert.NotNil(t, status.AppliedAt, "migration %d is not applied", status.Version)
+	}
+
+	reverted, err := migrator.Down(ctx, len(migrator.Migrations()))
+	require.NoError(t, err)
+	require.Len(t, reverted, len(migrator.Migrations()))
+	assert.Equal(t, latest, reverted[0].Version)
+	assert.False(t, gateway.DB().Migrator().HasTable("tasks"))
+}
+
+func TestSQLiteMigratorRejectsNewerSchema(t *testing.T) {
+	ctx := context.Background()
+	migrator, gateway := newSQLiteMigrator(t)
+
+	_, err := migrator.Up(ctx)
+	require.NoError(t, err)
+
+	// Базу обновила более новая версия приложения
+	newer := migrations.Latest(migrator.Migrations()) + 1
+	require.NoError(t, gateway.DB().Exec(
+		"INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, CURRENT_TIMESTAMP)", newer, "future",
+	).Error)
+
+	err = migrator.Check(ctx)
+	assert.True(t, migrations.IsSchemaTooNew(err))
+
+	_, err = migrator.Up(ctx)
+	assert.True(t, migrations.IsSchemaTooNew(err))
+}
+
+// legacySchema схема, которую создавали первые версии приложения через AutoMigrate
+const legacySchema = `
+CREATE TABLE users (
+    id text,
+    email varchar(255) NOT NULL,
+    name varchar(100) NOT NULL,
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    PRIMARY KEY (id)
+);
+CREATE UNIQUE INDEX idx_users_email ON users (email);
+CREATE INDEX idx_users_deleted_at ON users (deleted_at);
+
+CREATE TABLE tasks (
+    id text,
+    user_id text NOT NULL,
+    title varchar(200) NOT NULL,
+    description text,
+    status varchar(20) NOT NULL DEFAULT 'todo',
+    created_at datetime,
+    updated_at datetime,
+    deleted_at datetime,
+    PRIMARY KEY (id)
+);
+CREATE INDEX idx_tasks_user_id ON tasks (user_id);
+CREATE INDEX idx_tasks_status ON tasks (status);
+CREATE INDEX idx_tasks_deleted_at ON tasks (deleted_at);
+`
+
+func TestSQLiteMigratorUpgradesLegacySchema(t *testing.T) {
+	ctx := context.Background()
+	migrator, gateway := newSQLiteMigrator(t)
+	db := gateway.DB()
+
+	userID := "11111111-1111-1111-1111-111111111111"
+	taskID := "22222222-2222-2222-2222-222222222222"
+	require.NoError(t, db.Exec(legacySchema).Error)
+	require.NoError(t, db.Exec(
+		"INSERT INTO users (id, email, name, created_at, updated_at) VALUES (?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		userID, "legacy@example.com", "Legacy",
+	).Error)
+	require.NoError(t, db.Exec(
+		"INSERT INTO tasks (id, user_id, title, status, created_at, updated_at) VALUES (?, ?, ?, ?, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)",
+		taskID, userID, "Legacy task", "in_progress",
+	).Error)
+
+	applied, err := migrator.Up(ctx)
+	require.NoError(t, err)
+	assert.Len(t, applied, len(migrator.Migrations()))
+	require.NoError(t, migrator.Check(ctx))
+
+	// Существующие строки получают значения новых столбцов по умолчанию
+	var user struct {
+		OrganizationID string
+		Role           string
+		Version        int64
+	}
+	require.NoError(t, db.Raw("SELECT organization_id, role, version FROM users WHERE id = ?", userID).Scan(&user).Error)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", user.OrganizationID)
+	assert.Equal(t, "member", user.Role)
+	assert.Equal(t, int64(1), user.Version)
+
+	var task struct {
+		OrganizationID string
+		Title          string
+		Status         string
+		Priority       string
+		Version        int64
+	}
+	require.NoError(t, db.Raw("SELECT organization_id, title, status, priority, version FROM tasks WHERE id = ?", taskID).Scan(&task).Error)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", task.OrganizationID)
+	assert.Equal(t, "Legacy task", task.Title)
+	assert.Equal(t, "in_progress", task.Status)
+	assert.Equal(t, "medium", task.Priority)
+	assert.Equal(t, int64(1), task.Version)
+
+	// После миграции внешние ключи действуют и для перенесенных таблиц
+	err = db.Exec(
+		"INSERT INTO tasks (id, user_id, title) VALUES (?, ?, ?)",
+		"33333333-3333-3333-3333-333333333333", "44444444-4444-4444-4444-444444444444", "Orphan",
+	).Error
+	assert.Error(t, err)
+
+	// Откат до исходной схемы сохраняет данные
+	_, err = migrator.Down(ctx, len(migrator.Migrations())-1)
+	require.NoError(t, err)
+	assert.False(t, db.Migrator().HasColumn("tasks", "organization_id"))
+	assert.False(t, db.Migrator().HasTable("organizations"))
+
+	var title string
+	require.NoError(t, db.Raw("SELECT title FROM tasks WHERE id = ?", taskID).Scan(&title).Error)
+	assert.Equal(t, "Legacy task", title)
+}
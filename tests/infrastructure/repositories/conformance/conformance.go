@@ -0,0 +1,215 @@
+// Package conformance проверяет, что реализации репозиториев задач и пользователей и менеджеры
+// транзакций выполняют один и тот же контракт интерфейсов. Проверки получают фабрику пустого хранилища
+// и запускаются для каждой реализации: in-memory, SQLite и PostgreSQL
+package conformance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"crud/internal/application/common"
+	"crud/internal/domain/labels"
+	label_vo "crud/internal/domain/labels/value_objects"
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/projects"
+	project_vo "crud/internal/domain/projects/value_objects"
+	"crud/internal/domain/tasks"
+	task_vo "crud/internal/domain/tasks/value_objects"
+	"crud/internal/domain/users"
+	user_vo "crud/internal/domain/users/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// Repositories репозитории одного хранилища и менеджер его транзакций. Проекты и метки нужны задачам
+// для внешних ключей и фильтра по меткам
+type Repositories struct {
+	Users        users.BaseUsersRepository
+	Tasks        tasks.BaseTasksRepository
+	Projects     projects.BaseProjectsRepository
+	Labels       labels.BaseLabelsRepository
+	Transactions common.BaseTransactionManager
+}
+
+// Factory создает репозитории над пустым хранилищем; вызывается для каждой проверки
+type Factory func(t *testing.T) Repositories
+
+// fixture создает сущности для проверки. Время создания растет на секунду с каждой сущностью,
+// поэтому порядок по created_at не зависит от точности, с которой хранилище сохраняет время
+type fixture struct {
+	t     *testing.T
+	ctx   context.Context
+	repos Repositories
+	clock time.Time
+}
+
+// newFixture создает пустое хранилище и контекст запроса в организации по умолчанию
+func newFixture(t *testing.T, factory Factory) *fixture {
+	t.Helper()
+
+	return &fixture{
+		t:     t,
+		ctx:   organizations.ContextWithOrganization(context.Background(), organizations.DefaultOrganizationID),
+		repos: factory(t),
+		clock: time.Now().UTC().Add(-24 * time.Hour).Truncate(time.Second),
+	}
+}
+
+// tick возвращает следующее время создания
+func (f *fixture) tick() time.Time {
+	f.clock = f.clock.Add(time.Second)
+	return f.clock
+}
+
+// newUser возвращает несохраненного пользователя с email
+func (f *fixture) newUser(email string) *users.User {
+	f.t.Helper()
+
+	emailVO, err := user_vo.NewEmailValueObject(email)
+	require.NoError(f.t, err)
+	name, err := user_vo.NewUserNameValueObject("User " + email)
+	require.NoError(f.t, err)
+
+	user := users.NewUser(emailVO, name)
+	user.CreatedAt = f.tick()
+	user.UpdatedAt = user.CreatedAt
+	return user
+}
+
+// user сохраняет нового пользователя с email
+func (f *fixture) user(email string) *users.User {
+	f.t.Helper()
+
+	user, err := f.repos.Users.Create(f.ctx, f.newUser(email))
+	require.NoError(f.t, err)
+	return user
+}
+
+// taskOption настраивает задачу перед сохранением
+type taskOption func(t *testing.T, task *tasks.Task)
+
+// withStatus задает статус задачи
+func withStatus(status string) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		value, err := task_vo.NewTaskStatusValueObject(status)
+		require.NoError(t, err)
+		task.Status = value
+	}
+}
+
+// withPriority задает приоритет задачи
+func withPriority(priority string) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		value, err := task_vo.NewTaskPriorityValueObject(priority)
+		require.NoError(t, err)
+		task.Priority = value
+	}
+}
+
+// withDue задает срок выполнения задачи
+func withDue(due time.Time) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		schedule, err := task_vo.NewTaskScheduleValueObject(nil, &due)
+		require.NoError(t, err)
+		task.Schedule = schedule
+	}
+}
+
+// withDescription задает описание задачи
+func withDescription(description string) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		task.Description = description
+	}
+}
+
+// withParent делает задачу подзадачей parent
+func withParent(parent *tasks.Task) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		task.ParentID = &parent.ID
+	}
+}
+
+// withProject помещает задачу в проект
+func withProject(project *projects.Project) taskOption {
+	return func(t *testing.T, task *tasks.Task) {
+		task.ProjectID = &project.ID
+	}
+}
+
+// newTask возвращает несохраненную задачу owner
+func (f *fixture) newTask(owner *users.User, title string, options ...taskOption) *tasks.Task {
+	f.t.Helper()
+
+	titleVO, err := task_vo.NewTaskTitleValueObject(title)
+	require.NoError(f.t, err)
+	status, err := task_vo.NewTaskStatusValueObject(task_vo.StatusTodo)
+	require.NoError(f.t, err)
+
+	task := tasks.NewTask(owner.ID, titleVO, "", status)
+	task.CreatedAt = f.tick()
+	task.UpdatedAt = task.CreatedAt
+	for _, option := range options {
+		option(f.t, task)
+	}
+	return task
+}
+
+// task сохраняет новую задачу owner
+func (f *fixture) task(owner *users.User, title string, options ...taskOption) *tasks.Task {
+	f.t.Helper()
+
+	task, err := f.repos.Tasks.Create(f.ctx, f.newTask(owner, title, options...))
+	require.NoError(f.t, err)
+	return task
+}
+
+// project сохраняет новый проект owner
+func (f *fixture) project(owner *users.User, name string) *projects.Project {
+	f.t.Helper()
+
+	nameVO, err := project_vo.NewProjectNameValueObject(name)
+	require.NoError(f.t, err)
+
+	project := projects.NewProject(owner.ID, nameVO, "")
+	project.CreatedAt = f.tick()
+	project.UpdatedAt = project.CreatedAt
+
+	project, err = f.repos.Projects.Create(f.ctx, project)
+	require.NoError(f.t, err)
+	return project
+}
+
+// label сохраняет новую метку и добавляет ее к задачам
+func (f *fixture) label(name string, tagged ...*tasks.Task) *labels.Label {
+	f.t.Helper()
+
+	nameVO, err := label_vo.NewLabelNameValueObject(name)
+	require.NoError(f.t, err)
+
+	label, err := f.repos.Labels.Create(f.ctx, labels.NewLabel(nameVO))
+	require.NoError(f.t, err)
+	for _, task := range tagged {
+		require.NoError(f.t, f.repos.Labels.AttachToTask(f.ctx, task.ID, label.ID))
+	}
+	return label
+}
+
+// taskIDs возвращает ID задач в порядке аргументов
+func taskIDs(list ...*tasks.Task) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, task := range list {
+		ids = append(ids, task.ID)
+	}
+	return ids
+}
+
+// userIDs возвращает ID пользователей в порядке аргументов
+func userIDs(list ...*users.User) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(list))
+	for _, user := range list {
+		ids = append(ids, user.ID)
+	}
+	return ids
+}
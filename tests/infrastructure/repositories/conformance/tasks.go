@@ -0,0 +1,696 @@
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/tasks"
+	task_vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunTasksRepositoryTests проверяет контракт tasks.BaseTasksRepository
+func RunTasksRepositoryTests(t *testing.T, factory Factory) {
+	t.Run("create and get", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		project := f.project(owner, "Project")
+		due := f.clock.Add(72 * time.Hour)
+		parent := f.task(owner, "Parent")
+
+		created := f.task(owner, "Child",
+			withStatus(task_vo.StatusInProgress),
+			withPriority(task_vo.PriorityHigh),
+			withDue(due),
+			withParent(parent),
+			withProject(project),
+		)
+		assert.Equal(t, int64(1), created.Version)
+		assert.Equal(t, organizations.DefaultOrganizationID, created.OrganizationID)
+
+		stored, err := f.repos.Tasks.GetByID(f.ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, stored.ID)
+		assert.Equal(t, owner.ID, stored.UserID)
+		assert.Equal(t, "Child", stored.Title.Value())
+		assert.Equal(t, task_vo.StatusInProgress, stored.Status.Value())
+		assert.Equal(t, task_vo.PriorityHigh, stored.Priority.Value())
+		require.NotNil(t, stored.Schedule.DueDate())
+		assert.True(t, due.Equal(*stored.Schedule.DueDate()), "due date %v, expected %v", stored.Schedule.DueDate(), due)
+		assert.Nil(t, stored.Schedule.StartDate())
+		require.NotNil(t, stored.ParentID)
+		assert.Equal(t, parent.ID, *stored.ParentID)
+		require.NotNil(t, stored.ProjectID)
+		assert.Equal(t, project.ID, *stored.ProjectID)
+		assert.Equal(t, int64(1), stored.Version)
+		assert.Equal(t, organizations.DefaultOrganizationID, stored.OrganizationID)
+		assert.WithinDuration(t, created.CreatedAt, stored.CreatedAt, time.Millisecond)
+		assert.Nil(t, stored.DeletedAt)
+	})
+
+	t.Run("create rejects duplicates and missing owners", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		existing := f.task(owner, "Existing")
+
+		duplicate := f.newTask(owner, "Duplicate")
+		duplicate.ID = existing.ID
+		_, err := f.repos.Tasks.Create(f.ctx, duplicate)
+		assert.True(t, tasks.IsTaskAlreadyExists(err), "duplicate ID: %v", err)
+
+		orphan := f.newTask(owner, "Orphan")
+		orphan.UserID = uuid.New()
+		_, err = f.repos.Tasks.Create(f.ctx, orphan)
+		assert.True(t, tasks.IsTaskOwnerNotFound(err), "missing owner: %v", err)
+
+		list, total, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, taskIDs(existing), taskIDs(list...))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		f.task(owner, "Existing")
+		missing := uuid.New()
+
+		_, err := f.repos.Tasks.GetByID(f.ctx, missing)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetByID: %v", err)
+
+		task := f.newTask(owner, "Ghost")
+		task.ID = missing
+		_, err = f.repos.Tasks.Update(f.ctx, task)
+		assert.True(t, tasks.IsTaskNotFound(err), "Update: %v", err)
+
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Delete(f.ctx, missing)), "Delete")
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Restore(f.ctx, missing)), "Restore")
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Purge(f.ctx, missing)), "Purge")
+
+		_, err = f.repos.Tasks.GetDeletedByID(f.ctx, missing)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetDeletedByID: %v", err)
+
+		_, err = f.repos.Tasks.ListAncestors(f.ctx, missing)
+		assert.True(t, tasks.IsTaskNotFound(err), "ListAncestors: %v", err)
+	})
+
+	t.Run("update checks version", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		task := f.task(owner, "Task")
+
+		title, err := task_vo.NewTaskTitleValueObject("Renamed")
+		require.NoError(t, err)
+		changed := *task
+		changed.Title = title
+
+		updated, err := f.repos.Tasks.Update(f.ctx, &changed)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version)
+		assert.Equal(t, int64(1), changed.Version, "the argument must keep its version")
+		assert.True(t, updated.UpdatedAt.After(task.CreatedAt), "UpdatedAt must move forward")
+
+		stored, err := f.repos.Tasks.GetByID(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Renamed", stored.Title.Value())
+		assert.Equal(t, int64(2), stored.Version)
+
+		// Повторное сохранение устаревшей версии
+		_, err = f.repos.Tasks.Update(f.ctx, &changed)
+		assert.True(t, tasks.IsConcurrentModification(err), "stale Update: %v", err)
+
+		stored, err = f.repos.Tasks.GetByID(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), stored.Version)
+	})
+
+	t.Run("update checks owner", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		task := f.task(owner, "Task")
+
+		changed := *task
+		changed.UserID = uuid.New()
+		_, err := f.repos.Tasks.Update(f.ctx, &changed)
+		assert.True(t, tasks.IsTaskOwnerNotFound(err), "Update: %v", err)
+	})
+
+	t.Run("delete moves to trash", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		deleted := f.task(owner, "Deleted")
+		active := f.task(owner, "Active")
+
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, deleted.ID))
+
+		_, err := f.repos.Tasks.GetByID(f.ctx, deleted.ID)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetByID: %v", err)
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Delete(f.ctx, deleted.ID)), "second Delete")
+
+		list, total, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, taskIDs(active), taskIDs(list...))
+
+		stored, err := f.repos.Tasks.GetDeletedByID(f.ctx, deleted.ID)
+		require.NoError(t, err)
+		require.NotNil(t, stored.DeletedAt)
+
+		count, err := f.repos.Tasks.CountOpenByUser(f.ctx, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		// Действующая задача в корзине не видна
+		_, err = f.repos.Tasks.GetDeletedByID(f.ctx, active.ID)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetDeletedByID of an active task: %v", err)
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Restore(f.ctx, active.ID)), "Restore of an active task")
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Purge(f.ctx, active.ID)), "Purge of an active task")
+	})
+
+	t.Run("list deleted", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		other := f.user("other@example.com")
+		first := f.task(owner, "First")
+		second := f.task(other, "Second")
+		third := f.task(owner, "Third")
+		f.task(owner, "Active")
+
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, first.ID))
+		time.Sleep(10 * time.Millisecond)
+		cutoff := time.Now()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, second.ID))
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, third.ID))
+
+		testCases := []struct {
+			name     string
+			filter   tasks.ListDeletedTasksFilter
+			page     int
+			pageSize int
+			total    int64
+			expected []uuid.UUID
+		}{
+			{"latest first", tasks.ListDeletedTasksFilter{}, 1, 10, 3, taskIDs(third, second, first)},
+			{"second page", tasks.ListDeletedTasksFilter{}, 2, 2, 3, taskIDs(first)},
+			{"by owner", tasks.ListDeletedTasksFilter{UserID: &owner.ID}, 1, 10, 2, taskIDs(third, first)},
+			{"deleted before", tasks.ListDeletedTasksFilter{DeletedBefore: &cutoff}, 1, 10, 1, taskIDs(first)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				list, total, err := f.repos.Tasks.ListDeleted(f.ctx, tc.filter, tc.page, tc.pageSize)
+				require.NoError(t, err)
+				assert.Equal(t, tc.total, total)
+				assert.Equal(t, tc.expected, taskIDs(list...))
+			})
+		}
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		task := f.task(owner, "Task")
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, task.ID))
+
+		require.NoError(t, f.repos.Tasks.Restore(f.ctx, task.ID))
+
+		restored, err := f.repos.Tasks.GetByID(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, int64(2), restored.Version, "Restore must bump the version")
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Restore(f.ctx, task.ID)), "second Restore")
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		parent := f.task(owner, "Parent")
+		child := f.task(owner, "Child", withParent(parent))
+		dependent := f.task(owner, "Dependent")
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, dependent.ID, parent.ID))
+		f.label("purged", parent)
+
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, parent.ID))
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, child.ID))
+
+		// Подзадача в корзине по-прежнему ссылается на родителя
+		err := f.repos.Tasks.Purge(f.ctx, parent.ID)
+		assert.True(t, tasks.IsTaskHasSubtasks(err), "Purge of a parent: %v", err)
+
+		deletedSubtree, err := f.repos.Tasks.ListDeletedSubtree(f.ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(child), taskIDs(deletedSubtree...))
+
+		require.NoError(t, f.repos.Tasks.Purge(f.ctx, child.ID))
+		require.NoError(t, f.repos.Tasks.Purge(f.ctx, parent.ID))
+
+		_, err = f.repos.Tasks.GetDeletedByID(f.ctx, parent.ID)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetDeletedByID: %v", err)
+		assert.True(t, tasks.IsTaskNotFound(f.repos.Tasks.Purge(f.ctx, parent.ID)), "second Purge")
+
+		chain, err := f.repos.Tasks.ListBlockerChain(f.ctx, dependent.ID)
+		require.NoError(t, err)
+		assert.Empty(t, chain, "Purge must remove dependencies")
+
+		taskLabels, err := f.repos.Labels.ListByTask(f.ctx, parent.ID)
+		require.NoError(t, err)
+		assert.Empty(t, taskLabels, "Purge must detach labels")
+	})
+
+	t.Run("list orders by creation time", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+
+		// Порядок вставки не совпадает с порядком создания
+		second := f.newTask(owner, "Second")
+		first := f.newTask(owner, "First")
+		first.CreatedAt, second.CreatedAt = second.CreatedAt, first.CreatedAt
+		for _, task := range []*tasks.Task{second, first} {
+			_, err := f.repos.Tasks.Create(f.ctx, task)
+			require.NoError(t, err)
+		}
+		third := f.task(owner, "Third")
+
+		list, total, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, taskIDs(first, second, third), taskIDs(list...))
+	})
+
+	t.Run("list orders by priority", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		soon := f.clock.Add(24 * time.Hour)
+		later := f.clock.Add(48 * time.Hour)
+
+		lowLater := f.task(owner, "Low later", withPriority(task_vo.PriorityLow), withDue(later))
+		urgentUndated := f.task(owner, "Urgent undated", withPriority(task_vo.PriorityUrgent))
+		urgentLater := f.task(owner, "Urgent later", withPriority(task_vo.PriorityUrgent), withDue(later))
+		mediumFirst := f.task(owner, "Medium first")
+		urgentSoon := f.task(owner, "Urgent soon", withPriority(task_vo.PriorityUrgent), withDue(soon))
+		mediumSecond := f.task(owner, "Medium second")
+		high := f.task(owner, "High", withPriority(task_vo.PriorityHigh))
+
+		list, _, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{Sort: tasks.TaskSortPriority}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t,
+			taskIDs(urgentSoon, urgentLater, urgentUndated, high, mediumFirst, mediumSecond, lowLater),
+			taskIDs(list...),
+		)
+	})
+
+	t.Run("list pagination", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		a := f.task(owner, "A")
+		b := f.task(owner, "B")
+		c := f.task(owner, "C")
+
+		testCases := []struct {
+			name     string
+			page     int
+			pageSize int
+			expected []uuid.UUID
+		}{
+			{"first page", 1, 2, taskIDs(a, b)},
+			{"last partial page", 2, 2, taskIDs(c)},
+			{"page past the end", 3, 2, taskIDs()},
+			{"page size larger than total", 1, 10, taskIDs(a, b, c)},
+			{"page below one is the first page", 0, 2, taskIDs(a, b)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				list, total, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{}, tc.page, tc.pageSize)
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), total)
+				assert.NotNil(t, list)
+				assert.Equal(t, tc.expected, taskIDs(list...))
+			})
+		}
+	})
+
+	t.Run("list by cursor", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		other := f.user("other@example.com")
+		soon := f.clock.Add(24 * time.Hour)
+		later := f.clock.Add(48 * time.Hour)
+
+		// Одинаковые приоритеты и сроки проверяют переход между ключами сортировки на границе страниц
+		f.task(owner, "Low later", withPriority(task_vo.PriorityLow), withDue(later))
+		f.task(owner, "Urgent undated", withPriority(task_vo.PriorityUrgent))
+		f.task(owner, "Urgent later", withPriority(task_vo.PriorityUrgent), withDue(later))
+		f.task(owner, "Medium first")
+		f.task(owner, "Urgent soon", withPriority(task_vo.PriorityUrgent), withDue(soon))
+		f.task(owner, "Medium second")
+		f.task(owner, "Urgent soon again", withPriority(task_vo.PriorityUrgent), withDue(soon))
+		f.task(owner, "High", withPriority(task_vo.PriorityHigh))
+		f.task(owner, "Medium third", withDue(soon))
+		f.task(other, "Foreign")
+		deleted := f.task(owner, "Deleted", withPriority(task_vo.PriorityUrgent))
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, deleted.ID))
+
+		for _, order := range []tasks.TaskSortOrder{tasks.TaskSortDefault, tasks.TaskSortPriority} {
+			t.Run("sort "+string(order), func(t *testing.T) {
+				filter := tasks.ListTasksFilter{UserID: &owner.ID, Sort: order}
+				all, _, err := f.repos.Tasks.List(f.ctx, filter, 1, 100)
+				require.NoError(t, err)
+				require.Len(t, all, 9)
+
+				for _, limit := range []int{1, 2, 4, 9, 20} {
+					// Вперед от начала списка
+					var forward []*tasks.Task
+					var cursor *tasks.TaskCursor
+					for range len(all) + 1 {
+						page, err := f.repos.Tasks.ListByCursor(f.ctx, filter, cursor, limit)
+						require.NoError(t, err)
+						require.NotNil(t, page)
+						if len(page) == 0 {
+							break
+						}
+						assert.LessOrEqual(t, len(page), limit)
+						forward = append(forward, page...)
+						cursor = tasks.NewTaskCursor(page[len(page)-1], order, false)
+					}
+					assert.Equal(t, taskIDs(all...), taskIDs(forward...), "forward with limit %d", limit)
+
+					// Назад от последней задачи
+					backward := []*tasks.Task{all[len(all)-1]}
+					cursor = tasks.NewTaskCursor(all[len(all)-1], order, true)
+					for range len(all) + 1 {
+						page, err := f.repos.Tasks.ListByCursor(f.ctx, filter, cursor, limit)
+						require.NoError(t, err)
+						if len(page) == 0 {
+							break
+						}
+						assert.LessOrEqual(t, len(page), limit)
+						backward = append(append([]*tasks.Task{}, page...), backward...)
+						cursor = tasks.NewTaskCursor(page[0], order, true)
+					}
+					assert.Equal(t, taskIDs(all...), taskIDs(backward...), "backward with limit %d", limit)
+				}
+			})
+		}
+	})
+
+	t.Run("list filters", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		other := f.user("other@example.com")
+		project := f.project(owner, "Project")
+		now := f.clock.Add(12 * time.Hour)
+		yesterday := now.Add(-24 * time.Hour)
+		tomorrow := now.Add(24 * time.Hour)
+
+		overdue := f.task(owner, "Overdue", withDue(yesterday), withProject(project))
+		closedLate := f.task(owner, "Closed late", withDue(yesterday), withStatus(task_vo.StatusDone))
+		upcoming := f.task(owner, "Upcoming", withDue(tomorrow), withStatus(task_vo.StatusInProgress), withParent(overdue))
+		undated := f.task(owner, "Undated", withProject(project), withParent(overdue))
+		foreign := f.task(other, "Foreign", withDue(tomorrow))
+
+		bug := f.label("bug", overdue, upcoming)
+		f.label("ui", upcoming, foreign)
+
+		// undated ждет незавершенную задачу, foreign - только завершенную
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, undated.ID, upcoming.ID))
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, foreign.ID, closedLate.ID))
+
+		// Задачи в корзине не попадают ни в одну выборку
+		deleted := f.task(owner, "Deleted", withDue(yesterday), withProject(project))
+		require.NoError(t, f.repos.Labels.AttachToTask(f.ctx, deleted.ID, bug.ID))
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, deleted.ID))
+
+		status := task_vo.StatusInProgress
+		yes, no := true, false
+
+		testCases := []struct {
+			name     string
+			filter   tasks.ListTasksFilter
+			expected []uuid.UUID
+		}{
+			{"no filter", tasks.ListTasksFilter{}, taskIDs(overdue, closedLate, upcoming, undated, foreign)},
+			{"by owner", tasks.ListTasksFilter{UserID: &other.ID}, taskIDs(foreign)},
+			{"by status", tasks.ListTasksFilter{Status: &status}, taskIDs(upcoming)},
+			{"by project", tasks.ListTasksFilter{ProjectID: &project.ID}, taskIDs(overdue, undated)},
+			{"by parent", tasks.ListTasksFilter{ParentID: &overdue.ID}, taskIDs(upcoming, undated)},
+			{"due before is inclusive", tasks.ListTasksFilter{DueBefore: &yesterday}, taskIDs(overdue, closedLate)},
+			{"due after is inclusive", tasks.ListTasksFilter{DueAfter: &tomorrow}, taskIDs(upcoming, foreign)},
+			{"due range", tasks.ListTasksFilter{DueAfter: &yesterday, DueBefore: &now}, taskIDs(overdue, closedLate)},
+			{"overdue", tasks.ListTasksFilter{Overdue: &yes, Now: now}, taskIDs(overdue)},
+			{"not overdue", tasks.ListTasksFilter{Overdue: &no, Now: now}, taskIDs(closedLate, upcoming, undated, foreign)},
+			{"any label", tasks.ListTasksFilter{Labels: []string{"bug", "ui"}, LabelMatch: tasks.LabelMatchAny}, taskIDs(overdue, upcoming, foreign)},
+			{"all labels", tasks.ListTasksFilter{Labels: []string{"bug", "ui"}, LabelMatch: tasks.LabelMatchAll}, taskIDs(upcoming)},
+			{"unknown label", tasks.ListTasksFilter{Labels: []string{"missing"}}, taskIDs()},
+			{"blocked", tasks.ListTasksFilter{Blocked: &yes}, taskIDs(undated)},
+			{"not blocked", tasks.ListTasksFilter{Blocked: &no}, taskIDs(overdue, closedLate, upcoming, foreign)},
+			{
+				"combined",
+				tasks.ListTasksFilter{UserID: &owner.ID, Labels: []string{"bug"}, Overdue: &no, Now: now},
+				taskIDs(upcoming),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				list, total, err := f.repos.Tasks.List(f.ctx, tc.filter, 1, 10)
+				require.NoError(t, err)
+				assert.Equal(t, int64(len(tc.expected)), total)
+				assert.Equal(t, tc.expected, taskIDs(list...))
+			})
+		}
+	})
+
+	t.Run("list by specification", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		other := f.user("other@example.com")
+		project := f.project(owner, "Project")
+		day := f.clock.Truncate(24 * time.Hour).Add(48 * time.Hour)
+
+		report := f.task(owner, "Write report", withPriority(task_vo.PriorityHigh), withDue(day.Add(10*time.Hour)),
+			withProject(project), withDescription("Quarterly numbers"))
+		review := f.task(owner, "Review report", withPriority(task_vo.PriorityUrgent), withDue(day.Add(34*time.Hour)),
+			withStatus(task_vo.StatusInProgress), withDescription("Черновик ОТЧЁТА"))
+		fix := f.task(owner, "Fix login bug", withPriority(task_vo.PriorityLow), withStatus(task_vo.StatusDone),
+			withDescription("50% of users_affected"))
+		plan := f.task(owner, "Plan sprint", withDue(day.Add(23*time.Hour)), withProject(project))
+		foreign := f.task(other, "Foreign report")
+
+		date := day.Format("2006-01-02")
+		testCases := []struct {
+			name       string
+			expression string
+			expected   []uuid.UUID
+		}{
+			{"equal", "status = todo", taskIDs(report, plan, foreign)},
+			{"not equal", `title != "Plan sprint" and status != done`, taskIDs(report, review, foreign)},
+			{"in", "status in (in_progress, done)", taskIDs(review, fix)},
+			{"priority range", "priority >= medium", taskIDs(report, review, plan, foreign)},
+			{"contains ignores case", "title contains REPORT", taskIDs(report, review, foreign)},
+			{"contains ignores case beyond ascii", `description contains "черновик отчёта"`, taskIDs(review)},
+			{"contains is literal", `description contains "50%" or description contains "_"`, taskIDs(fix)},
+			{"or with nested and", "priority = urgent or (status = done and title contains fix)", taskIDs(review, fix)},
+			{"and binds tighter than or", "status = done or status = todo and priority = high", taskIDs(report, fix)},
+			{"by id", "user_id in (" + other.ID.String() + ")", taskIDs(foreign)},
+			{"missing value never matches", "project_id != " + project.ID.String(), taskIDs()},
+			{"day equal", "due_date = " + date, taskIDs(report, plan)},
+			{"day not equal", "due_date != " + date, taskIDs(review)},
+			{"day upper bound is inclusive", "due_date <= " + date, taskIDs(report, plan)},
+			{"after day", "due_date > " + date, taskIDs(review)},
+			{"moment", "created_at > " + review.CreatedAt.Format(time.RFC3339), taskIDs(fix, plan, foreign)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				spec, err := tasks.ParseTaskFilter(tc.expression, time.UTC)
+				require.NoError(t, err)
+
+				list, total, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{Specification: spec}, 1, 10)
+				require.NoError(t, err)
+				assert.Equal(t, int64(len(tc.expected)), total)
+				assert.Equal(t, tc.expected, taskIDs(list...))
+			})
+		}
+
+		t.Run("with other filters", func(t *testing.T) {
+			spec, err := tasks.ParseTaskFilter("title contains report", time.UTC)
+			require.NoError(t, err)
+
+			list, err := f.repos.Tasks.ListByCursor(f.ctx, tasks.ListTasksFilter{UserID: &owner.ID, Specification: spec}, nil, 10)
+			require.NoError(t, err)
+			assert.Equal(t, taskIDs(report, review), taskIDs(list...))
+		})
+	})
+
+	t.Run("list sorts by fields", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		day := f.clock.Add(48 * time.Hour)
+
+		report := f.task(owner, "Write report", withPriority(task_vo.PriorityHigh), withDue(day))
+		review := f.task(owner, "Review report", withPriority(task_vo.PriorityUrgent), withDue(day.Add(24*time.Hour)),
+			withStatus(task_vo.StatusInProgress))
+		fix := f.task(owner, "Fix login bug", withPriority(task_vo.PriorityLow), withStatus(task_vo.StatusDone))
+		plan := f.task(owner, "Plan sprint", withDue(day.Add(time.Hour)))
+		budget := f.task(owner, "Budget")
+
+		testCases := []struct {
+			sort     string
+			expected []uuid.UUID
+		}{
+			{"-priority,title", taskIDs(review, report, budget, plan, fix)},
+			{"due_date", taskIDs(report, plan, review, fix, budget)},
+			{"-due_date", taskIDs(review, plan, report, fix, budget)},
+			{"-updated_at", taskIDs(budget, plan, fix, review, report)},
+			{"status,-created_at", taskIDs(fix, review, budget, plan, report)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.sort, func(t *testing.T) {
+				_, keys, err := tasks.ParseTaskSort(tc.sort)
+				require.NoError(t, err)
+
+				list, _, err := f.repos.Tasks.List(f.ctx, tasks.ListTasksFilter{SortKeys: keys}, 1, 10)
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, taskIDs(list...))
+			})
+		}
+	})
+
+	t.Run("hierarchy", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+
+		// Подзадачи создаются раньше своих соседей, но сохраняются позже
+		root := f.task(owner, "Root")
+		lateChild := f.newTask(owner, "Late child", withParent(root))
+		earlyChild := f.newTask(owner, "Early child", withParent(root))
+		earlyChild.CreatedAt, lateChild.CreatedAt = lateChild.CreatedAt, earlyChild.CreatedAt
+		for _, task := range []*tasks.Task{lateChild, earlyChild} {
+			_, err := f.repos.Tasks.Create(f.ctx, task)
+			require.NoError(t, err)
+		}
+		grandchild := f.task(owner, "Grandchild", withParent(earlyChild))
+		deletedChild := f.task(owner, "Deleted child", withParent(root))
+		f.task(owner, "Under deleted child", withParent(deletedChild))
+		f.task(owner, "Unrelated")
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, deletedChild.ID))
+
+		subtree, err := f.repos.Tasks.ListSubtree(f.ctx, root.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(earlyChild, lateChild, grandchild), taskIDs(subtree...))
+
+		subtree, err = f.repos.Tasks.ListSubtree(f.ctx, grandchild.ID)
+		require.NoError(t, err)
+		assert.Empty(t, subtree)
+
+		ancestors, err := f.repos.Tasks.ListAncestors(f.ctx, grandchild.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(earlyChild, root), taskIDs(ancestors...))
+
+		ancestors, err = f.repos.Tasks.ListAncestors(f.ctx, root.ID)
+		require.NoError(t, err)
+		assert.Empty(t, ancestors)
+	})
+
+	t.Run("dependencies", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		task := f.task(owner, "Task")
+		lateBlocker := f.newTask(owner, "Late blocker")
+		earlyBlocker := f.newTask(owner, "Early blocker")
+		earlyBlocker.CreatedAt, lateBlocker.CreatedAt = lateBlocker.CreatedAt, earlyBlocker.CreatedAt
+		for _, blocker := range []*tasks.Task{lateBlocker, earlyBlocker} {
+			_, err := f.repos.Tasks.Create(f.ctx, blocker)
+			require.NoError(t, err)
+		}
+		root := f.task(owner, "Root blocker")
+
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, task.ID, lateBlocker.ID))
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, task.ID, earlyBlocker.ID))
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, task.ID, earlyBlocker.ID), "adding twice must succeed")
+		require.NoError(t, f.repos.Tasks.AddDependency(f.ctx, earlyBlocker.ID, root.ID))
+
+		// Существование самой задачи проверяет use case
+		err := f.repos.Tasks.AddDependency(f.ctx, task.ID, uuid.New())
+		assert.True(t, tasks.IsBlockerTaskNotFound(err), "missing blocker: %v", err)
+
+		blockers, err := f.repos.Tasks.ListBlockers(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(earlyBlocker, lateBlocker), taskIDs(blockers...))
+
+		dependents, err := f.repos.Tasks.ListDependents(f.ctx, earlyBlocker.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(task), taskIDs(dependents...))
+
+		chain, err := f.repos.Tasks.ListBlockerChain(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, taskIDs(earlyBlocker, lateBlocker, root), chain)
+
+		require.NoError(t, f.repos.Tasks.RemoveDependency(f.ctx, task.ID, lateBlocker.ID))
+		require.NoError(t, f.repos.Tasks.RemoveDependency(f.ctx, task.ID, lateBlocker.ID), "removing twice must succeed")
+
+		blockers, err = f.repos.Tasks.ListBlockers(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Equal(t, taskIDs(earlyBlocker), taskIDs(blockers...))
+
+		// Блокирующая задача в корзине в списках не видна
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, earlyBlocker.ID))
+		blockers, err = f.repos.Tasks.ListBlockers(f.ctx, task.ID)
+		require.NoError(t, err)
+		assert.Empty(t, blockers)
+	})
+
+	t.Run("owner operations", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		heir := f.user("heir@example.com")
+		open := f.task(owner, "Open")
+		f.task(owner, "In progress", withStatus(task_vo.StatusInProgress))
+		f.task(owner, "Done", withStatus(task_vo.StatusDone))
+		trashed := f.task(owner, "Trashed")
+		require.NoError(t, f.repos.Tasks.Delete(f.ctx, trashed.ID))
+		kept := f.task(heir, "Kept")
+
+		count, err := f.repos.Tasks.CountOpenByUser(f.ctx, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		_, err = f.repos.Tasks.ReassignOwner(f.ctx, owner.ID, uuid.New())
+		assert.True(t, tasks.IsTaskOwnerNotFound(err), "ReassignOwner to a missing user: %v", err)
+
+		reassigned, err := f.repos.Tasks.ReassignOwner(f.ctx, owner.ID, heir.ID)
+		require.NoError(t, err)
+		require.Len(t, reassigned, 3)
+		assert.Equal(t, open.ID, reassigned[0].ID)
+		assert.Equal(t, owner.ID, reassigned[0].UserID, "ReassignOwner returns tasks as they were before")
+
+		stored, err := f.repos.Tasks.GetByID(f.ctx, open.ID)
+		require.NoError(t, err)
+		assert.Equal(t, heir.ID, stored.UserID)
+		assert.Equal(t, int64(2), stored.Version, "ReassignOwner must bump the version")
+
+		inTrash, err := f.repos.Tasks.GetDeletedByID(f.ctx, trashed.ID)
+		require.NoError(t, err)
+		assert.Equal(t, owner.ID, inTrash.UserID, "tasks in the trash keep their owner")
+
+		deleted, err := f.repos.Tasks.DeleteByUser(f.ctx, heir.ID)
+		require.NoError(t, err)
+		require.Len(t, deleted, 4)
+		assert.Nil(t, deleted[0].DeletedAt, "DeleteByUser returns tasks as they were before")
+
+		_, err = f.repos.Tasks.GetByID(f.ctx, kept.ID)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetByID: %v", err)
+
+		count, err = f.repos.Tasks.CountOpenByUser(f.ctx, heir.ID)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+	})
+}
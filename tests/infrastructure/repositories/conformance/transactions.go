@@ -0,0 +1,128 @@
+package conformance
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"crud/internal/domain/tasks"
+	task_vo "crud/internal/domain/tasks/value_objects"
+	"crud/internal/domain/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// errAbort ошибка, которой проверки прерывают транзакцию
+var errAbort = errors.New("abort")
+
+// RunTransactionTests проверяет контракт common.BaseTransactionManager над репозиториями хранилища
+func RunTransactionTests(t *testing.T, factory Factory) {
+	t.Run("commit", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		var created *tasks.Task
+		err := f.repos.Transactions.WithinTransaction(f.ctx, func(ctx context.Context) error {
+			owner, err := f.repos.Users.Create(ctx, f.newUser("commit@example.com"))
+			if err != nil {
+				return err
+			}
+			created, err = f.repos.Tasks.Create(ctx, f.newTask(owner, "Committed"))
+			return err
+		})
+		require.NoError(t, err)
+
+		_, err = f.repos.Users.GetByEmail(f.ctx, "commit@example.com")
+		require.NoError(t, err)
+		_, err = f.repos.Tasks.GetByID(f.ctx, created.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("rollback on error", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		kept := f.task(owner, "Kept")
+
+		var created *tasks.Task
+		err := f.repos.Transactions.WithinTransaction(f.ctx, func(ctx context.Context) error {
+			var err error
+			if _, err = f.repos.Users.Create(ctx, f.newUser("rolled-back@example.com")); err != nil {
+				return err
+			}
+			if created, err = f.repos.Tasks.Create(ctx, f.newTask(owner, "Rolled back")); err != nil {
+				return err
+			}
+			if err := f.repos.Tasks.Delete(ctx, kept.ID); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		_, err = f.repos.Users.GetByEmail(f.ctx, "rolled-back@example.com")
+		assert.True(t, users.IsUserNotFound(err), "GetByEmail: %v", err)
+		_, err = f.repos.Tasks.GetByID(f.ctx, created.ID)
+		assert.True(t, tasks.IsTaskNotFound(err), "GetByID: %v", err)
+		_, err = f.repos.Tasks.GetByID(f.ctx, kept.ID)
+		assert.NoError(t, err, "a task deleted in a rolled back transaction must stay")
+	})
+
+	t.Run("rollback on panic", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		assert.Panics(t, func() {
+			_ = f.repos.Transactions.WithinTransaction(f.ctx, func(ctx context.Context) error {
+				if _, err := f.repos.Users.Create(ctx, f.newUser("panic@example.com")); err != nil {
+					return err
+				}
+				panic("abort")
+			})
+		})
+
+		_, err := f.repos.Users.GetByEmail(f.ctx, "panic@example.com")
+		assert.True(t, users.IsUserNotFound(err), "GetByEmail: %v", err)
+	})
+
+	t.Run("rolled back update keeps the stored task", func(t *testing.T) {
+		f := newFixture(t, factory)
+		owner := f.user("owner@example.com")
+		created := f.task(owner, "Original")
+
+		// Задача меняется до транзакции, как это делают use cases
+		loaded, err := f.repos.Tasks.GetByID(f.ctx, created.ID)
+		require.NoError(t, err)
+		title, err := task_vo.NewTaskTitleValueObject("Changed")
+		require.NoError(t, err)
+		loaded.Title = title
+
+		err = f.repos.Transactions.WithinTransaction(f.ctx, func(ctx context.Context) error {
+			if _, err := f.repos.Tasks.Update(ctx, loaded); err != nil {
+				return err
+			}
+			return errAbort
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		stored, err := f.repos.Tasks.GetByID(f.ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Original", stored.Title.Value())
+		assert.Equal(t, created.Version, stored.Version)
+	})
+
+	t.Run("nested call joins the open transaction", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		err := f.repos.Transactions.WithinTransaction(f.ctx, func(ctx context.Context) error {
+			return f.repos.Transactions.WithinTransaction(ctx, func(ctx context.Context) error {
+				if _, err := f.repos.Users.Create(ctx, f.newUser("nested@example.com")); err != nil {
+					return err
+				}
+				return errAbort
+			})
+		})
+		require.ErrorIs(t, err, errAbort)
+
+		_, err = f.repos.Users.GetByEmail(f.ctx, "nested@example.com")
+		assert.True(t, users.IsUserNotFound(err), "GetByEmail: %v", err)
+	})
+}
@@ -0,0 +1,329 @@
+package conformance
+
+import (
+	"testing"
+	"time"
+
+	"crud/internal/domain/organizations"
+	"crud/internal/domain/users"
+	user_vo "crud/internal/domain/users/value_objects"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// RunUsersRepositoryTests проверяет контракт users.BaseUsersRepository.
+// Запрет окончательно удалять пользователя, на которого ссылаются другие данные, не проверяется:
+// in-memory репозиторий о них не знает, и его проверяет use case
+func RunUsersRepositoryTests(t *testing.T, factory Factory) {
+	t.Run("create and get", func(t *testing.T) {
+		f := newFixture(t, factory)
+		created := f.user("alice@example.com")
+
+		assert.Equal(t, int64(1), created.Version)
+		assert.Equal(t, organizations.DefaultOrganizationID, created.OrganizationID)
+
+		byID, err := f.repos.Users.GetByID(f.ctx, created.ID)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, byID.ID)
+		assert.Equal(t, "alice@example.com", byID.Email.Value())
+		assert.Equal(t, created.Name.Value(), byID.Name.Value())
+		assert.Equal(t, user_vo.RoleMember, byID.Role.Value())
+		assert.Equal(t, int64(1), byID.Version)
+		assert.Equal(t, organizations.DefaultOrganizationID, byID.OrganizationID)
+		assert.WithinDuration(t, created.CreatedAt, byID.CreatedAt, time.Millisecond)
+		assert.Nil(t, byID.DeletedAt)
+
+		byEmail, err := f.repos.Users.GetByEmail(f.ctx, "alice@example.com")
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, byEmail.ID)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		f := newFixture(t, factory)
+		f.user("alice@example.com")
+		missing := uuid.New()
+
+		_, err := f.repos.Users.GetByID(f.ctx, missing)
+		assert.True(t, users.IsUserNotFound(err), "GetByID: %v", err)
+
+		_, err = f.repos.Users.GetByEmail(f.ctx, "nobody@example.com")
+		assert.True(t, users.IsUserNotFound(err), "GetByEmail: %v", err)
+
+		user := f.newUser("ghost@example.com")
+		user.ID = missing
+		_, err = f.repos.Users.Update(f.ctx, user)
+		assert.True(t, users.IsUserNotFound(err), "Update: %v", err)
+
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Delete(f.ctx, missing)), "Delete")
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Restore(f.ctx, missing)), "Restore")
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Purge(f.ctx, missing)), "Purge")
+
+		_, err = f.repos.Users.GetDeletedByID(f.ctx, missing)
+		assert.True(t, users.IsUserNotFound(err), "GetDeletedByID: %v", err)
+	})
+
+	t.Run("duplicate email", func(t *testing.T) {
+		f := newFixture(t, factory)
+		f.user("alice@example.com")
+		bob := f.user("bob@example.com")
+
+		_, err := f.repos.Users.Create(f.ctx, f.newUser("alice@example.com"))
+		assert.True(t, users.IsUserAlreadyExists(err), "Create: %v", err)
+
+		email, err := user_vo.NewEmailValueObject("alice@example.com")
+		require.NoError(t, err)
+		changed := *bob
+		changed.Email = email
+		_, err = f.repos.Users.Update(f.ctx, &changed)
+		assert.True(t, users.IsUserAlreadyExists(err), "Update: %v", err)
+
+		stored, err := f.repos.Users.GetByID(f.ctx, bob.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "bob@example.com", stored.Email.Value())
+	})
+
+	t.Run("email stays taken in trash", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+		require.NoError(t, f.repos.Users.Delete(f.ctx, alice.ID))
+
+		_, err := f.repos.Users.Create(f.ctx, f.newUser("alice@example.com"))
+		assert.True(t, users.IsUserAlreadyExists(err), "Create: %v", err)
+
+		_, err = f.repos.Users.GetByEmail(f.ctx, "alice@example.com")
+		assert.True(t, users.IsUserNotFound(err), "GetByEmail: %v", err)
+	})
+
+	t.Run("list orders by creation time", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		// Порядок вставки не совпадает с порядком создания
+		second := f.newUser("second@example.com")
+		first := f.newUser("first@example.com")
+		first.CreatedAt, second.CreatedAt = second.CreatedAt, first.CreatedAt
+		for _, user := range []*users.User{second, first} {
+			_, err := f.repos.Users.Create(f.ctx, user)
+			require.NoError(t, err)
+		}
+		third := f.user("third@example.com")
+
+		list, total, err := f.repos.Users.List(f.ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, userIDs(first, second, third), userIDs(list...))
+	})
+
+	t.Run("list pagination", func(t *testing.T) {
+		f := newFixture(t, factory)
+		a := f.user("a@example.com")
+		b := f.user("b@example.com")
+		c := f.user("c@example.com")
+
+		testCases := []struct {
+			name     string
+			page     int
+			pageSize int
+			expected []uuid.UUID
+		}{
+			{"first page", 1, 2, userIDs(a, b)},
+			{"last partial page", 2, 2, userIDs(c)},
+			{"page past the end", 3, 2, userIDs()},
+			{"page size larger than total", 1, 10, userIDs(a, b, c)},
+			{"page below one is the first page", 0, 2, userIDs(a, b)},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				list, total, err := f.repos.Users.List(f.ctx, tc.page, tc.pageSize)
+				require.NoError(t, err)
+				assert.Equal(t, int64(3), total)
+				assert.NotNil(t, list)
+				assert.Equal(t, tc.expected, userIDs(list...))
+			})
+		}
+	})
+
+	t.Run("list by cursor", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		// Порядок вставки не совпадает с порядком создания
+		b := f.newUser("b@example.com")
+		a := f.newUser("a@example.com")
+		a.CreatedAt, b.CreatedAt = b.CreatedAt, a.CreatedAt
+		for _, user := range []*users.User{b, a} {
+			_, err := f.repos.Users.Create(f.ctx, user)
+			require.NoError(t, err)
+		}
+		c := f.user("c@example.com")
+		d := f.user("d@example.com")
+		e := f.user("e@example.com")
+		deleted := f.user("deleted@example.com")
+		require.NoError(t, f.repos.Users.Delete(f.ctx, deleted.ID))
+
+		after := func(user *users.User) *users.UserCursor { return users.NewUserCursor(user, false) }
+		before := func(user *users.User) *users.UserCursor { return users.NewUserCursor(user, true) }
+
+		testCases := []struct {
+			name     string
+			cursor   *users.UserCursor
+			limit    int
+			expected []uuid.UUID
+		}{
+			{"first page", nil, 2, userIDs(a, b)},
+			{"after cursor", after(b), 2, userIDs(c, d)},
+			{"last partial page", after(d), 2, userIDs(e)},
+			{"after the last user", after(e), 2, userIDs()},
+			{"before cursor", before(e), 2, userIDs(c, d)},
+			{"first partial page backward", before(b), 2, userIDs(a)},
+			{"before the first user", before(a), 2, userIDs()},
+			{"limit larger than total", after(a), 10, userIDs(b, c, d, e)},
+			{"deleted user keeps its position", after(deleted), 10, userIDs()},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				list, err := f.repos.Users.ListByCursor(f.ctx, tc.cursor, tc.limit)
+				require.NoError(t, err)
+				assert.NotNil(t, list)
+				assert.Equal(t, tc.expected, userIDs(list...))
+			})
+		}
+	})
+
+	t.Run("list in empty storage", func(t *testing.T) {
+		f := newFixture(t, factory)
+
+		list, total, err := f.repos.Users.List(f.ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Zero(t, total)
+		assert.NotNil(t, list)
+		assert.Empty(t, list)
+
+		list, err = f.repos.Users.ListByCursor(f.ctx, nil, 10)
+		require.NoError(t, err)
+		assert.NotNil(t, list)
+		assert.Empty(t, list)
+	})
+
+	t.Run("update checks version", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+
+		name, err := user_vo.NewUserNameValueObject("Alice Updated")
+		require.NoError(t, err)
+		changed := *alice
+		changed.Name = name
+
+		updated, err := f.repos.Users.Update(f.ctx, &changed)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), updated.Version)
+		assert.Equal(t, int64(1), changed.Version, "the argument must keep its version")
+		assert.True(t, updated.UpdatedAt.After(alice.CreatedAt), "UpdatedAt must move forward")
+
+		stored, err := f.repos.Users.GetByID(f.ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "Alice Updated", stored.Name.Value())
+		assert.Equal(t, int64(2), stored.Version)
+
+		// Повторное сохранение устаревшей версии
+		_, err = f.repos.Users.Update(f.ctx, &changed)
+		assert.True(t, users.IsConcurrentModification(err), "stale Update: %v", err)
+	})
+
+	t.Run("delete moves to trash", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+		bob := f.user("bob@example.com")
+
+		require.NoError(t, f.repos.Users.Delete(f.ctx, alice.ID))
+
+		_, err := f.repos.Users.GetByID(f.ctx, alice.ID)
+		assert.True(t, users.IsUserNotFound(err), "GetByID: %v", err)
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Delete(f.ctx, alice.ID)), "second Delete")
+
+		_, err = f.repos.Users.Update(f.ctx, alice)
+		assert.True(t, users.IsUserNotFound(err), "Update: %v", err)
+
+		list, total, err := f.repos.Users.List(f.ctx, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, userIDs(bob), userIDs(list...))
+
+		deleted, err := f.repos.Users.GetDeletedByID(f.ctx, alice.ID)
+		require.NoError(t, err)
+		require.NotNil(t, deleted.DeletedAt)
+
+		// Действующий пользователь в корзине не виден
+		_, err = f.repos.Users.GetDeletedByID(f.ctx, bob.ID)
+		assert.True(t, users.IsUserNotFound(err), "GetDeletedByID of an active user: %v", err)
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Purge(f.ctx, bob.ID)), "Purge of an active user")
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Restore(f.ctx, bob.ID)), "Restore of an active user")
+	})
+
+	t.Run("list deleted", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+		bob := f.user("bob@example.com")
+		carol := f.user("carol@example.com")
+		f.user("active@example.com")
+
+		require.NoError(t, f.repos.Users.Delete(f.ctx, alice.ID))
+		time.Sleep(10 * time.Millisecond)
+		cutoff := time.Now()
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, f.repos.Users.Delete(f.ctx, bob.ID))
+		time.Sleep(10 * time.Millisecond)
+		require.NoError(t, f.repos.Users.Delete(f.ctx, carol.ID))
+
+		// Сначала удаленные последними
+		list, total, err := f.repos.Users.ListDeleted(f.ctx, users.ListDeletedUsersFilter{}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, userIDs(carol, bob, alice), userIDs(list...))
+
+		list, total, err = f.repos.Users.ListDeleted(f.ctx, users.ListDeletedUsersFilter{}, 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), total)
+		assert.Equal(t, userIDs(alice), userIDs(list...))
+
+		list, total, err = f.repos.Users.ListDeleted(f.ctx, users.ListDeletedUsersFilter{DeletedBefore: &cutoff}, 1, 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), total)
+		assert.Equal(t, userIDs(alice), userIDs(list...))
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+		require.NoError(t, f.repos.Users.Delete(f.ctx, alice.ID))
+
+		require.NoError(t, f.repos.Users.Restore(f.ctx, alice.ID))
+
+		restored, err := f.repos.Users.GetByID(f.ctx, alice.ID)
+		require.NoError(t, err)
+		assert.Nil(t, restored.DeletedAt)
+		assert.Equal(t, int64(2), restored.Version, "Restore must bump the version")
+
+		_, err = f.repos.Users.GetDeletedByID(f.ctx, alice.ID)
+		assert.True(t, users.IsUserNotFound(err), "GetDeletedByID: %v", err)
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Restore(f.ctx, alice.ID)), "second Restore")
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		f := newFixture(t, factory)
+		alice := f.user("alice@example.com")
+		require.NoError(t, f.repos.Users.Delete(f.ctx, alice.ID))
+
+		require.NoError(t, f.repos.Users.Purge(f.ctx, alice.ID))
+
+		_, err := f.repos.Users.GetDeletedByID(f.ctx, alice.ID)
+		assert.True(t, users.IsUserNotFound(err), "GetDeletedByID: %v", err)
+		assert.True(t, users.IsUserNotFound(f.repos.Users.Purge(f.ctx, alice.ID)), "second Purge")
+
+		// После окончательного удаления email снова свободен
+		_, err = f.repos.Users.Create(f.ctx, f.newUser("alice@example.com"))
+		assert.NoError(t, err)
+	})
+}
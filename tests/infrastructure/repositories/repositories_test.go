@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"crud/config"
+	"crud/internal/domain/organizations"
+	"crud/internal/infrastructure/database/gateways"
+	"crud/internal/infrastructure/database/migrations"
+	"crud/internal/infrastructure/database/repositories"
+	"crud/internal/infrastructure/database/repositories/dummy"
+	"crud/tests/infrastructure/repositories/conformance"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// newDummyRepositories создает пустые in-memory репозитории
+func newDummyRepositories(t *testing.T) conformance.Repositories {
+	usersRepo := dummy.NewUsersRepository()
+	labelsRepo := dummy.NewLabelsRepository()
+	commentsRepo := dummy.NewCommentsRepository()
+	tasksRepo := dummy.NewTasksRepository(usersRepo, labelsRepo, commentsRepo)
+	projectsRepo := dummy.NewProjectsRepository(usersRepo)
+
+	return conformance.Repositories{
+		Users:    usersRepo,
+		Tasks:    tasksRepo,
+		Projects: projectsRepo,
+		Labels:   labelsRepo,
+		Transactions: dummy.NewTransactionManager(
+			tasksRepo, projectsRepo, usersRepo, labelsRepo, commentsRepo,
+			dummy.NewAttachmentsRepository(), dummy.NewAuditRepository(),
+			dummy.NewRefreshTokensRepository(), dummy.NewOrganizationsRepository(),
+		),
+	}
+}
+
+// newSQLiteRepositories создает GORM репозитории над новой базой SQLite в памяти
+func newSQLiteRepositories(t *testing.T) conformance.Repositories {
+	gateway, err := gateways.NewSQLiteGateway(&config.Config{SQLitePath: ":memory:"})
+	require.NoError(t, err)
+	t.Cleanup(func() { gateway.Close() })
+
+	return newGormRepositories(t, gateway.DB())
+}
+
+// newPostgresRepositories создает GORM репозитории над базой TEST_POSTGRES_DB, очищая ее данные.
+// Остальные параметры подключения берутся из POSTGRES_* переменных окружения. Отдельная
+// переменная для имени базы не дает по ошибке очистить рабочую базу из POSTGRES_DB
+func newPostgresRepositories(t *testing.T) conformance.Repositories {
+	database := os.Getenv("TEST_POSTGRES_DB")
+	if database == "" {
+		t.Skip("TEST_POSTGRES_DB is not set")
+	}
+
+	cfg := config.Load()
+	cfg.PostgresDB = database
+	gateway, err := gateways.NewPostgresGateway(cfg)
+	if err != nil {
+		t.Skipf("PostgreSQL is not available: %v", err)
+	}
+	t.Cleanup(func() { gateway.Close() })
+
+	repos := newGormRepositories(t, gateway.DB())
+
+	// Остальные таблицы очищаются каскадом по внешним ключам; организация по умолчанию остается
+	ctx := organizations.ContextWithoutScope(context.Background())
+	require.NoError(t, gateway.DB().WithContext(ctx).
+		Exec("TRUNCATE users, labels, audit_entries, refresh_tokens CASCADE").Error)
+
+	return repos
+}
+
+// newGormRepositories применяет миграции и создает GORM репозитории над db
+func newGormRepositories(t *testing.T, db *gorm.DB) conformance.Repositories {
+	migrator, err := migrations.NewDialectMigrator(db)
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+
+	return conformance.Repositories{
+		Users:        repositories.NewUsersRepository(db),
+		Tasks:        repositories.NewTasksRepository(db),
+		Projects:     repositories.NewProjectsRepository(db),
+		Labels:       repositories.NewLabelsRepository(db),
+		Transactions: repositories.NewTransactionManager(db),
+	}
+}
+
+func TestDummyRepositories(t *testing.T) {
+	t.Run("users", func(t *testing.T) { conformance.RunUsersRepositoryTests(t, newDummyRepositories) })
+	t.Run("tasks", func(t *testing.T) { conformance.RunTasksRepositoryTests(t, newDummyRepositories) })
+	t.Run("transactions", func(t *testing.T) { conformance.RunTransactionTests(t, newDummyRepositories) })
+}
+
+func TestSQLiteRepositories(t *testing.T) {
+	t.Run("users", func(t *testing.T) { conformance.RunUsersRepositoryTests(t, newSQLiteRepositories) })
+	t.Run("tasks", func(t *testing.T) { conformance.RunTasksRepositoryTests(t, newSQLiteRepositories) })
+	t.Run("transactions", func(t *testing.T) { conformance.RunTransactionTests(t, newSQLiteRepositories) })
+}
+
+func TestPostgresRepositories(t *testing.T) {
+	t.Run("users", func(t *testing.T) { conformance.RunUsersRepositoryTests(t, newPostgresRepositories) })
+	t.Run("tasks", func(t *testing.T) { conformance.RunTasksRepositoryTests(t, newPostgresRepositories) })
+	t.Run("transactions", func(t *testing.T) { conformance.RunTransactionTests(t, newPostgresRepositories) })
+}
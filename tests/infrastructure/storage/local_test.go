@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"crud/config"
+	"crud/internal/domain/attachments"
+	"crud/internal/infrastructure/storage"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingReader отдает часть содержимого и затем ошибку
+type failingReader struct {
+	sent bool
+}
+
+func (r *failingReader) Read(p []byte) (int, error) {
+	if r.sent {
+		return 0, errors.New("connection reset")
+	}
+	r.sent = true
+	return copy(p, "partial"), nil
+}
+
+func TestLocalBlobStorage(t *testing.T) {
+	root := t.TempDir()
+	cfg := config.Load()
+	cfg.AttachmentsStoragePath = filepath.Join(root, "attachments")
+
+	blobs, err := storage.NewLocalBlobStorage(cfg)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	key := "tasks/task-id/blob-id"
+
+	t.Run("put, get and replace", func(t *testing.T) {
+		require.NoError(t, blobs.Put(ctx, key, strings.NewReader("first version")))
+		require.NoError(t, blobs.Put(ctx, key, strings.NewReader("second version")))
+
+		content, err := blobs.Get(ctx, key)
+		require.NoError(t, err)
+		defer content.Close()
+
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+		assert.Equal(t, "second version", string(data))
+	})
+
+	t.Run("failed upload leaves nothing behind", func(t *testing.T) {
+		failedKey := "tasks/task-id/failed"
+		err := blobs.Put(ctx, failedKey, &failingReader{})
+		assert.Error(t, err)
+
+		_, err = blobs.Get(ctx, failedKey)
+		assert.True(t, attachments.IsBlobNotFound(err))
+
+		entries, err := os.ReadDir(filepath.Join(cfg.AttachmentsStoragePath, "tasks", "task-id"))
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		assert.Equal(t, "blob-id", entries[0].Name())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.NoError(t, blobs.Delete(ctx, key))
+		_, err := blobs.Get(ctx, key)
+		assert.True(t, attachments.IsBlobNotFound(err))
+
+		// Повторное удаление не считается ошибкой
+		assert.NoError(t, blobs.Delete(ctx, key))
+	})
+
+	t.Run("keys cannot escape the storage root", func(t *testing.T) {
+		for _, key := range []string{"", "../outside", "/etc/passwd", "tasks/../../outside", `tasks\evil`, "tasks//double"} {
+			err := blobs.Put(ctx, key, strings.NewReader("x"))
+			assert.True(t, attachments.IsInvalidAttachmentData(err), "Expected error for key %q", key)
+		}
+
+		_, err := os.Stat(filepath.Join(root, "outside"))
+		assert.True(t, os.IsNotExist(err))
+	})
+}
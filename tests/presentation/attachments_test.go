@@ -0,0 +1,107 @@
+package presentation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"crud/config"
+	v1_attachments "crud/internal/presentation/api/v1/attachments"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskAttachments(t *testing.T) {
+	container := tests.NewTestContainer()
+	require.NoError(t, container.Decorate(func(cfg *config.Config) *config.Config {
+		cfg.AttachmentMaxSize = 1024
+		return cfg
+	}))
+	router := NewTestRouter(container)
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "uploader@example.com", "Uploader")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "uploader-other@example.com", "Other Uploader")
+
+	task := CreateTaskViaHTTP(t, router, ownerToken, owner.ID, "With files", "", "todo")
+	path := "/api/v1/tasks/" + task.ID + "/attachments"
+
+	content := []byte("id,title\n1,Write tests\n")
+	response := UploadFileViaHTTP(router, ownerToken, path, "план работ.csv", "text/csv", content)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	attachment := DecodeJSONResponse[v1_attachments.AttachmentResponse](t, response)
+
+	checksum := sha256.Sum256(content)
+	assert.Equal(t, "план работ.csv", attachment.FileName)
+	assert.Equal(t, "text/csv", attachment.ContentType)
+	assert.Equal(t, int64(len(content)), attachment.Size)
+	assert.Equal(t, hex.EncodeToString(checksum[:]), attachment.Checksum)
+	assert.Equal(t, owner.ID, attachment.UploaderID)
+
+	// Тип определяется по содержимому, если клиент его не указал
+	png := append([]byte("\x89PNG\r\n\x1a\n"), make([]byte, 32)...)
+	response = UploadFileViaHTTP(router, ownerToken, path, "image", "", png)
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	assert.Equal(t, "image/png", DecodeJSONResponse[v1_attachments.AttachmentResponse](t, response).ContentType)
+
+	t.Run("limits", func(t *testing.T) {
+		response := UploadFileViaHTTP(router, ownerToken, path, "big.csv", "text/csv", bytes.Repeat([]byte("a"), 1025))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, response.Code)
+
+		response = UploadFileViaHTTP(router, ownerToken, path, "run.exe", "application/x-msdownload", []byte("MZ"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, response.Code)
+
+		// HTML под видом изображения отклоняется, иначе его отдавали бы как image/png
+		response = UploadFileViaHTTP(router, ownerToken, path, "cat.png", "image/png", []byte("<html><script>alert(1)</script></html>"))
+		assert.Equal(t, http.StatusUnsupportedMediaType, response.Code)
+		assert.Contains(t, response.Body.String(), "content_type_mismatch")
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, path, map[string]string{"file": "nope"})
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("list and metadata", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		list := DecodeJSONResponse[v1_attachments.AttachmentsResponse](t, response)
+		require.Len(t, list.Data, 2)
+		assert.Equal(t, attachment.ID, list.Data[0].ID)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"/"+attachment.ID, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, attachment, DecodeJSONResponse[v1_attachments.AttachmentResponse](t, response))
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"/"+uuid.NewString(), nil)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("download", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"/"+attachment.ID+"/download", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, content, response.Body.Bytes())
+		assert.Equal(t, "text/csv", response.Header().Get("Content-Type"))
+		assert.Equal(t, strconv.Itoa(len(content)), response.Header().Get("Content-Length"))
+		assert.Equal(t, "nosniff", response.Header().Get("X-Content-Type-Options"))
+		assert.Equal(t, "attachment; filename*=utf-8''%D0%BF%D0%BB%D0%B0%D0%BD%20%D1%80%D0%B0%D0%B1%D0%BE%D1%82.csv",
+			response.Header().Get("Content-Disposition"))
+
+		response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, path+"/"+attachment.ID+"/download", nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, otherToken, http.MethodDelete, path+"/"+attachment.ID, nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, path+"/"+attachment.ID, nil)
+		assert.Equal(t, http.StatusNoContent, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"/"+attachment.ID+"/download", nil)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+}
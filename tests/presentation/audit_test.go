@@ -0,0 +1,85 @@
+package presentation
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	v1_audit "crud/internal/presentation/api/v1/audit"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// auditPage страница записей журнала аудита
+type auditPage struct {
+	Data  []v1_audit.AuditEntryResponse `json:"data"`
+	Total int64                         `json:"total"`
+}
+
+func TestAuditTrail(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "audited@example.com", "Audited")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "audited-other@example.com", "Other")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	task := CreateTaskViaHTTP(t, router, ownerToken, owner.ID, "Tracked", "", "todo")
+	taskPath := "/api/v1/tasks/" + task.ID
+
+	title := "Tracked task"
+	response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title})
+	require.Equal(t, http.StatusOK, response.Code)
+
+	t.Run("task history", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, taskPath+"/history", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		history := DecodeJSONResponse[auditPage](t, response)
+		require.Equal(t, int64(2), history.Total)
+
+		update := history.Data[0]
+		assert.Equal(t, "update", update.Action)
+		assert.Equal(t, "task", update.EntityType)
+		require.NotNil(t, update.ActorID)
+		assert.Equal(t, owner.ID, *update.ActorID)
+		assert.Equal(t, []v1_audit.FieldChangeResponse{{Field: "title", Old: "Tracked", New: "Tracked task"}}, update.Changes)
+		assert.Equal(t, "create", history.Data[1].Action)
+
+		response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, taskPath+"/history", nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("audit log", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/audit", nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+
+		query := url.Values{"actor_id": {owner.ID}, "entity_type": {"task"}}
+		response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/audit?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, int64(2), DecodeJSONResponse[auditPage](t, response).Total)
+
+		// Смена пароля попадает в журнал без значений
+		password := "rotated-password"
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPut, "/api/v1/users/"+owner.ID, map[string]string{"password": password})
+		require.Equal(t, http.StatusOK, response.Code)
+
+		query = url.Values{"entity_id": {owner.ID}}
+		response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/audit?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		log := DecodeJSONResponse[auditPage](t, response)
+		require.NotEmpty(t, log.Data)
+		assert.Equal(t, []v1_audit.FieldChangeResponse{{Field: "password", Redacted: true}}, log.Data[0].Changes)
+
+		query = url.Values{"from": {time.Now().Add(time.Hour).Format(time.RFC3339)}}
+		response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/audit?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, int64(0), DecodeJSONResponse[auditPage](t, response).Total)
+
+		for _, invalid := range []string{"entity_type=project", "actor_id=nope", "from=yesterday"} {
+			response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/audit?"+invalid, nil)
+			assert.Equal(t, http.StatusBadRequest, response.Code, invalid)
+		}
+	})
+}
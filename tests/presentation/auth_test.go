@@ -0,0 +1,157 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	v1_auth "crud/internal/presentation/api/v1/auth"
+	v1_users "crud/internal/presentation/api/v1/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogin(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	RegisterUserViaHTTP(t, router, "login@example.com", "Login User")
+
+	tokens := LoginViaHTTP(t, router, "login@example.com", TestPassword)
+
+	assert.Equal(t, "Bearer", tokens.TokenType)
+	assert.NotEmpty(t, tokens.RefreshToken)
+	assert.Positive(t, tokens.ExpiresIn)
+}
+
+func TestLoginWithWrongPassword(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	RegisterUserViaHTTP(t, router, "wrongpass@example.com", "Wrong Password User")
+
+	response := ExecuteRequest(router, http.MethodPost, "/api/v1/auth/login", v1_auth.LoginRequest{
+		Email:    "wrongpass@example.com",
+		Password: "not-the-password",
+	})
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestMe(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user, token := CreateUserAndLoginViaHTTP(t, router, "me@example.com", "Me User")
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/auth/me", nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	meResponse := DecodeJSONResponse[v1_users.UserResponse](t, response)
+	assert.Equal(t, user.ID, meResponse.ID)
+}
+
+func TestProtectedRoutesRequireToken(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	response := ExecuteRequest(router, http.MethodGet, "/api/v1/tasks", nil)
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+	assert.NotEmpty(t, response.Header().Get("WWW-Authenticate"))
+
+	response = ExecuteRequest(router, http.MethodGet, "/api/v1/users", nil)
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, "not-a-jwt", http.MethodGet, "/api/v1/tasks", nil)
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestRefreshAndLogout(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	RegisterUserViaHTTP(t, router, "refresh@example.com", "Refresh User")
+	tokens := LoginViaHTTP(t, router, "refresh@example.com", TestPassword)
+
+	// Ротация refresh токена
+	response := ExecuteRequest(router, http.MethodPost, "/api/v1/auth/refresh", v1_auth.RefreshRequest{
+		RefreshToken: tokens.RefreshToken,
+	})
+	require.Equal(t, http.StatusOK, response.Code)
+	rotated := DecodeJSONResponse[v1_auth.TokenResponse](t, response)
+	assert.NotEqual(t, tokens.RefreshToken, rotated.RefreshToken)
+
+	// Выход завершает сессию
+	response = ExecuteRequest(router, http.MethodPost, "/api/v1/auth/logout", v1_auth.LogoutRequest{
+		RefreshToken: rotated.RefreshToken,
+	})
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteRequest(router, http.MethodPost, "/api/v1/auth/refresh", v1_auth.RefreshRequest{
+		RefreshToken: rotated.RefreshToken,
+	})
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestRefreshAfterUserDeleted(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user := RegisterUserViaHTTP(t, router, "deleted-session@example.com", "Deleted Session")
+	tokens := LoginViaHTTP(t, router, "deleted-session@example.com", TestPassword)
+	otherSession := LoginViaHTTP(t, router, "deleted-session@example.com", TestPassword)
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/users/"+user.ID, nil)
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteRequest(router, http.MethodPost, "/api/v1/auth/refresh", v1_auth.RefreshRequest{
+		RefreshToken: tokens.RefreshToken,
+	})
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+
+	// Восстановление из корзины не возвращает отозванные сессии
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/trash/users/"+user.ID+"/restore", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteRequest(router, http.MethodPost, "/api/v1/auth/refresh", v1_auth.RefreshRequest{
+		RefreshToken: otherSession.RefreshToken,
+	})
+	assert.Equal(t, http.StatusUnauthorized, response.Code)
+}
+
+func TestRegisterAssignsMemberRole(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user := RegisterUserViaHTTP(t, router, "register@example.com", "Register User")
+	assert.Equal(t, "member", user.Role)
+}
+
+func TestRoleBasedAccess(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	adminToken := LoginAsAdminViaHTTP(t, router)
+	member, memberToken := CreateUserAndLoginViaHTTP(t, router, "rbac-member@example.com", "Member")
+
+	CreateUserViaHTTP(t, router, adminToken, "rbac-viewer@example.com", "Viewer", "viewer")
+	viewerToken := LoginViaHTTP(t, router, "rbac-viewer@example.com", TestPassword).AccessToken
+
+	// Участник не может создавать пользователей
+	response := ExecuteAuthorizedRequest(router, memberToken, http.MethodPost, "/api/v1/users", v1_users.CreateUserRequest{
+		Email:    "other@example.com",
+		Name:     "Other",
+		Password: TestPassword,
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	// Наблюдатель не может создавать задачи
+	response = ExecuteAuthorizedRequest(router, viewerToken, http.MethodPost, "/api/v1/tasks", map[string]string{
+		"title": "Viewer Task",
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	// Наблюдатель видит задачи других пользователей
+	task := CreateTaskViaHTTP(t, router, memberToken, member.ID, "Member Task", "", "todo")
+	response = ExecuteAuthorizedRequest(router, viewerToken, http.MethodGet, "/api/v1/tasks/"+task.ID, nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	// Участник не может повысить себе роль
+	admin := "admin"
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodPut, "/api/v1/users/"+member.ID, v1_users.UpdateUserRequest{
+		Role: &admin,
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+}
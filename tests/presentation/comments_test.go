@@ -0,0 +1,92 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	v1_comments "crud/internal/presentation/api/v1/comments"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskComments(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "commenter@example.com", "Commenter")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "commenter-other@example.com", "Other Commenter")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	task := CreateTaskViaHTTP(t, router, ownerToken, owner.ID, "Discussed", "", "todo")
+	path := "/api/v1/tasks/" + task.ID + "/comments"
+
+	response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, path, v1_comments.CreateCommentRequest{Body: "Ready for review"})
+	require.Equal(t, http.StatusCreated, response.Code)
+	comment := DecodeJSONResponse[v1_comments.CommentResponse](t, response)
+	assert.Equal(t, owner.ID, comment.AuthorID)
+	assert.Nil(t, comment.ParentID)
+	assert.Nil(t, comment.EditedAt)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, path, v1_comments.CreateCommentRequest{ParentID: comment.ID, Body: "Approved"})
+	require.Equal(t, http.StatusCreated, response.Code)
+	reply := DecodeJSONResponse[v1_comments.CommentResponse](t, response)
+	require.NotNil(t, reply.ParentID)
+	assert.Equal(t, comment.ID, *reply.ParentID)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, path, v1_comments.CreateCommentRequest{Body: ""})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, path, v1_comments.CreateCommentRequest{ParentID: uuid.NewString(), Body: "Orphan"})
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodPost, path, v1_comments.CreateCommentRequest{Body: "Not my task"})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, data, 1)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"?parent_id="+comment.ID, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+
+	// Изменять и удалять комментарий может только автор
+	commentPath := path + "/" + comment.ID
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPut, commentPath, v1_comments.UpdateCommentRequest{Body: "Changed"})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPut, commentPath, v1_comments.UpdateCommentRequest{Body: "Ready for final review"})
+	require.Equal(t, http.StatusOK, response.Code)
+	updated := DecodeJSONResponse[v1_comments.CommentResponse](t, response)
+	assert.Equal(t, "Ready for final review", updated.Body)
+	assert.NotNil(t, updated.EditedAt)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, commentPath+"/revisions", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	revisions := DecodeJSONResponse[v1_comments.CommentRevisionsResponse](t, response)
+	require.Len(t, revisions.Data, 1)
+	assert.Equal(t, "Ready for review", revisions.Data[0].Body)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, commentPath, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, commentPath, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path+"?parent_id="+comment.ID, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	// Комментарии удаляются вместе с задачей
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, path, v1_comments.CreateCommentRequest{Body: "Last words"})
+	require.Equal(t, http.StatusCreated, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, "/api/v1/tasks/"+task.ID, nil)
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
@@ -0,0 +1,143 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTaskConditionalRequests(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, token := CreateUserAndLoginViaHTTP(t, router, "etag@example.com", "Owner")
+	task := CreateTaskViaHTTP(t, router, token, owner.ID, "Shared", "", "todo")
+	taskPath := "/api/v1/tasks/" + task.ID
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, taskPath, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	etag := response.Header().Get("ETag")
+	assert.Equal(t, `"1"`, etag)
+
+	t.Run("if-none-match", func(t *testing.T) {
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, taskPath, nil, map[string]string{"If-None-Match": etag})
+		assert.Equal(t, http.StatusNotModified, response.Code)
+		assert.Empty(t, response.Body.String())
+		assert.Equal(t, etag, response.Header().Get("ETag"))
+
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, taskPath, nil, map[string]string{"If-None-Match": `"7", W/"1"`})
+		assert.Equal(t, http.StatusNotModified, response.Code)
+
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, taskPath, nil, map[string]string{"If-None-Match": `"7"`})
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("if-match", func(t *testing.T) {
+		// Первый редактор сохраняет изменения по актуальной версии
+		title := "First editor"
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": etag})
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, `"2"`, response.Header().Get("ETag"))
+		assert.Equal(t, int64(2), DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Version)
+
+		// Второй редактор прочитал ту же версию и не перезаписывает чужие изменения
+		title = "Second editor"
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": etag})
+		assert.Equal(t, http.StatusPreconditionFailed, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, token, http.MethodGet, taskPath, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "First editor", DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Title)
+
+		for _, header := range []string{`W/"2"`, "2", `"two"`, `"2", "3"`} {
+			response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": header})
+			assert.Equal(t, http.StatusPreconditionFailed, response.Code, header)
+		}
+
+		// "*" и запрос без If-Match не проверяют версию
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": "*"})
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, `"3"`, response.Header().Get("ETag"))
+	})
+
+	t.Run("transition changes etag", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, taskPath+"/transitions", v1_tasks.TransitionTaskRequest{Status: "in_progress"})
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, `"4"`, response.Header().Get("ETag"))
+	})
+}
+
+func TestTaskETagFollowsOverdue(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "etag-overdue@example.com", "Owner")
+	dueDate := time.Now().Add(300 * time.Millisecond).Format(time.RFC3339Nano)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		Title:   "Due soon",
+		Status:  "todo",
+		DueDate: dueDate,
+	})
+	require.Equal(t, http.StatusCreated, response.Code, response.Body.String())
+	taskPath := "/api/v1/tasks/" + DecodeJSONResponse[v1_tasks.TaskResponse](t, response).ID
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, taskPath, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	require.False(t, DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Overdue)
+	etag := response.Header().Get("ETag")
+	assert.Equal(t, `"1"`, etag)
+
+	// Срок наступил, версия не изменилась, но представление уже другое
+	time.Sleep(400 * time.Millisecond)
+
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, taskPath, nil, map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusOK, response.Code)
+	task := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	assert.True(t, task.Overdue)
+	assert.Equal(t, int64(1), task.Version)
+	assert.Equal(t, `"1-o"`, response.Header().Get("ETag"))
+
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, taskPath, nil, map[string]string{"If-None-Match": `"1-o"`})
+	assert.Equal(t, http.StatusNotModified, response.Code)
+
+	// If-Match сравнивает только версию: ETag до и после срока подходит для изменения
+	title := "Still due"
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": etag})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, `"2-o"`, response.Header().Get("ETag"))
+
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, taskPath, v1_tasks.UpdateTaskRequest{Title: &title}, map[string]string{"If-Match": `"2-o"`})
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestUserConditionalRequests(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user, token := CreateUserAndLoginViaHTTP(t, router, "etag-user@example.com", "Original")
+	userPath := "/api/v1/users/" + user.ID
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, userPath, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	etag := response.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, userPath, nil, map[string]string{"If-None-Match": etag})
+	assert.Equal(t, http.StatusNotModified, response.Code)
+
+	name := "Renamed"
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, userPath, v1_users.UpdateUserRequest{Name: &name}, map[string]string{"If-Match": etag})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.NotEqual(t, etag, response.Header().Get("ETag"))
+
+	name = "Overwritten"
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPut, userPath, v1_users.UpdateUserRequest{Name: &name}, map[string]string{"If-Match": etag})
+	assert.Equal(t, http.StatusPreconditionFailed, response.Code)
+
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodGet, userPath, nil, map[string]string{"If-None-Match": etag})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "Renamed", DecodeJSONResponse[v1_users.UserResponse](t, response).Name)
+}
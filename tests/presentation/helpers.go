@@ -3,28 +3,55 @@ package presentation
 import (
 	"bytes"
 	"encoding/json"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/textproto"
 	"testing"
 
+	v1_auth "crud/internal/presentation/api/v1/auth"
+	v1_labels "crud/internal/presentation/api/v1/labels"
+	v1_projects "crud/internal/presentation/api/v1/projects"
 	v1_tasks "crud/internal/presentation/api/v1/tasks"
 	v1_users "crud/internal/presentation/api/v1/users"
+	"crud/tests"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/stretchr/testify/require"
 )
 
-// CreateUserViaHTTP создает пользователя через HTTP запрос и возвращает ответ
-func CreateUserViaHTTP(t *testing.T, router chi.Router, email, name string) *v1_users.UserResponse {
+// TestPassword пароль, с которым тестовые хелперы создают пользователей
+const TestPassword = "password123"
+
+// RegisterUserViaHTTP регистрирует пользователя через HTTP запрос и возвращает ответ
+func RegisterUserViaHTTP(t *testing.T, router chi.Router, email, name string) *v1_users.UserResponse {
+	response := ExecuteRequest(router, http.MethodPost, "/api/v1/auth/register", v1_users.RegisterUserRequest{
+		Email:    email,
+		Name:     name,
+		Password: TestPassword,
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+
+	user := DecodeJSONResponse[v1_users.UserResponse](t, response)
+	require.NotEmpty(t, user.ID)
+
+	return &user
+}
+
+// CreateUserViaHTTP создает пользователя с ролью role от имени администратора и возвращает ответ
+func CreateUserViaHTTP(t *testing.T, router chi.Router, adminToken, email, name, role string) *v1_users.UserResponse {
 	reqBody := v1_users.CreateUserRequest{
-		Email: email,
-		Name:  name,
+		Email:    email,
+		Name:     name,
+		Password: TestPassword,
+		Role:     role,
 	}
 	jsonBody, err := json.Marshal(reqBody)
 	require.NoError(t, err)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/users", bytes.NewBuffer(jsonBody))
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+adminToken)
 	responseRecorder := httptest.NewRecorder()
 
 	router.ServeHTTP(responseRecorder, req)
@@ -39,19 +66,47 @@ func CreateUserViaHTTP(t *testing.T, router chi.Router, email, name string) *v1_
 	return &response
 }
 
-// CreateTaskViaHTTP создает задачу через HTTP запрос и возвращает ответ
-func CreateTaskViaHTTP(t *testing.T, router chi.Router, userID, title, description, status string) *v1_tasks.TaskResponse {
+// LoginViaHTTP выполняет вход через HTTP запрос и возвращает пару токенов
+func LoginViaHTTP(t *testing.T, router chi.Router, email, password string) *v1_auth.TokenResponse {
+	response := ExecuteRequest(router, http.MethodPost, "/api/v1/auth/login", v1_auth.LoginRequest{
+		Email:    email,
+		Password: password,
+	})
+	require.Equal(t, http.StatusOK, response.Code)
+
+	tokens := DecodeJSONResponse[v1_auth.TokenResponse](t, response)
+	require.NotEmpty(t, tokens.AccessToken)
+
+	return &tokens
+}
+
+// LoginAsAdminViaHTTP выполняет вход тестовым администратором и возвращает access токен
+func LoginAsAdminViaHTTP(t *testing.T, router chi.Router) string {
+	return LoginViaHTTP(t, router, tests.TestAdminEmail, tests.TestAdminPassword).AccessToken
+}
+
+// CreateUserAndLoginViaHTTP регистрирует пользователя и возвращает его вместе с access токеном
+func CreateUserAndLoginViaHTTP(t *testing.T, router chi.Router, email, name string) (*v1_users.UserResponse, string) {
+	user := RegisterUserViaHTTP(t, router, email, name)
+	tokens := LoginViaHTTP(t, router, email, TestPassword)
+	return user, tokens.AccessToken
+}
+
+// CreateTaskViaHTTP создает задачу через HTTP запрос и возвращает ответ. Задача создается
+// в начальном статусе todo и при необходимости переводится в status по рабочему процессу
+func CreateTaskViaHTTP(t *testing.T, router chi.Router, token, userID, title, description, status string) *v1_tasks.TaskResponse {
 	reqBody := v1_tasks.CreateTaskRequest{
 		UserID:      userID,
 		Title:       title,
 		Description: description,
-		Status:      status,
+		Status:      "todo",
 	}
 	jsonBody, err := json.Marshal(reqBody)
 	require.NoError(t, err)
 
 	req := httptest.NewRequest(http.MethodPost, "/api/v1/tasks", bytes.NewBuffer(jsonBody))
 	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
 	responseRecorder := httptest.NewRecorder()
 
 	router.ServeHTTP(responseRecorder, req)
@@ -63,15 +118,63 @@ func CreateTaskViaHTTP(t *testing.T, router chi.Router, userID, title, descripti
 	require.NoError(t, err)
 	require.NotEmpty(t, response.ID)
 
+	if status != response.Status {
+		transition := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+response.ID+"/transitions",
+			v1_tasks.TransitionTaskRequest{Status: status})
+		require.Equal(t, http.StatusOK, transition.Code, transition.Body.String())
+		response = DecodeJSONResponse[v1_tasks.TaskResponse](t, transition)
+	}
+
 	return &response
 }
 
-// ExecuteRequest выполняет HTTP запрос и возвращает recorder
+// CreateLabelViaHTTP создает метку через HTTP запрос и возвращает ответ
+func CreateLabelViaHTTP(t *testing.T, router chi.Router, token, name string) *v1_labels.LabelResponse {
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/labels", v1_labels.CreateLabelRequest{Name: name})
+	require.Equal(t, http.StatusCreated, response.Code)
+
+	label := DecodeJSONResponse[v1_labels.LabelResponse](t, response)
+	require.NotEmpty(t, label.ID)
+
+	return &label
+}
+
+// CreateProjectViaHTTP создает проект вызывающего через HTTP запрос и возвращает ответ
+func CreateProjectViaHTTP(t *testing.T, router chi.Router, token, name string) *v1_projects.ProjectResponse {
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/projects", v1_projects.CreateProjectRequest{Name: name})
+	require.Equal(t, http.StatusCreated, response.Code)
+
+	project := DecodeJSONResponse[v1_projects.ProjectResponse](t, response)
+	require.NotEmpty(t, project.ID)
+
+	return &project
+}
+
+// ExecuteRequest выполняет анонимный HTTP запрос и возвращает recorder
 func ExecuteRequest(router chi.Router, method, path string, body interface{}) *httptest.ResponseRecorder {
+	return ExecuteAuthorizedRequest(router, "", method, path, body)
+}
+
+// ExecuteAuthorizedRequest выполняет HTTP запрос с Bearer токеном и возвращает recorder
+func ExecuteAuthorizedRequest(router chi.Router, token, method, path string, body interface{}) *httptest.ResponseRecorder {
+	return ExecuteAuthorizedRequestWithHeaders(router, token, method, path, body, nil)
+}
+
+// ExecuteAuthorizedRequestWithHeaders выполняет HTTP запрос с Bearer токеном и дополнительными
+// заголовками, например If-Match, и возвращает recorder; тело []byte отправляется без кодирования
+func ExecuteAuthorizedRequestWithHeaders(
+	router chi.Router,
+	token, method, path string,
+	body interface{},
+	headers map[string]string,
+) *httptest.ResponseRecorder {
 	var jsonBody []byte
 	var err error
 
-	if body != nil {
+	// []byte передается как есть, чтобы можно было отправить и некорректный JSON
+	if raw, ok := body.([]byte); ok {
+		jsonBody = raw
+	} else if body != nil {
 		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			panic(err)
@@ -85,6 +188,46 @@ func ExecuteRequest(router chi.Router, method, path string, body interface{}) *h
 	} else {
 		req = httptest.NewRequest(method, path, nil)
 	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	responseRecorder := httptest.NewRecorder()
+	router.ServeHTTP(responseRecorder, req)
+
+	return responseRecorder
+}
+
+// UploadFileViaHTTP загружает файл в поле "file" multipart формы; пустой contentType
+// означает, что клиент не указал тип файла
+func UploadFileViaHTTP(router chi.Router, token, path, fileName, contentType string, content []byte) *httptest.ResponseRecorder {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", multipart.FileContentDisposition("file", fileName))
+	if contentType != "" {
+		header.Set("Content-Type", contentType)
+	}
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := part.Write(content); err != nil {
+		panic(err)
+	}
+	if err := writer.Close(); err != nil {
+		panic(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, path, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
 
 	responseRecorder := httptest.NewRecorder()
 	router.ServeHTTP(responseRecorder, req)
@@ -0,0 +1,107 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	v1_labels "crud/internal/presentation/api/v1/labels"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelsCRUD(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	adminToken := LoginAsAdminViaHTTP(t, router)
+	_, memberToken := CreateUserAndLoginViaHTTP(t, router, "labels@example.com", "Labels User")
+
+	label := CreateLabelViaHTTP(t, router, memberToken, "Bug")
+	assert.Equal(t, "bug", label.Name)
+
+	response := ExecuteAuthorizedRequest(router, memberToken, http.MethodPost, "/api/v1/labels", v1_labels.CreateLabelRequest{Name: "bug"})
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodPost, "/api/v1/labels", v1_labels.CreateLabelRequest{Name: ""})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodGet, "/api/v1/labels/"+label.ID, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodGet, "/api/v1/labels", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, data, 1)
+
+	// Переименование и удаление доступны только администратору
+	path := "/api/v1/labels/" + label.ID
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodPut, path, v1_labels.UpdateLabelRequest{Name: "defect"})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPut, path, v1_labels.UpdateLabelRequest{Name: "defect"})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "defect", DecodeJSONResponse[v1_labels.LabelResponse](t, response).Name)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestTaskLabels(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "tagger@example.com", "Tagger")
+	bug := CreateLabelViaHTTP(t, router, token, "bug")
+	frontend := CreateLabelViaHTTP(t, router, token, "frontend")
+
+	frontendBug := CreateTaskViaHTTP(t, router, token, "", "Frontend bug", "Description", "todo")
+	backendBug := CreateTaskViaHTTP(t, router, token, "", "Backend bug", "Description", "todo")
+	CreateTaskViaHTTP(t, router, token, "", "Unlabeled", "Description", "todo")
+
+	attach := func(taskID, labelID string) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+taskID+"/labels", v1_tasks.AttachTaskLabelRequest{LabelID: labelID})
+		require.Equal(t, http.StatusOK, response.Code)
+	}
+	attach(frontendBug.ID, bug.ID)
+	attach(frontendBug.ID, frontend.ID)
+	attach(backendBug.ID, bug.ID)
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+frontendBug.ID+"/labels", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	taskLabels := DecodeJSONResponse[v1_tasks.TaskLabelsResponse](t, response)
+	require.Len(t, taskLabels.Data, 2)
+	assert.Equal(t, "bug", taskLabels.Data[0].Name)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+frontendBug.ID+"/labels", v1_tasks.AttachTaskLabelRequest{LabelID: frontendBug.ID})
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	// Фильтрация списка задач по меткам
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?labels=bug,frontend", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(2), total)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?labels=bug,frontend&labels_match=all", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "Frontend bug", data[0].(map[string]interface{})["title"])
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?labels=bug&labels_match=some", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodDelete, "/api/v1/tasks/"+frontendBug.ID+"/labels/"+bug.ID, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?labels=bug,frontend&labels_match=all", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(0), total)
+}
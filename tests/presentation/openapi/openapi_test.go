@@ -0,0 +1,175 @@
+package openapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"crud/internal/presentation/api/v1/openapi"
+	"crud/internal/presentation/api/v1/problems"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type itemResponse struct {
+	ID       string  `json:"id"`
+	ParentID *string `json:"parent_id"`
+	Note     string  `json:"note,omitempty"`
+	Tags     []string
+	internal string
+}
+
+type deletedItemResponse struct {
+	itemResponse
+	DeletedAt string `json:"deleted_at"`
+}
+
+type ownerResponse struct {
+	Item  itemResponse    `json:"item"`
+	Items []*itemResponse `json:"items"`
+	Extra any             `json:"extra"`
+}
+
+func TestGeneratorSchema(t *testing.T) {
+	generator := openapi.NewGenerator()
+
+	ref := generator.Schema(reflect.TypeOf(deletedItemResponse{}))
+	assert.Equal(t, "#/components/schemas/deletedItemResponse", ref.Ref)
+
+	schema := generator.Schemas()["deletedItemResponse"]
+	require.NotNil(t, schema)
+	assert.Equal(t, "object", schema.Type)
+
+	// Встроенная структура раскрывается в поля внешней, неэкспортируемые поля пропускаются
+	assert.ElementsMatch(t, []string{"id", "parent_id", "note", "Tags", "deleted_at"}, keys(schema.Properties))
+	// Поля без omitempty обязательны
+	assert.ElementsMatch(t, []string{"id", "parent_id", "Tags", "deleted_at"}, schema.Required)
+
+	assert.Equal(t, "string", schema.Properties["id"].Type)
+	assert.Equal(t, []string{"string", "null"}, schema.Properties["parent_id"].Type)
+	assert.Equal(t, "array", schema.Properties["Tags"].Type)
+}
+
+func TestGeneratorReusesStructSchemas(t *testing.T) {
+	generator := openapi.NewGenerator()
+
+	generator.Schema(reflect.TypeOf(ownerResponse{}))
+	schema := generator.Schemas()["ownerResponse"]
+	require.NotNil(t, schema)
+
+	assert.Equal(t, "#/components/schemas/itemResponse", schema.Properties["item"].Ref)
+	// Указатель на структуру остается ссылкой на ту же схему
+	assert.Equal(t, "#/components/schemas/itemResponse", schema.Properties["items"].Items.Ref)
+	assert.Nil(t, schema.Properties["extra"].Type)
+	assert.Len(t, generator.Schemas(), 2)
+}
+
+func TestBuild(t *testing.T) {
+	document := openapi.Build(openapi.Info{Title: "Test", Version: "1"}, "/api", []openapi.Operation{
+		{Method: http.MethodPost, Path: "/login", ID: "login", Tag: "auth", Public: true,
+			Request: openapi.JSON(itemResponse{}), Responses: []openapi.Response{openapi.NoContent()}},
+		{Method: http.MethodGet, Path: "/items", ID: "listItems", Tag: "items",
+			Responses: []openapi.Response{openapi.OK(openapi.PageOf(itemResponse{}))}},
+	})
+
+	assert.Equal(t, openapi.Version, document.OpenAPI)
+	assert.Equal(t, []openapi.Tag{{Name: "auth"}, {Name: "items"}}, document.Tags)
+	assert.Contains(t, document.Components.Schemas, "Problem")
+
+	login, ok := document.Operation(http.MethodPost, "/login")
+	require.True(t, ok)
+	require.NotNil(t, login.Security)
+	assert.Empty(t, *login.Security)
+	assert.Contains(t, login.Responses, "204")
+	assert.Contains(t, login.Responses["default"].Content, problems.ContentType)
+
+	list, ok := document.Operation(http.MethodGet, "/items")
+	require.True(t, ok)
+	assert.Nil(t, list.Security)
+	page := list.Responses["200"].Content[openapi.JSONMediaType].Schema
+	assert.ElementsMatch(t, []string{"data", "total", "page", "page_size"}, page.Required)
+}
+
+func TestValidate(t *testing.T) {
+	document := openapi.Build(openapi.Info{Title: "Test", Version: "1"}, "/", []openapi.Operation{
+		{Method: http.MethodPost, Path: "/items", ID: "createItem", Public: true,
+			Request: openapi.JSON(ownerResponse{})},
+		{Method: http.MethodGet, Path: "/items/{id}", ID: "getItem", Public: true,
+			Parameters: []openapi.Parameter{openapi.PathID("id", "")}},
+		{Method: http.MethodGet, Path: "/items/search", ID: "searchItems", Public: true,
+			Parameters: []openapi.Parameter{openapi.Query("limit", "", openapi.Integer(1))}},
+		{Method: http.MethodGet, Path: "/private/{id}", ID: "getPrivate",
+			Parameters: []openapi.Parameter{openapi.PathID("id", "")}},
+	})
+
+	reached := false
+	handler := openapi.Validate(document)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !openapi.ValidatePending(w, r) {
+			return
+		}
+		reached = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	serve := func(method, target, body string) *httptest.ResponseRecorder {
+		reached = false
+		var request *http.Request
+		if body != "" {
+			request = httptest.NewRequest(method, target, bytes.NewBufferString(body))
+			request.Header.Set("Content-Type", "application/json")
+		} else {
+			request = httptest.NewRequest(method, target, nil)
+		}
+		response := httptest.NewRecorder()
+		handler.ServeHTTP(response, request)
+		return response
+	}
+
+	testCases := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		status  int
+		field   string
+		code    string
+		reached bool
+	}{
+		{"valid body", http.MethodPost, "/items", `{"item":{"id":"1","parent_id":null,"Tags":[]},"items":[],"extra":1}`, http.StatusNoContent, "", "", true},
+		{"nested required field", http.MethodPost, "/items", `{"item":{"id":"1","parent_id":null},"items":[],"extra":1}`, http.StatusBadRequest, "item.Tags", openapi.CodeRequired, false},
+		{"array item type", http.MethodPost, "/items", `{"item":{"id":"1","parent_id":null,"Tags":[]},"items":[7],"extra":1}`, http.StatusBadRequest, "items[0]", openapi.CodeType, false},
+		{"malformed body is left to the handler", http.MethodPost, "/items", `{`, http.StatusNoContent, "", "", true},
+		{"path parameter format", http.MethodGet, "/items/42", "", http.StatusBadRequest, "id", openapi.CodeFormat, false},
+		{"literal segment wins over parameter", http.MethodGet, "/items/search?limit=5", "", http.StatusNoContent, "", "", true},
+		{"integer query parameter", http.MethodGet, "/items/search?limit=many", "", http.StatusBadRequest, "limit", openapi.CodeType, false},
+		{"deferred validation", http.MethodGet, "/private/42", "", http.StatusBadRequest, "id", openapi.CodeFormat, false},
+		{"unknown path", http.MethodGet, "/unknown", "", http.StatusNoContent, "", "", true},
+		{"unknown method", http.MethodDelete, "/items/42", "", http.StatusNoContent, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			response := serve(tc.method, tc.target, tc.body)
+			assert.Equal(t, tc.status, response.Code)
+			assert.Equal(t, tc.reached, reached)
+			if tc.field == "" {
+				return
+			}
+
+			assert.Equal(t, problems.ContentType, response.Header().Get("Content-Type"))
+			assert.Contains(t, response.Body.String(), `"field":"`+tc.field+`"`)
+			assert.Contains(t, response.Body.String(), `"code":"`+tc.code+`"`)
+		})
+	}
+}
+
+func keys(properties map[string]*openapi.Schema) []string {
+	result := make([]string, 0, len(properties))
+	for name := range properties {
+		result = append(result, name)
+	}
+	return result
+}
@@ -0,0 +1,220 @@
+package presentation
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"testing"
+
+	"crud/internal/presentation/api/v1/openapi"
+	"crud/internal/presentation/api/v1/problems"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOpenAPIDocumentMatchesRoutes падает, если маршрут добавлен без описания в документе
+// или описание осталось от удаленного маршрута
+func TestOpenAPIDocumentMatchesRoutes(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	var routes []string
+	err := chi.Walk(router, func(method, route string, handler http.Handler, middlewares ...func(http.Handler) http.Handler) error {
+		path := strings.TrimPrefix(route, "/api/v1")
+		if len(path) > 1 {
+			path = strings.TrimSuffix(path, "/")
+		}
+		routes = append(routes, method+" "+path)
+		return nil
+	})
+	require.NoError(t, err)
+
+	response := ExecuteRequest(router, http.MethodGet, "/api/v1/openapi.json", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "application/json", response.Header().Get("Content-Type"))
+
+	document := DecodeJSONResponse[openapi.Document](t, response)
+	assert.Equal(t, openapi.Version, document.OpenAPI)
+
+	var operations []string
+	operationIDs := map[string]bool{}
+	for path, item := range document.Paths {
+		for method, operation := range item {
+			operations = append(operations, strings.ToUpper(method)+" "+path)
+
+			assert.NotEmpty(t, operation.OperationID, "%s %s", method, path)
+			assert.False(t, operationIDs[operation.OperationID], "duplicate operationId %s", operation.OperationID)
+			operationIDs[operation.OperationID] = true
+		}
+	}
+
+	sort.Strings(routes)
+	sort.Strings(operations)
+	assert.Equal(t, routes, operations)
+}
+
+func TestOpenAPIDocumentReferencesResolve(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	response := ExecuteRequest(router, http.MethodGet, "/api/v1/openapi.json", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	var document map[string]interface{}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &document))
+	schemas := document["components"].(map[string]interface{})["schemas"].(map[string]interface{})
+
+	var refs []string
+	collectRefs(document, &refs)
+	require.NotEmpty(t, refs)
+	for _, ref := range refs {
+		name := strings.TrimPrefix(ref, "#/components/schemas/")
+		assert.Contains(t, schemas, name, "unresolved $ref %s", ref)
+	}
+}
+
+func TestOpenAPIDocsPage(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	response := ExecuteRequest(router, http.MethodGet, "/api/v1/docs", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Contains(t, response.Header().Get("Content-Type"), "text/html")
+	assert.Contains(t, response.Body.String(), "openapi.json")
+}
+
+func TestRequestValidation(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "validation@example.com", "Validation User")
+
+	t.Run("wrong body field type", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", map[string]interface{}{
+			"title":  42,
+			"status": "todo",
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Equal(t, problems.ContentType, response.Header().Get("Content-Type"))
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, problems.CodeInvalidRequest, problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "title", problem.Errors[0].Field)
+		assert.Equal(t, openapi.CodeType, problem.Errors[0].Code)
+	})
+
+	t.Run("missing required body fields", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", map[string]interface{}{
+			"description": "no title and status",
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 2)
+		assert.Equal(t, "status", problem.Errors[0].Field)
+		assert.Equal(t, "title", problem.Errors[1].Field)
+		assert.Equal(t, openapi.CodeRequired, problem.Errors[1].Code)
+	})
+
+	t.Run("body that is not an object", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", []byte(`["title"]`))
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, problems.CodeInvalidRequest, problem.Code)
+		assert.Empty(t, problem.Errors)
+	})
+
+	t.Run("query parameter below minimum", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?page=0", nil)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, problems.CodeInvalidParameter, problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "page", problem.Errors[0].Field)
+		assert.Equal(t, openapi.CodeMinimum, problem.Errors[0].Code)
+	})
+
+	t.Run("page size above maximum", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?page_size=1000", nil)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "page_size", problem.Errors[0].Field)
+		assert.Equal(t, openapi.CodeMaximum, problem.Errors[0].Code)
+	})
+
+	t.Run("query parameter outside enum", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?labels_match=some", nil)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "labels_match", problem.Errors[0].Field)
+		assert.Equal(t, openapi.CodeEnum, problem.Errors[0].Code)
+	})
+
+	t.Run("nested path parameter", func(t *testing.T) {
+		task := CreateTaskViaHTTP(t, router, token, "", "Commented", "", "todo")
+
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/tasks/"+task.ID+"/comments/not-a-uuid", map[string]string{
+			"body": "edited",
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "commentID", problem.Errors[0].Field)
+		assert.Equal(t, openapi.CodeFormat, problem.Errors[0].Code)
+	})
+
+	t.Run("literal segment is not a path parameter", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/workflow", nil)
+		assert.Equal(t, http.StatusOK, response.Code)
+	})
+
+	t.Run("public operation is validated before the handler", func(t *testing.T) {
+		response := ExecuteRequest(router, http.MethodPost, "/api/v1/auth/login", map[string]interface{}{
+			"email":    "validation@example.com",
+			"password": 123,
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "password", problem.Errors[0].Field)
+	})
+
+	t.Run("authentication is checked before the schema", func(t *testing.T) {
+		response := ExecuteRequest(router, http.MethodPost, "/api/v1/tasks", map[string]interface{}{"title": 42})
+		assert.Equal(t, http.StatusUnauthorized, response.Code)
+	})
+
+	t.Run("valid request reaches the handler", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", map[string]interface{}{
+			"title":  "Valid",
+			"status": "todo",
+		})
+		assert.Equal(t, http.StatusCreated, response.Code)
+	})
+}
+
+// collectRefs собирает значения всех $ref документа
+func collectRefs(value interface{}, refs *[]string) {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for key, item := range value {
+			if ref, ok := item.(string); ok && key == "$ref" {
+				*refs = append(*refs, ref)
+				continue
+			}
+			collectRefs(item, refs)
+		}
+	case []interface{}:
+		for _, item := range value {
+			collectRefs(item, refs)
+		}
+	}
+}
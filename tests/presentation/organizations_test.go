@@ -0,0 +1,112 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	organizations_domain "crud/internal/domain/organizations"
+	v1_organizations "crud/internal/presentation/api/v1/organizations"
+	v1_users "crud/internal/presentation/api/v1/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizations(t *testing.T) {
+	router := NewTestRouterWithContainer()
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/organizations", v1_organizations.CreateOrganizationRequest{
+		Name:          "Acme",
+		AdminEmail:    "acme-admin@example.com",
+		AdminName:     "Acme Admin",
+		AdminPassword: "password123",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	created := DecodeJSONResponse[v1_organizations.CreateOrganizationResponse](t, response)
+	assert.Equal(t, "Acme", created.Organization.Name)
+	assert.Equal(t, created.Organization.ID, created.Admin.OrganizationID)
+	assert.Equal(t, "admin", created.Admin.Role)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/organizations", v1_organizations.CreateOrganizationRequest{
+		Name:          "",
+		AdminEmail:    "blank-admin@example.com",
+		AdminName:     "Blank Admin",
+		AdminPassword: "password123",
+	})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/organizations", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(2), total)
+
+	acmeToken := LoginViaHTTP(t, router, "acme-admin@example.com", "password123").AccessToken
+
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/organizations/current", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, created.Organization.ID, DecodeJSONResponse[v1_organizations.OrganizationResponse](t, response).ID)
+
+	// Администратор другой организации не управляет организациями
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/organizations", nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	_, memberToken := CreateUserAndLoginViaHTTP(t, router, "org-member@example.com", "Org Member")
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodGet, "/api/v1/organizations/current", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, organizations_domain.DefaultOrganizationID.String(), DecodeJSONResponse[v1_organizations.OrganizationResponse](t, response).ID)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodPost, "/api/v1/organizations", v1_organizations.CreateOrganizationRequest{Name: "Nope"})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+}
+
+func TestOrganizationIsolation(t *testing.T) {
+	router := NewTestRouterWithContainer()
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/organizations", v1_organizations.CreateOrganizationRequest{
+		Name:          "Acme",
+		AdminEmail:    "acme-admin@example.com",
+		AdminName:     "Acme Admin",
+		AdminPassword: "password123",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	acmeToken := LoginViaHTTP(t, router, "acme-admin@example.com", "password123").AccessToken
+
+	member, memberToken := CreateUserAndLoginViaHTTP(t, router, "isolated@example.com", "Isolated User")
+	task := CreateTaskViaHTTP(t, router, memberToken, member.ID, "Private task", "", "todo")
+	CreateLabelViaHTTP(t, router, memberToken, "bug")
+
+	// Данные организации по умолчанию не видны из другой организации
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/tasks/"+task.ID, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/tasks", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(0), total)
+
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/users/"+member.ID, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/users", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, data, 1)
+
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodDelete, "/api/v1/tasks/"+task.ID, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+
+	// Имена меток уникальны в пределах организации
+	CreateLabelViaHTTP(t, router, acmeToken, "bug")
+
+	// Созданные в организации пользователи попадают в нее же
+	acmeUser := CreateUserViaHTTP(t, router, acmeToken, "acme-member@example.com", "Acme Member", "member")
+	response = ExecuteAuthorizedRequest(router, acmeToken, http.MethodGet, "/api/v1/users/"+acmeUser.ID, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, acmeUser.OrganizationID, DecodeJSONResponse[v1_users.UserResponse](t, response).OrganizationID)
+
+	response = ExecuteAuthorizedRequest(router, memberToken, http.MethodGet, "/api/v1/users/"+acmeUser.ID, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
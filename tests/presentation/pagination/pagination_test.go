@@ -0,0 +1,63 @@
+package pagination
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"crud/internal/presentation/api/v1/pagination"
+	"crud/internal/presentation/api/v1/problems"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		page, pageSize, ok := pagination.Parse(recorder, httptest.NewRequest(http.MethodGet, "/tasks", nil))
+		require.True(t, ok)
+		assert.Equal(t, 1, page)
+		assert.Equal(t, pagination.DefaultPageSize, pageSize)
+	})
+
+	t.Run("explicit values", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		page, pageSize, ok := pagination.Parse(recorder, httptest.NewRequest(http.MethodGet, "/tasks?page=3&page_size=100", nil))
+		require.True(t, ok)
+		assert.Equal(t, 3, page)
+		assert.Equal(t, pagination.MaxPageSize, pageSize)
+	})
+
+	// Без OpenAPI middleware некорректные значения отклоняет сам обработчик
+	t.Run("invalid values", func(t *testing.T) {
+		testCases := []struct {
+			query string
+			field string
+		}{
+			{"page=abc", "page"},
+			{"page=0", "page"},
+			{"page=-1", "page"},
+			{"page_size=ten", "page_size"},
+			{"page_size=0", "page_size"},
+			{"page_size=101", "page_size"},
+			{"page_size=1000000000", "page_size"},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.query, func(t *testing.T) {
+				recorder := httptest.NewRecorder()
+				_, _, ok := pagination.Parse(recorder, httptest.NewRequest(http.MethodGet, "/tasks?"+tc.query, nil))
+				require.False(t, ok)
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var problem problems.Problem
+				require.NoError(t, json.NewDecoder(recorder.Body).Decode(&problem))
+				assert.Equal(t, problems.CodeInvalidParameter, problem.Code)
+				require.Len(t, problem.Errors, 1)
+				assert.Equal(t, tc.field, problem.Errors[0].Field)
+			})
+		}
+	})
+}
@@ -0,0 +1,157 @@
+package patch
+
+import (
+	"testing"
+
+	"crud/internal/presentation/api/v1/patch"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePatch(t *testing.T) {
+	// Примеры из приложения A RFC 7396
+	testCases := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+	}{
+		{"replace member", `{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"add member", `{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{"null removes member", `{"a":"b"}`, `{"a":null}`, `{}`},
+		{"null removes only named member", `{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{"array replaces array", `{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{"nested objects are merged", `{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{"arrays are not merged", `{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{"non-object patch replaces document", `{"a":"foo"}`, `"bar"`, `"bar"`},
+		{"nested null is removed from new object", `{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{"object target created for nested patch", `{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patched, err := patch.Apply(patch.MergePatchMediaType, []byte(tc.document), []byte(tc.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(patched))
+		})
+	}
+
+	_, err := patch.Apply(patch.MergePatchMediaType, []byte(`{}`), []byte(`{"a":`))
+	assert.True(t, patch.IsMalformedPatch(err))
+}
+
+func TestJSONPatch(t *testing.T) {
+	// Примеры из приложения A RFC 6902
+	testCases := []struct {
+		name     string
+		document string
+		patch    string
+		expected string
+	}{
+		{"add object member", `{"foo":"bar"}`, `[{"op":"add","path":"/baz","value":"qux"}]`, `{"baz":"qux","foo":"bar"}`},
+		{"add array element", `{"foo":["bar","baz"]}`, `[{"op":"add","path":"/foo/1","value":"qux"}]`, `{"foo":["bar","qux","baz"]}`},
+		{"append array element", `{"foo":["bar"]}`, `[{"op":"add","path":"/foo/-","value":["abc","def"]}]`, `{"foo":["bar",["abc","def"]]}`},
+		{"remove object member", `{"baz":"qux","foo":"bar"}`, `[{"op":"remove","path":"/baz"}]`, `{"foo":"bar"}`},
+		{"remove array element", `{"foo":["bar","qux","baz"]}`, `[{"op":"remove","path":"/foo/1"}]`, `{"foo":["bar","baz"]}`},
+		{"replace value", `{"baz":"qux","foo":"bar"}`, `[{"op":"replace","path":"/baz","value":"boo"}]`, `{"baz":"boo","foo":"bar"}`},
+		{"replace with null", `{"baz":"qux"}`, `[{"op":"replace","path":"/baz","value":null}]`, `{"baz":null}`},
+		{
+			"move value",
+			`{"foo":{"bar":"baz","waldo":"fred"},"qux":{"corge":"grault"}}`,
+			`[{"op":"move","from":"/foo/waldo","path":"/qux/thud"}]`,
+			`{"foo":{"bar":"baz"},"qux":{"corge":"grault","thud":"fred"}}`,
+		},
+		{"move array element", `{"foo":["all","grass","cows","eat"]}`, `[{"op":"move","from":"/foo/1","path":"/foo/3"}]`, `{"foo":["all","cows","eat","grass"]}`},
+		{"copy value", `{"foo":{"bar":1}}`, `[{"op":"copy","from":"/foo","path":"/baz"}]`, `{"foo":{"bar":1},"baz":{"bar":1}}`},
+		{"test success", `{"baz":"qux","foo":["a",2,"c"]}`, `[{"op":"test","path":"/baz","value":"qux"},{"op":"test","path":"/foo/1","value":2}]`, `{"baz":"qux","foo":["a",2,"c"]}`},
+		{"escaped pointer", `{"/":9,"~1":10}`, `[{"op":"test","path":"/~01","value":10},{"op":"remove","path":"/~1"}]`, `{"~1":10}`},
+		{"numbers compared by value", `{"n":1}`, `[{"op":"test","path":"/n","value":1.0}]`, `{"n":1}`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patched, err := patch.Apply(patch.JSONPatchMediaType, []byte(tc.document), []byte(tc.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, string(patched))
+		})
+	}
+
+	t.Run("errors", func(t *testing.T) {
+		document := []byte(`{"foo":"bar","list":[1]}`)
+
+		_, err := patch.Apply(patch.JSONPatchMediaType, document, []byte(`[{"op":"test","path":"/foo","value":"baz"}]`))
+		assert.True(t, patch.IsTestFailed(err))
+
+		for _, invalid := range []string{
+			`[{"op":"remove","path":"/missing"}]`,
+			`[{"op":"replace","path":"/missing","value":1}]`,
+			`[{"op":"add","path":"/missing/child","value":1}]`,
+			`[{"op":"add","path":"/list/5","value":1}]`,
+			`[{"op":"remove","path":"/list/01"}]`,
+			`[{"op":"move","from":"/list","path":"/list/0"}]`,
+			`[{"op":"add","path":"foo","value":1}]`,
+		} {
+			_, err := patch.Apply(patch.JSONPatchMediaType, document, []byte(invalid))
+			assert.True(t, patch.IsInvalidPatch(err), invalid)
+		}
+
+		for _, malformed := range []string{
+			`{"op":"add"}`,
+			`[{"path":"/foo"}]`,
+			`[{"op":"jump","path":"/foo"}]`,
+			`[{"op":"add","path":"/foo"}]`,
+			`[{"op":"move","path":"/foo"}]`,
+		} {
+			_, err := patch.Apply(patch.JSONPatchMediaType, document, []byte(malformed))
+			assert.True(t, patch.IsMalformedPatch(err), malformed)
+		}
+	})
+
+	t.Run("patch is atomic", func(t *testing.T) {
+		document := []byte(`{"foo":"bar"}`)
+		_, err := patch.Apply(patch.JSONPatchMediaType, document, []byte(`[{"op":"remove","path":"/foo"},{"op":"test","path":"/foo","value":"bar"}]`))
+		require.Error(t, err)
+		assert.JSONEq(t, `{"foo":"bar"}`, string(document))
+	})
+}
+
+func TestApplyMediaType(t *testing.T) {
+	patched, err := patch.Apply("application/merge-patch+json; charset=utf-8", []byte(`{"a":1}`), []byte(`{"a":2}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"a":2}`, string(patched))
+
+	for _, contentType := range []string{"application/json", "", "text/plain"} {
+		_, err := patch.Apply(contentType, []byte(`{}`), []byte(`{}`))
+		assert.True(t, patch.IsUnsupportedMediaType(err), contentType)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	original := []byte(`{"id":"1","title":"Old","due_date":null,"description":"text"}`)
+
+	changes, err := patch.Changes(original, []byte(`{"id":"1","title":"New","due_date":null}`), "title", "description", "due_date")
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, "New", *changes["title"])
+	// Удаленный член считается очищенным
+	value, ok := changes["description"]
+	assert.True(t, ok)
+	assert.Nil(t, value)
+
+	_, err = patch.Changes(original, []byte(`{"id":"2","title":"Old","due_date":null,"description":"text"}`), "title")
+	assert.True(t, patch.IsInvalidPatch(err))
+	assert.Contains(t, err.Error(), "read-only")
+
+	_, err = patch.Changes(original, []byte(`{"id":"1","title":"Old","due_date":null,"description":"text","color":"red"}`), "title")
+	assert.Contains(t, err.Error(), "unknown field 'color'")
+
+	_, err = patch.Changes(original, []byte(`{"id":"1","title":5,"due_date":null,"description":"text"}`), "title")
+	assert.Contains(t, err.Error(), "must be a string or null")
+
+	_, err = patch.Changes(original, []byte(`[]`), "title")
+	assert.True(t, patch.IsInvalidPatch(err))
+
+	assert.True(t, patch.IsInvalidPatch(patch.Required(map[string]*string{"title": nil}, "title")))
+	assert.NoError(t, patch.Required(map[string]*string{}, "title"))
+}
@@ -0,0 +1,164 @@
+package presentation
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mergePatchHeaders заголовки запроса с телом JSON Merge Patch
+var mergePatchHeaders = map[string]string{"Content-Type": "application/merge-patch+json"}
+
+// jsonPatchHeaders заголовки запроса с телом JSON Patch
+var jsonPatchHeaders = map[string]string{"Content-Type": "application/json-patch+json"}
+
+func TestPatchTask(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, token := CreateUserAndLoginViaHTTP(t, router, "patch@example.com", "Owner")
+	task := CreateTaskViaHTTP(t, router, token, owner.ID, "Patched", "Long description", "todo")
+	taskPath := "/api/v1/tasks/" + task.ID
+
+	patchTask := func(headers map[string]string, body string) *http.Response {
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath, []byte(body), headers)
+		return response.Result()
+	}
+
+	t.Run("merge patch", func(t *testing.T) {
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath,
+			json.RawMessage(`{"title":"Merged","due_date":"2030-01-15","description":null}`), mergePatchHeaders)
+		require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+		patched := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+		assert.Equal(t, "Merged", patched.Title)
+		// null очищает описание, а не оставляет его без изменений
+		assert.Equal(t, "", patched.Description)
+		require.NotNil(t, patched.DueDate)
+		assert.Equal(t, "2030-01-15T23:59:59Z", *patched.DueDate)
+		assert.Equal(t, "todo", patched.Status)
+		assert.Equal(t, `"2"`, response.Header().Get("ETag"))
+
+		// Отсутствующие в патче поля не меняются, null снимает срок выполнения
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath, json.RawMessage(`{"due_date":null}`), mergePatchHeaders)
+		require.Equal(t, http.StatusOK, response.Code)
+		patched = DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+		assert.Nil(t, patched.DueDate)
+		assert.Equal(t, "Merged", patched.Title)
+	})
+
+	t.Run("json patch", func(t *testing.T) {
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath, json.RawMessage(`[
+			{"op": "test", "path": "/title", "value": "Merged"},
+			{"op": "replace", "path": "/title", "value": "Replaced"},
+			{"op": "replace", "path": "/priority", "value": "high"},
+			{"op": "copy", "from": "/title", "path": "/description"}
+		]`), jsonPatchHeaders)
+		require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+		patched := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+		assert.Equal(t, "Replaced", patched.Title)
+		assert.Equal(t, "Replaced", patched.Description)
+		assert.Equal(t, "high", patched.Priority)
+
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath,
+			json.RawMessage(`[{"op": "remove", "path": "/description"}]`), jsonPatchHeaders)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "", DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Description)
+	})
+
+	t.Run("status change goes through workflow", func(t *testing.T) {
+		other := CreateTaskViaHTTP(t, router, token, owner.ID, "Workflow", "", "todo")
+		otherPath := "/api/v1/tasks/" + other.ID
+
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, otherPath, json.RawMessage(`{"status":"done"}`), mergePatchHeaders)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, "done", DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Status)
+
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, otherPath, json.RawMessage(`{"status":"todo"}`), mergePatchHeaders)
+		assert.Equal(t, http.StatusConflict, response.Code)
+	})
+
+	t.Run("failed patch changes nothing", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, taskPath, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		before := response.Body.String()
+
+		testCases := []struct {
+			name    string
+			headers map[string]string
+			body    string
+			status  int
+		}{
+			{"test op fails", jsonPatchHeaders, `[{"op":"replace","path":"/title","value":"X"},{"op":"test","path":"/priority","value":"low"}]`, http.StatusConflict},
+			{"missing path", jsonPatchHeaders, `[{"op":"remove","path":"/color"}]`, http.StatusUnprocessableEntity},
+			{"required field cleared", mergePatchHeaders, `{"title":null}`, http.StatusUnprocessableEntity},
+			{"read-only field", mergePatchHeaders, `{"id":"00000000-0000-0000-0000-000000000000"}`, http.StatusUnprocessableEntity},
+			{"unknown field", mergePatchHeaders, `{"color":"red"}`, http.StatusUnprocessableEntity},
+			{"wrong type", mergePatchHeaders, `{"title":42}`, http.StatusUnprocessableEntity},
+			{"invalid title", mergePatchHeaders, `{"title":""}`, http.StatusBadRequest},
+			{"invalid date", mergePatchHeaders, `{"due_date":"tomorrow"}`, http.StatusBadRequest},
+			{"malformed json", mergePatchHeaders, `{"title":`, http.StatusBadRequest},
+			{"malformed operations", jsonPatchHeaders, `{"op":"add"}`, http.StatusBadRequest},
+		}
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				result := patchTask(tc.headers, tc.body)
+				assert.Equal(t, tc.status, result.StatusCode)
+			})
+		}
+
+		response = ExecuteAuthorizedRequest(router, token, http.MethodGet, taskPath, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.JSONEq(t, before, response.Body.String())
+	})
+
+	t.Run("unsupported media type", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPatch, taskPath, map[string]string{"title": "Plain"})
+		assert.Equal(t, http.StatusUnsupportedMediaType, response.Code)
+		assert.Equal(t, "application/merge-patch+json, application/json-patch+json", response.Header().Get("Accept-Patch"))
+	})
+
+	t.Run("if-match", func(t *testing.T) {
+		response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, taskPath, json.RawMessage(`{"title":"Stale"}`),
+			map[string]string{"Content-Type": "application/merge-patch+json", "If-Match": `"1"`})
+		assert.Equal(t, http.StatusPreconditionFailed, response.Code)
+	})
+
+	t.Run("other member cannot patch", func(t *testing.T) {
+		_, otherToken := CreateUserAndLoginViaHTTP(t, router, "patch-other@example.com", "Other")
+		response := ExecuteAuthorizedRequestWithHeaders(router, otherToken, http.MethodPatch, taskPath, json.RawMessage(`{"title":"Hijacked"}`), mergePatchHeaders)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+}
+
+func TestPatchUser(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user, token := CreateUserAndLoginViaHTTP(t, router, "patch-user@example.com", "Original")
+	userPath := "/api/v1/users/" + user.ID
+
+	response := ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, userPath, json.RawMessage(`{"name":"Merged"}`), mergePatchHeaders)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	assert.Equal(t, "Merged", DecodeJSONResponse[v1_users.UserResponse](t, response).Name)
+
+	// Пароля нет в представлении пользователя, поэтому JSON Patch добавляет его
+	response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, userPath,
+		json.RawMessage(`[{"op":"add","path":"/password","value":"new-password-123"}]`), jsonPatchHeaders)
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+	LoginViaHTTP(t, router, "patch-user@example.com", "new-password-123")
+
+	testCases := map[string]int{
+		`{"name":null}`:            http.StatusUnprocessableEntity,
+		`{"organization_id":"x"}`:  http.StatusUnprocessableEntity,
+		`{"email":"not-an-email"}`: http.StatusBadRequest,
+		`{"role":"admin"}`:         http.StatusForbidden,
+	}
+	for body, status := range testCases {
+		response = ExecuteAuthorizedRequestWithHeaders(router, token, http.MethodPatch, userPath, json.RawMessage(body), mergePatchHeaders)
+		assert.Equal(t, status, response.Code, body)
+	}
+}
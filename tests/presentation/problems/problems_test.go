@@ -0,0 +1,129 @@
+package problems
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"crud/internal/domain/auth"
+	"crud/internal/domain/labels"
+	"crud/internal/domain/projects"
+	"crud/internal/domain/tasks"
+	tasks_vo "crud/internal/domain/tasks/value_objects"
+	"crud/internal/domain/users"
+	"crud/internal/presentation/api/v1/patch"
+	"crud/internal/presentation/api/v1/problems"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromError(t *testing.T) {
+	testCases := []struct {
+		name   string
+		err    error
+		status int
+		code   string
+	}{
+		{"unauthenticated", &auth.UnauthenticatedError{}, http.StatusUnauthorized, "unauthenticated"},
+		{"invalid credentials", &auth.InvalidCredentialsError{}, http.StatusUnauthorized, "invalid_credentials"},
+		{"forbidden", &auth.ForbiddenError{Action: "update", Resource: "task"}, http.StatusForbidden, "forbidden"},
+		{"task not found", &tasks.TaskNotFoundError{TaskID: uuid.New()}, http.StatusNotFound, "task_not_found"},
+		{"user already exists", &users.UserAlreadyExistsError{Email: "a@example.com"}, http.StatusConflict, "user_already_exists"},
+		{"illegal transition", &tasks.IllegalTransitionError{From: "done", To: "todo"}, http.StatusConflict, "illegal_transition"},
+		{"concurrent modification", &tasks.ConcurrentModificationError{TaskID: uuid.New(), Version: 2}, http.StatusPreconditionFailed, "concurrent_modification"},
+		{"parent task not found", &tasks.ParentTaskNotFoundError{ParentID: uuid.New()}, http.StatusUnprocessableEntity, "parent_task_not_found"},
+		{"invalid task data", &tasks.InvalidTaskDataError{Field: "sort", Message: "unknown sort order"}, http.StatusBadRequest, "invalid_task_data"},
+		{"invalid status", &tasks_vo.InvalidStatusError{Value: "later"}, http.StatusBadRequest, "invalid_status"},
+		{"project has tasks", &projects.ProjectHasTasksError{ProjectID: uuid.New(), Tasks: 3}, http.StatusConflict, "project_has_tasks"},
+		{"label not found", &labels.LabelNotFoundError{LabelID: uuid.New()}, http.StatusNotFound, "label_not_found"},
+		{"malformed patch", &patch.MalformedPatchError{Reason: "not an object"}, http.StatusBadRequest, "malformed_patch"},
+		{"request too large", &http.MaxBytesError{Limit: 1024}, http.StatusRequestEntityTooLarge, problems.CodeRequestTooLarge},
+		{"wrapped error", fmt.Errorf("get task: %w", &tasks.TaskNotFoundError{TaskID: uuid.New()}), http.StatusNotFound, "task_not_found"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			problem := problems.FromError(tc.err)
+			assert.Equal(t, tc.status, problem.Status)
+			assert.Equal(t, tc.code, problem.Code)
+			assert.Equal(t, http.StatusText(tc.status), problem.Title)
+			assert.Equal(t, "about:blank", problem.Type)
+			assert.Equal(t, tc.err.Error(), problem.Detail)
+		})
+	}
+}
+
+func TestFromErrorFieldErrors(t *testing.T) {
+	problem := problems.FromError(&tasks.InvalidTaskDataError{Field: "due_date", Message: "invalid date"})
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, problems.FieldError{Field: "due_date", Code: "invalid_task_data", Message: "invalid date"}, problem.Errors[0])
+
+	// Ошибка объекта-значения относится к полю, которое он проверяет
+	err := &tasks_vo.InvalidTitleError{Message: "title cannot be empty"}
+	problem = problems.FromError(err)
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, problems.FieldError{Field: "title", Code: "invalid_title", Message: "title cannot be empty"}, problem.Errors[0])
+
+	// Ошибка без поля не добавляет ошибок полей
+	problem = problems.FromError(&tasks.InvalidTaskDataError{Message: "task cannot be nil"})
+	assert.Empty(t, problem.Errors)
+}
+
+func TestFromErrorHidesInternalErrors(t *testing.T) {
+	for _, err := range []error{
+		&tasks.TaskOperationFailedError{Operation: "get", Reason: "connection refused"},
+		&users.UserOperationFailedError{Operation: "create", Reason: "disk full"},
+		errors.New("unexpected"),
+	} {
+		problem := problems.FromError(err)
+		assert.Equal(t, http.StatusInternalServerError, problem.Status)
+		assert.Equal(t, problems.CodeInternal, problem.Code)
+		assert.NotContains(t, problem.Detail, err.Error())
+		assert.Empty(t, problem.Errors)
+	}
+}
+
+func TestFromErrorReferences(t *testing.T) {
+	err := &projects.ProjectNotFoundError{ProjectID: uuid.New()}
+	reference := problems.Reference{Field: "project_id", Match: projects.IsProjectNotFound}
+
+	problem := problems.FromError(err, reference)
+	assert.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+	assert.Equal(t, "project_not_found", problem.Code)
+	require.Len(t, problem.Errors, 1)
+	assert.Equal(t, "project_id", problem.Errors[0].Field)
+
+	// Ссылка не меняет описание других ошибок
+	problem = problems.FromError(&tasks.TaskNotFoundError{TaskID: uuid.New()}, reference)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Empty(t, problem.Errors)
+}
+
+func TestWrite(t *testing.T) {
+	request := httptest.NewRequest(http.MethodGet, "/api/v1/tasks/123", nil)
+	recorder := httptest.NewRecorder()
+
+	problems.InvalidParameter(recorder, request, "id", "Invalid task ID")
+
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.Equal(t, problems.ContentType, recorder.Header().Get("Content-Type"))
+
+	var body map[string]interface{}
+	require.NoError(t, json.NewDecoder(recorder.Body).Decode(&body))
+	assert.Equal(t, "about:blank", body["type"])
+	assert.Equal(t, "Bad Request", body["title"])
+	assert.Equal(t, float64(http.StatusBadRequest), body["status"])
+	assert.Equal(t, "Invalid task ID", body["detail"])
+	assert.Equal(t, "/api/v1/tasks/123", body["instance"])
+	assert.Equal(t, problems.CodeInvalidParameter, body["code"])
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"field":   "id",
+		"code":    problems.CodeInvalidParameter,
+		"message": "Invalid task ID",
+	}}, body["errors"])
+}
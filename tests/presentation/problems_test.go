@@ -0,0 +1,136 @@
+package presentation
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"crud/internal/domain/tasks"
+	"crud/internal/presentation/api/v1/problems"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+	v1_users "crud/internal/presentation/api/v1/users"
+	"crud/tests"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unavailableTasksRepository репозиторий задач, чтение из которого завершается ошибкой базы данных
+type unavailableTasksRepository struct {
+	tasks.BaseTasksRepository
+}
+
+func (r *unavailableTasksRepository) GetByID(ctx context.Context, id uuid.UUID) (*tasks.Task, error) {
+	return nil, &tasks.TaskOperationFailedError{Operation: "get", Reason: "connection refused"}
+}
+
+func TestProblemDetails(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, token := CreateUserAndLoginViaHTTP(t, router, "problems@example.com", "Problems User")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	t.Run("validation error names the field", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{Status: "todo"})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Equal(t, problems.ContentType, response.Header().Get("Content-Type"))
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, "invalid_title", problem.Code)
+		assert.Equal(t, "/api/v1/tasks", problem.Instance)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "title", problem.Errors[0].Field)
+		assert.Equal(t, "invalid_title", problem.Errors[0].Code)
+	})
+
+	t.Run("invalid date names the field", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+			Title:   "Dated",
+			Status:  "todo",
+			DueDate: "tomorrow",
+		})
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, "invalid_task_data", problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "due_date", problem.Errors[0].Field)
+	})
+
+	t.Run("invalid path parameter", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/not-a-uuid", nil)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, problems.CodeInvalidParameter, problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "id", problem.Errors[0].Field)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+uuid.NewString(), nil)
+		require.Equal(t, http.StatusNotFound, response.Code)
+		assert.Equal(t, "task_not_found", DecodeJSONResponse[problems.Problem](t, response).Code)
+	})
+
+	t.Run("missing referenced project", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+			ProjectID: uuid.NewString(),
+			Title:     "In a project",
+			Status:    "todo",
+		})
+		require.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, "project_not_found", problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "project_id", problem.Errors[0].Field)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/users", v1_users.CreateUserRequest{
+			Email:    owner.Email,
+			Name:     "Duplicate",
+			Password: "password123",
+		})
+		require.Equal(t, http.StatusConflict, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		assert.Equal(t, "user_already_exists", problem.Code)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "email", problem.Errors[0].Field)
+	})
+
+	t.Run("forbidden", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/audit", nil)
+		require.Equal(t, http.StatusForbidden, response.Code)
+		assert.Equal(t, "forbidden", DecodeJSONResponse[problems.Problem](t, response).Code)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		response := ExecuteRequest(router, http.MethodGet, "/api/v1/tasks", nil)
+		require.Equal(t, http.StatusUnauthorized, response.Code)
+		assert.Equal(t, problems.ContentType, response.Header().Get("Content-Type"))
+		assert.NotEmpty(t, response.Header().Get("WWW-Authenticate"))
+		assert.Equal(t, "unauthenticated", DecodeJSONResponse[problems.Problem](t, response).Code)
+	})
+}
+
+func TestProblemDetailsForStorageFailure(t *testing.T) {
+	container := tests.NewTestContainer()
+	require.NoError(t, container.Decorate(func(repo tasks.BaseTasksRepository) tasks.BaseTasksRepository {
+		return &unavailableTasksRepository{BaseTasksRepository: repo}
+	}))
+	router := NewTestRouter(container)
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "outage@example.com", "Outage User")
+
+	// Сбой хранилища - ошибка сервера, а не отсутствующая задача, и его причина не раскрывается клиенту
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+uuid.NewString(), nil)
+	require.Equal(t, http.StatusInternalServerError, response.Code)
+
+	problem := DecodeJSONResponse[problems.Problem](t, response)
+	assert.Equal(t, problems.CodeInternal, problem.Code)
+	assert.NotContains(t, problem.Detail, "connection refused")
+}
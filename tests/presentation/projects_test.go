@@ -0,0 +1,122 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	v1_projects "crud/internal/presentation/api/v1/projects"
+	v1_tasks "crud/internal/presentation/api/v1/tasks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsCRUD(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "projects@example.com", "Projects User")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "projects-other@example.com", "Other User")
+
+	project := CreateProjectViaHTTP(t, router, ownerToken, " Website ")
+	assert.Equal(t, "Website", project.Name)
+	assert.Equal(t, owner.ID, project.UserID)
+
+	response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/projects", v1_projects.CreateProjectRequest{Name: ""})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	path := "/api/v1/projects/" + project.ID
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/projects", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, data, 1)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/projects", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(0), total)
+
+	name := "Website v2"
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPut, path, v1_projects.UpdateProjectRequest{Name: &name})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "Website v2", DecodeJSONResponse[v1_projects.ProjectResponse](t, response).Name)
+
+	// Проект с задачами удалить нельзя
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ProjectID: project.ID,
+		Title:     "Landing page",
+		Status:    "todo",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	task := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	// Пустая строка убирает задачу из проекта
+	empty := ""
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPut, "/api/v1/tasks/"+task.ID, v1_tasks.UpdateTaskRequest{ProjectID: &empty})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Nil(t, DecodeJSONResponse[v1_tasks.TaskResponse](t, response).ProjectID)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, response.Code)
+}
+
+func TestTasksByProject(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "project-tasks@example.com", "Project Tasks")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "project-tasks-other@example.com", "Other User")
+
+	project := CreateProjectViaHTTP(t, router, ownerToken, "Backend")
+	foreign := CreateProjectViaHTTP(t, router, otherToken, "Foreign")
+
+	response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ProjectID: project.ID,
+		Title:     "API",
+		Status:    "todo",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	task := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	require.NotNil(t, task.ProjectID)
+	assert.Equal(t, project.ID, *task.ProjectID)
+
+	CreateTaskViaHTTP(t, router, ownerToken, owner.ID, "Unscoped", "", "todo")
+
+	// Чужой проект нельзя назначить задаче
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ProjectID: foreign.ID,
+		Title:     "Leak",
+		Status:    "todo",
+	})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ProjectID: "not-a-uuid",
+		Title:     "Broken",
+		Status:    "todo",
+	})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/tasks?project_id="+project.ID, nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Len(t, data, 1)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks?project_id="+project.ID, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/tasks?project_id=bad", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
@@ -1,6 +1,9 @@
 package presentation
 
 import (
+	"context"
+
+	users_usecases "crud/internal/application/users/usecases"
 	v1 "crud/internal/presentation/api/v1"
 	"crud/tests"
 
@@ -9,7 +12,16 @@ import (
 )
 
 // NewTestRouter создает новый тестовый chi роутер с настроенными маршрутами
+// и тестовым администратором
 func NewTestRouter(container *dig.Container) chi.Router {
+	ensureAdmin, err := tests.ResolveFromContainer[*users_usecases.EnsureAdminUseCase](container)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := ensureAdmin.Execute(context.Background()); err != nil {
+		panic(err)
+	}
+
 	r := chi.NewRouter()
 
 	// Настраиваем API v1 с тестовым контейнером
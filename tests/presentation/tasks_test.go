@@ -2,21 +2,25 @@ package presentation
 
 import (
 	"net/http"
+	"net/url"
 	"testing"
 
+	"crud/internal/presentation/api/v1/problems"
 	v1_tasks "crud/internal/presentation/api/v1/tasks"
 
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateTask(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	userResponse := CreateUserViaHTTP(t, router, "taskuser@example.com", "Task User")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "taskuser@example.com", "Task User")
 
 	// Создаем задачу
-	response := CreateTaskViaHTTP(t, router, userResponse.ID, "Test Task", "Test Description", "todo")
+	response := CreateTaskViaHTTP(t, router, token, userResponse.ID, "Test Task", "Test Description", "todo")
 
 	assert.Equal(t, "Test Task", response.Title)
 	assert.Equal(t, "Test Description", response.Description)
@@ -31,11 +35,11 @@ func TestGetTaskByID(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя и задачу
-	userResponse := CreateUserViaHTTP(t, router, "gettaskuser@example.com", "Get Task User")
-	createTaskResponse := CreateTaskViaHTTP(t, router, userResponse.ID, "Get Task", "Get Task Description", "in_progress")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "gettaskuser@example.com", "Get Task User")
+	createTaskResponse := CreateTaskViaHTTP(t, router, token, userResponse.ID, "Get Task", "Get Task Description", "in_progress")
 
 	// Получаем задачу по ID
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/tasks/"+createTaskResponse.ID, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+createTaskResponse.ID, nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	getResponse := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
@@ -50,7 +54,7 @@ func TestListTasks(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	userResponse := CreateUserViaHTTP(t, router, "listtasksuser@example.com", "List Tasks User")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "listtasksuser@example.com", "List Tasks User")
 
 	// Создаем несколько задач
 	tasks := []struct {
@@ -62,11 +66,11 @@ func TestListTasks(t *testing.T) {
 	}
 
 	for _, task := range tasks {
-		CreateTaskViaHTTP(t, router, userResponse.ID, task.title, task.description, task.status)
+		CreateTaskViaHTTP(t, router, token, userResponse.ID, task.title, task.description, task.status)
 	}
 
 	// Получаем список задач
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/tasks", nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks", nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	data, total := DecodeJSONListResponse(t, response)
@@ -78,14 +82,14 @@ func TestListTasksWithFilters(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	userResponse := CreateUserViaHTTP(t, router, "filtertasksuser@example.com", "Filter Tasks User")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "filtertasksuser@example.com", "Filter Tasks User")
 
 	// Создаем задачи с разными статусами
-	CreateTaskViaHTTP(t, router, userResponse.ID, "Todo Task", "Description", "todo")
-	CreateTaskViaHTTP(t, router, userResponse.ID, "Done Task", "Description", "done")
+	CreateTaskViaHTTP(t, router, token, userResponse.ID, "Todo Task", "Description", "todo")
+	CreateTaskViaHTTP(t, router, token, userResponse.ID, "Done Task", "Description", "done")
 
 	// Получаем список задач с фильтром по статусу
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/tasks?status=todo&user_id="+userResponse.ID, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?status=todo&user_id="+userResponse.ID, nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 }
 
@@ -93,8 +97,8 @@ func TestUpdateTask(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя и задачу
-	userResponse := CreateUserViaHTTP(t, router, "updatetaskuser@example.com", "Update Task User")
-	createTaskResponse := CreateTaskViaHTTP(t, router, userResponse.ID, "Update Task", "Original Description", "todo")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "updatetaskuser@example.com", "Update Task User")
+	createTaskResponse := CreateTaskViaHTTP(t, router, token, userResponse.ID, "Update Task", "Original Description", "todo")
 
 	// Обновляем задачу
 	updatedTitle := "Updated Task Title"
@@ -106,7 +110,7 @@ func TestUpdateTask(t *testing.T) {
 		Status:      &updatedStatus,
 	}
 
-	response := ExecuteRequest(router, http.MethodPut, "/api/v1/tasks/"+createTaskResponse.ID, updateReqBody)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/tasks/"+createTaskResponse.ID, updateReqBody)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	updateResponse := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
@@ -121,14 +125,477 @@ func TestDeleteTask(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя и задачу
-	userResponse := CreateUserViaHTTP(t, router, "deletetaskuser@example.com", "Delete Task User")
-	createTaskResponse := CreateTaskViaHTTP(t, router, userResponse.ID, "Delete Task", "Delete Description", "todo")
+	userResponse, token := CreateUserAndLoginViaHTTP(t, router, "deletetaskuser@example.com", "Delete Task User")
+	createTaskResponse := CreateTaskViaHTTP(t, router, token, userResponse.ID, "Delete Task", "Delete Description", "todo")
 
 	// Удаляем задачу
-	response := ExecuteRequest(router, http.MethodDelete, "/api/v1/tasks/"+createTaskResponse.ID, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodDelete, "/api/v1/tasks/"+createTaskResponse.ID, nil)
 	assert.Equal(t, http.StatusNoContent, response.Code)
 
 	// Проверяем, что задача действительно удалена
-	response = ExecuteRequest(router, http.MethodGet, "/api/v1/tasks/"+createTaskResponse.ID, nil)
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+createTaskResponse.ID, nil)
 	assert.Equal(t, http.StatusNotFound, response.Code)
 }
+
+func TestTaskOwnership(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "owner@example.com", "Task Owner")
+	other, otherToken := CreateUserAndLoginViaHTTP(t, router, "other@example.com", "Other User")
+
+	task := CreateTaskViaHTTP(t, router, ownerToken, "", "Owner Task", "Description", "todo")
+	assert.Equal(t, owner.ID, task.UserID)
+
+	// Чужую задачу нельзя получить, изменить или удалить
+	response := ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks/"+task.ID, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	updatedTitle := "Hijacked"
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodPut, "/api/v1/tasks/"+task.ID, v1_tasks.UpdateTaskRequest{
+		Title: &updatedTitle,
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodDelete, "/api/v1/tasks/"+task.ID, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	// Нельзя создать задачу от имени другого пользователя
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		UserID: owner.ID,
+		Title:  "Foreign Task",
+		Status: "todo",
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	// Список по умолчанию содержит только свои задачи
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks", nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Empty(t, data)
+	assert.Equal(t, int64(0), total)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks?user_id="+owner.ID, nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks?user_id="+other.ID, nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/tasks?user_id=not-a-uuid", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestTaskDueDates(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "dates@example.com", "Dates User")
+
+	// Дата без времени трактуется в часовом поясе запроса: конец дня 2020-01-01 в Токио
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		Title:    "Overdue Task",
+		Status:   "todo",
+		DueDate:  "2020-01-01",
+		Timezone: "Asia/Tokyo",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	overdueTask := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	require.NotNil(t, overdueTask.DueDate)
+	assert.Equal(t, "2020-01-01T14:59:59Z", *overdueTask.DueDate)
+	assert.True(t, overdueTask.Overdue)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		Title:   "Future Task",
+		Status:  "todo",
+		DueDate: "2999-01-01T10:00:00+03:00",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	futureTask := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	assert.Equal(t, "2999-01-01T07:00:00Z", *futureTask.DueDate)
+	assert.False(t, futureTask.Overdue)
+
+	// Фильтр просроченных задач
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?overdue=true", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, overdueTask.ID, data[0].(map[string]interface{})["id"])
+
+	// Фильтр по сроку выполнения в часовом поясе клиента
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?due_after=2020-01-01&timezone=Asia/Tokyo", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(2), total)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?due_after=2020-01-02&timezone=Asia/Tokyo", nil)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+
+	// Снятие срока выполнения
+	empty := ""
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/tasks/"+overdueTask.ID, v1_tasks.UpdateTaskRequest{
+		DueDate: &empty,
+	})
+	require.Equal(t, http.StatusOK, response.Code)
+	updatedTask := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	assert.Nil(t, updatedTask.DueDate)
+	assert.False(t, updatedTask.Overdue)
+
+	// Некорректные параметры
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?timezone=Mars/Olympus", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?due_before=tomorrow", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		Title:     "Broken Task",
+		Status:    "todo",
+		StartDate: "2026-02-10",
+		DueDate:   "2026-02-01",
+	})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestListTasksSortedByPriority(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "priority@example.com", "Priority User")
+
+	for _, priority := range []string{"low", "urgent", "medium"} {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+			Title:    priority + " task",
+			Status:   "todo",
+			Priority: priority,
+		})
+		require.Equal(t, http.StatusCreated, response.Code)
+		task := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+		assert.Equal(t, priority, task.Priority)
+	}
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?sort=priority", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, _ := DecodeJSONListResponse(t, response)
+	require.Len(t, data, 3)
+	assert.Equal(t, "urgent", data[0].(map[string]interface{})["priority"])
+	assert.Equal(t, "medium", data[1].(map[string]interface{})["priority"])
+	assert.Equal(t, "low", data[2].(map[string]interface{})["priority"])
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?sort=color", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		Title:    "Bad priority",
+		Status:   "todo",
+		Priority: "critical",
+	})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestTaskTransitions(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "workflow@example.com", "Workflow User")
+	task := CreateTaskViaHTTP(t, router, token, "", "Workflow Task", "Description", "todo")
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/workflow", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	workflow := DecodeJSONResponse[v1_tasks.WorkflowResponse](t, response)
+	assert.Equal(t, []string{"todo", "in_progress", "done"}, workflow.Statuses)
+	assert.Equal(t, []string{"todo"}, workflow.Initial)
+	assert.Equal(t, []string{"done"}, workflow.Closed)
+	assert.NotEmpty(t, workflow.Transitions)
+
+	// Задачу нельзя создать в статусе, который не является начальным
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks",
+		v1_tasks.CreateTaskRequest{Title: "Already done", Status: "done"})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	path := "/api/v1/tasks/" + task.ID + "/transitions"
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, path, v1_tasks.TransitionTaskRequest{Status: "done"})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Equal(t, "done", DecodeJSONResponse[v1_tasks.TaskResponse](t, response).Status)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, path, v1_tasks.TransitionTaskRequest{Status: "todo"})
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, path, v1_tasks.TransitionTaskRequest{Status: "archived"})
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestTaskSubtasks(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "subtasks@example.com", "Subtasks User")
+	root := CreateTaskViaHTTP(t, router, token, "", "Root", "Description", "todo")
+
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ParentID: root.ID,
+		Title:    "Child",
+		Status:   "todo",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+	child := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+	require.NotNil(t, child.ParentID)
+	assert.Equal(t, root.ID, *child.ParentID)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+child.ID+"/transitions",
+		v1_tasks.TransitionTaskRequest{Status: "done"})
+	require.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ParentID: child.ID,
+		Title:    "Grandchild",
+		Status:   "todo",
+	})
+	require.Equal(t, http.StatusCreated, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+root.ID+"/children", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+root.ID+"/subtree", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	_, total = DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(2), total)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+root.ID+"/progress", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	progress := DecodeJSONResponse[v1_tasks.TaskProgressResponse](t, response)
+	assert.Equal(t, 2, progress.Total)
+	assert.Equal(t, 1, progress.Done)
+	assert.Equal(t, 50, progress.Percent)
+
+	// Задача не может стать подзадачей своего потомка
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/tasks/"+root.ID, v1_tasks.UpdateTaskRequest{ParentID: &child.ID})
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", v1_tasks.CreateTaskRequest{
+		ParentID: uuid.NewString(),
+		Title:    "Orphan",
+		Status:   "todo",
+	})
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodDelete, "/api/v1/tasks/"+root.ID, nil)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	// Пустой parent_id делает задачу корневой
+	empty := ""
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/tasks/"+child.ID, v1_tasks.UpdateTaskRequest{ParentID: &empty})
+	require.Equal(t, http.StatusOK, response.Code)
+	assert.Nil(t, DecodeJSONResponse[v1_tasks.TaskResponse](t, response).ParentID)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodDelete, "/api/v1/tasks/"+root.ID, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+}
+
+func TestTaskDependencies(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "dependencies@example.com", "Dependencies User")
+	task := CreateTaskViaHTTP(t, router, token, "", "Release", "Description", "todo")
+	blocker := CreateTaskViaHTTP(t, router, token, "", "Fix bugs", "Description", "todo")
+
+	path := "/api/v1/tasks/" + task.ID + "/dependencies"
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPost, path, v1_tasks.AddTaskDependencyRequest{BlockerID: blocker.ID})
+	require.Equal(t, http.StatusOK, response.Code)
+	dependencies := DecodeJSONResponse[v1_tasks.TaskDependenciesResponse](t, response)
+	require.Len(t, dependencies.Blockers, 1)
+	assert.Equal(t, blocker.ID, dependencies.Blockers[0].ID)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks/"+blocker.ID+"/dependencies", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	dependencies = DecodeJSONResponse[v1_tasks.TaskDependenciesResponse](t, response)
+	assert.Empty(t, dependencies.Blockers)
+	require.Len(t, dependencies.Dependents, 1)
+	assert.Equal(t, task.ID, dependencies.Dependents[0].ID)
+
+	// Обратная зависимость замкнула бы цикл
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+blocker.ID+"/dependencies",
+		v1_tasks.AddTaskDependencyRequest{BlockerID: task.ID})
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, path, v1_tasks.AddTaskDependencyRequest{BlockerID: uuid.NewString()})
+	assert.Equal(t, http.StatusUnprocessableEntity, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+task.ID+"/transitions",
+		v1_tasks.TransitionTaskRequest{Status: "in_progress"})
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?blocked=false", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	data, total := DecodeJSONListResponse(t, response)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, blocker.ID, data[0].(map[string]interface{})["id"])
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?blocked=maybe", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodDelete, path+"/"+blocker.ID, nil)
+	assert.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+task.ID+"/transitions",
+		v1_tasks.TransitionTaskRequest{Status: "in_progress"})
+	assert.Equal(t, http.StatusOK, response.Code)
+}
+
+func TestListTasksByCursor(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "cursor@example.com", "Cursor User")
+
+	var titles []string
+	for _, title := range []string{"First", "Second", "Third", "Fourth", "Fifth"} {
+		CreateTaskViaHTTP(t, router, token, "", title, "", "todo")
+		titles = append(titles, title)
+	}
+
+	// listPage возвращает заголовки задач страницы и тело ответа
+	listPage := func(t *testing.T, query string) ([]string, map[string]interface{}) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?"+query, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		body := DecodeJSONResponse[map[string]interface{}](t, response)
+		var pageTitles []string
+		for _, item := range body["data"].([]interface{}) {
+			pageTitles = append(pageTitles, item.(map[string]interface{})["title"].(string))
+		}
+		return pageTitles, body
+	}
+
+	t.Run("walk forward and back", func(t *testing.T) {
+		page, body := listPage(t, "page_size=2")
+		assert.Equal(t, titles[:2], page)
+		assert.NotContains(t, body, "prev_cursor")
+		next := body["next_cursor"].(string)
+
+		page, body = listPage(t, "page_size=2&cursor="+next)
+		assert.Equal(t, titles[2:4], page)
+		assert.NotContains(t, body, "total")
+		assert.NotContains(t, body, "page")
+		next = body["next_cursor"].(string)
+		prev := body["prev_cursor"].(string)
+
+		page, body = listPage(t, "page_size=2&cursor="+next)
+		assert.Equal(t, titles[4:], page)
+		assert.NotContains(t, body, "next_cursor")
+
+		page, body = listPage(t, "page_size=2&cursor="+prev)
+		assert.Equal(t, titles[:2], page)
+		assert.NotContains(t, body, "prev_cursor")
+		assert.Contains(t, body, "next_cursor")
+	})
+
+	t.Run("page mode links to cursors", func(t *testing.T) {
+		page, body := listPage(t, "page=2&page_size=2")
+		assert.Equal(t, titles[2:4], page)
+		assert.Equal(t, float64(5), body["total"])
+
+		page, _ = listPage(t, "page_size=2&cursor="+body["next_cursor"].(string))
+		assert.Equal(t, titles[4:], page)
+
+		page, _ = listPage(t, "page_size=2&cursor="+body["prev_cursor"].(string))
+		assert.Equal(t, titles[:2], page)
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?cursor=not-a-cursor", nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("cursor with page", func(t *testing.T) {
+		_, body := listPage(t, "page_size=2")
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet,
+			"/api/v1/tasks?page=2&cursor="+body["next_cursor"].(string), nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("cursor of another sort order", func(t *testing.T) {
+		_, body := listPage(t, "page_size=2")
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet,
+			"/api/v1/tasks?sort=priority&cursor="+body["next_cursor"].(string), nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestListTasksWithFilterExpression(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	_, token := CreateUserAndLoginViaHTTP(t, router, "expression@example.com", "Expression User")
+
+	for _, request := range []v1_tasks.CreateTaskRequest{
+		{Title: "Write report", Status: "todo", Priority: "high", DueDate: "2030-07-01"},
+		{Title: "Review report", Status: "in_progress", Priority: "urgent"},
+		{Title: "Fix login", Status: "done", Priority: "low", DueDate: "2030-07-02"},
+	} {
+		status := request.Status
+		request.Status = "todo"
+		response := ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks", request)
+		require.Equal(t, http.StatusCreated, response.Code)
+
+		if status != request.Status {
+			created := DecodeJSONResponse[v1_tasks.TaskResponse](t, response)
+			response = ExecuteAuthorizedRequest(router, token, http.MethodPost, "/api/v1/tasks/"+created.ID+"/transitions",
+				v1_tasks.TransitionTaskRequest{Status: status})
+			require.Equal(t, http.StatusOK, response.Code)
+		}
+	}
+
+	// listTitles возвращает заголовки задач первой страницы для параметров запроса
+	listTitles := func(t *testing.T, query url.Values) []string {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?"+query.Encode(), nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		data, _ := DecodeJSONListResponse(t, response)
+		titles := make([]string, len(data))
+		for i, item := range data {
+			titles[i] = item.(map[string]interface{})["title"].(string)
+		}
+		return titles
+	}
+
+	t.Run("filter", func(t *testing.T) {
+		titles := listTitles(t, url.Values{"filter": {`title contains report and (priority = urgent or due_date <= 2030-07-01)`}})
+		assert.Equal(t, []string{"Write report", "Review report"}, titles)
+
+		titles = listTitles(t, url.Values{"filter": {"status in (todo, done)"}, "sort": {"-due_date"}})
+		assert.Equal(t, []string{"Fix login", "Write report"}, titles)
+	})
+
+	t.Run("sort by fields", func(t *testing.T) {
+		titles := listTitles(t, url.Values{"sort": {"title"}})
+		assert.Equal(t, []string{"Fix login", "Review report", "Write report"}, titles)
+
+		titles = listTitles(t, url.Values{"sort": {"-priority,title"}})
+		assert.Equal(t, []string{"Review report", "Write report", "Fix login"}, titles)
+	})
+
+	t.Run("malformed filter", func(t *testing.T) {
+		query := url.Values{"filter": {"status = todo and"}}
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?"+query.Encode(), nil)
+		require.Equal(t, http.StatusBadRequest, response.Code)
+
+		problem := DecodeJSONResponse[problems.Problem](t, response)
+		require.Len(t, problem.Errors, 1)
+		assert.Equal(t, "filter", problem.Errors[0].Field)
+		assert.Contains(t, problem.Errors[0].Message, "at position 18")
+	})
+
+	t.Run("unknown sort field", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?sort=-color", nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+
+	t.Run("sort by fields has no cursors", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?sort=title&page_size=1", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.NotContains(t, DecodeJSONResponse[map[string]interface{}](t, response), "next_cursor")
+
+		response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?page_size=1", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		next := DecodeJSONResponse[map[string]interface{}](t, response)["next_cursor"].(string)
+
+		response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/tasks?sort=title&cursor="+next, nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
@@ -0,0 +1,112 @@
+package presentation
+
+import (
+	"net/http"
+	"testing"
+
+	v1_trash "crud/internal/presentation/api/v1/trash"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// deletedTasksPage страница задач в корзине
+type deletedTasksPage struct {
+	Data  []v1_trash.DeletedTaskResponse `json:"data"`
+	Total int64                          `json:"total"`
+}
+
+// deletedUsersPage страница пользователей в корзине
+type deletedUsersPage struct {
+	Data  []v1_trash.DeletedUserResponse `json:"data"`
+	Total int64                          `json:"total"`
+}
+
+func TestTaskTrashEndpoints(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	owner, ownerToken := CreateUserAndLoginViaHTTP(t, router, "trash-http@example.com", "Owner")
+	_, otherToken := CreateUserAndLoginViaHTTP(t, router, "trash-http-other@example.com", "Other")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	task := CreateTaskViaHTTP(t, router, ownerToken, owner.ID, "Trashed", "", "todo")
+	response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, "/api/v1/tasks/"+task.ID, nil)
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	t.Run("list", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/trash/tasks", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		page := DecodeJSONResponse[deletedTasksPage](t, response)
+		require.Equal(t, int64(1), page.Total)
+		assert.Equal(t, task.ID, page.Data[0].ID)
+		assert.NotEmpty(t, page.Data[0].DeletedAt)
+
+		response = ExecuteAuthorizedRequest(router, otherToken, http.MethodGet, "/api/v1/trash/tasks", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+		assert.Equal(t, int64(0), DecodeJSONResponse[deletedTasksPage](t, response).Total)
+	})
+
+	t.Run("restore", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, otherToken, http.MethodPost, "/api/v1/trash/tasks/"+task.ID+"/restore", nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/trash/tasks/"+task.ID+"/restore", nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodGet, "/api/v1/tasks/"+task.ID, nil)
+		assert.Equal(t, http.StatusOK, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/trash/tasks/"+task.ID+"/restore", nil)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("purge", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, "/api/v1/tasks/"+task.ID, nil)
+		require.Equal(t, http.StatusNoContent, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, ownerToken, http.MethodDelete, "/api/v1/trash/tasks/"+task.ID, nil)
+		assert.Equal(t, http.StatusForbidden, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/trash/tasks/"+task.ID, nil)
+		assert.Equal(t, http.StatusNoContent, response.Code)
+
+		response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/trash/tasks/"+task.ID, nil)
+		assert.Equal(t, http.StatusNotFound, response.Code)
+	})
+
+	t.Run("invalid id", func(t *testing.T) {
+		response := ExecuteAuthorizedRequest(router, ownerToken, http.MethodPost, "/api/v1/trash/tasks/nope/restore", nil)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+	})
+}
+
+func TestUserTrashEndpoints(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user, userToken := CreateUserAndLoginViaHTTP(t, router, "trash-user-http@example.com", "User")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	CreateTaskViaHTTP(t, router, userToken, user.ID, "Owned", "", "done")
+
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/users/"+user.ID, nil)
+	require.Equal(t, http.StatusNoContent, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, userToken, http.MethodGet, "/api/v1/trash/users", nil)
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/trash/users", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	page := DecodeJSONResponse[deletedUsersPage](t, response)
+	require.Equal(t, int64(1), page.Total)
+	assert.Equal(t, user.ID, page.Data[0].ID)
+
+	// Пользователь с задачами не удаляется окончательно
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/trash/users/"+user.ID, nil)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodPost, "/api/v1/trash/users/"+user.ID+"/restore", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/users/"+user.ID, nil)
+	assert.Equal(t, http.StatusOK, response.Code)
+}
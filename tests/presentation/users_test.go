@@ -7,15 +7,19 @@ import (
 	v1_users "crud/internal/presentation/api/v1/users"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateUser(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
-	response := CreateUserViaHTTP(t, router, "test@example.com", "Test User")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	response := CreateUserViaHTTP(t, router, adminToken, "test@example.com", "Test User", "")
 
 	assert.Equal(t, "test@example.com", response.Email)
 	assert.Equal(t, "Test User", response.Name)
+	assert.Equal(t, "member", response.Role)
 	assert.NotEmpty(t, response.ID)
 	assert.NotEmpty(t, response.CreatedAt)
 	assert.NotEmpty(t, response.UpdatedAt)
@@ -25,10 +29,10 @@ func TestGetUserByID(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	createResponse := CreateUserViaHTTP(t, router, "get@example.com", "Get User")
+	createResponse, token := CreateUserAndLoginViaHTTP(t, router, "get@example.com", "Get User")
 
 	// Получаем пользователя по ID
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/users/"+createResponse.ID, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/users/"+createResponse.ID, nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	getResponse := DecodeJSONResponse[v1_users.UserResponse](t, response)
@@ -42,10 +46,10 @@ func TestGetUserByEmail(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	createResponse := CreateUserViaHTTP(t, router, "email@example.com", "Email User")
+	createResponse, token := CreateUserAndLoginViaHTTP(t, router, "email@example.com", "Email User")
 
 	// Получаем пользователя по email
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/users/email/"+createResponse.Email, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/users/email/"+createResponse.Email, nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	getResponse := DecodeJSONResponse[v1_users.UserResponse](t, response)
@@ -63,12 +67,14 @@ func TestListUsers(t *testing.T) {
 	var createdUsers []v1_users.UserResponse
 
 	for i, email := range emails {
-		userResponse := CreateUserViaHTTP(t, router, email, "List User "+string(rune('1'+i)))
+		userResponse := RegisterUserViaHTTP(t, router, email, "List User "+string(rune('1'+i)))
 		createdUsers = append(createdUsers, *userResponse)
 	}
 
+	token := LoginViaHTTP(t, router, emails[0], TestPassword).AccessToken
+
 	// Получаем список пользователей
-	response := ExecuteRequest(router, http.MethodGet, "/api/v1/users", nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/users", nil)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	data, total := DecodeJSONListResponse(t, response)
@@ -80,7 +86,7 @@ func TestUpdateUser(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	createResponse := CreateUserViaHTTP(t, router, "update@example.com", "Update User")
+	createResponse, token := CreateUserAndLoginViaHTTP(t, router, "update@example.com", "Update User")
 
 	// Обновляем пользователя
 	updatedName := "Updated Name"
@@ -88,7 +94,7 @@ func TestUpdateUser(t *testing.T) {
 		Name: &updatedName,
 	}
 
-	response := ExecuteRequest(router, http.MethodPut, "/api/v1/users/"+createResponse.ID, updateReqBody)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/users/"+createResponse.ID, updateReqBody)
 	assert.Equal(t, http.StatusOK, response.Code)
 
 	updateResponse := DecodeJSONResponse[v1_users.UserResponse](t, response)
@@ -98,17 +104,85 @@ func TestUpdateUser(t *testing.T) {
 	assert.Equal(t, "Updated Name", updateResponse.Name)
 }
 
+func TestUpdateAnotherUserPassword(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	victim := RegisterUserViaHTTP(t, router, "victim@example.com", "Victim")
+	_, token := CreateUserAndLoginViaHTTP(t, router, "attacker@example.com", "Attacker")
+
+	// Участник не может сменить пароль другому пользователю
+	password := "attacker-password"
+	response := ExecuteAuthorizedRequest(router, token, http.MethodPut, "/api/v1/users/"+victim.ID, v1_users.UpdateUserRequest{
+		Password: &password,
+	})
+	assert.Equal(t, http.StatusForbidden, response.Code)
+
+	// Прежний пароль по-прежнему действует
+	LoginViaHTTP(t, router, "victim@example.com", TestPassword)
+}
+
 func TestDeleteUser(t *testing.T) {
 	router := NewTestRouterWithContainer()
 
 	// Создаем пользователя
-	createResponse := CreateUserViaHTTP(t, router, "delete@example.com", "Delete User")
+	createResponse := RegisterUserViaHTTP(t, router, "delete@example.com", "Delete User")
+	token := LoginAsAdminViaHTTP(t, router)
 
 	// Удаляем пользователя
-	response := ExecuteRequest(router, http.MethodDelete, "/api/v1/users/"+createResponse.ID, nil)
+	response := ExecuteAuthorizedRequest(router, token, http.MethodDelete, "/api/v1/users/"+createResponse.ID, nil)
 	assert.Equal(t, http.StatusNoContent, response.Code)
 
 	// Проверяем, что пользователь действительно удален
-	response = ExecuteRequest(router, http.MethodGet, "/api/v1/users/"+createResponse.ID, nil)
+	response = ExecuteAuthorizedRequest(router, token, http.MethodGet, "/api/v1/users/"+createResponse.ID, nil)
 	assert.Equal(t, http.StatusNotFound, response.Code)
 }
+
+func TestDeleteUserWithOpenTasks(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	user, userToken := CreateUserAndLoginViaHTTP(t, router, "busy@example.com", "Busy User")
+	CreateTaskViaHTTP(t, router, userToken, user.ID, "Open Task", "", "todo")
+	adminToken := LoginAsAdminViaHTTP(t, router)
+
+	// Политика restrict по умолчанию запрещает удаление
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/users/"+user.ID, nil)
+	assert.Equal(t, http.StatusConflict, response.Code)
+
+	// reassign_to допустим только при политике reassign
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodDelete, "/api/v1/users/"+user.ID+"?reassign_to="+user.ID, nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
+
+func TestListUsersByCursor(t *testing.T) {
+	router := NewTestRouterWithContainer()
+
+	adminToken := LoginAsAdminViaHTTP(t, router)
+	for _, email := range []string{"cursor1@example.com", "cursor2@example.com", "cursor3@example.com"} {
+		CreateUserViaHTTP(t, router, adminToken, email, "Cursor User", "")
+	}
+
+	response := ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/users?page_size=100", nil)
+	require.Equal(t, http.StatusOK, response.Code)
+	all, total := DecodeJSONListResponse(t, response)
+	require.Len(t, all, int(total))
+
+	// Проход по курсорам возвращает тех же пользователей в том же порядке
+	var walked []interface{}
+	query := "page_size=2"
+	for pages := 0; pages < 10; pages++ {
+		response := ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/users?"+query, nil)
+		require.Equal(t, http.StatusOK, response.Code)
+
+		body := DecodeJSONResponse[map[string]interface{}](t, response)
+		walked = append(walked, body["data"].([]interface{})...)
+		next, ok := body["next_cursor"].(string)
+		if !ok {
+			break
+		}
+		query = "page_size=2&cursor=" + next
+	}
+	assert.Equal(t, all, walked)
+
+	response = ExecuteAuthorizedRequest(router, adminToken, http.MethodGet, "/api/v1/users?cursor=e30", nil)
+	assert.Equal(t, http.StatusBadRequest, response.Code)
+}
@@ -0,0 +1,34 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	application_tasks "crud/internal/application/tasks/usecases"
+	"crud/internal/domain/tasks"
+	vo "crud/internal/domain/tasks/value_objects"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+)
+
+// CreateTestTask создает задачу в начальном статусе и переводит ее в input.Status по рабочему процессу
+func CreateTestTask(t *testing.T, container *dig.Container, ctx context.Context, input application_tasks.CreateTaskInput) *tasks.Task {
+	t.Helper()
+
+	createUseCase, err := ResolveFromContainer[*application_tasks.CreateTaskUseCase](container)
+	require.NoError(t, err)
+	transitionUseCase, err := ResolveFromContainer[*application_tasks.TransitionTaskUseCase](container)
+	require.NoError(t, err)
+
+	status := input.Status
+	input.Status = vo.StatusTodo
+	task, err := createUseCase.Execute(ctx, input)
+	require.NoError(t, err)
+
+	if status != input.Status {
+		task, err = transitionUseCase.Execute(ctx, task.ID, status)
+		require.NoError(t, err)
+	}
+	return task
+}
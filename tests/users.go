@@ -0,0 +1,25 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	application_users "crud/internal/application/users/usecases"
+	"crud/internal/domain/users"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/dig"
+)
+
+// CreateTestUser регистрирует участника с указанным email и возвращает его
+func CreateTestUser(t *testing.T, container *dig.Container, email string) *users.User {
+	t.Helper()
+
+	registerUseCase, err := ResolveFromContainer[*application_users.RegisterUserUseCase](container)
+	require.NoError(t, err)
+
+	user, err := registerUseCase.Execute(DefaultOrganizationContext(context.Background()), email, "Test User", "password123")
+	require.NoError(t, err)
+
+	return user
+}